@@ -0,0 +1,25 @@
+// Package events contains the events emitted by the ocis services in addition to the events of reva.
+package events
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// AccountPurged is emitted when the settings values and role assignments of an account were removed
+type AccountPurged struct {
+	Executant          *user.UserId
+	AccountUUID        string
+	RemovedValues      uint32
+	RemovedAssignments uint32
+	Timestamp          *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (AccountPurged) Unmarshal(v []byte) (interface{}, error) {
+	e := AccountPurged{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
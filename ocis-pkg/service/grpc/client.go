@@ -23,6 +23,8 @@ var (
 type ClientOptions struct {
 	tlsMode string
 	caCert  string
+	cert    string
+	key     string
 }
 
 // Option is used to pass client options
@@ -42,6 +44,14 @@ func WithTLSCACert(v string) ClientOption {
 	}
 }
 
+// WithTLSCert allows to set the client certificate and key presented by grpc clients
+func WithTLSCert(cert, key string) ClientOption {
+	return func(o *ClientOptions) {
+		o.cert = cert
+		o.key = key
+	}
+}
+
 // Configure configures the default oOCIS grpc client (e.g. TLS settings)
 func Configure(opts ...ClientOption) error {
 	var options ClientOptions
@@ -81,6 +91,15 @@ func Configure(opts ...ClientOption) error {
 			}
 			cOpts = append(cOpts, mgrpcc.AuthTLS(tlsConfig))
 		}
+		// the certificate is only sent to services requiring client certificates
+		if tlsConfig != nil && options.cert != "" {
+			cert, err := tls.LoadX509KeyPair(options.cert, options.key)
+			if err != nil {
+				outerr = err
+				return
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
 
 		defaultClient = mgrpcc.NewClient(cOpts...)
 	})
@@ -96,6 +115,7 @@ func GetClientOptions(t *shared.GRPCClientTLS) []ClientOption {
 	opts := []ClientOption{
 		WithTLSMode(t.Mode),
 		WithTLSCACert(t.CACert),
+		WithTLSCert(t.Cert, t.Key),
 	}
 	return opts
 }
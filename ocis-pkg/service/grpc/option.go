@@ -5,6 +5,7 @@ import (
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/urfave/cli/v2"
+	"go-micro.dev/v4/server"
 )
 
 // Option defines a single option function.
@@ -20,8 +21,11 @@ type Options struct {
 	TLSEnabled bool
 	TLSCert    string
 	TLSKey     string
-	Context    context.Context
-	Flags      []cli.Flag
+	// TLSClientCA is the CA certificate verifying the certificates clients have to present
+	TLSClientCA     string
+	HandlerWrappers []server.HandlerWrapper
+	Context         context.Context
+	Flags           []cli.Flag
 }
 
 // newOptions initializes the available default options.
@@ -87,6 +91,20 @@ func TLSCert(c string, k string) Option {
 	}
 }
 
+// TLSClientCA provides a function to require client certificates signed by the CA
+func TLSClientCA(ca string) Option {
+	return func(o *Options) {
+		o.TLSClientCA = ca
+	}
+}
+
+// HandlerWrapper provides a function to wrap the handlers of the service
+func HandlerWrapper(w server.HandlerWrapper) Option {
+	return func(o *Options) {
+		o.HandlerWrappers = append(o.HandlerWrappers, w)
+	}
+}
+
 // Context provides a function to set the context option.
 func Context(ctx context.Context) Option {
 	return func(o *Options) {
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"go-micro.dev/v4/client"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+	"go-micro.dev/v4/server"
+)
+
+// SecretHeader is the metadata key of the shared secret authenticating the calls of a client.
+const SecretHeader = "Service-Secret"
+
+// NewSecretHandlerWrapper rejects the calls without the shared secret with Unauthenticated.
+func NewSecretHandlerWrapper(secret string) server.HandlerWrapper {
+	return func(h server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp interface{}) error {
+			s, _ := metadata.Get(ctx, SecretHeader)
+			if subtle.ConstantTimeCompare([]byte(s), []byte(secret)) != 1 {
+				return merrors.Unauthorized(req.Service(), "missing or invalid service secret")
+			}
+			return h(ctx, req, rsp)
+		}
+	}
+}
+
+// secretClient sends the shared secret with every call.
+type secretClient struct {
+	client.Client
+	secret string
+}
+
+// NewSecretClient wraps the client to authenticate its calls with the shared secret, an empty secret leaves
+// the client unchanged.
+func NewSecretClient(c client.Client, secret string) client.Client {
+	if secret == "" {
+		return c
+	}
+	return &secretClient{Client: c, secret: secret}
+}
+
+func (c *secretClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	return c.Client.Call(metadata.Set(ctx, SecretHeader, c.secret), req, rsp, opts...)
+}
+
+func (c *secretClient) Stream(ctx context.Context, req client.Request, opts ...client.CallOption) (client.Stream, error) {
+	return c.Client.Stream(metadata.Set(ctx, SecretHeader, c.secret), req, opts...)
+}
@@ -2,7 +2,9 @@ package grpc
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"strings"
 	"time"
 
@@ -43,6 +45,17 @@ func NewService(opts ...Option) (Service, error) {
 			}
 		}
 		tlsConfig.Certificates = []tls.Certificate{cert}
+		if sopts.TLSClientCA != "" {
+			pemData, err := ioutil.ReadFile(sopts.TLSClientCA)
+			if err != nil {
+				return Service{}, fmt.Errorf("grpc service error loading client CA certificate: %w", err)
+			}
+			tlsConfig.ClientCAs = x509.NewCertPool()
+			if !tlsConfig.ClientCAs.AppendCertsFromPEM(pemData) {
+				return Service{}, fmt.Errorf("grpc service error adding client CA certificate %s", sopts.TLSClientCA)
+			}
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
 		mServer = mgrpcs.NewServer(mgrpcs.AuthTLS(tlsConfig))
 	} else {
 		mServer = mgrpcs.NewServer()
@@ -66,6 +79,9 @@ func NewService(opts ...Option) (Service, error) {
 		micro.WrapHandler(opencensus.NewHandlerWrapper()),
 		micro.WrapSubscriber(opencensus.NewSubscriberWrapper()),
 	}
+	for _, w := range sopts.HandlerWrappers {
+		mopts = append(mopts, micro.WrapHandler(w))
+	}
 
 	return Service{micro.NewService(mopts...)}, nil
 }
@@ -38,6 +38,8 @@ type Reva struct {
 type GRPCClientTLS struct {
 	Mode   string `yaml:"mode" env:"OCIS_GRPC_CLIENT_TLS_MODE" desc:"TLS mode for grpc connection to the go-micro based grpc services. Possible values are 'off', 'insecure' and 'on'. 'off': disables transport security for the clients. 'insecure' allows to use transport security, but disables certificate verification (to be used with the autogenerated self-signed certificates). 'on' enables transport security, including server ceritificate verification."`
 	CACert string `yaml:"cacert env:"OCIS_GRPC_CLIENT_TLS_CACERT" desc:"The root CA certificate used to validate TLS server certificates of the go-micro based grpc services."`
+	Cert   string `yaml:"cert" env:"OCIS_GRPC_CLIENT_TLS_CERTIFICATE" desc:"Path/File name of the TLS client certificate (in PEM format) presented to the go-micro based grpc services requiring client certificates. Requires OCIS_GRPC_CLIENT_TLS_MODE to be 'insecure' or 'on'."`
+	Key    string `yaml:"key" env:"OCIS_GRPC_CLIENT_TLS_KEY" desc:"Path/File name of the key (in PEM format) of the TLS client certificate."`
 }
 
 type GRPCServiceTLS struct {
@@ -0,0 +1,150 @@
+package fallback
+
+import (
+	"errors"
+
+	"go-micro.dev/v4/store"
+)
+
+// FallbackStore reads from a primary store and falls back to a secondary store
+// when the primary doesn't have the requested records. Writes only go to the
+// primary store, so the secondary store is drained over time. This is meant
+// to be used while migrating from one store backend to another.
+type FallbackStore struct {
+	primary   store.Store
+	secondary store.Store
+	promote   bool
+}
+
+// NewFallbackStore creates a new go-micro store which reads from the primary
+// store first and from the secondary store on a miss. If promote is true,
+// records found in the secondary store are written to the primary store.
+func NewFallbackStore(primary, secondary store.Store, promote bool) store.Store {
+	return &FallbackStore{
+		primary:   primary,
+		secondary: secondary,
+		promote:   promote,
+	}
+}
+
+// Init initializes both stores with the given options.
+func (fs *FallbackStore) Init(opts ...store.Option) error {
+	if err := fs.primary.Init(opts...); err != nil {
+		return err
+	}
+	return fs.secondary.Init(opts...)
+}
+
+// Options returns the options of the primary store.
+func (fs *FallbackStore) Options() store.Options {
+	return fs.primary.Options()
+}
+
+// Read reads the records from the primary store. If there are none, they are
+// read from the secondary store and, if promotion is enabled, written to the
+// primary store. Failing to promote a record doesn't fail the read.
+func (fs *FallbackStore) Read(key string, opts ...store.ReadOption) ([]*store.Record, error) {
+	records, err := fs.primary.Read(key, opts...)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+	if len(records) > 0 {
+		return records, nil
+	}
+
+	records, err = fs.secondary.Read(key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, store.ErrNotFound
+	}
+
+	if fs.promote {
+		readOpts := store.ReadOptions{}
+		for _, o := range opts {
+			o(&readOpts)
+		}
+		for _, record := range records {
+			_ = fs.primary.Write(record, store.WriteTo(readOpts.Database, readOpts.Table))
+		}
+	}
+	return records, nil
+}
+
+// Write writes the record to the primary store.
+func (fs *FallbackStore) Write(r *store.Record, opts ...store.WriteOption) error {
+	return fs.primary.Write(r, opts...)
+}
+
+// Delete deletes the record from both stores, otherwise it would be read from
+// the secondary store again.
+func (fs *FallbackStore) Delete(key string, opts ...store.DeleteOption) error {
+	if err := fs.primary.Delete(key, opts...); err != nil && !errors.Is(err, store.ErrNotFound) {
+		return err
+	}
+	if err := fs.secondary.Delete(key, opts...); err != nil && !errors.Is(err, store.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// List returns the keys of both stores without duplicates. The limit and
+// offset are applied to the combined list.
+func (fs *FallbackStore) List(opts ...store.ListOption) ([]string, error) {
+	listOpts := store.ListOptions{}
+	for _, o := range opts {
+		o(&listOpts)
+	}
+	// limit and offset can only be applied after merging the keys
+	unpaginated := []store.ListOption{
+		store.ListFrom(listOpts.Database, listOpts.Table),
+		store.ListPrefix(listOpts.Prefix),
+		store.ListSuffix(listOpts.Suffix),
+	}
+
+	keys, err := fs.primary.List(unpaginated...)
+	if err != nil {
+		return nil, err
+	}
+	secondaryKeys, err := fs.secondary.List(unpaginated...)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		seen[k] = struct{}{}
+	}
+	for _, k := range secondaryKeys {
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			keys = append(keys, k)
+		}
+	}
+
+	if listOpts.Offset > 0 {
+		if listOpts.Offset >= uint(len(keys)) {
+			return []string{}, nil
+		}
+		keys = keys[listOpts.Offset:]
+	}
+	if listOpts.Limit > 0 && listOpts.Limit < uint(len(keys)) {
+		keys = keys[:listOpts.Limit]
+	}
+	return keys, nil
+}
+
+// Close closes both stores.
+func (fs *FallbackStore) Close() error {
+	err := fs.primary.Close()
+	if serr := fs.secondary.Close(); err == nil {
+		err = serr
+	}
+	return err
+}
+
+// String returns the name of the implementation.
+func (fs *FallbackStore) String() string {
+	return "fallback"
+}
@@ -0,0 +1,117 @@
+package fallback
+
+import (
+	"testing"
+
+	"go-micro.dev/v4/store"
+)
+
+func newStores(t *testing.T, promote bool) (store.Store, store.Store, store.Store) {
+	primary := store.NewMemoryStore()
+	secondary := store.NewMemoryStore()
+	if err := secondary.Write(&store.Record{Key: "old", Value: []byte("from secondary")}, store.WriteTo("db", "table")); err != nil {
+		t.Fatal(err)
+	}
+	return primary, secondary, NewFallbackStore(primary, secondary, promote)
+}
+
+func TestReadFallsBackOnMiss(t *testing.T) {
+	primary, _, fs := newStores(t, false)
+
+	records, err := fs.Read("old", store.ReadFrom("db", "table"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || string(records[0].Value) != "from secondary" {
+		t.Fatalf("unexpected records %v", records)
+	}
+
+	// without promotion the primary store stays untouched
+	if _, err := primary.Read("old", store.ReadFrom("db", "table")); err != store.ErrNotFound {
+		t.Fatalf("expected the record to be missing in the primary store, got %v", err)
+	}
+
+	if _, err := fs.Read("missing", store.ReadFrom("db", "table")); err != store.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestReadPrefersPrimary(t *testing.T) {
+	primary, _, fs := newStores(t, false)
+	if err := primary.Write(&store.Record{Key: "old", Value: []byte("from primary")}, store.WriteTo("db", "table")); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := fs.Read("old", store.ReadFrom("db", "table"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || string(records[0].Value) != "from primary" {
+		t.Fatalf("unexpected records %v", records)
+	}
+}
+
+func TestReadPromotesRecords(t *testing.T) {
+	primary, _, fs := newStores(t, true)
+
+	if _, err := fs.Read("old", store.ReadFrom("db", "table")); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := primary.Read("old", store.ReadFrom("db", "table"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || string(records[0].Value) != "from secondary" {
+		t.Fatalf("expected the record to be promoted, got %v", records)
+	}
+}
+
+func TestWriteAndDelete(t *testing.T) {
+	primary, secondary, fs := newStores(t, false)
+
+	if err := fs.Write(&store.Record{Key: "new", Value: []byte("v")}, store.WriteTo("db", "table")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := primary.Read("new", store.ReadFrom("db", "table")); err != nil {
+		t.Fatalf("expected the record in the primary store, got %v", err)
+	}
+	if _, err := secondary.Read("new", store.ReadFrom("db", "table")); err != store.ErrNotFound {
+		t.Fatalf("expected the record to be missing in the secondary store, got %v", err)
+	}
+
+	if err := fs.Delete("old", store.DeleteFrom("db", "table")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.Read("old", store.ReadFrom("db", "table")); err != store.ErrNotFound {
+		t.Fatalf("expected deleted record to be gone, got %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	primary, secondary, fs := newStores(t, false)
+	for _, k := range []string{"a", "old"} {
+		if err := primary.Write(&store.Record{Key: k}, store.WriteTo("db", "table")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := secondary.Write(&store.Record{Key: "b"}, store.WriteTo("db", "table")); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := fs.List(store.ListFrom("db", "table"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 distinct keys, got %v", keys)
+	}
+
+	keys, err = fs.List(store.ListFrom("db", "table"), store.ListLimit(2), store.ListOffset(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("expected 1 key on the second page, got %v", keys)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/store/etcd"
+	"github.com/owncloud/ocis/v2/ocis-pkg/store/fallback"
 	"github.com/owncloud/ocis/v2/ocis-pkg/store/memory"
 	"go-micro.dev/v4/store"
 )
@@ -21,6 +22,11 @@ type OcisStoreOptions struct {
 	Type    string
 	Address string
 	Size    int
+
+	// Fallback is the store to read from when a record is missing in this one.
+	Fallback *OcisStoreOptions
+	// PromoteFallback writes records found in the fallback store to this one.
+	PromoteFallback bool
 }
 
 // Get the configured key-value store to be used.
@@ -50,6 +56,10 @@ type OcisStoreOptions struct {
 // The parameter only affects to the "ocmem" implementation, the rest will ignore it.
 // If an invalid value is used, the default will be used instead, so up to 512 elements
 // the cache will hold.
+//
+// If a fallback store is configured, records missing in the store are read from
+// the fallback store, while writes only go to the store. This allows migrating
+// from one store to another one without losing the existing records.
 func GetStore(ocisOpts OcisStoreOptions) store.Store {
 	var s store.Store
 
@@ -87,5 +97,9 @@ func GetStore(ocisOpts OcisStoreOptions) store.Store {
 	default:
 		s = store.NewMemoryStore(opts...)
 	}
+
+	if ocisOpts.Fallback != nil {
+		s = fallback.NewFallbackStore(s, GetStore(*ocisOpts.Fallback), ocisOpts.PromoteFallback)
+	}
 	return s
 }
@@ -190,6 +190,42 @@ func (m *Bundle) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*Bundle)(nil)
 
+// SettingGroupJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SettingGroup. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SettingGroupJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SettingGroup) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SettingGroupJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SettingGroup)(nil)
+
+// SettingGroupJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SettingGroup. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SettingGroupJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SettingGroup) UnmarshalJSON(b []byte) error {
+	return SettingGroupJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SettingGroup)(nil)
+
 // SettingJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Setting. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -226,6 +262,42 @@ func (m *Setting) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*Setting)(nil)
 
+// VisibleWhenJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of VisibleWhen. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VisibleWhenJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *VisibleWhen) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := VisibleWhenJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*VisibleWhen)(nil)
+
+// VisibleWhenJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of VisibleWhen. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VisibleWhenJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *VisibleWhen) UnmarshalJSON(b []byte) error {
+	return VisibleWhenJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*VisibleWhen)(nil)
+
 // IntJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Int. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -334,6 +406,42 @@ func (m *Bool) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*Bool)(nil)
 
+// JsonJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Json. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var JsonJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Json) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := JsonJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Json)(nil)
+
+// JsonJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Json. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var JsonJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Json) UnmarshalJSON(b []byte) error {
+	return JsonJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Json)(nil)
+
 // SingleChoiceListJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of SingleChoiceList. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -585,3 +693,75 @@ func (m *ListOptionValue) UnmarshalJSON(b []byte) error {
 }
 
 var _ json.Unmarshaler = (*ListOptionValue)(nil)
+
+// ValueHistoryEntryJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValueHistoryEntry. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValueHistoryEntryJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValueHistoryEntry) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValueHistoryEntryJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValueHistoryEntry)(nil)
+
+// ValueHistoryEntryJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValueHistoryEntry. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValueHistoryEntryJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValueHistoryEntry) UnmarshalJSON(b []byte) error {
+	return ValueHistoryEntryJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValueHistoryEntry)(nil)
+
+// ReadAuditEntryJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ReadAuditEntry. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ReadAuditEntryJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ReadAuditEntry) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ReadAuditEntryJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ReadAuditEntry)(nil)
+
+// ReadAuditEntryJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ReadAuditEntry. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ReadAuditEntryJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ReadAuditEntry) UnmarshalJSON(b []byte) error {
+	return ReadAuditEntryJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ReadAuditEntry)(nil)
@@ -39,6 +39,8 @@ type MockBundleService struct {
 	ListBundlesFunc             func(ctx context.Context, req *ListBundlesRequest, opts ...client.CallOption) (*ListBundlesResponse, error)
 	GetBundleFunc               func(ctx context.Context, req *GetBundleRequest, opts ...client.CallOption) (*GetBundleResponse, error)
 	SaveBundleFunc              func(ctx context.Context, req *SaveBundleRequest, opts ...client.CallOption) (*SaveBundleResponse, error)
+	UpdateBundleFunc            func(ctx context.Context, req *UpdateBundleRequest, opts ...client.CallOption) (*UpdateBundleResponse, error)
+	CloneBundleFunc             func(ctx context.Context, req *CloneBundleRequest, opts ...client.CallOption) (*CloneBundleResponse, error)
 	AddSettingToBundleFunc      func(ctx context.Context, req *AddSettingToBundleRequest, opts ...client.CallOption) (*AddSettingToBundleResponse, error)
 	RemoveSettingFromBundleFunc func(ctx context.Context, req *RemoveSettingFromBundleRequest, opts ...client.CallOption) (*emptypb.Empty, error)
 }
@@ -67,6 +69,22 @@ func (m MockBundleService) SaveBundle(ctx context.Context, req *SaveBundleReques
 	panic("SaveBundleFunc was called in test but not mocked")
 }
 
+// UpdateBundle will panic if the function has been called, but not mocked
+func (m MockBundleService) UpdateBundle(ctx context.Context, req *UpdateBundleRequest, opts ...client.CallOption) (*UpdateBundleResponse, error) {
+	if m.UpdateBundleFunc != nil {
+		return m.UpdateBundleFunc(ctx, req, opts...)
+	}
+	panic("UpdateBundleFunc was called in test but not mocked")
+}
+
+// CloneBundle will panic if the function has been called, but not mocked
+func (m MockBundleService) CloneBundle(ctx context.Context, req *CloneBundleRequest, opts ...client.CallOption) (*CloneBundleResponse, error) {
+	if m.CloneBundleFunc != nil {
+		return m.CloneBundleFunc(ctx, req, opts...)
+	}
+	panic("CloneBundleFunc was called in test but not mocked")
+}
+
 // AddSettingToBundle will panic if the function has been called, but not mocked
 func (m MockBundleService) AddSettingToBundle(ctx context.Context, req *AddSettingToBundleRequest, opts ...client.CallOption) (*AddSettingToBundleResponse, error) {
 	if m.AddSettingToBundleFunc != nil {
@@ -89,6 +107,11 @@ type MockValueService struct {
 	GetValueFunc                    func(ctx context.Context, req *GetValueRequest, opts ...client.CallOption) (*GetValueResponse, error)
 	GetValueByUniqueIdentifiersFunc func(ctx context.Context, req *GetValueByUniqueIdentifiersRequest, opts ...client.CallOption) (*GetValueResponse, error)
 	SaveValueFunc                   func(ctx context.Context, req *SaveValueRequest, opts ...client.CallOption) (*SaveValueResponse, error)
+	ListValueHistoryFunc            func(ctx context.Context, req *ListValueHistoryRequest, opts ...client.CallOption) (*ListValueHistoryResponse, error)
+	ValidateValueFunc               func(ctx context.Context, req *ValidateValueRequest, opts ...client.CallOption) (*ValidateValueResponse, error)
+	ListValuesModifiedSinceFunc     func(ctx context.Context, req *ListValuesModifiedSinceRequest, opts ...client.CallOption) (*ListValuesModifiedSinceResponse, error)
+	PurgeAccountFunc                func(ctx context.Context, req *PurgeAccountRequest, opts ...client.CallOption) (*PurgeAccountResponse, error)
+	GetValuesFunc                   func(ctx context.Context, req *GetValuesRequest, opts ...client.CallOption) (*GetValuesResponse, error)
 }
 
 // ListValues will panic if the function has been called, but not mocked
@@ -123,6 +146,46 @@ func (m MockValueService) SaveValue(ctx context.Context, req *SaveValueRequest,
 	panic("SaveValueFunc was called in test but not mocked")
 }
 
+// ListValueHistory will panic if the function has been called, but not mocked
+func (m MockValueService) ListValueHistory(ctx context.Context, req *ListValueHistoryRequest, opts ...client.CallOption) (*ListValueHistoryResponse, error) {
+	if m.ListValueHistoryFunc != nil {
+		return m.ListValueHistoryFunc(ctx, req, opts...)
+	}
+	panic("ListValueHistoryFunc was called in test but not mocked")
+}
+
+// ValidateValue will panic if the function has been called, but not mocked
+func (m MockValueService) ValidateValue(ctx context.Context, req *ValidateValueRequest, opts ...client.CallOption) (*ValidateValueResponse, error) {
+	if m.ValidateValueFunc != nil {
+		return m.ValidateValueFunc(ctx, req, opts...)
+	}
+	panic("ValidateValueFunc was called in test but not mocked")
+}
+
+// ListValuesModifiedSince will panic if the function has been called, but not mocked
+func (m MockValueService) ListValuesModifiedSince(ctx context.Context, req *ListValuesModifiedSinceRequest, opts ...client.CallOption) (*ListValuesModifiedSinceResponse, error) {
+	if m.ListValuesModifiedSinceFunc != nil {
+		return m.ListValuesModifiedSinceFunc(ctx, req, opts...)
+	}
+	panic("ListValuesModifiedSinceFunc was called in test but not mocked")
+}
+
+// PurgeAccount will panic if the function has been called, but not mocked
+func (m MockValueService) PurgeAccount(ctx context.Context, req *PurgeAccountRequest, opts ...client.CallOption) (*PurgeAccountResponse, error) {
+	if m.PurgeAccountFunc != nil {
+		return m.PurgeAccountFunc(ctx, req, opts...)
+	}
+	panic("PurgeAccountFunc was called in test but not mocked")
+}
+
+// GetValues will panic if the function has been called, but not mocked
+func (m MockValueService) GetValues(ctx context.Context, req *GetValuesRequest, opts ...client.CallOption) (*GetValuesResponse, error) {
+	if m.GetValuesFunc != nil {
+		return m.GetValuesFunc(ctx, req, opts...)
+	}
+	panic("GetValuesFunc was called in test but not mocked")
+}
+
 // MockRoleService will panic if the function has been called, but not mocked
 type MockRoleService struct {
 	ListRolesFunc           func(ctx context.Context, req *ListBundlesRequest, opts ...client.CallOption) (*ListBundlesResponse, error)
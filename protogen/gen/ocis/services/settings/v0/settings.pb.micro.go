@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	proto "google.golang.org/protobuf/proto"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	math "math"
 )
 
@@ -41,12 +42,30 @@ func NewBundleServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "BundleService.UpdateBundle",
+			Path:    []string{"/api/v0/settings/bundle-update"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BundleService.CloneBundle",
+			Path:    []string{"/api/v0/settings/bundle-clone"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "BundleService.GetBundle",
 			Path:    []string{"/api/v0/settings/bundle-get"},
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "BundleService.GetSetting",
+			Path:    []string{"/api/v0/settings/setting-get"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "BundleService.ListBundles",
 			Path:    []string{"/api/v0/settings/bundles-list"},
@@ -65,6 +84,42 @@ func NewBundleServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "BundleService.ReorderSettings",
+			Path:    []string{"/api/v0/settings/bundles-reorder-settings"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BundleService.ListAudit",
+			Path:    []string{"/api/v0/settings/bundle-audit-list"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BundleService.ListChangedBundles",
+			Path:    []string{"/api/v0/settings/bundles-list-changed"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BundleService.ListPublicBundles",
+			Path:    []string{"/api/v0/settings/bundles-list-public"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BundleService.DiffBundles",
+			Path:    []string{"/api/v0/settings/bundles-diff"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BundleService.PlanImport",
+			Path:    []string{"/api/v0/settings/bundles-plan-import"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -72,10 +127,23 @@ func NewBundleServiceEndpoints() []*api.Endpoint {
 
 type BundleService interface {
 	SaveBundle(ctx context.Context, in *SaveBundleRequest, opts ...client.CallOption) (*SaveBundleResponse, error)
+	UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...client.CallOption) (*UpdateBundleResponse, error)
+	CloneBundle(ctx context.Context, in *CloneBundleRequest, opts ...client.CallOption) (*CloneBundleResponse, error)
 	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...client.CallOption) (*GetBundleResponse, error)
+	GetSetting(ctx context.Context, in *GetSettingRequest, opts ...client.CallOption) (*GetSettingResponse, error)
 	ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...client.CallOption) (*ListBundlesResponse, error)
 	AddSettingToBundle(ctx context.Context, in *AddSettingToBundleRequest, opts ...client.CallOption) (*AddSettingToBundleResponse, error)
 	RemoveSettingFromBundle(ctx context.Context, in *RemoveSettingFromBundleRequest, opts ...client.CallOption) (*emptypb.Empty, error)
+	ReorderSettings(ctx context.Context, in *ReorderSettingsRequest, opts ...client.CallOption) (*ReorderSettingsResponse, error)
+	ListAudit(ctx context.Context, in *ListAuditRequest, opts ...client.CallOption) (*ListAuditResponse, error)
+	// ListChangedBundles lists the bundles saved after a given time, e.g. to detect changes made outside of a deployment pipeline.
+	ListChangedBundles(ctx context.Context, in *ListChangedBundlesRequest, opts ...client.CallOption) (*ListChangedBundlesResponse, error)
+	ListPublicBundles(ctx context.Context, in *ListPublicBundlesRequest, opts ...client.CallOption) (*ListPublicBundlesResponse, error)
+	// DiffBundles compares a bundle with a saved or a supplied bundle, e.g. to review a bundle before importing it.
+	DiffBundles(ctx context.Context, in *DiffBundlesRequest, opts ...client.CallOption) (*DiffBundlesResponse, error)
+	// PlanImport checks and compares bundles with the saved bundles without saving them, e.g. to review the bundles
+	// of another environment before they are imported.
+	PlanImport(ctx context.Context, in *PlanImportRequest, opts ...client.CallOption) (*PlanImportResponse, error)
 }
 
 type bundleService struct {
@@ -100,6 +168,26 @@ func (c *bundleService) SaveBundle(ctx context.Context, in *SaveBundleRequest, o
 	return out, nil
 }
 
+func (c *bundleService) UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...client.CallOption) (*UpdateBundleResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.UpdateBundle", in)
+	out := new(UpdateBundleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleService) CloneBundle(ctx context.Context, in *CloneBundleRequest, opts ...client.CallOption) (*CloneBundleResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.CloneBundle", in)
+	out := new(CloneBundleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bundleService) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...client.CallOption) (*GetBundleResponse, error) {
 	req := c.c.NewRequest(c.name, "BundleService.GetBundle", in)
 	out := new(GetBundleResponse)
@@ -110,6 +198,16 @@ func (c *bundleService) GetBundle(ctx context.Context, in *GetBundleRequest, opt
 	return out, nil
 }
 
+func (c *bundleService) GetSetting(ctx context.Context, in *GetSettingRequest, opts ...client.CallOption) (*GetSettingResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.GetSetting", in)
+	out := new(GetSettingResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bundleService) ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...client.CallOption) (*ListBundlesResponse, error) {
 	req := c.c.NewRequest(c.name, "BundleService.ListBundles", in)
 	out := new(ListBundlesResponse)
@@ -140,23 +238,105 @@ func (c *bundleService) RemoveSettingFromBundle(ctx context.Context, in *RemoveS
 	return out, nil
 }
 
+func (c *bundleService) ReorderSettings(ctx context.Context, in *ReorderSettingsRequest, opts ...client.CallOption) (*ReorderSettingsResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.ReorderSettings", in)
+	out := new(ReorderSettingsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleService) ListAudit(ctx context.Context, in *ListAuditRequest, opts ...client.CallOption) (*ListAuditResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.ListAudit", in)
+	out := new(ListAuditResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleService) ListChangedBundles(ctx context.Context, in *ListChangedBundlesRequest, opts ...client.CallOption) (*ListChangedBundlesResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.ListChangedBundles", in)
+	out := new(ListChangedBundlesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleService) ListPublicBundles(ctx context.Context, in *ListPublicBundlesRequest, opts ...client.CallOption) (*ListPublicBundlesResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.ListPublicBundles", in)
+	out := new(ListPublicBundlesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleService) DiffBundles(ctx context.Context, in *DiffBundlesRequest, opts ...client.CallOption) (*DiffBundlesResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.DiffBundles", in)
+	out := new(DiffBundlesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleService) PlanImport(ctx context.Context, in *PlanImportRequest, opts ...client.CallOption) (*PlanImportResponse, error) {
+	req := c.c.NewRequest(c.name, "BundleService.PlanImport", in)
+	out := new(PlanImportResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BundleService service
 
 type BundleServiceHandler interface {
 	SaveBundle(context.Context, *SaveBundleRequest, *SaveBundleResponse) error
+	UpdateBundle(context.Context, *UpdateBundleRequest, *UpdateBundleResponse) error
+	CloneBundle(context.Context, *CloneBundleRequest, *CloneBundleResponse) error
 	GetBundle(context.Context, *GetBundleRequest, *GetBundleResponse) error
+	GetSetting(context.Context, *GetSettingRequest, *GetSettingResponse) error
 	ListBundles(context.Context, *ListBundlesRequest, *ListBundlesResponse) error
 	AddSettingToBundle(context.Context, *AddSettingToBundleRequest, *AddSettingToBundleResponse) error
 	RemoveSettingFromBundle(context.Context, *RemoveSettingFromBundleRequest, *emptypb.Empty) error
+	ReorderSettings(context.Context, *ReorderSettingsRequest, *ReorderSettingsResponse) error
+	ListAudit(context.Context, *ListAuditRequest, *ListAuditResponse) error
+	// ListChangedBundles lists the bundles saved after a given time, e.g. to detect changes made outside of a deployment pipeline.
+	ListChangedBundles(context.Context, *ListChangedBundlesRequest, *ListChangedBundlesResponse) error
+	ListPublicBundles(context.Context, *ListPublicBundlesRequest, *ListPublicBundlesResponse) error
+	// DiffBundles compares a bundle with a saved or a supplied bundle, e.g. to review a bundle before importing it.
+	DiffBundles(context.Context, *DiffBundlesRequest, *DiffBundlesResponse) error
+	// PlanImport checks and compares bundles with the saved bundles without saving them, e.g. to review the bundles
+	// of another environment before they are imported.
+	PlanImport(context.Context, *PlanImportRequest, *PlanImportResponse) error
 }
 
 func RegisterBundleServiceHandler(s server.Server, hdlr BundleServiceHandler, opts ...server.HandlerOption) error {
 	type bundleService interface {
 		SaveBundle(ctx context.Context, in *SaveBundleRequest, out *SaveBundleResponse) error
+		UpdateBundle(ctx context.Context, in *UpdateBundleRequest, out *UpdateBundleResponse) error
+		CloneBundle(ctx context.Context, in *CloneBundleRequest, out *CloneBundleResponse) error
 		GetBundle(ctx context.Context, in *GetBundleRequest, out *GetBundleResponse) error
+		GetSetting(ctx context.Context, in *GetSettingRequest, out *GetSettingResponse) error
 		ListBundles(ctx context.Context, in *ListBundlesRequest, out *ListBundlesResponse) error
 		AddSettingToBundle(ctx context.Context, in *AddSettingToBundleRequest, out *AddSettingToBundleResponse) error
 		RemoveSettingFromBundle(ctx context.Context, in *RemoveSettingFromBundleRequest, out *emptypb.Empty) error
+		ReorderSettings(ctx context.Context, in *ReorderSettingsRequest, out *ReorderSettingsResponse) error
+		ListAudit(ctx context.Context, in *ListAuditRequest, out *ListAuditResponse) error
+		ListChangedBundles(ctx context.Context, in *ListChangedBundlesRequest, out *ListChangedBundlesResponse) error
+		ListPublicBundles(ctx context.Context, in *ListPublicBundlesRequest, out *ListPublicBundlesResponse) error
+		DiffBundles(ctx context.Context, in *DiffBundlesRequest, out *DiffBundlesResponse) error
+		PlanImport(ctx context.Context, in *PlanImportRequest, out *PlanImportResponse) error
 	}
 	type BundleService struct {
 		bundleService
@@ -168,12 +348,30 @@ func RegisterBundleServiceHandler(s server.Server, hdlr BundleServiceHandler, op
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.UpdateBundle",
+		Path:    []string{"/api/v0/settings/bundle-update"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.CloneBundle",
+		Path:    []string{"/api/v0/settings/bundle-clone"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "BundleService.GetBundle",
 		Path:    []string{"/api/v0/settings/bundle-get"},
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.GetSetting",
+		Path:    []string{"/api/v0/settings/setting-get"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "BundleService.ListBundles",
 		Path:    []string{"/api/v0/settings/bundles-list"},
@@ -192,6 +390,42 @@ func RegisterBundleServiceHandler(s server.Server, hdlr BundleServiceHandler, op
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.ReorderSettings",
+		Path:    []string{"/api/v0/settings/bundles-reorder-settings"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.ListAudit",
+		Path:    []string{"/api/v0/settings/bundle-audit-list"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.ListChangedBundles",
+		Path:    []string{"/api/v0/settings/bundles-list-changed"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.ListPublicBundles",
+		Path:    []string{"/api/v0/settings/bundles-list-public"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.DiffBundles",
+		Path:    []string{"/api/v0/settings/bundles-diff"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BundleService.PlanImport",
+		Path:    []string{"/api/v0/settings/bundles-plan-import"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&BundleService{h}, opts...))
 }
 
@@ -203,10 +437,22 @@ func (h *bundleServiceHandler) SaveBundle(ctx context.Context, in *SaveBundleReq
 	return h.BundleServiceHandler.SaveBundle(ctx, in, out)
 }
 
+func (h *bundleServiceHandler) UpdateBundle(ctx context.Context, in *UpdateBundleRequest, out *UpdateBundleResponse) error {
+	return h.BundleServiceHandler.UpdateBundle(ctx, in, out)
+}
+
+func (h *bundleServiceHandler) CloneBundle(ctx context.Context, in *CloneBundleRequest, out *CloneBundleResponse) error {
+	return h.BundleServiceHandler.CloneBundle(ctx, in, out)
+}
+
 func (h *bundleServiceHandler) GetBundle(ctx context.Context, in *GetBundleRequest, out *GetBundleResponse) error {
 	return h.BundleServiceHandler.GetBundle(ctx, in, out)
 }
 
+func (h *bundleServiceHandler) GetSetting(ctx context.Context, in *GetSettingRequest, out *GetSettingResponse) error {
+	return h.BundleServiceHandler.GetSetting(ctx, in, out)
+}
+
 func (h *bundleServiceHandler) ListBundles(ctx context.Context, in *ListBundlesRequest, out *ListBundlesResponse) error {
 	return h.BundleServiceHandler.ListBundles(ctx, in, out)
 }
@@ -219,6 +465,30 @@ func (h *bundleServiceHandler) RemoveSettingFromBundle(ctx context.Context, in *
 	return h.BundleServiceHandler.RemoveSettingFromBundle(ctx, in, out)
 }
 
+func (h *bundleServiceHandler) ReorderSettings(ctx context.Context, in *ReorderSettingsRequest, out *ReorderSettingsResponse) error {
+	return h.BundleServiceHandler.ReorderSettings(ctx, in, out)
+}
+
+func (h *bundleServiceHandler) ListAudit(ctx context.Context, in *ListAuditRequest, out *ListAuditResponse) error {
+	return h.BundleServiceHandler.ListAudit(ctx, in, out)
+}
+
+func (h *bundleServiceHandler) ListChangedBundles(ctx context.Context, in *ListChangedBundlesRequest, out *ListChangedBundlesResponse) error {
+	return h.BundleServiceHandler.ListChangedBundles(ctx, in, out)
+}
+
+func (h *bundleServiceHandler) ListPublicBundles(ctx context.Context, in *ListPublicBundlesRequest, out *ListPublicBundlesResponse) error {
+	return h.BundleServiceHandler.ListPublicBundles(ctx, in, out)
+}
+
+func (h *bundleServiceHandler) DiffBundles(ctx context.Context, in *DiffBundlesRequest, out *DiffBundlesResponse) error {
+	return h.BundleServiceHandler.DiffBundles(ctx, in, out)
+}
+
+func (h *bundleServiceHandler) PlanImport(ctx context.Context, in *PlanImportRequest, out *PlanImportResponse) error {
+	return h.BundleServiceHandler.PlanImport(ctx, in, out)
+}
+
 // Api Endpoints for ValueService service
 
 func NewValueServiceEndpoints() []*api.Endpoint {
@@ -235,6 +505,12 @@ func NewValueServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ValueService.GetValues",
+			Path:    []string{"/api/v0/settings/values-get-many"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "ValueService.ListValues",
 			Path:    []string{"/api/v0/settings/values-list"},
@@ -247,6 +523,42 @@ func NewValueServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "ValueService.ListValueHistory",
+			Path:    []string{"/api/v0/settings/values-history-list"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ValueService.ValidateValue",
+			Path:    []string{"/api/v0/settings/values-validate"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ValueService.BulkValidateValues",
+			Path:    []string{"/api/v0/settings/values-validate-bulk"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ValueService.ListValuesModifiedSince",
+			Path:    []string{"/api/v0/settings/values-list-modified-since"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ValueService.PurgeAccount",
+			Path:    []string{"/api/v0/settings/values-purge-account"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "ValueService.BatchGetEffectiveValues",
+			Path:    []string{"/api/v0/settings/values-get-effective"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -255,8 +567,17 @@ func NewValueServiceEndpoints() []*api.Endpoint {
 type ValueService interface {
 	SaveValue(ctx context.Context, in *SaveValueRequest, opts ...client.CallOption) (*SaveValueResponse, error)
 	GetValue(ctx context.Context, in *GetValueRequest, opts ...client.CallOption) (*GetValueResponse, error)
+	GetValues(ctx context.Context, in *GetValuesRequest, opts ...client.CallOption) (*GetValuesResponse, error)
 	ListValues(ctx context.Context, in *ListValuesRequest, opts ...client.CallOption) (*ListValuesResponse, error)
 	GetValueByUniqueIdentifiers(ctx context.Context, in *GetValueByUniqueIdentifiersRequest, opts ...client.CallOption) (*GetValueResponse, error)
+	ListValueHistory(ctx context.Context, in *ListValueHistoryRequest, opts ...client.CallOption) (*ListValueHistoryResponse, error)
+	ValidateValue(ctx context.Context, in *ValidateValueRequest, opts ...client.CallOption) (*ValidateValueResponse, error)
+	BulkValidateValues(ctx context.Context, in *BulkValidateValuesRequest, opts ...client.CallOption) (*BulkValidateValuesResponse, error)
+	ListValuesModifiedSince(ctx context.Context, in *ListValuesModifiedSinceRequest, opts ...client.CallOption) (*ListValuesModifiedSinceResponse, error)
+	PurgeAccount(ctx context.Context, in *PurgeAccountRequest, opts ...client.CallOption) (*PurgeAccountResponse, error)
+	// BatchGetEffectiveValues returns the values that apply to each of the accounts for a setting or for the
+	// settings of a bundle, together with where each value comes from.
+	BatchGetEffectiveValues(ctx context.Context, in *BatchGetEffectiveValuesRequest, opts ...client.CallOption) (*BatchGetEffectiveValuesResponse, error)
 }
 
 type valueService struct {
@@ -291,6 +612,16 @@ func (c *valueService) GetValue(ctx context.Context, in *GetValueRequest, opts .
 	return out, nil
 }
 
+func (c *valueService) GetValues(ctx context.Context, in *GetValuesRequest, opts ...client.CallOption) (*GetValuesResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.GetValues", in)
+	out := new(GetValuesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *valueService) ListValues(ctx context.Context, in *ListValuesRequest, opts ...client.CallOption) (*ListValuesResponse, error) {
 	req := c.c.NewRequest(c.name, "ValueService.ListValues", in)
 	out := new(ListValuesResponse)
@@ -311,21 +642,97 @@ func (c *valueService) GetValueByUniqueIdentifiers(ctx context.Context, in *GetV
 	return out, nil
 }
 
+func (c *valueService) ListValueHistory(ctx context.Context, in *ListValueHistoryRequest, opts ...client.CallOption) (*ListValueHistoryResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.ListValueHistory", in)
+	out := new(ListValueHistoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valueService) ValidateValue(ctx context.Context, in *ValidateValueRequest, opts ...client.CallOption) (*ValidateValueResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.ValidateValue", in)
+	out := new(ValidateValueResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valueService) BulkValidateValues(ctx context.Context, in *BulkValidateValuesRequest, opts ...client.CallOption) (*BulkValidateValuesResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.BulkValidateValues", in)
+	out := new(BulkValidateValuesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valueService) ListValuesModifiedSince(ctx context.Context, in *ListValuesModifiedSinceRequest, opts ...client.CallOption) (*ListValuesModifiedSinceResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.ListValuesModifiedSince", in)
+	out := new(ListValuesModifiedSinceResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valueService) PurgeAccount(ctx context.Context, in *PurgeAccountRequest, opts ...client.CallOption) (*PurgeAccountResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.PurgeAccount", in)
+	out := new(PurgeAccountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valueService) BatchGetEffectiveValues(ctx context.Context, in *BatchGetEffectiveValuesRequest, opts ...client.CallOption) (*BatchGetEffectiveValuesResponse, error) {
+	req := c.c.NewRequest(c.name, "ValueService.BatchGetEffectiveValues", in)
+	out := new(BatchGetEffectiveValuesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ValueService service
 
 type ValueServiceHandler interface {
 	SaveValue(context.Context, *SaveValueRequest, *SaveValueResponse) error
 	GetValue(context.Context, *GetValueRequest, *GetValueResponse) error
+	GetValues(context.Context, *GetValuesRequest, *GetValuesResponse) error
 	ListValues(context.Context, *ListValuesRequest, *ListValuesResponse) error
 	GetValueByUniqueIdentifiers(context.Context, *GetValueByUniqueIdentifiersRequest, *GetValueResponse) error
+	ListValueHistory(context.Context, *ListValueHistoryRequest, *ListValueHistoryResponse) error
+	ValidateValue(context.Context, *ValidateValueRequest, *ValidateValueResponse) error
+	BulkValidateValues(context.Context, *BulkValidateValuesRequest, *BulkValidateValuesResponse) error
+	ListValuesModifiedSince(context.Context, *ListValuesModifiedSinceRequest, *ListValuesModifiedSinceResponse) error
+	PurgeAccount(context.Context, *PurgeAccountRequest, *PurgeAccountResponse) error
+	// BatchGetEffectiveValues returns the values that apply to each of the accounts for a setting or for the
+	// settings of a bundle, together with where each value comes from.
+	BatchGetEffectiveValues(context.Context, *BatchGetEffectiveValuesRequest, *BatchGetEffectiveValuesResponse) error
 }
 
 func RegisterValueServiceHandler(s server.Server, hdlr ValueServiceHandler, opts ...server.HandlerOption) error {
 	type valueService interface {
 		SaveValue(ctx context.Context, in *SaveValueRequest, out *SaveValueResponse) error
 		GetValue(ctx context.Context, in *GetValueRequest, out *GetValueResponse) error
+		GetValues(ctx context.Context, in *GetValuesRequest, out *GetValuesResponse) error
 		ListValues(ctx context.Context, in *ListValuesRequest, out *ListValuesResponse) error
 		GetValueByUniqueIdentifiers(ctx context.Context, in *GetValueByUniqueIdentifiersRequest, out *GetValueResponse) error
+		ListValueHistory(ctx context.Context, in *ListValueHistoryRequest, out *ListValueHistoryResponse) error
+		ValidateValue(ctx context.Context, in *ValidateValueRequest, out *ValidateValueResponse) error
+		BulkValidateValues(ctx context.Context, in *BulkValidateValuesRequest, out *BulkValidateValuesResponse) error
+		ListValuesModifiedSince(ctx context.Context, in *ListValuesModifiedSinceRequest, out *ListValuesModifiedSinceResponse) error
+		PurgeAccount(ctx context.Context, in *PurgeAccountRequest, out *PurgeAccountResponse) error
+		BatchGetEffectiveValues(ctx context.Context, in *BatchGetEffectiveValuesRequest, out *BatchGetEffectiveValuesResponse) error
 	}
 	type ValueService struct {
 		valueService
@@ -343,6 +750,12 @@ func RegisterValueServiceHandler(s server.Server, hdlr ValueServiceHandler, opts
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.GetValues",
+		Path:    []string{"/api/v0/settings/values-get-many"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "ValueService.ListValues",
 		Path:    []string{"/api/v0/settings/values-list"},
@@ -355,6 +768,42 @@ func RegisterValueServiceHandler(s server.Server, hdlr ValueServiceHandler, opts
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.ListValueHistory",
+		Path:    []string{"/api/v0/settings/values-history-list"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.ValidateValue",
+		Path:    []string{"/api/v0/settings/values-validate"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.BulkValidateValues",
+		Path:    []string{"/api/v0/settings/values-validate-bulk"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.ListValuesModifiedSince",
+		Path:    []string{"/api/v0/settings/values-list-modified-since"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.PurgeAccount",
+		Path:    []string{"/api/v0/settings/values-purge-account"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "ValueService.BatchGetEffectiveValues",
+		Path:    []string{"/api/v0/settings/values-get-effective"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&ValueService{h}, opts...))
 }
 
@@ -370,6 +819,10 @@ func (h *valueServiceHandler) GetValue(ctx context.Context, in *GetValueRequest,
 	return h.ValueServiceHandler.GetValue(ctx, in, out)
 }
 
+func (h *valueServiceHandler) GetValues(ctx context.Context, in *GetValuesRequest, out *GetValuesResponse) error {
+	return h.ValueServiceHandler.GetValues(ctx, in, out)
+}
+
 func (h *valueServiceHandler) ListValues(ctx context.Context, in *ListValuesRequest, out *ListValuesResponse) error {
 	return h.ValueServiceHandler.ListValues(ctx, in, out)
 }
@@ -378,6 +831,30 @@ func (h *valueServiceHandler) GetValueByUniqueIdentifiers(ctx context.Context, i
 	return h.ValueServiceHandler.GetValueByUniqueIdentifiers(ctx, in, out)
 }
 
+func (h *valueServiceHandler) ListValueHistory(ctx context.Context, in *ListValueHistoryRequest, out *ListValueHistoryResponse) error {
+	return h.ValueServiceHandler.ListValueHistory(ctx, in, out)
+}
+
+func (h *valueServiceHandler) ValidateValue(ctx context.Context, in *ValidateValueRequest, out *ValidateValueResponse) error {
+	return h.ValueServiceHandler.ValidateValue(ctx, in, out)
+}
+
+func (h *valueServiceHandler) BulkValidateValues(ctx context.Context, in *BulkValidateValuesRequest, out *BulkValidateValuesResponse) error {
+	return h.ValueServiceHandler.BulkValidateValues(ctx, in, out)
+}
+
+func (h *valueServiceHandler) ListValuesModifiedSince(ctx context.Context, in *ListValuesModifiedSinceRequest, out *ListValuesModifiedSinceResponse) error {
+	return h.ValueServiceHandler.ListValuesModifiedSince(ctx, in, out)
+}
+
+func (h *valueServiceHandler) PurgeAccount(ctx context.Context, in *PurgeAccountRequest, out *PurgeAccountResponse) error {
+	return h.ValueServiceHandler.PurgeAccount(ctx, in, out)
+}
+
+func (h *valueServiceHandler) BatchGetEffectiveValues(ctx context.Context, in *BatchGetEffectiveValuesRequest, out *BatchGetEffectiveValuesResponse) error {
+	return h.ValueServiceHandler.BatchGetEffectiveValues(ctx, in, out)
+}
+
 // Api Endpoints for RoleService service
 
 func NewRoleServiceEndpoints() []*api.Endpoint {
@@ -400,12 +877,48 @@ func NewRoleServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "RoleService.PreviewRoleAssignment",
+			Path:    []string{"/api/v0/settings/assignments-preview"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "RoleService.RemoveRoleFromUser",
 			Path:    []string{"/api/v0/settings/assignments-remove"},
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "RoleService.BulkAssignRole",
+			Path:    []string{"/api/v0/settings/assignments-bulk-add"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "RoleService.BulkRemoveRole",
+			Path:    []string{"/api/v0/settings/assignments-bulk-remove"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "RoleService.AnalyzeRolePermissions",
+			Path:    []string{"/api/v0/settings/roles-analyze"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "RoleService.ExportPermissionMatrix",
+			Path:    []string{"/api/v0/settings/roles-permission-matrix"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "RoleService.RebuildAssignmentIndex",
+			Path:    []string{"/api/v0/settings/assignments-rebuild-index"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -415,7 +928,19 @@ type RoleService interface {
 	ListRoles(ctx context.Context, in *ListBundlesRequest, opts ...client.CallOption) (*ListBundlesResponse, error)
 	ListRoleAssignments(ctx context.Context, in *ListRoleAssignmentsRequest, opts ...client.CallOption) (*ListRoleAssignmentsResponse, error)
 	AssignRoleToUser(ctx context.Context, in *AssignRoleToUserRequest, opts ...client.CallOption) (*AssignRoleToUserResponse, error)
+	// PreviewRoleAssignment returns the changes of the effective permissions of the account that assigning
+	// the role would cause, without assigning it.
+	PreviewRoleAssignment(ctx context.Context, in *AssignRoleToUserRequest, opts ...client.CallOption) (*PreviewRoleAssignmentResponse, error)
 	RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, opts ...client.CallOption) (*emptypb.Empty, error)
+	// BulkAssignRole assigns the role to all accounts, replacing their current role.
+	BulkAssignRole(ctx context.Context, in *BulkRoleAssignmentRequest, opts ...client.CallOption) (*BulkRoleAssignmentResponse, error)
+	// BulkRemoveRole removes the assignments of the role from all accounts.
+	BulkRemoveRole(ctx context.Context, in *BulkRoleAssignmentRequest, opts ...client.CallOption) (*BulkRoleAssignmentResponse, error)
+	AnalyzeRolePermissions(ctx context.Context, in *AnalyzeRolePermissionsRequest, opts ...client.CallOption) (*AnalyzeRolePermissionsResponse, error)
+	// ExportPermissionMatrix lists the permissions of the roles as one row per role, resource and permission setting.
+	ExportPermissionMatrix(ctx context.Context, in *ExportPermissionMatrixRequest, opts ...client.CallOption) (*ExportPermissionMatrixResponse, error)
+	// RebuildAssignmentIndex reads the stored role assignments again and replaces the index of the assignments per account.
+	RebuildAssignmentIndex(ctx context.Context, in *RebuildAssignmentIndexRequest, opts ...client.CallOption) (*RebuildAssignmentIndexResponse, error)
 }
 
 type roleService struct {
@@ -460,6 +985,16 @@ func (c *roleService) AssignRoleToUser(ctx context.Context, in *AssignRoleToUser
 	return out, nil
 }
 
+func (c *roleService) PreviewRoleAssignment(ctx context.Context, in *AssignRoleToUserRequest, opts ...client.CallOption) (*PreviewRoleAssignmentResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.PreviewRoleAssignment", in)
+	out := new(PreviewRoleAssignmentResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleService) RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, opts ...client.CallOption) (*emptypb.Empty, error) {
 	req := c.c.NewRequest(c.name, "RoleService.RemoveRoleFromUser", in)
 	out := new(emptypb.Empty)
@@ -470,13 +1005,75 @@ func (c *roleService) RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFrom
 	return out, nil
 }
 
+func (c *roleService) BulkAssignRole(ctx context.Context, in *BulkRoleAssignmentRequest, opts ...client.CallOption) (*BulkRoleAssignmentResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.BulkAssignRole", in)
+	out := new(BulkRoleAssignmentResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleService) BulkRemoveRole(ctx context.Context, in *BulkRoleAssignmentRequest, opts ...client.CallOption) (*BulkRoleAssignmentResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.BulkRemoveRole", in)
+	out := new(BulkRoleAssignmentResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleService) AnalyzeRolePermissions(ctx context.Context, in *AnalyzeRolePermissionsRequest, opts ...client.CallOption) (*AnalyzeRolePermissionsResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.AnalyzeRolePermissions", in)
+	out := new(AnalyzeRolePermissionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleService) ExportPermissionMatrix(ctx context.Context, in *ExportPermissionMatrixRequest, opts ...client.CallOption) (*ExportPermissionMatrixResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.ExportPermissionMatrix", in)
+	out := new(ExportPermissionMatrixResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleService) RebuildAssignmentIndex(ctx context.Context, in *RebuildAssignmentIndexRequest, opts ...client.CallOption) (*RebuildAssignmentIndexResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.RebuildAssignmentIndex", in)
+	out := new(RebuildAssignmentIndexResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RoleService service
 
 type RoleServiceHandler interface {
 	ListRoles(context.Context, *ListBundlesRequest, *ListBundlesResponse) error
 	ListRoleAssignments(context.Context, *ListRoleAssignmentsRequest, *ListRoleAssignmentsResponse) error
 	AssignRoleToUser(context.Context, *AssignRoleToUserRequest, *AssignRoleToUserResponse) error
+	// PreviewRoleAssignment returns the changes of the effective permissions of the account that assigning
+	// the role would cause, without assigning it.
+	PreviewRoleAssignment(context.Context, *AssignRoleToUserRequest, *PreviewRoleAssignmentResponse) error
 	RemoveRoleFromUser(context.Context, *RemoveRoleFromUserRequest, *emptypb.Empty) error
+	// BulkAssignRole assigns the role to all accounts, replacing their current role.
+	BulkAssignRole(context.Context, *BulkRoleAssignmentRequest, *BulkRoleAssignmentResponse) error
+	// BulkRemoveRole removes the assignments of the role from all accounts.
+	BulkRemoveRole(context.Context, *BulkRoleAssignmentRequest, *BulkRoleAssignmentResponse) error
+	AnalyzeRolePermissions(context.Context, *AnalyzeRolePermissionsRequest, *AnalyzeRolePermissionsResponse) error
+	// ExportPermissionMatrix lists the permissions of the roles as one row per role, resource and permission setting.
+	ExportPermissionMatrix(context.Context, *ExportPermissionMatrixRequest, *ExportPermissionMatrixResponse) error
+	// RebuildAssignmentIndex reads the stored role assignments again and replaces the index of the assignments per account.
+	RebuildAssignmentIndex(context.Context, *RebuildAssignmentIndexRequest, *RebuildAssignmentIndexResponse) error
 }
 
 func RegisterRoleServiceHandler(s server.Server, hdlr RoleServiceHandler, opts ...server.HandlerOption) error {
@@ -484,7 +1081,13 @@ func RegisterRoleServiceHandler(s server.Server, hdlr RoleServiceHandler, opts .
 		ListRoles(ctx context.Context, in *ListBundlesRequest, out *ListBundlesResponse) error
 		ListRoleAssignments(ctx context.Context, in *ListRoleAssignmentsRequest, out *ListRoleAssignmentsResponse) error
 		AssignRoleToUser(ctx context.Context, in *AssignRoleToUserRequest, out *AssignRoleToUserResponse) error
+		PreviewRoleAssignment(ctx context.Context, in *AssignRoleToUserRequest, out *PreviewRoleAssignmentResponse) error
 		RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, out *emptypb.Empty) error
+		BulkAssignRole(ctx context.Context, in *BulkRoleAssignmentRequest, out *BulkRoleAssignmentResponse) error
+		BulkRemoveRole(ctx context.Context, in *BulkRoleAssignmentRequest, out *BulkRoleAssignmentResponse) error
+		AnalyzeRolePermissions(ctx context.Context, in *AnalyzeRolePermissionsRequest, out *AnalyzeRolePermissionsResponse) error
+		ExportPermissionMatrix(ctx context.Context, in *ExportPermissionMatrixRequest, out *ExportPermissionMatrixResponse) error
+		RebuildAssignmentIndex(ctx context.Context, in *RebuildAssignmentIndexRequest, out *RebuildAssignmentIndexResponse) error
 	}
 	type RoleService struct {
 		roleService
@@ -508,12 +1111,48 @@ func RegisterRoleServiceHandler(s server.Server, hdlr RoleServiceHandler, opts .
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.PreviewRoleAssignment",
+		Path:    []string{"/api/v0/settings/assignments-preview"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "RoleService.RemoveRoleFromUser",
 		Path:    []string{"/api/v0/settings/assignments-remove"},
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.BulkAssignRole",
+		Path:    []string{"/api/v0/settings/assignments-bulk-add"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.BulkRemoveRole",
+		Path:    []string{"/api/v0/settings/assignments-bulk-remove"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.AnalyzeRolePermissions",
+		Path:    []string{"/api/v0/settings/roles-analyze"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.ExportPermissionMatrix",
+		Path:    []string{"/api/v0/settings/roles-permission-matrix"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.RebuildAssignmentIndex",
+		Path:    []string{"/api/v0/settings/assignments-rebuild-index"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&RoleService{h}, opts...))
 }
 
@@ -533,10 +1172,34 @@ func (h *roleServiceHandler) AssignRoleToUser(ctx context.Context, in *AssignRol
 	return h.RoleServiceHandler.AssignRoleToUser(ctx, in, out)
 }
 
+func (h *roleServiceHandler) PreviewRoleAssignment(ctx context.Context, in *AssignRoleToUserRequest, out *PreviewRoleAssignmentResponse) error {
+	return h.RoleServiceHandler.PreviewRoleAssignment(ctx, in, out)
+}
+
 func (h *roleServiceHandler) RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, out *emptypb.Empty) error {
 	return h.RoleServiceHandler.RemoveRoleFromUser(ctx, in, out)
 }
 
+func (h *roleServiceHandler) BulkAssignRole(ctx context.Context, in *BulkRoleAssignmentRequest, out *BulkRoleAssignmentResponse) error {
+	return h.RoleServiceHandler.BulkAssignRole(ctx, in, out)
+}
+
+func (h *roleServiceHandler) BulkRemoveRole(ctx context.Context, in *BulkRoleAssignmentRequest, out *BulkRoleAssignmentResponse) error {
+	return h.RoleServiceHandler.BulkRemoveRole(ctx, in, out)
+}
+
+func (h *roleServiceHandler) AnalyzeRolePermissions(ctx context.Context, in *AnalyzeRolePermissionsRequest, out *AnalyzeRolePermissionsResponse) error {
+	return h.RoleServiceHandler.AnalyzeRolePermissions(ctx, in, out)
+}
+
+func (h *roleServiceHandler) ExportPermissionMatrix(ctx context.Context, in *ExportPermissionMatrixRequest, out *ExportPermissionMatrixResponse) error {
+	return h.RoleServiceHandler.ExportPermissionMatrix(ctx, in, out)
+}
+
+func (h *roleServiceHandler) RebuildAssignmentIndex(ctx context.Context, in *RebuildAssignmentIndexRequest, out *RebuildAssignmentIndexResponse) error {
+	return h.RoleServiceHandler.RebuildAssignmentIndex(ctx, in, out)
+}
+
 // Api Endpoints for PermissionService service
 
 func NewPermissionServiceEndpoints() []*api.Endpoint {
@@ -553,6 +1216,12 @@ func NewPermissionServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "PermissionService.ExplainPermission",
+			Path:    []string{"/api/v0/settings/permissions-explain"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -561,6 +1230,8 @@ func NewPermissionServiceEndpoints() []*api.Endpoint {
 type PermissionService interface {
 	ListPermissionsByResource(ctx context.Context, in *ListPermissionsByResourceRequest, opts ...client.CallOption) (*ListPermissionsByResourceResponse, error)
 	GetPermissionByID(ctx context.Context, in *GetPermissionByIDRequest, opts ...client.CallOption) (*GetPermissionByIDResponse, error)
+	// ExplainPermission reports the effective permissions of an account on a setting and the roles granting them.
+	ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...client.CallOption) (*ExplainPermissionResponse, error)
 }
 
 type permissionService struct {
@@ -595,17 +1266,30 @@ func (c *permissionService) GetPermissionByID(ctx context.Context, in *GetPermis
 	return out, nil
 }
 
+func (c *permissionService) ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...client.CallOption) (*ExplainPermissionResponse, error) {
+	req := c.c.NewRequest(c.name, "PermissionService.ExplainPermission", in)
+	out := new(ExplainPermissionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PermissionService service
 
 type PermissionServiceHandler interface {
 	ListPermissionsByResource(context.Context, *ListPermissionsByResourceRequest, *ListPermissionsByResourceResponse) error
 	GetPermissionByID(context.Context, *GetPermissionByIDRequest, *GetPermissionByIDResponse) error
+	// ExplainPermission reports the effective permissions of an account on a setting and the roles granting them.
+	ExplainPermission(context.Context, *ExplainPermissionRequest, *ExplainPermissionResponse) error
 }
 
 func RegisterPermissionServiceHandler(s server.Server, hdlr PermissionServiceHandler, opts ...server.HandlerOption) error {
 	type permissionService interface {
 		ListPermissionsByResource(ctx context.Context, in *ListPermissionsByResourceRequest, out *ListPermissionsByResourceResponse) error
 		GetPermissionByID(ctx context.Context, in *GetPermissionByIDRequest, out *GetPermissionByIDResponse) error
+		ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, out *ExplainPermissionResponse) error
 	}
 	type PermissionService struct {
 		permissionService
@@ -623,6 +1307,12 @@ func RegisterPermissionServiceHandler(s server.Server, hdlr PermissionServiceHan
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "PermissionService.ExplainPermission",
+		Path:    []string{"/api/v0/settings/permissions-explain"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&PermissionService{h}, opts...))
 }
 
@@ -637,3 +1327,7 @@ func (h *permissionServiceHandler) ListPermissionsByResource(ctx context.Context
 func (h *permissionServiceHandler) GetPermissionByID(ctx context.Context, in *GetPermissionByIDRequest, out *GetPermissionByIDResponse) error {
 	return h.PermissionServiceHandler.GetPermissionByID(ctx, in, out)
 }
+
+func (h *permissionServiceHandler) ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, out *ExplainPermissionResponse) error {
+	return h.PermissionServiceHandler.ExplainPermission(ctx, in, out)
+}
@@ -51,6 +51,58 @@ func (h *webBundleServiceHandler) SaveBundle(w http.ResponseWriter, r *http.Requ
 	render.JSON(w, r, resp)
 }
 
+func (h *webBundleServiceHandler) UpdateBundle(w http.ResponseWriter, r *http.Request) {
+	req := &UpdateBundleRequest{}
+	resp := &UpdateBundleResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.UpdateBundle(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webBundleServiceHandler) CloneBundle(w http.ResponseWriter, r *http.Request) {
+	req := &CloneBundleRequest{}
+	resp := &CloneBundleResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CloneBundle(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
 func (h *webBundleServiceHandler) GetBundle(w http.ResponseWriter, r *http.Request) {
 	req := &GetBundleRequest{}
 	resp := &GetBundleResponse{}
@@ -77,6 +129,32 @@ func (h *webBundleServiceHandler) GetBundle(w http.ResponseWriter, r *http.Reque
 	render.JSON(w, r, resp)
 }
 
+func (h *webBundleServiceHandler) GetSetting(w http.ResponseWriter, r *http.Request) {
+	req := &GetSettingRequest{}
+	resp := &GetSettingResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.GetSetting(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
 func (h *webBundleServiceHandler) ListBundles(w http.ResponseWriter, r *http.Request) {
 	req := &ListBundlesRequest{}
 	resp := &ListBundlesResponse{}
@@ -155,38 +233,68 @@ func (h *webBundleServiceHandler) RemoveSettingFromBundle(w http.ResponseWriter,
 	render.NoContent(w, r)
 }
 
-func RegisterBundleServiceWeb(r chi.Router, i BundleServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webBundleServiceHandler{
-		r: r,
-		h: i,
+func (h *webBundleServiceHandler) ReorderSettings(w http.ResponseWriter, r *http.Request) {
+	req := &ReorderSettingsRequest{}
+	resp := &ReorderSettingsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	r.MethodFunc("POST", "/api/v0/settings/bundle-save", handler.SaveBundle)
-	r.MethodFunc("POST", "/api/v0/settings/bundle-get", handler.GetBundle)
-	r.MethodFunc("POST", "/api/v0/settings/bundles-list", handler.ListBundles)
-	r.MethodFunc("POST", "/api/v0/settings/bundles-add-setting", handler.AddSettingToBundle)
-	r.MethodFunc("POST", "/api/v0/settings/bundles-remove-setting", handler.RemoveSettingFromBundle)
-}
+	if err := h.h.ReorderSettings(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
 
-type webValueServiceHandler struct {
-	r chi.Router
-	h ValueServiceHandler
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webValueServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	h.r.ServeHTTP(w, r)
+func (h *webBundleServiceHandler) ListAudit(w http.ResponseWriter, r *http.Request) {
+	req := &ListAuditRequest{}
+	resp := &ListAuditResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListAudit(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webValueServiceHandler) SaveValue(w http.ResponseWriter, r *http.Request) {
-	req := &SaveValueRequest{}
-	resp := &SaveValueResponse{}
+func (h *webBundleServiceHandler) ListChangedBundles(w http.ResponseWriter, r *http.Request) {
+	req := &ListChangedBundlesRequest{}
+	resp := &ListChangedBundlesResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.SaveValue(
+	if err := h.h.ListChangedBundles(
 		r.Context(),
 		req,
 		resp,
@@ -203,16 +311,16 @@ func (h *webValueServiceHandler) SaveValue(w http.ResponseWriter, r *http.Reques
 	render.JSON(w, r, resp)
 }
 
-func (h *webValueServiceHandler) GetValue(w http.ResponseWriter, r *http.Request) {
-	req := &GetValueRequest{}
-	resp := &GetValueResponse{}
+func (h *webBundleServiceHandler) ListPublicBundles(w http.ResponseWriter, r *http.Request) {
+	req := &ListPublicBundlesRequest{}
+	resp := &ListPublicBundlesResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.GetValue(
+	if err := h.h.ListPublicBundles(
 		r.Context(),
 		req,
 		resp,
@@ -229,16 +337,16 @@ func (h *webValueServiceHandler) GetValue(w http.ResponseWriter, r *http.Request
 	render.JSON(w, r, resp)
 }
 
-func (h *webValueServiceHandler) ListValues(w http.ResponseWriter, r *http.Request) {
-	req := &ListValuesRequest{}
-	resp := &ListValuesResponse{}
+func (h *webBundleServiceHandler) DiffBundles(w http.ResponseWriter, r *http.Request) {
+	req := &DiffBundlesRequest{}
+	resp := &DiffBundlesResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListValues(
+	if err := h.h.DiffBundles(
 		r.Context(),
 		req,
 		resp,
@@ -255,16 +363,16 @@ func (h *webValueServiceHandler) ListValues(w http.ResponseWriter, r *http.Reque
 	render.JSON(w, r, resp)
 }
 
-func (h *webValueServiceHandler) GetValueByUniqueIdentifiers(w http.ResponseWriter, r *http.Request) {
-	req := &GetValueByUniqueIdentifiersRequest{}
-	resp := &GetValueResponse{}
+func (h *webBundleServiceHandler) PlanImport(w http.ResponseWriter, r *http.Request) {
+	req := &PlanImportRequest{}
+	resp := &PlanImportResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.GetValueByUniqueIdentifiers(
+	if err := h.h.PlanImport(
 		r.Context(),
 		req,
 		resp,
@@ -281,37 +389,47 @@ func (h *webValueServiceHandler) GetValueByUniqueIdentifiers(w http.ResponseWrit
 	render.JSON(w, r, resp)
 }
 
-func RegisterValueServiceWeb(r chi.Router, i ValueServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webValueServiceHandler{
+func RegisterBundleServiceWeb(r chi.Router, i BundleServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webBundleServiceHandler{
 		r: r,
 		h: i,
 	}
 
-	r.MethodFunc("POST", "/api/v0/settings/values-save", handler.SaveValue)
-	r.MethodFunc("POST", "/api/v0/settings/values-get", handler.GetValue)
-	r.MethodFunc("POST", "/api/v0/settings/values-list", handler.ListValues)
-	r.MethodFunc("POST", "/api/v0/settings/values-get-by-unique-identifiers", handler.GetValueByUniqueIdentifiers)
+	r.MethodFunc("POST", "/api/v0/settings/bundle-save", handler.SaveBundle)
+	r.MethodFunc("POST", "/api/v0/settings/bundle-update", handler.UpdateBundle)
+	r.MethodFunc("POST", "/api/v0/settings/bundle-clone", handler.CloneBundle)
+	r.MethodFunc("POST", "/api/v0/settings/bundle-get", handler.GetBundle)
+	r.MethodFunc("POST", "/api/v0/settings/setting-get", handler.GetSetting)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-list", handler.ListBundles)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-add-setting", handler.AddSettingToBundle)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-remove-setting", handler.RemoveSettingFromBundle)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-reorder-settings", handler.ReorderSettings)
+	r.MethodFunc("POST", "/api/v0/settings/bundle-audit-list", handler.ListAudit)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-list-changed", handler.ListChangedBundles)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-list-public", handler.ListPublicBundles)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-diff", handler.DiffBundles)
+	r.MethodFunc("POST", "/api/v0/settings/bundles-plan-import", handler.PlanImport)
 }
 
-type webRoleServiceHandler struct {
+type webValueServiceHandler struct {
 	r chi.Router
-	h RoleServiceHandler
+	h ValueServiceHandler
 }
 
-func (h *webRoleServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+func (h *webValueServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.r.ServeHTTP(w, r)
 }
 
-func (h *webRoleServiceHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
-	req := &ListBundlesRequest{}
-	resp := &ListBundlesResponse{}
+func (h *webValueServiceHandler) SaveValue(w http.ResponseWriter, r *http.Request) {
+	req := &SaveValueRequest{}
+	resp := &SaveValueResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListRoles(
+	if err := h.h.SaveValue(
 		r.Context(),
 		req,
 		resp,
@@ -328,16 +446,16 @@ func (h *webRoleServiceHandler) ListRoles(w http.ResponseWriter, r *http.Request
 	render.JSON(w, r, resp)
 }
 
-func (h *webRoleServiceHandler) ListRoleAssignments(w http.ResponseWriter, r *http.Request) {
-	req := &ListRoleAssignmentsRequest{}
-	resp := &ListRoleAssignmentsResponse{}
+func (h *webValueServiceHandler) GetValue(w http.ResponseWriter, r *http.Request) {
+	req := &GetValueRequest{}
+	resp := &GetValueResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListRoleAssignments(
+	if err := h.h.GetValue(
 		r.Context(),
 		req,
 		resp,
@@ -354,16 +472,16 @@ func (h *webRoleServiceHandler) ListRoleAssignments(w http.ResponseWriter, r *ht
 	render.JSON(w, r, resp)
 }
 
-func (h *webRoleServiceHandler) AssignRoleToUser(w http.ResponseWriter, r *http.Request) {
-	req := &AssignRoleToUserRequest{}
-	resp := &AssignRoleToUserResponse{}
+func (h *webValueServiceHandler) GetValues(w http.ResponseWriter, r *http.Request) {
+	req := &GetValuesRequest{}
+	resp := &GetValuesResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.AssignRoleToUser(
+	if err := h.h.GetValues(
 		r.Context(),
 		req,
 		resp,
@@ -380,16 +498,16 @@ func (h *webRoleServiceHandler) AssignRoleToUser(w http.ResponseWriter, r *http.
 	render.JSON(w, r, resp)
 }
 
-func (h *webRoleServiceHandler) RemoveRoleFromUser(w http.ResponseWriter, r *http.Request) {
-	req := &RemoveRoleFromUserRequest{}
-	resp := &ptypesempty.Empty{}
+func (h *webValueServiceHandler) ListValues(w http.ResponseWriter, r *http.Request) {
+	req := &ListValuesRequest{}
+	resp := &ListValuesResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.RemoveRoleFromUser(
+	if err := h.h.ListValues(
 		r.Context(),
 		req,
 		resp,
@@ -402,41 +520,46 @@ func (h *webRoleServiceHandler) RemoveRoleFromUser(w http.ResponseWriter, r *htt
 		return
 	}
 
-	render.Status(r, http.StatusNoContent)
-	render.NoContent(w, r)
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func RegisterRoleServiceWeb(r chi.Router, i RoleServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webRoleServiceHandler{
-		r: r,
-		h: i,
-	}
+func (h *webValueServiceHandler) GetValueByUniqueIdentifiers(w http.ResponseWriter, r *http.Request) {
+	req := &GetValueByUniqueIdentifiersRequest{}
+	resp := &GetValueResponse{}
 
-	r.MethodFunc("POST", "/api/v0/settings/roles-list", handler.ListRoles)
-	r.MethodFunc("POST", "/api/v0/settings/assignments-list", handler.ListRoleAssignments)
-	r.MethodFunc("POST", "/api/v0/settings/assignments-add", handler.AssignRoleToUser)
-	r.MethodFunc("POST", "/api/v0/settings/assignments-remove", handler.RemoveRoleFromUser)
-}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
-type webPermissionServiceHandler struct {
-	r chi.Router
-	h PermissionServiceHandler
-}
+	if err := h.h.GetValueByUniqueIdentifiers(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
 
-func (h *webPermissionServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	h.r.ServeHTTP(w, r)
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webPermissionServiceHandler) ListPermissionsByResource(w http.ResponseWriter, r *http.Request) {
-	req := &ListPermissionsByResourceRequest{}
-	resp := &ListPermissionsByResourceResponse{}
+func (h *webValueServiceHandler) ListValueHistory(w http.ResponseWriter, r *http.Request) {
+	req := &ListValueHistoryRequest{}
+	resp := &ListValueHistoryResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListPermissionsByResource(
+	if err := h.h.ListValueHistory(
 		r.Context(),
 		req,
 		resp,
@@ -453,16 +576,16 @@ func (h *webPermissionServiceHandler) ListPermissionsByResource(w http.ResponseW
 	render.JSON(w, r, resp)
 }
 
-func (h *webPermissionServiceHandler) GetPermissionByID(w http.ResponseWriter, r *http.Request) {
-	req := &GetPermissionByIDRequest{}
-	resp := &GetPermissionByIDResponse{}
+func (h *webValueServiceHandler) ValidateValue(w http.ResponseWriter, r *http.Request) {
+	req := &ValidateValueRequest{}
+	resp := &ValidateValueResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.GetPermissionByID(
+	if err := h.h.ValidateValue(
 		r.Context(),
 		req,
 		resp,
@@ -479,771 +602,3213 @@ func (h *webPermissionServiceHandler) GetPermissionByID(w http.ResponseWriter, r
 	render.JSON(w, r, resp)
 }
 
-func RegisterPermissionServiceWeb(r chi.Router, i PermissionServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webPermissionServiceHandler{
-		r: r,
-		h: i,
+func (h *webValueServiceHandler) BulkValidateValues(w http.ResponseWriter, r *http.Request) {
+	req := &BulkValidateValuesRequest{}
+	resp := &BulkValidateValuesResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	r.MethodFunc("POST", "/api/v0/settings/permissions-list-by-resource", handler.ListPermissionsByResource)
-	r.MethodFunc("POST", "/api/v0/settings/permissions-get-by-id", handler.GetPermissionByID)
+	if err := h.h.BulkValidateValues(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-// SaveBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of SaveBundleRequest. This struct is safe to replace or modify but
+func (h *webValueServiceHandler) ListValuesModifiedSince(w http.ResponseWriter, r *http.Request) {
+	req := &ListValuesModifiedSinceRequest{}
+	resp := &ListValuesModifiedSinceResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListValuesModifiedSince(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webValueServiceHandler) PurgeAccount(w http.ResponseWriter, r *http.Request) {
+	req := &PurgeAccountRequest{}
+	resp := &PurgeAccountResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.PurgeAccount(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webValueServiceHandler) BatchGetEffectiveValues(w http.ResponseWriter, r *http.Request) {
+	req := &BatchGetEffectiveValuesRequest{}
+	resp := &BatchGetEffectiveValuesResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.BatchGetEffectiveValues(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func RegisterValueServiceWeb(r chi.Router, i ValueServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webValueServiceHandler{
+		r: r,
+		h: i,
+	}
+
+	r.MethodFunc("POST", "/api/v0/settings/values-save", handler.SaveValue)
+	r.MethodFunc("POST", "/api/v0/settings/values-get", handler.GetValue)
+	r.MethodFunc("POST", "/api/v0/settings/values-get-many", handler.GetValues)
+	r.MethodFunc("POST", "/api/v0/settings/values-list", handler.ListValues)
+	r.MethodFunc("POST", "/api/v0/settings/values-get-by-unique-identifiers", handler.GetValueByUniqueIdentifiers)
+	r.MethodFunc("POST", "/api/v0/settings/values-history-list", handler.ListValueHistory)
+	r.MethodFunc("POST", "/api/v0/settings/values-validate", handler.ValidateValue)
+	r.MethodFunc("POST", "/api/v0/settings/values-validate-bulk", handler.BulkValidateValues)
+	r.MethodFunc("POST", "/api/v0/settings/values-list-modified-since", handler.ListValuesModifiedSince)
+	r.MethodFunc("POST", "/api/v0/settings/values-purge-account", handler.PurgeAccount)
+	r.MethodFunc("POST", "/api/v0/settings/values-get-effective", handler.BatchGetEffectiveValues)
+}
+
+type webRoleServiceHandler struct {
+	r chi.Router
+	h RoleServiceHandler
+}
+
+func (h *webRoleServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.r.ServeHTTP(w, r)
+}
+
+func (h *webRoleServiceHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	req := &ListBundlesRequest{}
+	resp := &ListBundlesResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListRoles(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) ListRoleAssignments(w http.ResponseWriter, r *http.Request) {
+	req := &ListRoleAssignmentsRequest{}
+	resp := &ListRoleAssignmentsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListRoleAssignments(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) AssignRoleToUser(w http.ResponseWriter, r *http.Request) {
+	req := &AssignRoleToUserRequest{}
+	resp := &AssignRoleToUserResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.AssignRoleToUser(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) PreviewRoleAssignment(w http.ResponseWriter, r *http.Request) {
+	req := &AssignRoleToUserRequest{}
+	resp := &PreviewRoleAssignmentResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.PreviewRoleAssignment(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) RemoveRoleFromUser(w http.ResponseWriter, r *http.Request) {
+	req := &RemoveRoleFromUserRequest{}
+	resp := &ptypesempty.Empty{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RemoveRoleFromUser(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+func (h *webRoleServiceHandler) BulkAssignRole(w http.ResponseWriter, r *http.Request) {
+	req := &BulkRoleAssignmentRequest{}
+	resp := &BulkRoleAssignmentResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.BulkAssignRole(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) BulkRemoveRole(w http.ResponseWriter, r *http.Request) {
+	req := &BulkRoleAssignmentRequest{}
+	resp := &BulkRoleAssignmentResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.BulkRemoveRole(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) AnalyzeRolePermissions(w http.ResponseWriter, r *http.Request) {
+	req := &AnalyzeRolePermissionsRequest{}
+	resp := &AnalyzeRolePermissionsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.AnalyzeRolePermissions(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) ExportPermissionMatrix(w http.ResponseWriter, r *http.Request) {
+	req := &ExportPermissionMatrixRequest{}
+	resp := &ExportPermissionMatrixResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ExportPermissionMatrix(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) RebuildAssignmentIndex(w http.ResponseWriter, r *http.Request) {
+	req := &RebuildAssignmentIndexRequest{}
+	resp := &RebuildAssignmentIndexResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RebuildAssignmentIndex(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func RegisterRoleServiceWeb(r chi.Router, i RoleServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webRoleServiceHandler{
+		r: r,
+		h: i,
+	}
+
+	r.MethodFunc("POST", "/api/v0/settings/roles-list", handler.ListRoles)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-list", handler.ListRoleAssignments)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-add", handler.AssignRoleToUser)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-preview", handler.PreviewRoleAssignment)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-remove", handler.RemoveRoleFromUser)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-bulk-add", handler.BulkAssignRole)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-bulk-remove", handler.BulkRemoveRole)
+	r.MethodFunc("POST", "/api/v0/settings/roles-analyze", handler.AnalyzeRolePermissions)
+	r.MethodFunc("POST", "/api/v0/settings/roles-permission-matrix", handler.ExportPermissionMatrix)
+	r.MethodFunc("POST", "/api/v0/settings/assignments-rebuild-index", handler.RebuildAssignmentIndex)
+}
+
+type webPermissionServiceHandler struct {
+	r chi.Router
+	h PermissionServiceHandler
+}
+
+func (h *webPermissionServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.r.ServeHTTP(w, r)
+}
+
+func (h *webPermissionServiceHandler) ListPermissionsByResource(w http.ResponseWriter, r *http.Request) {
+	req := &ListPermissionsByResourceRequest{}
+	resp := &ListPermissionsByResourceResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListPermissionsByResource(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webPermissionServiceHandler) GetPermissionByID(w http.ResponseWriter, r *http.Request) {
+	req := &GetPermissionByIDRequest{}
+	resp := &GetPermissionByIDResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.GetPermissionByID(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webPermissionServiceHandler) ExplainPermission(w http.ResponseWriter, r *http.Request) {
+	req := &ExplainPermissionRequest{}
+	resp := &ExplainPermissionResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ExplainPermission(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func RegisterPermissionServiceWeb(r chi.Router, i PermissionServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webPermissionServiceHandler{
+		r: r,
+		h: i,
+	}
+
+	r.MethodFunc("POST", "/api/v0/settings/permissions-list-by-resource", handler.ListPermissionsByResource)
+	r.MethodFunc("POST", "/api/v0/settings/permissions-get-by-id", handler.GetPermissionByID)
+	r.MethodFunc("POST", "/api/v0/settings/permissions-explain", handler.ExplainPermission)
+}
+
+// SaveBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveBundleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveBundleRequest)(nil)
+
+// SaveBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveBundleRequest) UnmarshalJSON(b []byte) error {
+	return SaveBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveBundleRequest)(nil)
+
+// SaveBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveBundleResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveBundleResponse)(nil)
+
+// SaveBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveBundleResponse) UnmarshalJSON(b []byte) error {
+	return SaveBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveBundleResponse)(nil)
+
+// UpdateBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UpdateBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UpdateBundleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpdateBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UpdateBundleRequest)(nil)
+
+// UpdateBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UpdateBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UpdateBundleRequest) UnmarshalJSON(b []byte) error {
+	return UpdateBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UpdateBundleRequest)(nil)
+
+// UpdateBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UpdateBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UpdateBundleResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpdateBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UpdateBundleResponse)(nil)
+
+// UpdateBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UpdateBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UpdateBundleResponse) UnmarshalJSON(b []byte) error {
+	return UpdateBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UpdateBundleResponse)(nil)
+
+// CloneBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CloneBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CloneBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CloneBundleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CloneBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CloneBundleRequest)(nil)
+
+// CloneBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CloneBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CloneBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CloneBundleRequest) UnmarshalJSON(b []byte) error {
+	return CloneBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CloneBundleRequest)(nil)
+
+// CloneBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CloneBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CloneBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CloneBundleResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CloneBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CloneBundleResponse)(nil)
+
+// CloneBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CloneBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CloneBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CloneBundleResponse) UnmarshalJSON(b []byte) error {
+	return CloneBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CloneBundleResponse)(nil)
+
+// GetBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetBundleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetBundleRequest)(nil)
+
+// GetBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetBundleRequest) UnmarshalJSON(b []byte) error {
+	return GetBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetBundleRequest)(nil)
+
+// GetBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetBundleResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetBundleResponse)(nil)
+
+// GetBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetBundleResponse) UnmarshalJSON(b []byte) error {
+	return GetBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetBundleResponse)(nil)
+
+// GetSettingRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetSettingRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSettingRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetSettingRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetSettingRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetSettingRequest)(nil)
+
+// GetSettingRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetSettingRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSettingRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetSettingRequest) UnmarshalJSON(b []byte) error {
+	return GetSettingRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetSettingRequest)(nil)
+
+// GetSettingResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetSettingResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSettingResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetSettingResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetSettingResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetSettingResponse)(nil)
+
+// GetSettingResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetSettingResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetSettingResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetSettingResponse) UnmarshalJSON(b []byte) error {
+	return GetSettingResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetSettingResponse)(nil)
+
+// RoleGrantsJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RoleGrants. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RoleGrantsJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RoleGrants) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RoleGrantsJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RoleGrants)(nil)
+
+// RoleGrantsJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RoleGrants. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RoleGrantsJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RoleGrants) UnmarshalJSON(b []byte) error {
+	return RoleGrantsJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RoleGrants)(nil)
+
+// RoleGrantJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RoleGrant. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RoleGrantJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RoleGrant) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RoleGrantJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RoleGrant)(nil)
+
+// RoleGrantJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RoleGrant. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RoleGrantJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RoleGrant) UnmarshalJSON(b []byte) error {
+	return RoleGrantJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RoleGrant)(nil)
+
+// ListBundlesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListBundlesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListBundlesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListBundlesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListBundlesRequest)(nil)
+
+// ListBundlesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListBundlesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListBundlesRequest) UnmarshalJSON(b []byte) error {
+	return ListBundlesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListBundlesRequest)(nil)
+
+// ListBundlesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListBundlesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListBundlesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListBundlesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListBundlesResponse)(nil)
+
+// ListBundlesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListBundlesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListBundlesResponse) UnmarshalJSON(b []byte) error {
+	return ListBundlesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListBundlesResponse)(nil)
+
+// AddSettingToBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AddSettingToBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddSettingToBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AddSettingToBundleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AddSettingToBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AddSettingToBundleRequest)(nil)
+
+// AddSettingToBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AddSettingToBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddSettingToBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AddSettingToBundleRequest) UnmarshalJSON(b []byte) error {
+	return AddSettingToBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AddSettingToBundleRequest)(nil)
+
+// AddSettingToBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AddSettingToBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddSettingToBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AddSettingToBundleResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AddSettingToBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AddSettingToBundleResponse)(nil)
+
+// AddSettingToBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AddSettingToBundleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddSettingToBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AddSettingToBundleResponse) UnmarshalJSON(b []byte) error {
+	return AddSettingToBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AddSettingToBundleResponse)(nil)
+
+// RemoveSettingFromBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RemoveSettingFromBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RemoveSettingFromBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RemoveSettingFromBundleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RemoveSettingFromBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RemoveSettingFromBundleRequest)(nil)
+
+// RemoveSettingFromBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RemoveSettingFromBundleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RemoveSettingFromBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RemoveSettingFromBundleRequest) UnmarshalJSON(b []byte) error {
+	return RemoveSettingFromBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RemoveSettingFromBundleRequest)(nil)
+
+// ReorderSettingsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ReorderSettingsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ReorderSettingsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ReorderSettingsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ReorderSettingsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ReorderSettingsRequest)(nil)
+
+// ReorderSettingsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ReorderSettingsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ReorderSettingsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ReorderSettingsRequest) UnmarshalJSON(b []byte) error {
+	return ReorderSettingsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ReorderSettingsRequest)(nil)
+
+// ReorderSettingsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ReorderSettingsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ReorderSettingsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ReorderSettingsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ReorderSettingsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ReorderSettingsResponse)(nil)
+
+// ReorderSettingsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ReorderSettingsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ReorderSettingsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ReorderSettingsResponse) UnmarshalJSON(b []byte) error {
+	return ReorderSettingsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ReorderSettingsResponse)(nil)
+
+// ListAuditRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAuditRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAuditRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAuditRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAuditRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAuditRequest)(nil)
+
+// ListAuditRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAuditRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAuditRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAuditRequest) UnmarshalJSON(b []byte) error {
+	return ListAuditRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAuditRequest)(nil)
+
+// ListAuditResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAuditResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAuditResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAuditResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAuditResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAuditResponse)(nil)
+
+// ListAuditResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAuditResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAuditResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAuditResponse) UnmarshalJSON(b []byte) error {
+	return ListAuditResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAuditResponse)(nil)
+
+// ListChangedBundlesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListChangedBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListChangedBundlesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListChangedBundlesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListChangedBundlesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListChangedBundlesRequest)(nil)
+
+// ListChangedBundlesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListChangedBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListChangedBundlesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListChangedBundlesRequest) UnmarshalJSON(b []byte) error {
+	return ListChangedBundlesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListChangedBundlesRequest)(nil)
+
+// ListChangedBundlesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListChangedBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListChangedBundlesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListChangedBundlesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListChangedBundlesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListChangedBundlesResponse)(nil)
+
+// ListChangedBundlesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListChangedBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListChangedBundlesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListChangedBundlesResponse) UnmarshalJSON(b []byte) error {
+	return ListChangedBundlesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListChangedBundlesResponse)(nil)
+
+// DiffBundlesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DiffBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffBundlesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DiffBundlesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DiffBundlesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DiffBundlesRequest)(nil)
+
+// DiffBundlesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DiffBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffBundlesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DiffBundlesRequest) UnmarshalJSON(b []byte) error {
+	return DiffBundlesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DiffBundlesRequest)(nil)
+
+// DiffBundlesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DiffBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffBundlesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DiffBundlesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DiffBundlesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DiffBundlesResponse)(nil)
+
+// DiffBundlesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DiffBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffBundlesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DiffBundlesResponse) UnmarshalJSON(b []byte) error {
+	return DiffBundlesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DiffBundlesResponse)(nil)
+
+// SettingChangeJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SettingChange. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SettingChangeJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SettingChange) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SettingChangeJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SettingChange)(nil)
+
+// SettingChangeJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SettingChange. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SettingChangeJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SettingChange) UnmarshalJSON(b []byte) error {
+	return SettingChangeJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SettingChange)(nil)
+
+// FieldChangeJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of FieldChange. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FieldChangeJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *FieldChange) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := FieldChangeJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*FieldChange)(nil)
+
+// FieldChangeJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of FieldChange. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FieldChangeJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *FieldChange) UnmarshalJSON(b []byte) error {
+	return FieldChangeJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*FieldChange)(nil)
+
+// PlanImportRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PlanImportRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PlanImportRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *PlanImportRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := PlanImportRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*PlanImportRequest)(nil)
+
+// PlanImportRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PlanImportRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PlanImportRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *PlanImportRequest) UnmarshalJSON(b []byte) error {
+	return PlanImportRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*PlanImportRequest)(nil)
+
+// PlanImportResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PlanImportResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PlanImportResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *PlanImportResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := PlanImportResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*PlanImportResponse)(nil)
+
+// PlanImportResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PlanImportResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PlanImportResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *PlanImportResponse) UnmarshalJSON(b []byte) error {
+	return PlanImportResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*PlanImportResponse)(nil)
+
+// ImportPlanEntryJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ImportPlanEntry. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ImportPlanEntryJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ImportPlanEntry) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ImportPlanEntryJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ImportPlanEntry)(nil)
+
+// ImportPlanEntryJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ImportPlanEntry. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ImportPlanEntryJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ImportPlanEntry) UnmarshalJSON(b []byte) error {
+	return ImportPlanEntryJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ImportPlanEntry)(nil)
+
+// ListPublicBundlesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListPublicBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListPublicBundlesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListPublicBundlesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListPublicBundlesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListPublicBundlesRequest)(nil)
+
+// ListPublicBundlesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListPublicBundlesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListPublicBundlesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListPublicBundlesRequest) UnmarshalJSON(b []byte) error {
+	return ListPublicBundlesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListPublicBundlesRequest)(nil)
+
+// ListPublicBundlesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListPublicBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListPublicBundlesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListPublicBundlesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListPublicBundlesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListPublicBundlesResponse)(nil)
+
+// ListPublicBundlesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListPublicBundlesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListPublicBundlesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListPublicBundlesResponse) UnmarshalJSON(b []byte) error {
+	return ListPublicBundlesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListPublicBundlesResponse)(nil)
+
+// SaveValueRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveValueRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveValueRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveValueRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveValueRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveValueRequest)(nil)
+
+// SaveValueRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveValueRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveValueRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveValueRequest) UnmarshalJSON(b []byte) error {
+	return SaveValueRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveValueRequest)(nil)
+
+// SaveValueResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveValueResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveValueResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveValueResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveValueResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveValueResponse)(nil)
+
+// SaveValueResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveValueResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveValueResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveValueResponse) UnmarshalJSON(b []byte) error {
+	return SaveValueResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveValueResponse)(nil)
+
+// GetValueRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetValueRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValueRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetValueRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetValueRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetValueRequest)(nil)
+
+// GetValueRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetValueRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValueRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetValueRequest) UnmarshalJSON(b []byte) error {
+	return GetValueRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetValueRequest)(nil)
+
+// GetValueResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetValueResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValueResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetValueResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetValueResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetValueResponse)(nil)
+
+// GetValueResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetValueResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValueResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetValueResponse) UnmarshalJSON(b []byte) error {
+	return GetValueResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetValueResponse)(nil)
+
+// GetValuesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetValuesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValuesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetValuesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetValuesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetValuesRequest)(nil)
+
+// GetValuesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetValuesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValuesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetValuesRequest) UnmarshalJSON(b []byte) error {
+	return GetValuesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetValuesRequest)(nil)
+
+// GetValuesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValuesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetValuesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetValuesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetValuesResponse)(nil)
+
+// GetValuesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValuesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetValuesResponse) UnmarshalJSON(b []byte) error {
+	return GetValuesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetValuesResponse)(nil)
+
+// BatchGetEffectiveValuesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BatchGetEffectiveValuesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var BatchGetEffectiveValuesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *BatchGetEffectiveValuesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := BatchGetEffectiveValuesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*BatchGetEffectiveValuesRequest)(nil)
+
+// BatchGetEffectiveValuesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BatchGetEffectiveValuesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var BatchGetEffectiveValuesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *BatchGetEffectiveValuesRequest) UnmarshalJSON(b []byte) error {
+	return BatchGetEffectiveValuesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*BatchGetEffectiveValuesRequest)(nil)
+
+// BatchGetEffectiveValuesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BatchGetEffectiveValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var BatchGetEffectiveValuesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *BatchGetEffectiveValuesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := BatchGetEffectiveValuesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*BatchGetEffectiveValuesResponse)(nil)
+
+// BatchGetEffectiveValuesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BatchGetEffectiveValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var BatchGetEffectiveValuesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *BatchGetEffectiveValuesResponse) UnmarshalJSON(b []byte) error {
+	return BatchGetEffectiveValuesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*BatchGetEffectiveValuesResponse)(nil)
+
+// EffectiveValueJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of EffectiveValue. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var EffectiveValueJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *EffectiveValue) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := EffectiveValueJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*EffectiveValue)(nil)
+
+// EffectiveValueJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of EffectiveValue. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var EffectiveValueJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *EffectiveValue) UnmarshalJSON(b []byte) error {
+	return EffectiveValueJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*EffectiveValue)(nil)
+
+// ListValuesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListValuesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListValuesRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListValuesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListValuesRequest)(nil)
+
+// ListValuesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListValuesRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListValuesRequest) UnmarshalJSON(b []byte) error {
+	return ListValuesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListValuesRequest)(nil)
+
+// ListValuesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListValuesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListValuesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListValuesResponse)(nil)
+
+// ListValuesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListValuesResponse) UnmarshalJSON(b []byte) error {
+	return ListValuesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListValuesResponse)(nil)
+
+// ListValuesModifiedSinceRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListValuesModifiedSinceRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesModifiedSinceRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListValuesModifiedSinceRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListValuesModifiedSinceRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListValuesModifiedSinceRequest)(nil)
+
+// ListValuesModifiedSinceRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListValuesModifiedSinceRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesModifiedSinceRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListValuesModifiedSinceRequest) UnmarshalJSON(b []byte) error {
+	return ListValuesModifiedSinceRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListValuesModifiedSinceRequest)(nil)
+
+// ListValuesModifiedSinceResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListValuesModifiedSinceResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesModifiedSinceResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListValuesModifiedSinceResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListValuesModifiedSinceResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListValuesModifiedSinceResponse)(nil)
+
+// ListValuesModifiedSinceResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListValuesModifiedSinceResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListValuesModifiedSinceResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListValuesModifiedSinceResponse) UnmarshalJSON(b []byte) error {
+	return ListValuesModifiedSinceResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListValuesModifiedSinceResponse)(nil)
+
+// PurgeAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PurgeAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PurgeAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *PurgeAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := PurgeAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*PurgeAccountRequest)(nil)
+
+// PurgeAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PurgeAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PurgeAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *PurgeAccountRequest) UnmarshalJSON(b []byte) error {
+	return PurgeAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*PurgeAccountRequest)(nil)
+
+// PurgeAccountResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PurgeAccountResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PurgeAccountResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *PurgeAccountResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := PurgeAccountResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*PurgeAccountResponse)(nil)
+
+// PurgeAccountResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PurgeAccountResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PurgeAccountResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *PurgeAccountResponse) UnmarshalJSON(b []byte) error {
+	return PurgeAccountResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*PurgeAccountResponse)(nil)
+
+// GetValueByUniqueIdentifiersRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetValueByUniqueIdentifiersRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValueByUniqueIdentifiersRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetValueByUniqueIdentifiersRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetValueByUniqueIdentifiersRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetValueByUniqueIdentifiersRequest)(nil)
+
+// GetValueByUniqueIdentifiersRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetValueByUniqueIdentifiersRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetValueByUniqueIdentifiersRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetValueByUniqueIdentifiersRequest) UnmarshalJSON(b []byte) error {
+	return GetValueByUniqueIdentifiersRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetValueByUniqueIdentifiersRequest)(nil)
+
+// ListValueHistoryRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListValueHistoryRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+var ListValueHistoryRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *SaveBundleRequest) MarshalJSON() ([]byte, error) {
+func (m *ListValueHistoryRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := SaveBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ListValueHistoryRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*SaveBundleRequest)(nil)
+var _ json.Marshaler = (*ListValueHistoryRequest)(nil)
 
-// SaveBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of SaveBundleRequest. This struct is safe to replace or modify but
+// ListValueHistoryRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListValueHistoryRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ListValueHistoryRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *SaveBundleRequest) UnmarshalJSON(b []byte) error {
-	return SaveBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ListValueHistoryRequest) UnmarshalJSON(b []byte) error {
+	return ListValueHistoryRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*SaveBundleRequest)(nil)
+var _ json.Unmarshaler = (*ListValueHistoryRequest)(nil)
 
-// SaveBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of SaveBundleResponse. This struct is safe to replace or modify but
+// ListValueHistoryResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListValueHistoryResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+var ListValueHistoryResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *SaveBundleResponse) MarshalJSON() ([]byte, error) {
+func (m *ListValueHistoryResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := SaveBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ListValueHistoryResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*SaveBundleResponse)(nil)
+var _ json.Marshaler = (*ListValueHistoryResponse)(nil)
 
-// SaveBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of SaveBundleResponse. This struct is safe to replace or modify but
+// ListValueHistoryResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListValueHistoryResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ListValueHistoryResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *SaveBundleResponse) UnmarshalJSON(b []byte) error {
-	return SaveBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ListValueHistoryResponse) UnmarshalJSON(b []byte) error {
+	return ListValueHistoryResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*SaveBundleResponse)(nil)
+var _ json.Unmarshaler = (*ListValueHistoryResponse)(nil)
 
-// GetBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetBundleRequest. This struct is safe to replace or modify but
+// ValidateValueRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateValueRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+var ValidateValueRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetBundleRequest) MarshalJSON() ([]byte, error) {
+func (m *ValidateValueRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ValidateValueRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetBundleRequest)(nil)
+var _ json.Marshaler = (*ValidateValueRequest)(nil)
 
-// GetBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetBundleRequest. This struct is safe to replace or modify but
+// ValidateValueRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateValueRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ValidateValueRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetBundleRequest) UnmarshalJSON(b []byte) error {
-	return GetBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ValidateValueRequest) UnmarshalJSON(b []byte) error {
+	return ValidateValueRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetBundleRequest)(nil)
+var _ json.Unmarshaler = (*ValidateValueRequest)(nil)
 
-// GetBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetBundleResponse. This struct is safe to replace or modify but
+// ValidateValueResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateValueResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+var ValidateValueResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetBundleResponse) MarshalJSON() ([]byte, error) {
+func (m *ValidateValueResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ValidateValueResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetBundleResponse)(nil)
+var _ json.Marshaler = (*ValidateValueResponse)(nil)
 
-// GetBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetBundleResponse. This struct is safe to replace or modify but
+// ValidateValueResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateValueResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ValidateValueResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetBundleResponse) UnmarshalJSON(b []byte) error {
-	return GetBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ValidateValueResponse) UnmarshalJSON(b []byte) error {
+	return ValidateValueResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetBundleResponse)(nil)
+var _ json.Unmarshaler = (*ValidateValueResponse)(nil)
 
-// ListBundlesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListBundlesRequest. This struct is safe to replace or modify but
+// BulkValidateValuesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BulkValidateValuesRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListBundlesRequestJSONMarshaler = new(jsonpb.Marshaler)
+var BulkValidateValuesRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListBundlesRequest) MarshalJSON() ([]byte, error) {
+func (m *BulkValidateValuesRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListBundlesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := BulkValidateValuesRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListBundlesRequest)(nil)
+var _ json.Marshaler = (*BulkValidateValuesRequest)(nil)
 
-// ListBundlesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListBundlesRequest. This struct is safe to replace or modify but
+// BulkValidateValuesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BulkValidateValuesRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListBundlesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var BulkValidateValuesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListBundlesRequest) UnmarshalJSON(b []byte) error {
-	return ListBundlesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *BulkValidateValuesRequest) UnmarshalJSON(b []byte) error {
+	return BulkValidateValuesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*BulkValidateValuesRequest)(nil)
+
+// BulkValidateValuesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BulkValidateValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var BulkValidateValuesResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *BulkValidateValuesResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := BulkValidateValuesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*BulkValidateValuesResponse)(nil)
+
+// BulkValidateValuesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BulkValidateValuesResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var BulkValidateValuesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *BulkValidateValuesResponse) UnmarshalJSON(b []byte) error {
+	return BulkValidateValuesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*BulkValidateValuesResponse)(nil)
+
+// ValidationErrorJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidationError. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidationErrorJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidationError) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidationErrorJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidationError)(nil)
+
+// ValidationErrorJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidationError. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidationErrorJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidationError) UnmarshalJSON(b []byte) error {
+	return ValidationErrorJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidationError)(nil)
+
+// ListRoleAssignmentsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListRoleAssignmentsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListRoleAssignmentsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListRoleAssignmentsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListRoleAssignmentsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListRoleAssignmentsRequest)(nil)
+
+// ListRoleAssignmentsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListRoleAssignmentsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListRoleAssignmentsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListRoleAssignmentsRequest) UnmarshalJSON(b []byte) error {
+	return ListRoleAssignmentsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListRoleAssignmentsRequest)(nil)
+
+// ListRoleAssignmentsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListRoleAssignmentsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListRoleAssignmentsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListRoleAssignmentsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListRoleAssignmentsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListRoleAssignmentsResponse)(nil)
+
+// ListRoleAssignmentsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListRoleAssignmentsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListRoleAssignmentsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListRoleAssignmentsResponse) UnmarshalJSON(b []byte) error {
+	return ListRoleAssignmentsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListRoleAssignmentsResponse)(nil)
+
+// AssignRoleToUserRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AssignRoleToUserRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AssignRoleToUserRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AssignRoleToUserRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AssignRoleToUserRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AssignRoleToUserRequest)(nil)
+
+// AssignRoleToUserRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AssignRoleToUserRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AssignRoleToUserRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AssignRoleToUserRequest) UnmarshalJSON(b []byte) error {
+	return AssignRoleToUserRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AssignRoleToUserRequest)(nil)
+
+// AssignRoleToUserResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AssignRoleToUserResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AssignRoleToUserResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AssignRoleToUserResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AssignRoleToUserResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AssignRoleToUserResponse)(nil)
+
+// AssignRoleToUserResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AssignRoleToUserResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AssignRoleToUserResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AssignRoleToUserResponse) UnmarshalJSON(b []byte) error {
+	return AssignRoleToUserResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListBundlesRequest)(nil)
+var _ json.Unmarshaler = (*AssignRoleToUserResponse)(nil)
 
-// ListBundlesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListBundlesResponse. This struct is safe to replace or modify but
+// PreviewRoleAssignmentResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PreviewRoleAssignmentResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListBundlesResponseJSONMarshaler = new(jsonpb.Marshaler)
+var PreviewRoleAssignmentResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListBundlesResponse) MarshalJSON() ([]byte, error) {
+func (m *PreviewRoleAssignmentResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListBundlesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := PreviewRoleAssignmentResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListBundlesResponse)(nil)
+var _ json.Marshaler = (*PreviewRoleAssignmentResponse)(nil)
 
-// ListBundlesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListBundlesResponse. This struct is safe to replace or modify but
+// PreviewRoleAssignmentResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PreviewRoleAssignmentResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListBundlesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var PreviewRoleAssignmentResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListBundlesResponse) UnmarshalJSON(b []byte) error {
-	return ListBundlesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *PreviewRoleAssignmentResponse) UnmarshalJSON(b []byte) error {
+	return PreviewRoleAssignmentResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListBundlesResponse)(nil)
+var _ json.Unmarshaler = (*PreviewRoleAssignmentResponse)(nil)
 
-// AddSettingToBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of AddSettingToBundleRequest. This struct is safe to replace or modify but
+// PermissionGrantJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PermissionGrant. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AddSettingToBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+var PermissionGrantJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *AddSettingToBundleRequest) MarshalJSON() ([]byte, error) {
+func (m *PermissionGrant) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := AddSettingToBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := PermissionGrantJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*AddSettingToBundleRequest)(nil)
+var _ json.Marshaler = (*PermissionGrant)(nil)
 
-// AddSettingToBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of AddSettingToBundleRequest. This struct is safe to replace or modify but
+// PermissionGrantJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PermissionGrant. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AddSettingToBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var PermissionGrantJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *AddSettingToBundleRequest) UnmarshalJSON(b []byte) error {
-	return AddSettingToBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *PermissionGrant) UnmarshalJSON(b []byte) error {
+	return PermissionGrantJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*AddSettingToBundleRequest)(nil)
+var _ json.Unmarshaler = (*PermissionGrant)(nil)
 
-// AddSettingToBundleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of AddSettingToBundleResponse. This struct is safe to replace or modify but
+// RemoveRoleFromUserRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RemoveRoleFromUserRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AddSettingToBundleResponseJSONMarshaler = new(jsonpb.Marshaler)
+var RemoveRoleFromUserRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *AddSettingToBundleResponse) MarshalJSON() ([]byte, error) {
+func (m *RemoveRoleFromUserRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := AddSettingToBundleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := RemoveRoleFromUserRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*AddSettingToBundleResponse)(nil)
+var _ json.Marshaler = (*RemoveRoleFromUserRequest)(nil)
 
-// AddSettingToBundleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of AddSettingToBundleResponse. This struct is safe to replace or modify but
+// RemoveRoleFromUserRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RemoveRoleFromUserRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AddSettingToBundleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var RemoveRoleFromUserRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *AddSettingToBundleResponse) UnmarshalJSON(b []byte) error {
-	return AddSettingToBundleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *RemoveRoleFromUserRequest) UnmarshalJSON(b []byte) error {
+	return RemoveRoleFromUserRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*AddSettingToBundleResponse)(nil)
+var _ json.Unmarshaler = (*RemoveRoleFromUserRequest)(nil)
 
-// RemoveSettingFromBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of RemoveSettingFromBundleRequest. This struct is safe to replace or modify but
+// BulkRoleAssignmentRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BulkRoleAssignmentRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var RemoveSettingFromBundleRequestJSONMarshaler = new(jsonpb.Marshaler)
+var BulkRoleAssignmentRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *RemoveSettingFromBundleRequest) MarshalJSON() ([]byte, error) {
+func (m *BulkRoleAssignmentRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := RemoveSettingFromBundleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := BulkRoleAssignmentRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*RemoveSettingFromBundleRequest)(nil)
+var _ json.Marshaler = (*BulkRoleAssignmentRequest)(nil)
 
-// RemoveSettingFromBundleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of RemoveSettingFromBundleRequest. This struct is safe to replace or modify but
+// BulkRoleAssignmentRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BulkRoleAssignmentRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var RemoveSettingFromBundleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var BulkRoleAssignmentRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *RemoveSettingFromBundleRequest) UnmarshalJSON(b []byte) error {
-	return RemoveSettingFromBundleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *BulkRoleAssignmentRequest) UnmarshalJSON(b []byte) error {
+	return BulkRoleAssignmentRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*RemoveSettingFromBundleRequest)(nil)
+var _ json.Unmarshaler = (*BulkRoleAssignmentRequest)(nil)
 
-// SaveValueRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of SaveValueRequest. This struct is safe to replace or modify but
+// BulkRoleAssignmentResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BulkRoleAssignmentResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveValueRequestJSONMarshaler = new(jsonpb.Marshaler)
+var BulkRoleAssignmentResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *SaveValueRequest) MarshalJSON() ([]byte, error) {
+func (m *BulkRoleAssignmentResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := SaveValueRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := BulkRoleAssignmentResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*SaveValueRequest)(nil)
+var _ json.Marshaler = (*BulkRoleAssignmentResponse)(nil)
 
-// SaveValueRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of SaveValueRequest. This struct is safe to replace or modify but
+// BulkRoleAssignmentResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BulkRoleAssignmentResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveValueRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var BulkRoleAssignmentResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *SaveValueRequest) UnmarshalJSON(b []byte) error {
-	return SaveValueRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *BulkRoleAssignmentResponse) UnmarshalJSON(b []byte) error {
+	return BulkRoleAssignmentResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*SaveValueRequest)(nil)
+var _ json.Unmarshaler = (*BulkRoleAssignmentResponse)(nil)
 
-// SaveValueResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of SaveValueResponse. This struct is safe to replace or modify but
+// BulkRoleAssignmentResultJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of BulkRoleAssignmentResult. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveValueResponseJSONMarshaler = new(jsonpb.Marshaler)
+var BulkRoleAssignmentResultJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *SaveValueResponse) MarshalJSON() ([]byte, error) {
+func (m *BulkRoleAssignmentResult) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := SaveValueResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := BulkRoleAssignmentResultJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*SaveValueResponse)(nil)
+var _ json.Marshaler = (*BulkRoleAssignmentResult)(nil)
 
-// SaveValueResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of SaveValueResponse. This struct is safe to replace or modify but
+// BulkRoleAssignmentResultJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of BulkRoleAssignmentResult. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var SaveValueResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var BulkRoleAssignmentResultJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *SaveValueResponse) UnmarshalJSON(b []byte) error {
-	return SaveValueResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *BulkRoleAssignmentResult) UnmarshalJSON(b []byte) error {
+	return BulkRoleAssignmentResultJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*SaveValueResponse)(nil)
+var _ json.Unmarshaler = (*BulkRoleAssignmentResult)(nil)
 
-// GetValueRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetValueRequest. This struct is safe to replace or modify but
+// AnalyzeRolePermissionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AnalyzeRolePermissionsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetValueRequestJSONMarshaler = new(jsonpb.Marshaler)
+var AnalyzeRolePermissionsRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetValueRequest) MarshalJSON() ([]byte, error) {
+func (m *AnalyzeRolePermissionsRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetValueRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := AnalyzeRolePermissionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetValueRequest)(nil)
+var _ json.Marshaler = (*AnalyzeRolePermissionsRequest)(nil)
 
-// GetValueRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetValueRequest. This struct is safe to replace or modify but
+// AnalyzeRolePermissionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AnalyzeRolePermissionsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetValueRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var AnalyzeRolePermissionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetValueRequest) UnmarshalJSON(b []byte) error {
-	return GetValueRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *AnalyzeRolePermissionsRequest) UnmarshalJSON(b []byte) error {
+	return AnalyzeRolePermissionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetValueRequest)(nil)
+var _ json.Unmarshaler = (*AnalyzeRolePermissionsRequest)(nil)
 
-// GetValueResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetValueResponse. This struct is safe to replace or modify but
+// AnalyzeRolePermissionsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AnalyzeRolePermissionsResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetValueResponseJSONMarshaler = new(jsonpb.Marshaler)
+var AnalyzeRolePermissionsResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetValueResponse) MarshalJSON() ([]byte, error) {
+func (m *AnalyzeRolePermissionsResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetValueResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := AnalyzeRolePermissionsResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetValueResponse)(nil)
+var _ json.Marshaler = (*AnalyzeRolePermissionsResponse)(nil)
 
-// GetValueResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetValueResponse. This struct is safe to replace or modify but
+// AnalyzeRolePermissionsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AnalyzeRolePermissionsResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetValueResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var AnalyzeRolePermissionsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetValueResponse) UnmarshalJSON(b []byte) error {
-	return GetValueResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *AnalyzeRolePermissionsResponse) UnmarshalJSON(b []byte) error {
+	return AnalyzeRolePermissionsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetValueResponse)(nil)
+var _ json.Unmarshaler = (*AnalyzeRolePermissionsResponse)(nil)
 
-// ListValuesRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListValuesRequest. This struct is safe to replace or modify but
+// PermissionSourceJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PermissionSource. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListValuesRequestJSONMarshaler = new(jsonpb.Marshaler)
+var PermissionSourceJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListValuesRequest) MarshalJSON() ([]byte, error) {
+func (m *PermissionSource) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListValuesRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := PermissionSourceJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListValuesRequest)(nil)
+var _ json.Marshaler = (*PermissionSource)(nil)
 
-// ListValuesRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListValuesRequest. This struct is safe to replace or modify but
+// PermissionSourceJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PermissionSource. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListValuesRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var PermissionSourceJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListValuesRequest) UnmarshalJSON(b []byte) error {
-	return ListValuesRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *PermissionSource) UnmarshalJSON(b []byte) error {
+	return PermissionSourceJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListValuesRequest)(nil)
+var _ json.Unmarshaler = (*PermissionSource)(nil)
 
-// ListValuesResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListValuesResponse. This struct is safe to replace or modify but
+// ResourcePermissionsJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ResourcePermissions. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListValuesResponseJSONMarshaler = new(jsonpb.Marshaler)
+var ResourcePermissionsJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListValuesResponse) MarshalJSON() ([]byte, error) {
+func (m *ResourcePermissions) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListValuesResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ResourcePermissionsJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListValuesResponse)(nil)
+var _ json.Marshaler = (*ResourcePermissions)(nil)
 
-// ListValuesResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListValuesResponse. This struct is safe to replace or modify but
+// ResourcePermissionsJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ResourcePermissions. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListValuesResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ResourcePermissionsJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListValuesResponse) UnmarshalJSON(b []byte) error {
-	return ListValuesResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ResourcePermissions) UnmarshalJSON(b []byte) error {
+	return ResourcePermissionsJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListValuesResponse)(nil)
+var _ json.Unmarshaler = (*ResourcePermissions)(nil)
 
-// GetValueByUniqueIdentifiersRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetValueByUniqueIdentifiersRequest. This struct is safe to replace or modify but
+// PermissionConflictJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PermissionConflict. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetValueByUniqueIdentifiersRequestJSONMarshaler = new(jsonpb.Marshaler)
+var PermissionConflictJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetValueByUniqueIdentifiersRequest) MarshalJSON() ([]byte, error) {
+func (m *PermissionConflict) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetValueByUniqueIdentifiersRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := PermissionConflictJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetValueByUniqueIdentifiersRequest)(nil)
+var _ json.Marshaler = (*PermissionConflict)(nil)
 
-// GetValueByUniqueIdentifiersRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetValueByUniqueIdentifiersRequest. This struct is safe to replace or modify but
+// PermissionConflictJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PermissionConflict. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetValueByUniqueIdentifiersRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var PermissionConflictJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetValueByUniqueIdentifiersRequest) UnmarshalJSON(b []byte) error {
-	return GetValueByUniqueIdentifiersRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *PermissionConflict) UnmarshalJSON(b []byte) error {
+	return PermissionConflictJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetValueByUniqueIdentifiersRequest)(nil)
+var _ json.Unmarshaler = (*PermissionConflict)(nil)
 
-// ListRoleAssignmentsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListRoleAssignmentsRequest. This struct is safe to replace or modify but
+// ExportPermissionMatrixRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExportPermissionMatrixRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListRoleAssignmentsRequestJSONMarshaler = new(jsonpb.Marshaler)
+var ExportPermissionMatrixRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListRoleAssignmentsRequest) MarshalJSON() ([]byte, error) {
+func (m *ExportPermissionMatrixRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListRoleAssignmentsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ExportPermissionMatrixRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListRoleAssignmentsRequest)(nil)
+var _ json.Marshaler = (*ExportPermissionMatrixRequest)(nil)
 
-// ListRoleAssignmentsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListRoleAssignmentsRequest. This struct is safe to replace or modify but
+// ExportPermissionMatrixRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExportPermissionMatrixRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListRoleAssignmentsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ExportPermissionMatrixRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListRoleAssignmentsRequest) UnmarshalJSON(b []byte) error {
-	return ListRoleAssignmentsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ExportPermissionMatrixRequest) UnmarshalJSON(b []byte) error {
+	return ExportPermissionMatrixRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListRoleAssignmentsRequest)(nil)
+var _ json.Unmarshaler = (*ExportPermissionMatrixRequest)(nil)
 
-// ListRoleAssignmentsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListRoleAssignmentsResponse. This struct is safe to replace or modify but
+// ExportPermissionMatrixResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExportPermissionMatrixResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListRoleAssignmentsResponseJSONMarshaler = new(jsonpb.Marshaler)
+var ExportPermissionMatrixResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListRoleAssignmentsResponse) MarshalJSON() ([]byte, error) {
+func (m *ExportPermissionMatrixResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListRoleAssignmentsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ExportPermissionMatrixResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListRoleAssignmentsResponse)(nil)
+var _ json.Marshaler = (*ExportPermissionMatrixResponse)(nil)
 
-// ListRoleAssignmentsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListRoleAssignmentsResponse. This struct is safe to replace or modify but
+// ExportPermissionMatrixResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExportPermissionMatrixResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListRoleAssignmentsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ExportPermissionMatrixResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListRoleAssignmentsResponse) UnmarshalJSON(b []byte) error {
-	return ListRoleAssignmentsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ExportPermissionMatrixResponse) UnmarshalJSON(b []byte) error {
+	return ExportPermissionMatrixResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListRoleAssignmentsResponse)(nil)
+var _ json.Unmarshaler = (*ExportPermissionMatrixResponse)(nil)
 
-// AssignRoleToUserRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of AssignRoleToUserRequest. This struct is safe to replace or modify but
+// PermissionMatrixEntryJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PermissionMatrixEntry. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AssignRoleToUserRequestJSONMarshaler = new(jsonpb.Marshaler)
+var PermissionMatrixEntryJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *AssignRoleToUserRequest) MarshalJSON() ([]byte, error) {
+func (m *PermissionMatrixEntry) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := AssignRoleToUserRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := PermissionMatrixEntryJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*AssignRoleToUserRequest)(nil)
+var _ json.Marshaler = (*PermissionMatrixEntry)(nil)
 
-// AssignRoleToUserRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of AssignRoleToUserRequest. This struct is safe to replace or modify but
+// PermissionMatrixEntryJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PermissionMatrixEntry. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AssignRoleToUserRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var PermissionMatrixEntryJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *AssignRoleToUserRequest) UnmarshalJSON(b []byte) error {
-	return AssignRoleToUserRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *PermissionMatrixEntry) UnmarshalJSON(b []byte) error {
+	return PermissionMatrixEntryJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*AssignRoleToUserRequest)(nil)
+var _ json.Unmarshaler = (*PermissionMatrixEntry)(nil)
 
-// AssignRoleToUserResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of AssignRoleToUserResponse. This struct is safe to replace or modify but
+// RebuildAssignmentIndexRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RebuildAssignmentIndexRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AssignRoleToUserResponseJSONMarshaler = new(jsonpb.Marshaler)
+var RebuildAssignmentIndexRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *AssignRoleToUserResponse) MarshalJSON() ([]byte, error) {
+func (m *RebuildAssignmentIndexRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := AssignRoleToUserResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := RebuildAssignmentIndexRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*AssignRoleToUserResponse)(nil)
+var _ json.Marshaler = (*RebuildAssignmentIndexRequest)(nil)
 
-// AssignRoleToUserResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of AssignRoleToUserResponse. This struct is safe to replace or modify but
+// RebuildAssignmentIndexRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RebuildAssignmentIndexRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AssignRoleToUserResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var RebuildAssignmentIndexRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *AssignRoleToUserResponse) UnmarshalJSON(b []byte) error {
-	return AssignRoleToUserResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *RebuildAssignmentIndexRequest) UnmarshalJSON(b []byte) error {
+	return RebuildAssignmentIndexRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*AssignRoleToUserResponse)(nil)
+var _ json.Unmarshaler = (*RebuildAssignmentIndexRequest)(nil)
 
-// RemoveRoleFromUserRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of RemoveRoleFromUserRequest. This struct is safe to replace or modify but
+// RebuildAssignmentIndexResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RebuildAssignmentIndexResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var RemoveRoleFromUserRequestJSONMarshaler = new(jsonpb.Marshaler)
+var RebuildAssignmentIndexResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *RemoveRoleFromUserRequest) MarshalJSON() ([]byte, error) {
+func (m *RebuildAssignmentIndexResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := RemoveRoleFromUserRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := RebuildAssignmentIndexResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*RemoveRoleFromUserRequest)(nil)
+var _ json.Marshaler = (*RebuildAssignmentIndexResponse)(nil)
 
-// RemoveRoleFromUserRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of RemoveRoleFromUserRequest. This struct is safe to replace or modify but
+// RebuildAssignmentIndexResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RebuildAssignmentIndexResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var RemoveRoleFromUserRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var RebuildAssignmentIndexResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *RemoveRoleFromUserRequest) UnmarshalJSON(b []byte) error {
-	return RemoveRoleFromUserRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *RebuildAssignmentIndexResponse) UnmarshalJSON(b []byte) error {
+	return RebuildAssignmentIndexResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*RemoveRoleFromUserRequest)(nil)
+var _ json.Unmarshaler = (*RebuildAssignmentIndexResponse)(nil)
 
 // ListPermissionsByResourceRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of ListPermissionsByResourceRequest. This struct is safe to replace or modify but
@@ -1388,3 +3953,75 @@ func (m *GetPermissionByIDResponse) UnmarshalJSON(b []byte) error {
 }
 
 var _ json.Unmarshaler = (*GetPermissionByIDResponse)(nil)
+
+// ExplainPermissionRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExplainPermissionRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainPermissionRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ExplainPermissionRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ExplainPermissionRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ExplainPermissionRequest)(nil)
+
+// ExplainPermissionRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExplainPermissionRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainPermissionRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ExplainPermissionRequest) UnmarshalJSON(b []byte) error {
+	return ExplainPermissionRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ExplainPermissionRequest)(nil)
+
+// ExplainPermissionResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExplainPermissionResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainPermissionResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ExplainPermissionResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ExplainPermissionResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ExplainPermissionResponse)(nil)
+
+// ExplainPermissionResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExplainPermissionResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainPermissionResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ExplainPermissionResponse) UnmarshalJSON(b []byte) error {
+	return ExplainPermissionResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ExplainPermissionResponse)(nil)
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-micro. DO NOT EDIT.
+// source: ocis/services/settingschanges/v0/settingschanges.proto
+
+package v0
+
+import (
+	fmt "fmt"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	proto "google.golang.org/protobuf/proto"
+	math "math"
+)
+
+import (
+	context "context"
+	api "go-micro.dev/v4/api"
+	client "go-micro.dev/v4/client"
+	server "go-micro.dev/v4/server"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ api.Endpoint
+var _ context.Context
+var _ client.Option
+var _ server.Option
+
+// Api Endpoints for ChangeService service
+
+func NewChangeServiceEndpoints() []*api.Endpoint {
+	return []*api.Endpoint{}
+}
+
+// Client API for ChangeService service
+
+type ChangeService interface {
+	// SubscribeValueChanges streams the changes of the values of the given settings.
+	SubscribeValueChanges(ctx context.Context, in *SubscribeValueChangesRequest, opts ...client.CallOption) (ChangeService_SubscribeValueChangesService, error)
+}
+
+type changeService struct {
+	c    client.Client
+	name string
+}
+
+func NewChangeService(name string, c client.Client) ChangeService {
+	return &changeService{
+		c:    c,
+		name: name,
+	}
+}
+
+func (c *changeService) SubscribeValueChanges(ctx context.Context, in *SubscribeValueChangesRequest, opts ...client.CallOption) (ChangeService_SubscribeValueChangesService, error) {
+	req := c.c.NewRequest(c.name, "ChangeService.SubscribeValueChanges", &SubscribeValueChangesRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &changeServiceSubscribeValueChanges{stream}, nil
+}
+
+type ChangeService_SubscribeValueChangesService interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	CloseSend() error
+	Close() error
+	Recv() (*SubscribeValueChangesResponse, error)
+}
+
+type changeServiceSubscribeValueChanges struct {
+	stream client.Stream
+}
+
+func (x *changeServiceSubscribeValueChanges) CloseSend() error {
+	return x.stream.CloseSend()
+}
+
+func (x *changeServiceSubscribeValueChanges) Close() error {
+	return x.stream.Close()
+}
+
+func (x *changeServiceSubscribeValueChanges) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *changeServiceSubscribeValueChanges) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *changeServiceSubscribeValueChanges) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *changeServiceSubscribeValueChanges) Recv() (*SubscribeValueChangesResponse, error) {
+	m := new(SubscribeValueChangesResponse)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for ChangeService service
+
+type ChangeServiceHandler interface {
+	// SubscribeValueChanges streams the changes of the values of the given settings.
+	SubscribeValueChanges(context.Context, *SubscribeValueChangesRequest, ChangeService_SubscribeValueChangesStream) error
+}
+
+func RegisterChangeServiceHandler(s server.Server, hdlr ChangeServiceHandler, opts ...server.HandlerOption) error {
+	type changeService interface {
+		SubscribeValueChanges(ctx context.Context, stream server.Stream) error
+	}
+	type ChangeService struct {
+		changeService
+	}
+	h := &changeServiceHandler{hdlr}
+	return s.Handle(s.NewHandler(&ChangeService{h}, opts...))
+}
+
+type changeServiceHandler struct {
+	ChangeServiceHandler
+}
+
+func (h *changeServiceHandler) SubscribeValueChanges(ctx context.Context, stream server.Stream) error {
+	m := new(SubscribeValueChangesRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.ChangeServiceHandler.SubscribeValueChanges(ctx, m, &changeServiceSubscribeValueChangesStream{stream})
+}
+
+type ChangeService_SubscribeValueChangesStream interface {
+	Context() context.Context
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*SubscribeValueChangesResponse) error
+}
+
+type changeServiceSubscribeValueChangesStream struct {
+	stream server.Stream
+}
+
+func (x *changeServiceSubscribeValueChangesStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *changeServiceSubscribeValueChangesStream) Context() context.Context {
+	return x.stream.Context()
+}
+
+func (x *changeServiceSubscribeValueChangesStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *changeServiceSubscribeValueChangesStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *changeServiceSubscribeValueChangesStream) Send(m *SubscribeValueChangesResponse) error {
+	return x.stream.Send(m)
+}
@@ -44,6 +44,9 @@ type StoreService interface {
 	List(ctx context.Context, in *ListRequest, opts ...client.CallOption) (Store_ListService, error)
 	Databases(ctx context.Context, in *DatabasesRequest, opts ...client.CallOption) (*DatabasesResponse, error)
 	Tables(ctx context.Context, in *TablesRequest, opts ...client.CallOption) (*TablesResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...client.CallOption) (*StatsResponse, error)
+	AppendElements(ctx context.Context, in *AppendElementsRequest, opts ...client.CallOption) (*AppendElementsResponse, error)
+	RemoveElements(ctx context.Context, in *RemoveElementsRequest, opts ...client.CallOption) (*RemoveElementsResponse, error)
 }
 
 type storeService struct {
@@ -162,6 +165,36 @@ func (c *storeService) Tables(ctx context.Context, in *TablesRequest, opts ...cl
 	return out, nil
 }
 
+func (c *storeService) Stats(ctx context.Context, in *StatsRequest, opts ...client.CallOption) (*StatsResponse, error) {
+	req := c.c.NewRequest(c.name, "Store.Stats", in)
+	out := new(StatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeService) AppendElements(ctx context.Context, in *AppendElementsRequest, opts ...client.CallOption) (*AppendElementsResponse, error) {
+	req := c.c.NewRequest(c.name, "Store.AppendElements", in)
+	out := new(AppendElementsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeService) RemoveElements(ctx context.Context, in *RemoveElementsRequest, opts ...client.CallOption) (*RemoveElementsResponse, error) {
+	req := c.c.NewRequest(c.name, "Store.RemoveElements", in)
+	out := new(RemoveElementsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Store service
 
 type StoreHandler interface {
@@ -171,6 +204,9 @@ type StoreHandler interface {
 	List(context.Context, *ListRequest, Store_ListStream) error
 	Databases(context.Context, *DatabasesRequest, *DatabasesResponse) error
 	Tables(context.Context, *TablesRequest, *TablesResponse) error
+	Stats(context.Context, *StatsRequest, *StatsResponse) error
+	AppendElements(context.Context, *AppendElementsRequest, *AppendElementsResponse) error
+	RemoveElements(context.Context, *RemoveElementsRequest, *RemoveElementsResponse) error
 }
 
 func RegisterStoreHandler(s server.Server, hdlr StoreHandler, opts ...server.HandlerOption) error {
@@ -181,6 +217,9 @@ func RegisterStoreHandler(s server.Server, hdlr StoreHandler, opts ...server.Han
 		List(ctx context.Context, stream server.Stream) error
 		Databases(ctx context.Context, in *DatabasesRequest, out *DatabasesResponse) error
 		Tables(ctx context.Context, in *TablesRequest, out *TablesResponse) error
+		Stats(ctx context.Context, in *StatsRequest, out *StatsResponse) error
+		AppendElements(ctx context.Context, in *AppendElementsRequest, out *AppendElementsResponse) error
+		RemoveElements(ctx context.Context, in *RemoveElementsRequest, out *RemoveElementsResponse) error
 	}
 	type Store struct {
 		store
@@ -252,3 +291,15 @@ func (h *storeHandler) Databases(ctx context.Context, in *DatabasesRequest, out
 func (h *storeHandler) Tables(ctx context.Context, in *TablesRequest, out *TablesResponse) error {
 	return h.StoreHandler.Tables(ctx, in, out)
 }
+
+func (h *storeHandler) Stats(ctx context.Context, in *StatsRequest, out *StatsResponse) error {
+	return h.StoreHandler.Stats(ctx, in, out)
+}
+
+func (h *storeHandler) AppendElements(ctx context.Context, in *AppendElementsRequest, out *AppendElementsResponse) error {
+	return h.StoreHandler.AppendElements(ctx, in, out)
+}
+
+func (h *storeHandler) RemoveElements(ctx context.Context, in *RemoveElementsRequest, out *RemoveElementsResponse) error {
+	return h.StoreHandler.RemoveElements(ctx, in, out)
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/cs3org/reva/v2/pkg/events"
+	ocisevents "github.com/owncloud/ocis/v2/ocis-pkg/events"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/audit/pkg/config"
 	"github.com/owncloud/ocis/v2/services/audit/pkg/types"
@@ -101,6 +102,8 @@ func StartAuditLogger(ctx context.Context, ch <-chan interface{}, log log.Logger
 				auditEvent = types.GroupMemberAdded(ev)
 			case events.GroupMemberRemoved:
 				auditEvent = types.GroupMemberRemoved(ev)
+			case ocisevents.AccountPurged:
+				auditEvent = types.AccountPurged(ev)
 			default:
 				log.Error().Interface("event", ev).Msg(fmt.Sprintf("can't handle event of type '%T'", ev))
 				continue
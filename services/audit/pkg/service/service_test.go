@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/cs3org/reva/v2/pkg/events"
+	ocisevents "github.com/owncloud/ocis/v2/ocis-pkg/events"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/audit/pkg/types"
 	"github.com/test-go/testify/require"
@@ -511,6 +512,26 @@ var testCases = []struct {
 			// AuditEventSpaces fields
 			checkSpacesAuditEvent(t, ev.AuditEventSpaces, "space-123")
 		},
+	}, {
+		Alias: "Account purged",
+		SystemEvent: ocisevents.AccountPurged{
+			Executant:          userID("uid-123"),
+			AccountUUID:        "uid-456",
+			RemovedValues:      2,
+			RemovedAssignments: 1,
+			Timestamp:          timestamp(10e9),
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventAccountPurged{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2286-11-20T17:46:40Z", "user 'uid-123' purged account 'uid-456', removed 2 values and 1 role assignments", "account_purged")
+			// AuditEventAccountPurged fields
+			require.Equal(t, "uid-456", ev.AccountUUID)
+			require.Equal(t, uint32(2), ev.RemovedValues)
+			require.Equal(t, uint32(1), ev.RemovedAssignments)
+		},
 	},
 }
 
@@ -47,6 +47,9 @@ const (
 	ActionGroupDeleted       = "group_deleted"
 	ActionGroupMemberAdded   = "group_member_added"
 	ActionGroupMemberRemoved = "group_member_removed"
+
+	// Settings
+	ActionAccountPurged = "account_purged"
 )
 
 // MessageShareCreated returns the human readable string that describes the action
@@ -206,3 +209,8 @@ func MessageGroupMemberAdded(executant, userID, groupID string) string {
 func MessageGroupMemberRemoved(executant, userID, groupID string) string {
 	return fmt.Sprintf("user '%s' added user '%s' was removed from group '%s'", executant, userID, groupID)
 }
+
+// MessageAccountPurged returns the human readable string that describes the action
+func MessageAccountPurged(executant, accountUUID string, removedValues, removedAssignments uint32) string {
+	return fmt.Sprintf("user '%s' purged account '%s', removed %d values and %d role assignments", executant, accountUUID, removedValues, removedAssignments)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cs3org/reva/v2/pkg/events"
 	"github.com/cs3org/reva/v2/pkg/storagespace"
+	ocisevents "github.com/owncloud/ocis/v2/ocis-pkg/events"
 
 	group "github.com/cs3org/go-cs3apis/cs3/identity/group/v1beta1"
 	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
@@ -449,6 +450,18 @@ func GroupMemberRemoved(ev events.GroupMemberRemoved) AuditEventGroupMemberRemov
 	}
 }
 
+// AccountPurged converts an AccountPurged event to an AuditEventAccountPurged
+func AccountPurged(ev ocisevents.AccountPurged) AuditEventAccountPurged {
+	msg := MessageAccountPurged(ev.Executant.GetOpaqueId(), ev.AccountUUID, ev.RemovedValues, ev.RemovedAssignments)
+	base := BasicAuditEvent(ev.Executant.GetOpaqueId(), formatTime(ev.Timestamp), msg, ActionAccountPurged)
+	return AuditEventAccountPurged{
+		AuditEvent:         base,
+		AccountUUID:        ev.AccountUUID,
+		RemovedValues:      ev.RemovedValues,
+		RemovedAssignments: ev.RemovedAssignments,
+	}
+}
+
 func extractGrantee(uid *user.UserId, gid *group.GroupId) (string, string) {
 	switch {
 	case uid != nil && uid.OpaqueId != "":
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cs3org/reva/v2/pkg/events"
+	ocisevents "github.com/owncloud/ocis/v2/ocis-pkg/events"
 )
 
 // RegisteredEvents returns the events the service is registered for
@@ -37,5 +38,6 @@ func RegisteredEvents() []events.Unmarshaller {
 		events.GroupDeleted{},
 		events.GroupMemberAdded{},
 		events.GroupMemberRemoved{},
+		ocisevents.AccountPurged{},
 	}
 }
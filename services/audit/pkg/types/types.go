@@ -249,3 +249,11 @@ type AuditEventGroupMemberRemoved struct {
 	GroupID string
 	UserID  string
 }
+
+// AuditEventAccountPurged is the event logged when the settings of an account are purged
+type AuditEventAccountPurged struct {
+	AuditEvent
+	AccountUUID        string
+	RemovedValues      uint32
+	RemovedAssignments uint32
+}
@@ -5,4 +5,8 @@ type CacheStore struct {
 	Type    string `yaml:"type" env:"OCIS_CACHE_STORE_TYPE;GRAPH_CACHE_STORE_TYPE" desc:"The type of the cache store. Valid options are \"noop\", \"ocmem\", \"etcd\" and \"memory\""`
 	Address string `yaml:"address" env:"OCIS_CACHE_STORE_ADDRESS;GRAPH_CACHE_STORE_ADDRESS" desc:"A comma-separated list of addresses to connect to. Only valid if the above setting is set to \"etcd\""`
 	Size    int    `yaml:"size" env:"OCIS_CACHE_STORE_SIZE;GRAPH_CACHE_STORE_SIZE" desc:"Maximum number of items per table in the ocmem cache store. Other cache stores will ignore the option and can grow indefinitely."`
+
+	FallbackType    string `yaml:"fallback_type" env:"OCIS_CACHE_STORE_FALLBACK_TYPE;GRAPH_CACHE_STORE_FALLBACK_TYPE" desc:"The type of a fallback cache store which is read when a record is missing in the cache store, e.g. the previous store while migrating to another one. Writes only go to the cache store. Valid options are \"noop\", \"ocmem\", \"etcd\" and \"memory\". If empty, no fallback store is used."`
+	FallbackAddress string `yaml:"fallback_address" env:"OCIS_CACHE_STORE_FALLBACK_ADDRESS;GRAPH_CACHE_STORE_FALLBACK_ADDRESS" desc:"A comma-separated list of addresses of the fallback cache store to connect to. Only valid if the fallback type is set to \"etcd\"."`
+	PromoteFallback bool   `yaml:"promote_fallback" env:"OCIS_CACHE_STORE_PROMOTE_FALLBACK;GRAPH_CACHE_STORE_PROMOTE_FALLBACK" desc:"Write the records read from the fallback cache store to the cache store, so the fallback store is no longer needed for them."`
 }
@@ -119,6 +119,8 @@ func EnsureDefaults(cfg *config.Config) {
 		if cfg.Commons != nil && cfg.Commons.GRPCClientTLS != nil {
 			cfg.GRPCClientTLS.Mode = cfg.Commons.GRPCClientTLS.Mode
 			cfg.GRPCClientTLS.CACert = cfg.Commons.GRPCClientTLS.CACert
+			cfg.GRPCClientTLS.Cert = cfg.Commons.GRPCClientTLS.Cert
+			cfg.GRPCClientTLS.Key = cfg.Commons.GRPCClientTLS.Key
 		}
 	}
 
@@ -152,6 +152,14 @@ func NewService(opts ...Option) Service {
 			Address: options.Config.CacheStore.Address,
 			Size:    options.Config.CacheStore.Size,
 		}
+		if cs := options.Config.CacheStore; cs.FallbackType != "" {
+			storeOptions.Fallback = &store.OcisStoreOptions{
+				Type:    cs.FallbackType,
+				Address: cs.FallbackAddress,
+				Size:    cs.Size,
+			}
+			storeOptions.PromoteFallback = cs.PromoteFallback
+		}
 		m := roles.NewManager(
 			roles.StoreOptions(storeOptions),
 			roles.Logger(options.Logger),
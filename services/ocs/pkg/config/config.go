@@ -27,6 +27,7 @@ type Config struct {
 
 	AccountBackend    string `yaml:"-"` // we only support cs3 backend, no need to have this configurable
 	MachineAuthAPIKey string `yaml:"machine_auth_api_key" env:"OCIS_MACHINE_AUTH_API_KEY;OCS_MACHINE_AUTH_API_KEY" desc:"Machine auth API key used to validate internal requests necessary to access resources from other services."`
+	StoreAuthSecret   string `mask:"password" yaml:"store_auth_secret" env:"OCIS_STORE_AUTH_SECRET;OCS_STORE_AUTH_SECRET" desc:"Shared secret authenticating the calls to the store service, it has to match STORE_GRPC_AUTH_SECRET."`
 
 	Context context.Context `yaml:"-"`
 }
@@ -5,9 +5,13 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/cs3org/reva/v2/pkg/token/manager/jwt"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
@@ -22,6 +26,7 @@ import (
 	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
 	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config/defaults"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config/parser"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/logging"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
@@ -87,7 +92,7 @@ func Server(cfg *config.Config) *cli.Command {
 					proxyHTTP.Context(ctx),
 					proxyHTTP.Config(cfg),
 					proxyHTTP.Metrics(metrics.New()),
-					proxyHTTP.Middlewares(loadMiddlewares(ctx, logger, cfg)),
+					proxyHTTP.Middlewares(loadMiddlewares(ctx, logger, cfg, m)),
 				)
 
 				if err != nil {
@@ -134,7 +139,7 @@ func Server(cfg *config.Config) *cli.Command {
 	}
 }
 
-func loadMiddlewares(ctx context.Context, logger log.Logger, cfg *config.Config) alice.Chain {
+func loadMiddlewares(ctx context.Context, logger log.Logger, cfg *config.Config, m *metrics.Metrics) alice.Chain {
 	rolesClient := settingssvc.NewRoleService("com.owncloud.api.settings", grpc.DefaultClient())
 	revaClient, err := pool.GetGatewayServiceClient(cfg.Reva.Address, cfg.Reva.GetRevaOptions()...)
 	var userProvider backend.UserBackend
@@ -153,65 +158,40 @@ func loadMiddlewares(ctx context.Context, logger log.Logger, cfg *config.Config)
 		logger.Fatal().Msgf("Invalid accounts backend type '%s'", cfg.AccountBackend)
 	}
 
-	storeClient := storesvc.NewStoreService("com.owncloud.api.store", grpc.DefaultClient())
+	storeClient := storesvc.NewStoreService("com.owncloud.api.store", grpc.NewSecretClient(grpc.DefaultClient(), cfg.StoreAuthSecret))
 	if err != nil {
 		logger.Error().Err(err).
 			Str("gateway", cfg.Reva.Address).
 			Msg("Failed to create reva gateway service client")
 	}
 
-	var oidcHTTPClient = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				MinVersion:         tls.VersionTLS12,
-				InsecureSkipVerify: cfg.OIDC.Insecure, //nolint:gosec
-			},
-			DisableKeepAlives: true,
-		},
-		Timeout: time.Second * 10,
-	}
+	oidcHTTPClient := newOIDCHTTPClient(cfg)
 
-	var authenticators []middleware.Authenticator
+	// the proxies have already been validated by the config parser
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid trusted proxies")
+	}
 	if cfg.EnableBasicAuth {
 		logger.Warn().Msg("basic auth enabled, use only for testing or development")
-		authenticators = append(authenticators, middleware.BasicAuthenticator{
-			Logger:       logger,
-			UserProvider: userProvider,
-		})
 	}
-	authenticators = append(authenticators, middleware.NewOIDCAuthenticator(
-		logger,
-		cfg.OIDC.UserinfoCache.TTL,
-		oidcHTTPClient,
-		cfg.OIDC.Issuer,
-		func() (middleware.OIDCProvider, error) {
-			// Initialize a provider by specifying the issuer URL.
-			// it will fetch the keys from the issuer using the .well-known
-			// endpoint
-			return oidc.NewProvider(
-				context.WithValue(ctx, oauth2.HTTPClient, oidcHTTPClient),
-				cfg.OIDC.Issuer,
-			)
-		},
-		cfg.OIDC.JWKS,
-		cfg.OIDC.AccessTokenVerifyMethod,
-	))
-	authenticators = append(authenticators, middleware.PublicShareAuthenticator{
-		Logger:            logger,
-		RevaGatewayClient: revaClient,
-	})
-
-	authenticators = append(authenticators, middleware.SignedURLAuthenticator{
-		Logger:             logger,
-		PreSignedURLConfig: cfg.PreSignedURL,
-		UserProvider:       userProvider,
-		Store:              storeClient,
-	})
+	// the used nonces are kept when the authentication is reloaded, so signed URLs can't be replayed afterwards
+	memoryNonces := middleware.NewMemoryNonceStore()
+	// the error pages and tenants have already been validated by the config parser
+	load := func(cfg *config.Config) ([]middleware.Authenticator, []middleware.Option, error) {
+		return loadAuthentication(ctx, logger, cfg, m, userProvider, revaClient, storeClient, memoryNonces)
+	}
+	authenticators, authenticationOptions, err := load(cfg)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid authentication")
+	}
+	authentication := middleware.NewReloadableAuthentication(authenticators, authenticationOptions...)
+	go reloadAuthenticationOnSignal(ctx, logger, cfg, authentication, load)
 
 	return alice.New(
 		// first make sure we log all requests and redirect to https if necessary
 		pkgmiddleware.TraceContext,
-		chimiddleware.RealIP,
+		middleware.RealIP(trustedProxies),
 		chimiddleware.RequestID,
 		middleware.AccessLog(logger),
 		middleware.HTTPSRedirect,
@@ -223,18 +203,13 @@ func loadMiddlewares(ctx context.Context, logger log.Logger, cfg *config.Config)
 
 		router.Middleware(cfg.PolicySelector, cfg.Policies, logger),
 
-		middleware.Authentication(
-			authenticators,
-			middleware.CredentialsByUserAgent(cfg.AuthMiddleware.CredentialsByUserAgent),
-			middleware.Logger(logger),
-			middleware.OIDCIss(cfg.OIDC.Issuer),
-			middleware.EnableBasicAuth(cfg.EnableBasicAuth),
-		),
+		authentication.Handler,
 		middleware.AccountResolver(
 			middleware.Logger(logger),
 			middleware.UserProvider(userProvider),
 			middleware.TokenManagerConfig(*cfg.TokenManager),
 			middleware.UserOIDCClaim(cfg.UserOIDCClaim),
+			middleware.UserOIDCClaimFallbacks(cfg.UserOIDCClaimFallbacks...),
 			middleware.UserCS3Claim(cfg.UserCS3Claim),
 			middleware.AutoprovisionAccounts(cfg.AutoprovisionAccounts),
 		),
@@ -253,3 +228,205 @@ func loadMiddlewares(ctx context.Context, logger log.Logger, cfg *config.Config)
 		),
 	)
 }
+
+// loadAuthentication creates the authenticators and options of the authentication middleware from the config.
+// It is used again when the config is reloaded, so it returns errors instead of exiting.
+func loadAuthentication(ctx context.Context, logger log.Logger, cfg *config.Config, m *metrics.Metrics, userProvider backend.UserBackend,
+	revaClient gateway.GatewayAPIClient, storeClient storesvc.StoreService, memoryNonces middleware.NonceStore) ([]middleware.Authenticator, []middleware.Option, error) {
+	oidcHTTPClient := newOIDCHTTPClient(cfg)
+	errorPages, err := middleware.ParseErrorPages(cfg.AuthMiddleware.ErrorPages)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid error pages: %w", err)
+	}
+
+	var basicAuthenticator middleware.Authenticator
+	if cfg.EnableBasicAuth {
+		basicAuthenticator = middleware.BasicAuthenticator{
+			Logger:        logger,
+			UserProvider:  userProvider,
+			PublicMethods: cfg.AuthMiddleware.PublicPathMethods,
+		}
+	}
+	var revocations *middleware.RevocationList
+	revocationTTL := time.Duration(cfg.OIDC.Revocation.CacheTTL) * time.Second
+	switch cfg.OIDC.Revocation.Source {
+	case config.RevocationSourceFile:
+		revocations = middleware.NewRevocationList(middleware.FileRevocationSource{Path: cfg.OIDC.Revocation.File}, revocationTTL, logger)
+	case config.RevocationSourceStore:
+		revocations = middleware.NewRevocationList(middleware.StoreRevocationSource{Store: storeClient}, revocationTTL, logger)
+	}
+	newOIDCAuthenticator := func(issuer string) middleware.Authenticator {
+		// every IDP gets its own circuit breaker, so a failing IDP doesn't affect the other tenants
+		idpHTTPClient := middleware.WrapHTTPClient(oidcHTTPClient, cfg.OIDC.CircuitBreaker, issuer, logger, m)
+		authenticator := middleware.NewOIDCAuthenticator(
+			logger,
+			cfg.OIDC.UserinfoCache.TTL,
+			idpHTTPClient,
+			issuer,
+			func() (middleware.OIDCProvider, error) {
+				// Initialize a provider by specifying the issuer URL.
+				// it will fetch the keys from the issuer using the .well-known
+				// endpoint
+				return oidc.NewProvider(
+					context.WithValue(ctx, oauth2.HTTPClient, idpHTTPClient),
+					issuer,
+				)
+			},
+			cfg.OIDC.JWKS,
+			cfg.OIDC.AccessTokenVerifyMethod,
+			cfg.OIDC.AccessTokenCookie,
+			cfg.OIDC.TokenExchange,
+		)
+		authenticator.Revocations = revocations
+		authenticator.PublicMethods = cfg.AuthMiddleware.PublicPathMethods
+		return authenticator
+	}
+	var nonces middleware.NonceStore
+	switch cfg.PreSignedURL.ReplayProtection {
+	case config.ReplayProtectionMemory:
+		nonces = memoryNonces
+	case config.ReplayProtectionStore:
+		nonces = middleware.StoreNonceStore{Store: storeClient}
+	}
+	// public shares and signed URLs don't depend on the tenant
+	tokenAuthenticators := []middleware.Authenticator{
+		middleware.PublicShareAuthenticator{
+			Logger:            logger,
+			RevaGatewayClient: revaClient,
+			PublicMethods:     cfg.AuthMiddleware.PublicPathMethods,
+		},
+		middleware.SignedURLAuthenticator{
+			Logger:             logger,
+			PreSignedURLConfig: cfg.PreSignedURL,
+			UserProvider:       userProvider,
+			Store:              storeClient,
+			Nonces:             nonces,
+		},
+	}
+
+	var authenticators []middleware.Authenticator
+	// internal requests are authenticated first, they skip the IDP
+	var internalAuthenticator middleware.Authenticator
+	if cfg.InternalAuth.Enabled {
+		networks, err := middleware.ParseTrustedProxies(cfg.InternalAuth.TrustedNetworks)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid internal auth networks: %w", err)
+		}
+		internalAuthenticator = middleware.InternalAuthenticator{
+			Logger:          logger,
+			UserProvider:    userProvider,
+			Secret:          cfg.InternalAuth.Secret,
+			UserID:          cfg.InternalAuth.UserID,
+			TrustedNetworks: networks,
+		}
+		authenticators = append(authenticators, internalAuthenticator)
+	}
+	if basicAuthenticator != nil {
+		authenticators = append(authenticators, basicAuthenticator)
+	}
+	authenticators = append(authenticators, newOIDCAuthenticator(cfg.OIDC.Issuer))
+	authenticators = append(authenticators, tokenAuthenticators...)
+
+	var tenants *middleware.TenantResolver
+	if len(cfg.Tenants.Tenants) > 0 {
+		tenants, err = middleware.NewTenantResolver(cfg.Tenants, func(t config.Tenant) []middleware.Authenticator {
+			var basic, bearer bool
+			for _, s := range t.Strategies {
+				basic = basic || s == middleware.StrategyBasic
+				bearer = bearer || s == middleware.StrategyBearer
+			}
+			var auths []middleware.Authenticator
+			if internalAuthenticator != nil {
+				auths = append(auths, internalAuthenticator)
+			}
+			if basic {
+				auths = append(auths, basicAuthenticator)
+			}
+			if bearer {
+				auths = append(auths, newOIDCAuthenticator(t.Issuer))
+			}
+			return append(auths, tokenAuthenticators...)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid tenants: %w", err)
+		}
+	}
+
+	return authenticators, []middleware.Option{
+		middleware.CredentialsByUserAgent(cfg.AuthMiddleware.CredentialsByUserAgent),
+		middleware.LoginRedirectURL(cfg.AuthMiddleware.LoginRedirectURL),
+		middleware.AuthenticatorTimeout(time.Duration(cfg.AuthMiddleware.AuthenticatorTimeout) * time.Second),
+		middleware.AuthenticatorTimeouts(authenticatorTimeouts(cfg.AuthMiddleware.AuthenticatorTimeouts)),
+		middleware.SuppressXHRBasicChallenge(cfg.AuthMiddleware.SuppressXHRBasicChallenge),
+		middleware.MultipleAuthorization(cfg.AuthMiddleware.MultipleAuthorization),
+		middleware.Maintenance(cfg.AuthMiddleware.Maintenance),
+		middleware.ErrorPages(errorPages),
+		middleware.ForbiddenBody(cfg.AuthMiddleware.ForbiddenBody),
+		middleware.WebDAVBodyDrainLimit(cfg.AuthMiddleware.WebDAVBodyDrainLimit),
+		middleware.SecurityHeaders(cfg.AuthMiddleware.SecurityHeaders),
+		middleware.WebSocketAuth(cfg.AuthMiddleware.WebSocket),
+		middleware.Challenges(cfg.AuthMiddleware.Challenges),
+		middleware.Tenants(tenants),
+		middleware.Metrics(m),
+		middleware.PublicPathAccessLog(cfg.AuthMiddleware.PublicPathAccessLog),
+		middleware.PublicPathMethods(cfg.AuthMiddleware.PublicPathMethods),
+		middleware.Logger(logger),
+		middleware.OIDCIss(cfg.OIDC.Issuer),
+		middleware.EnableBasicAuth(cfg.EnableBasicAuth),
+		middleware.PreSignedURLConfig(cfg.PreSignedURL),
+	}, nil
+}
+
+// authenticatorTimeouts converts the configured timeouts of the authenticators from seconds.
+func authenticatorTimeouts(seconds map[string]uint64) map[string]time.Duration {
+	timeouts := make(map[string]time.Duration, len(seconds))
+	for name, s := range seconds {
+		timeouts[name] = time.Duration(s) * time.Second
+	}
+	return timeouts
+}
+
+// newOIDCHTTPClient returns the http client used to talk to the IDP.
+func newOIDCHTTPClient(cfg *config.Config) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion:         tls.VersionTLS12,
+				InsecureSkipVerify: cfg.OIDC.Insecure, //nolint:gosec
+			},
+			DisableKeepAlives: true,
+		},
+		Timeout: time.Second * 10,
+	}
+}
+
+// reloadAuthenticationOnSignal reloads the config on SIGHUP and replaces the authenticators and options of the
+// authentication middleware, e.g. to rotate the OIDC client secret. The previous ones are kept if the reloaded
+// config is invalid.
+func reloadAuthenticationOnSignal(ctx context.Context, logger log.Logger, cfg *config.Config, auth *middleware.ReloadableAuthentication,
+	load func(cfg *config.Config) ([]middleware.Authenticator, []middleware.Option, error)) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+		}
+
+		reloaded := defaults.DefaultConfig()
+		reloaded.Commons = cfg.Commons
+		if err := parser.ParseConfig(reloaded); err != nil {
+			logger.Error().Err(err).Msg("Failed to reload the config, keeping the current authentication")
+			continue
+		}
+		auths, opts, err := load(reloaded)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to reload the authentication, keeping the current one")
+			continue
+		}
+		auth.Reload(auths, opts...)
+		logger.Info().Msg("Reloaded the authentication")
+	}
+}
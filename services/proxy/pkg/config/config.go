@@ -21,20 +21,25 @@ type Config struct {
 	Reva          *shared.Reva          `yaml:"reva"`
 	GRPCClientTLS *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
 
-	Policies              []Policy        `yaml:"policies"`
-	OIDC                  OIDC            `yaml:"oidc"`
-	TokenManager          *TokenManager   `mask:"struct" yaml:"token_manager"`
-	PolicySelector        *PolicySelector `yaml:"policy_selector"`
-	PreSignedURL          PreSignedURL    `yaml:"pre_signed_url"`
-	AccountBackend        string          `yaml:"account_backend" env:"PROXY_ACCOUNT_BACKEND_TYPE" desc:"Account backend the PROXY service should use. Currently only 'cs3' is possible here."`
-	UserOIDCClaim         string          `yaml:"user_oidc_claim" env:"PROXY_USER_OIDC_CLAIM" desc:"The name of an OpenID Connect claim that should be used for resolving users with the account backend. Currently defaults to 'email'."`
-	UserCS3Claim          string          `yaml:"user_cs3_claim" env:"PROXY_USER_CS3_CLAIM" desc:"The name of a CS3 user attribute (claim) that should be mapped to the 'user_oidc_claim'. Supported values are 'username', 'mail' and 'userid'."`
-	MachineAuthAPIKey     string          `mask:"password" yaml:"machine_auth_api_key" env:"OCIS_MACHINE_AUTH_API_KEY;PROXY_MACHINE_AUTH_API_KEY" desc:"Machine auth API key used to validate internal requests necessary to access resources from other services."`
-	AutoprovisionAccounts bool            `yaml:"auto_provision_accounts" env:"PROXY_AUTOPROVISION_ACCOUNTS" desc:"Set this to 'true' to automatically provision users that do not yet exist in the users service on-demand upon first sign-in. To use this a write-enabled libregraph user backend needs to be setup an running."`
-	EnableBasicAuth       bool            `yaml:"enable_basic_auth" env:"PROXY_ENABLE_BASIC_AUTH" desc:"Set this to true to enable 'basic authentication' (username/password)."`
-	InsecureBackends      bool            `yaml:"insecure_backends" env:"PROXY_INSECURE_BACKENDS" desc:"Disable TLS certificate validation for all HTTP backend connections."`
-	BackendHTTPSCACert    string          `yaml:"backend_https_cacert" env:"PROXY_HTTPS_CACERT" desc:"The root CA certificate used to validate TLS server certificates of https enabled backend services."`
-	AuthMiddleware        AuthMiddleware  `yaml:"auth_middleware"`
+	Policies               []Policy        `yaml:"policies"`
+	OIDC                   OIDC            `yaml:"oidc"`
+	TokenManager           *TokenManager   `mask:"struct" yaml:"token_manager"`
+	PolicySelector         *PolicySelector `yaml:"policy_selector"`
+	PreSignedURL           PreSignedURL    `yaml:"pre_signed_url"`
+	AccountBackend         string          `yaml:"account_backend" env:"PROXY_ACCOUNT_BACKEND_TYPE" desc:"Account backend the PROXY service should use. Currently only 'cs3' is possible here."`
+	UserOIDCClaim          string          `yaml:"user_oidc_claim" env:"PROXY_USER_OIDC_CLAIM" desc:"The name of an OpenID Connect claim that should be used for resolving users with the account backend. Currently defaults to 'email'."`
+	UserOIDCClaimFallbacks []string        `yaml:"user_oidc_claim_fallbacks" env:"PROXY_USER_OIDC_CLAIM_FALLBACKS" desc:"A comma-separated list of OpenID Connect claims that are used in the given order for resolving users, if the claim set in PROXY_USER_OIDC_CLAIM is missing or empty. This is useful if the IDP doesn't put the identifier of a user into the same claim for all users."`
+	UserCS3Claim           string          `yaml:"user_cs3_claim" env:"PROXY_USER_CS3_CLAIM" desc:"The name of a CS3 user attribute (claim) that should be mapped to the 'user_oidc_claim'. Supported values are 'username', 'mail' and 'userid'."`
+	MachineAuthAPIKey      string          `mask:"password" yaml:"machine_auth_api_key" env:"OCIS_MACHINE_AUTH_API_KEY;PROXY_MACHINE_AUTH_API_KEY" desc:"Machine auth API key used to validate internal requests necessary to access resources from other services."`
+	StoreAuthSecret        string          `mask:"password" yaml:"store_auth_secret" env:"OCIS_STORE_AUTH_SECRET;PROXY_STORE_AUTH_SECRET" desc:"Shared secret authenticating the calls to the store service, it has to match STORE_GRPC_AUTH_SECRET."`
+	AutoprovisionAccounts  bool            `yaml:"auto_provision_accounts" env:"PROXY_AUTOPROVISION_ACCOUNTS" desc:"Set this to 'true' to automatically provision users that do not yet exist in the users service on-demand upon first sign-in. To use this a write-enabled libregraph user backend needs to be setup an running."`
+	EnableBasicAuth        bool            `yaml:"enable_basic_auth" env:"PROXY_ENABLE_BASIC_AUTH" desc:"Set this to true to enable 'basic authentication' (username/password)."`
+	InsecureBackends       bool            `yaml:"insecure_backends" env:"PROXY_INSECURE_BACKENDS" desc:"Disable TLS certificate validation for all HTTP backend connections."`
+	BackendHTTPSCACert     string          `yaml:"backend_https_cacert" env:"PROXY_HTTPS_CACERT" desc:"The root CA certificate used to validate TLS server certificates of https enabled backend services."`
+	AuthMiddleware         AuthMiddleware  `yaml:"auth_middleware"`
+	Tenants                Tenants         `yaml:"tenants"`
+	InternalAuth           InternalAuth    `yaml:"internal_auth"`
+	TrustedProxies         []string        `yaml:"trusted_proxies" env:"PROXY_TRUSTED_PROXIES" desc:"A comma-separated list of IP addresses or CIDR ranges of reverse proxies or load balancers in front of the PROXY service. The client IP is only taken from the 'Forwarded', 'X-Forwarded-For' and 'X-Real-IP' headers when the request comes from one of these addresses. If empty, these headers are ignored."`
 
 	Context context.Context `yaml:"-" json:"-"`
 }
@@ -80,9 +85,98 @@ var (
 
 // AuthMiddleware configures the proxy http auth middleware.
 type AuthMiddleware struct {
-	CredentialsByUserAgent map[string]string `yaml:"credentials_by_user_agent"`
+	CredentialsByUserAgent    map[string]string `yaml:"credentials_by_user_agent"`
+	LoginRedirectURL          string            `yaml:"login_redirect_url" env:"PROXY_AUTH_MIDDLEWARE_LOGIN_REDIRECT_URL" desc:"URL unauthenticated browser navigations are redirected to, e.g. '/login'. Requests are considered browser navigations if they are GET requests accepting 'text/html' that are not sent via XHR or fetch. If empty, these requests receive a 401 response like all other unauthenticated requests."`
+	AuthenticatorTimeout      uint64            `yaml:"authenticator_timeout" env:"PROXY_AUTH_MIDDLEWARE_AUTHENTICATOR_TIMEOUT" desc:"The timeout in seconds for each authenticator, e.g. for validating a token with the IDP. Authenticators exceeding it are treated as failed and the next one is tried. Set to 0 to disable the timeout."`
+	SuppressXHRBasicChallenge bool              `yaml:"suppress_xhr_basic_challenge" env:"PROXY_AUTH_MIDDLEWARE_SUPPRESS_XHR_BASIC_CHALLENGE" desc:"Don't send the Basic challenge in the 'Www-Authenticate' header of 401 responses to XHR and fetch requests. Browsers show a native login dialog for these challenges, single page applications handle the login themselves. Requests are only considered XHR or fetch requests if browsers marked them with the 'X-Requested-With: XMLHttpRequest' header or the 'Sec-Fetch-Mode' values 'cors' and 'same-origin'. Browser navigations, API and WebDAV clients still receive the challenge."`
+	MultipleAuthorization     string            `yaml:"multiple_authorization" env:"PROXY_AUTH_MIDDLEWARE_MULTIPLE_AUTHORIZATION" desc:"Defines how requests with multiple 'Authorization' headers are handled. Supported values are 'pick_bearer' and 'reject'. 'pick_bearer' uses the bearer token if exactly one bearer token is sent, other credentials like basic auth are ignored then. Requests with several different credentials and no or several bearer tokens are rejected with a 400 status. 'reject' rejects all requests with more than one 'Authorization' header."`
+	PublicPathAccessLog       bool              `yaml:"public_path_access_log" env:"PROXY_AUTH_MIDDLEWARE_PUBLIC_PATH_ACCESS_LOG" desc:"Log the requests to public paths like the public share endpoints at info level, including the matched public path prefix and whether the request was authenticated. The requests to public paths are counted in the 'ocis_proxy_public_path_requests_total' metric regardless of this setting."`
+	ForbiddenBody             string            `yaml:"forbidden_body" env:"PROXY_AUTH_MIDDLEWARE_FORBIDDEN_BODY" desc:"The plain text body of the 403 response to authenticated requests which are denied by a custom authorization hook. If empty, 'Forbidden' is sent. Without an authorization hook all authenticated requests are passed on."`
+	WebDAVBodyDrainLimit      int64             `yaml:"webdav_body_drain_limit" env:"PROXY_AUTH_MIDDLEWARE_WEBDAV_BODY_DRAIN_LIMIT" desc:"The maximum number of bytes of the body of unauthenticated WebDAV requests, e.g. a PROPFIND, which is read and discarded before the 401 response, so the client can keep using the connection. The connection is closed after the response for larger bodies instead of reading them. Set to 0 to leave draining the body to the http server."`
+	Maintenance               Maintenance       `yaml:"maintenance"`
+	ErrorPages                ErrorPages        `yaml:"error_pages"`
+	SecurityHeaders           SecurityHeaders   `yaml:"security_headers"`
+	WebSocket                 WebSocketAuth     `yaml:"websocket"`
+	Challenges                Challenges        `yaml:"challenges"`
+	// AuthenticatorTimeouts maps the names of authenticators like 'oidc' or 'basic' to their timeout in seconds,
+	// overriding the authenticator_timeout. A timeout of 0 disables the timeout of the authenticator.
+	AuthenticatorTimeouts map[string]uint64 `yaml:"authenticator_timeouts"`
+	// PublicPathMethods maps public path prefixes like '/remote.php/dav/public-files/' to the methods that are
+	// allowed without authentication, the other methods need to be authenticated. Public paths without an entry
+	// are public for all methods.
+	PublicPathMethods map[string][]string `yaml:"public_path_methods"`
+}
+
+// Challenges configures the challenges in the 'Www-Authenticate' header of 401 responses. Some clients use the
+// first challenge offered, the order expresses the preferred strategy.
+type Challenges struct {
+	Order     []string `yaml:"order" env:"PROXY_AUTH_CHALLENGES_ORDER" desc:"A semicolon-separated list of the strategies in the order their challenges are sent. Supported strategies are 'bearer', 'basic', 'signed-url' and 'mtls'. Strategies that are not listed send no challenge."`
+	Bearer    bool     `yaml:"bearer" env:"PROXY_AUTH_CHALLENGE_BEARER" desc:"Send the 'Bearer' challenge of the OIDC authentication. It is only sent if an OIDC issuer is configured."`
+	Basic     bool     `yaml:"basic" env:"PROXY_AUTH_CHALLENGE_BASIC" desc:"Send the 'Basic' challenge. It is only sent if basic auth is enabled."`
+	SignedURL bool     `yaml:"signed_url" env:"PROXY_AUTH_CHALLENGE_SIGNED_URL" desc:"Send the 'Signed-URL' challenge. It is only sent if pre-signed URLs are enabled."`
+	MTLS      bool     `yaml:"mtls" env:"PROXY_AUTH_CHALLENGE_MTLS" desc:"Send the 'mTLS' challenge of client certificate authentication. The proxy doesn't verify client certificates itself, enable it if a TLS terminating load balancer in front of the proxy authenticates clients with certificates."`
+}
+
+// WebSocketAuth configures where WebSocket upgrade requests may carry their token. Browsers can't set the
+// 'Authorization' header for WebSockets, the token is moved there before the request is authenticated.
+type WebSocketAuth struct {
+	TokenQueryParameter    string `yaml:"token_query_parameter" env:"PROXY_WEBSOCKET_TOKEN_QUERY_PARAMETER" desc:"Name of the query parameter carrying the access token of WebSocket upgrade requests, e.g. 'access_token'. The parameter is removed before the request is passed on. If empty, tokens are not read from the query."`
+	TokenSubprotocolPrefix string `yaml:"token_subprotocol_prefix" env:"PROXY_WEBSOCKET_TOKEN_SUBPROTOCOL_PREFIX" desc:"Prefix of the entry of the 'Sec-WebSocket-Protocol' header carrying the access token of WebSocket upgrade requests, e.g. 'access_token.'. The entry is removed before the request is passed on. If empty, tokens are not read from the subprotocols."`
+}
+
+// Tenants configures the selection of the authentication configuration by tenant. Without tenants, all requests
+// are authenticated with the OIDC issuer of the proxy.
+type Tenants struct {
+	Header  string   `yaml:"header" env:"PROXY_TENANT_HEADER" desc:"Header carrying the name of the tenant of a request. If empty, the tenants are selected by the host of the request."`
+	Default string   `yaml:"default" env:"PROXY_TENANT_DEFAULT" desc:"Name of the tenant used for requests of unknown tenants. If empty, these requests are rejected with a 400 status."`
+	Tenants []Tenant `yaml:"tenants"`
+}
+
+// Tenant is the authentication configuration of a tenant. The other OIDC options and the account
+// backend are shared by all tenants.
+type Tenant struct {
+	Name string `yaml:"name"`
+	// Hosts select the tenant if no header is configured
+	Hosts []string `yaml:"hosts"`
+	// Issuer is the OIDC issuer the access tokens of the tenant are verified with
+	Issuer string `yaml:"issuer"`
+	// Realm of the challenges sent to unauthenticated clients, defaults to the host of the request
+	Realm string `yaml:"realm"`
+	// Strategies are the supported authentication strategies, 'bearer' and 'basic'. Defaults to 'bearer'.
+	// Basic auth has to be enabled for the proxy as well.
+	Strategies []string `yaml:"strategies"`
+}
+
+// ErrorPages configures the HTML pages rendered instead of the plain responses for browsers.
+type ErrorPages struct {
+	UnauthorizedPath       string `yaml:"unauthorized_path" env:"PROXY_ERROR_PAGE_UNAUTHORIZED_PATH" desc:"Path to an HTML template rendered for unauthenticated requests of browsers, which accept 'text/html' and are neither XHR, fetch nor WebDAV requests. The template can use the fields '.Path', '.Host', '.Strategies', '.RequestID' and '.Status'. Other clients keep receiving the JSON or WebDAV error. Browser navigations are still redirected if a login redirect URL is configured."`
+	Unauthorized           string `yaml:"unauthorized" env:"PROXY_ERROR_PAGE_UNAUTHORIZED" desc:"Inline HTML template rendered for unauthenticated requests of browsers. See PROXY_ERROR_PAGE_UNAUTHORIZED_PATH for details. Only one of both can be set."`
+	ServiceUnavailablePath string `yaml:"service_unavailable_path" env:"PROXY_ERROR_PAGE_SERVICE_UNAVAILABLE_PATH" desc:"Path to an HTML template rendered for requests of browsers rejected by the maintenance mode. Additionally to the fields of the unauthorized page, the template can use '.RetryAfter'."`
+	ServiceUnavailable     string `yaml:"service_unavailable" env:"PROXY_ERROR_PAGE_SERVICE_UNAVAILABLE" desc:"Inline HTML template rendered for requests of browsers rejected by the maintenance mode. See PROXY_ERROR_PAGE_SERVICE_UNAVAILABLE_PATH for details. Only one of both can be set."`
+}
+
+// SecurityHeaders configures the security headers added to the responses passing the authentication middleware.
+// Headers already set by the services are kept as they are.
+type SecurityHeaders struct {
+	StrictTransportSecurity string `yaml:"strict_transport_security" env:"PROXY_SECURITY_HEADERS_STRICT_TRANSPORT_SECURITY" desc:"Value of the 'Strict-Transport-Security' header. Browsers ignore it for responses which aren't received via HTTPS. Set to an empty string to not send the header."`
+	ContentTypeOptions      string `yaml:"content_type_options" env:"PROXY_SECURITY_HEADERS_CONTENT_TYPE_OPTIONS" desc:"Value of the 'X-Content-Type-Options' header. Set to an empty string to not send the header."`
+	ReferrerPolicy          string `yaml:"referrer_policy" env:"PROXY_SECURITY_HEADERS_REFERRER_POLICY" desc:"Value of the 'Referrer-Policy' header. Set to an empty string to not send the header."`
+}
+
+// Maintenance configures the maintenance mode of the proxy.
+type Maintenance struct {
+	Enabled     bool   `yaml:"enabled" env:"PROXY_MAINTENANCE_MODE" desc:"Reject all requests with a 503 status, except for requests carrying the maintenance bypass token."`
+	BypassToken string `yaml:"bypass_token" env:"PROXY_MAINTENANCE_BYPASS_TOKEN" desc:"Token that lets requests pass while the maintenance mode is enabled. It has to be sent in the 'X-Maintenance-Token' header, the requests are then authenticated as usual. If empty, all requests are rejected."`
+	RetryAfter  uint64 `yaml:"retry_after" env:"PROXY_MAINTENANCE_RETRY_AFTER" desc:"The number of seconds sent in the 'Retry-After' header of rejected requests."`
 }
 
+const (
+	// MultipleAuthorizationPickBearer uses the bearer token among multiple Authorization headers.
+	MultipleAuthorizationPickBearer = "pick_bearer"
+	// MultipleAuthorizationReject rejects requests with multiple Authorization headers.
+	MultipleAuthorizationReject = "reject"
+)
+
 const (
 	AccessTokenVerificationNone = "none"
 	AccessTokenVerificationJWT  = "jwt"
@@ -90,15 +184,37 @@ const (
 	// AccessTokenVerificationIntrospect = "introspect"
 )
 
+const (
+	// RevocationSourceNone disables the revocation check.
+	RevocationSourceNone = "none"
+	// RevocationSourceFile reads the revocation list from a file.
+	RevocationSourceFile = "file"
+	// RevocationSourceStore reads the revocation list from the store service.
+	RevocationSourceStore = "store"
+)
+
+const (
+	// ReplayProtectionNone accepts signed URLs until they expire.
+	ReplayProtectionNone = "none"
+	// ReplayProtectionMemory remembers the used nonces of signed URLs in memory.
+	ReplayProtectionMemory = "memory"
+	// ReplayProtectionStore records the used nonces of signed URLs in the store service.
+	ReplayProtectionStore = "store"
+)
+
 // OIDC is the config for the OpenID-Connect middleware. If set the proxy will try to authenticate every request
 // with the configured oidc-provider
 type OIDC struct {
-	Issuer                  string        `yaml:"issuer" env:"OCIS_URL;OCIS_OIDC_ISSUER;PROXY_OIDC_ISSUER" desc:"URL of the OIDC issuer. It defaults to URL of the builtin IDP."`
-	Insecure                bool          `yaml:"insecure" env:"OCIS_INSECURE;PROXY_OIDC_INSECURE" desc:"Disable TLS certificate validation for connections to the IDP. Note that this is not recommended for production environments."`
-	AccessTokenVerifyMethod string        `yaml:"access_token_verify_method" env:"PROXY_OIDC_ACCESS_TOKEN_VERIFY_METHOD" desc:"Sets how OIDC access tokens should be verified. Possible values are 'none' and 'jwt'. When using 'none', no special validation apart from using it for accessing the IPD's userinfo endpoint will be done. When using 'jwt', it tries to parse the access token as a jwt token and verifies the signature using the keys published on the IDP's 'jwks_uri'."`
-	UserinfoCache           UserinfoCache `yaml:"user_info_cache"`
-	JWKS                    JWKS          `yaml:"jwks"`
-	RewriteWellKnown        bool          `yaml:"rewrite_well_known" env:"PROXY_OIDC_REWRITE_WELLKNOWN" desc:"Enables rewriting the /.well-known/openid-configuration to the configured OIDC issuer. Needed by the Desktop Client, Android Client and iOS Client to discover the OIDC provider."`
+	Issuer                  string         `yaml:"issuer" env:"OCIS_URL;OCIS_OIDC_ISSUER;PROXY_OIDC_ISSUER" desc:"URL of the OIDC issuer. It defaults to URL of the builtin IDP."`
+	Insecure                bool           `yaml:"insecure" env:"OCIS_INSECURE;PROXY_OIDC_INSECURE" desc:"Disable TLS certificate validation for connections to the IDP. Note that this is not recommended for production environments."`
+	AccessTokenVerifyMethod string         `yaml:"access_token_verify_method" env:"PROXY_OIDC_ACCESS_TOKEN_VERIFY_METHOD" desc:"Sets how OIDC access tokens should be verified. Possible values are 'none' and 'jwt'. When using 'none', no special validation apart from using it for accessing the IPD's userinfo endpoint will be done. When using 'jwt', it tries to parse the access token as a jwt token and verifies the signature using the keys published on the IDP's 'jwks_uri'."`
+	AccessTokenCookie       string         `yaml:"access_token_cookie" env:"PROXY_OIDC_ACCESS_TOKEN_COOKIE" desc:"Name of a cookie the access token is read from if the request has no 'Authorization' header. This allows browser applications to keep the access token in an HttpOnly cookie. The cookie is only accepted on same-site requests and is removed before the request is forwarded. If empty, access tokens are not read from cookies."`
+	UserinfoCache           UserinfoCache  `yaml:"user_info_cache"`
+	JWKS                    JWKS           `yaml:"jwks"`
+	TokenExchange           TokenExchange  `yaml:"token_exchange"`
+	Revocation              Revocation     `yaml:"revocation"`
+	CircuitBreaker          CircuitBreaker `yaml:"circuit_breaker"`
+	RewriteWellKnown        bool           `yaml:"rewrite_well_known" env:"PROXY_OIDC_REWRITE_WELLKNOWN" desc:"Enables rewriting the /.well-known/openid-configuration to the configured OIDC issuer. Needed by the Desktop Client, Android Client and iOS Client to discover the OIDC provider."`
 }
 
 type JWKS struct {
@@ -106,6 +222,32 @@ type JWKS struct {
 	RefreshTimeout    uint64 `yaml:"refresh_timeout" env:"PROXY_OIDC_JWKS_REFRESH_TIMEOUT" desc:"The timeout in seconds for an outgoing JWKS request."`
 	RefreshRateLimit  uint64 `yaml:"refresh_limit" env:"PROXY_OIDC_JWKS_REFRESH_RATE_LIMIT" desc:"Limits the rate in seconds at which refresh requests are performed for unknown keys. This is used to prevent malicious clients from imposing high network load on the IDP via ocis."`
 	RefreshUnknownKID bool   `yaml:"refresh_unknown_kid" env:"PROXY_OIDC_JWKS_REFRESH_UNKNOWN_KID" desc:"If set to 'true', the JWKS refresh request will occur every time an unknown KEY ID (KID) is seen. Always set a 'refresh_limit' when enabling this."`
+	StaleGracePeriod  uint64 `yaml:"stale_grace_period" env:"PROXY_OIDC_JWKS_STALE_GRACE_PERIOD" desc:"The time in minutes the last known JWKS is still used after the refresh interval has passed, if the IDP can't be reached. Failed refreshes are retried with an increasing backoff. After the grace period, access tokens are rejected until the JWKS could be refreshed."`
+}
+
+// TokenExchange configures the exchange of access tokens for tokens of the services behind the proxy (RFC 8693).
+type TokenExchange struct {
+	Enabled       bool   `yaml:"enabled" env:"PROXY_OIDC_TOKEN_EXCHANGE_ENABLED" desc:"Exchange verified access tokens at the IDP for a token issued for the services behind the proxy, as specified in RFC 8693. The exchanged token is forwarded instead of the original one. If disabled, the original access token is forwarded."`
+	TokenEndpoint string `yaml:"token_endpoint" env:"PROXY_OIDC_TOKEN_EXCHANGE_TOKEN_ENDPOINT" desc:"URL of the token endpoint used for the exchange. If empty, the token endpoint is discovered via the IDP's '.well-known/openid-configuration'."`
+	ClientID      string `yaml:"client_id" env:"PROXY_OIDC_TOKEN_EXCHANGE_CLIENT_ID" desc:"ID of the client the proxy authenticates as at the token endpoint."`
+	ClientSecret  string `yaml:"client_secret" env:"PROXY_OIDC_TOKEN_EXCHANGE_CLIENT_SECRET" desc:"Secret of the client the proxy authenticates as at the token endpoint."`
+	Audience      string `yaml:"audience" env:"PROXY_OIDC_TOKEN_EXCHANGE_AUDIENCE" desc:"Audience the exchanged token is requested for."`
+	Scope         string `yaml:"scope" env:"PROXY_OIDC_TOKEN_EXCHANGE_SCOPE" desc:"Space separated list of scopes the exchanged token is requested with. If empty, the IDP decides about the scopes."`
+	CacheSize     int    `yaml:"cache_size" env:"PROXY_OIDC_TOKEN_EXCHANGE_CACHE_SIZE" desc:"Cache size for exchanged tokens. Exchanged tokens are cached per user and audience until they expire."`
+}
+
+// Revocation configures the rejection of revoked access tokens before they expire.
+type Revocation struct {
+	Source   string `yaml:"source" env:"PROXY_OIDC_REVOCATION_SOURCE" desc:"Source of the list of revoked access tokens. Possible values are 'none', 'file' and 'store'. Entries of the list are the subjects ('sub:<subject>') or token ids ('jti:<id>') of revoked access tokens, which are rejected even if they are otherwise valid. With 'file', the entries are read from the file set in PROXY_OIDC_REVOCATION_FILE, one per line. With 'store', the entries are the keys of the 'revocations' table of the 'proxy' database of the store service. Token ids are only checked when the access tokens are verified as JWTs."`
+	File     string `yaml:"file" env:"PROXY_OIDC_REVOCATION_FILE" desc:"Path of the revocation list when using the 'file' source."`
+	CacheTTL int    `yaml:"cache_ttl" env:"PROXY_OIDC_REVOCATION_CACHE_TTL" desc:"Time in seconds the revocation list is cached before it is reloaded. Revoked tokens are rejected after this time at the latest. If the list can't be reloaded, the last loaded list is used. Access tokens are rejected as long as the list couldn't be loaded at all."`
+}
+
+// CircuitBreaker configures failing fast while the IDP is unavailable.
+type CircuitBreaker struct {
+	FailureThreshold int `yaml:"failure_threshold" env:"PROXY_OIDC_CIRCUIT_BREAKER_FAILURE_THRESHOLD" desc:"Number of consecutive failed requests to the IDP after which the circuit breaker opens. Requests fail with an error or a 5xx status. While the breaker is open, requests to the IDP fail immediately and access tokens which need the IDP to be verified are rejected. Set to 0 to disable the circuit breaker."`
+	OpenDuration     int `yaml:"open_duration" env:"PROXY_OIDC_CIRCUIT_BREAKER_OPEN_DURATION" desc:"Time in seconds the circuit breaker stays open before probe requests are sent to the IDP again."`
+	HalfOpenProbes   int `yaml:"half_open_probes" env:"PROXY_OIDC_CIRCUIT_BREAKER_HALF_OPEN_PROBES" desc:"Number of probe requests which have to succeed to close the circuit breaker again. Only this many requests are sent to the IDP at a time while probing, a failed probe opens the breaker again."`
 }
 
 // UserinfoCache is a TTL cache configuration.
@@ -131,10 +273,20 @@ type TokenManager struct {
 	JWTSecret string `mask:"password" yaml:"jwt_secret" env:"OCIS_JWT_SECRET;PROXY_JWT_SECRET" desc:"The secret to mint and validate JWT tokens."`
 }
 
+// InternalAuth configures the authentication of internal requests of other services with a shared secret. These
+// requests skip the other authenticators and are made as the configured service user.
+type InternalAuth struct {
+	Enabled         bool     `yaml:"enabled" env:"PROXY_INTERNAL_AUTH_ENABLED" desc:"Authenticate requests carrying the shared secret in the 'X-Internal-Secret' header as the service user without asking the IDP. Only requests from the trusted networks are accepted, every authenticated request is logged at info level. Disabled by default."`
+	Secret          string   `mask:"password" yaml:"secret" env:"PROXY_INTERNAL_AUTH_SECRET" desc:"The shared secret of the internal requests. It is required if the internal authentication is enabled."`
+	UserID          string   `yaml:"user_id" env:"PROXY_INTERNAL_AUTH_USER_ID" desc:"The id of the service user internal requests are made as. It is required if the internal authentication is enabled."`
+	TrustedNetworks []string `yaml:"trusted_networks" env:"PROXY_INTERNAL_AUTH_TRUSTED_NETWORKS" desc:"A comma-separated list of IP addresses or CIDR ranges internal requests are accepted from, e.g. the network of the services. At least one is required if the internal authentication is enabled. The client IP is determined like for PROXY_TRUSTED_PROXIES."`
+}
+
 // PreSignedURL is the config for the presigned url middleware
 type PreSignedURL struct {
 	AllowedHTTPMethods []string `yaml:"allowed_http_methods"`
 	Enabled            bool     `yaml:"enabled" env:"PROXY_ENABLE_PRESIGNEDURLS" desc:"Allow OCS to get a signing key to sign requests."`
+	ReplayProtection   string   `yaml:"replay_protection" env:"PROXY_PRESIGNEDURLS_REPLAY_PROTECTION" desc:"Reject signed URLs which are used a second time. Possible values are 'none', 'memory' and 'store'. With 'memory' or 'store', signed URLs need an 'OC-Nonce' parameter and are only accepted once before they expire. With 'memory', the used nonces are only known to the proxy instance they were sent to and forgotten on restarts. With 'store', they are recorded in the 'signed-url-nonces' table of the 'proxy' database of the store service, which works with multiple proxies. With 'none', signed URLs can be used until they expire."`
 }
 
 // ClaimsSelectorConf is the config for the claims-selector
@@ -46,6 +46,37 @@ func DefaultConfig() *config.Config {
 				RefreshRateLimit:  60, // seconds
 				RefreshTimeout:    10, // seconds
 				RefreshUnknownKID: true,
+				StaleGracePeriod:  30, // minutes
+			},
+			TokenExchange: config.TokenExchange{
+				CacheSize: 1024,
+			},
+			Revocation: config.Revocation{
+				Source:   config.RevocationSourceNone,
+				CacheTTL: 10,
+			},
+			CircuitBreaker: config.CircuitBreaker{
+				FailureThreshold: 10,
+				OpenDuration:     30, // seconds
+				HalfOpenProbes:   1,
+			},
+		},
+		AuthMiddleware: config.AuthMiddleware{
+			SuppressXHRBasicChallenge: true,
+			MultipleAuthorization:     config.MultipleAuthorizationPickBearer,
+			WebDAVBodyDrainLimit:      64 * 1024, // 64 KiB
+			Maintenance: config.Maintenance{
+				RetryAfter: 300,
+			},
+			Challenges: config.Challenges{
+				Order:  []string{"bearer", "basic", "signed-url", "mtls"},
+				Bearer: true,
+				Basic:  true,
+			},
+			SecurityHeaders: config.SecurityHeaders{
+				StrictTransportSecurity: "max-age=31536000",
+				ContentTypeOptions:      "nosniff",
+				ReferrerPolicy:          "strict-origin-when-cross-origin",
 			},
 		},
 		PolicySelector: nil,
@@ -53,6 +84,7 @@ func DefaultConfig() *config.Config {
 		PreSignedURL: config.PreSignedURL{
 			AllowedHTTPMethods: []string{"GET"},
 			Enabled:            true,
+			ReplayProtection:   config.ReplayProtectionNone,
 		},
 		AccountBackend:        "cs3",
 		UserOIDCClaim:         "preferred_username",
@@ -189,6 +221,12 @@ func DefaultPolicies() []config.Policy {
 					Endpoint: "/graph/",
 					Service:  "com.owncloud.graph.graph",
 				},
+				{
+					// public bundles can be read without authentication, the route has to precede the settings route
+					Endpoint:    "/api/v0/settings/bundles-list-public",
+					Service:     "com.owncloud.web.settings",
+					Unprotected: true,
+				},
 				{
 					Endpoint: "/api/v0/settings",
 					Service:  "com.owncloud.web.settings",
@@ -254,6 +292,8 @@ func EnsureDefaults(cfg *config.Config) {
 		if cfg.Commons != nil && cfg.Commons.GRPCClientTLS != nil {
 			cfg.GRPCClientTLS.Mode = cfg.Commons.GRPCClientTLS.Mode
 			cfg.GRPCClientTLS.CACert = cfg.Commons.GRPCClientTLS.CACert
+			cfg.GRPCClientTLS.Cert = cfg.Commons.GRPCClientTLS.Cert
+			cfg.GRPCClientTLS.Key = cfg.Commons.GRPCClientTLS.Key
 		}
 	}
 }
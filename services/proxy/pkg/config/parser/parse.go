@@ -8,6 +8,7 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/middleware"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/config/envdecode"
 )
@@ -51,5 +52,92 @@ func Validate(cfg *config.Config) error {
 		)
 	}
 
+	if cfg.OIDC.TokenExchange.Enabled && cfg.OIDC.TokenExchange.ClientID == "" {
+		return fmt.Errorf("The token exchange is enabled but 'client_id' is not set in service %s", cfg.Service.Name)
+	}
+
+	switch cfg.OIDC.Revocation.Source {
+	case config.RevocationSourceNone, config.RevocationSourceStore:
+	case config.RevocationSourceFile:
+		if cfg.OIDC.Revocation.File == "" {
+			return fmt.Errorf("The revocation source is 'file' but 'file' is not set in service %s", cfg.Service.Name)
+		}
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'revocation.source' in service %s. Possible values are: '%s', '%s' or '%s'.",
+			cfg.OIDC.Revocation.Source, cfg.Service.Name,
+			config.RevocationSourceNone, config.RevocationSourceFile, config.RevocationSourceStore,
+		)
+	}
+	if cfg.OIDC.Revocation.CacheTTL < 0 {
+		return fmt.Errorf("Invalid value %d for 'revocation.cache_ttl' in service %s, it can't be negative", cfg.OIDC.Revocation.CacheTTL, cfg.Service.Name)
+	}
+	if cb := cfg.OIDC.CircuitBreaker; cb.FailureThreshold < 0 || cb.OpenDuration < 0 || cb.HalfOpenProbes < 0 {
+		return fmt.Errorf("Invalid value for 'circuit_breaker' in service %s, the values can't be negative", cfg.Service.Name)
+	}
+
+	if cfg.AuthMiddleware.WebDAVBodyDrainLimit < 0 {
+		return fmt.Errorf("Invalid value %d for 'webdav_body_drain_limit' in service %s, it can't be negative", cfg.AuthMiddleware.WebDAVBodyDrainLimit, cfg.Service.Name)
+	}
+
+	switch cfg.PreSignedURL.ReplayProtection {
+	case config.ReplayProtectionNone, config.ReplayProtectionMemory, config.ReplayProtectionStore:
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'replay_protection' in service %s. Possible values are: '%s', '%s' or '%s'.",
+			cfg.PreSignedURL.ReplayProtection, cfg.Service.Name,
+			config.ReplayProtectionNone, config.ReplayProtectionMemory, config.ReplayProtectionStore,
+		)
+	}
+
+	if cfg.AuthMiddleware.MultipleAuthorization != config.MultipleAuthorizationPickBearer &&
+		cfg.AuthMiddleware.MultipleAuthorization != config.MultipleAuthorizationReject {
+		return fmt.Errorf(
+			"Invalid value '%s' for 'multiple_authorization' in service %s. Possible values are: '%s' or '%s'.",
+			cfg.AuthMiddleware.MultipleAuthorization, cfg.Service.Name,
+			config.MultipleAuthorizationPickBearer, config.MultipleAuthorizationReject,
+		)
+	}
+
+	if _, err := middleware.ParseErrorPages(cfg.AuthMiddleware.ErrorPages); err != nil {
+		return fmt.Errorf("Invalid value for 'error_pages' in service %s: %s", cfg.Service.Name, err)
+	}
+
+	if err := middleware.ValidateChallenges(cfg.AuthMiddleware.Challenges); err != nil {
+		return fmt.Errorf("Invalid value for 'challenges' in service %s: %s", cfg.Service.Name, err)
+	}
+
+	if err := middleware.ValidatePublicPathMethods(cfg.AuthMiddleware.PublicPathMethods); err != nil {
+		return fmt.Errorf("Invalid value for 'public_path_methods' in service %s: %s", cfg.Service.Name, err)
+	}
+
+	if err := middleware.ValidateTenants(cfg.Tenants); err != nil {
+		return fmt.Errorf("Invalid value for 'tenants' in service %s: %s", cfg.Service.Name, err)
+	}
+	for _, t := range cfg.Tenants.Tenants {
+		for _, s := range t.Strategies {
+			if s == middleware.StrategyBasic && !cfg.EnableBasicAuth {
+				return fmt.Errorf("The tenant %s uses basic auth but basic auth is not enabled in service %s", t.Name, cfg.Service.Name)
+			}
+		}
+	}
+
+	if _, err := middleware.ParseTrustedProxies(cfg.TrustedProxies); err != nil {
+		return fmt.Errorf("Invalid value for 'trusted_proxies' in service %s: %s", cfg.Service.Name, err)
+	}
+
+	if cfg.InternalAuth.Enabled {
+		if cfg.InternalAuth.Secret == "" || cfg.InternalAuth.UserID == "" {
+			return fmt.Errorf("The internal authentication of service %s needs a secret and a user id", cfg.Service.Name)
+		}
+		networks, err := middleware.ParseTrustedProxies(cfg.InternalAuth.TrustedNetworks)
+		if err != nil {
+			return fmt.Errorf("Invalid value for 'internal_auth.trusted_networks' in service %s: %s", cfg.Service.Name, err)
+		}
+		if len(networks) == 0 {
+			return fmt.Errorf("The internal authentication of service %s needs at least one trusted network", cfg.Service.Name)
+		}
+	}
+
 	return nil
 }
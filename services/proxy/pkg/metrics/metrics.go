@@ -18,6 +18,18 @@ type Metrics struct {
 	Latency   *prometheus.SummaryVec
 	Duration  *prometheus.HistogramVec
 	BuildInfo *prometheus.GaugeVec
+	// AuthenticatorDuration is the time spent in each authenticator, labeled by authenticator and outcome
+	AuthenticatorDuration *prometheus.HistogramVec
+	// AuthenticationDuration is the time spent in the authentication middleware, labeled by the
+	// authenticator that authenticated the request and the outcome
+	AuthenticationDuration *prometheus.HistogramVec
+	// PublicPathRequests counts the requests to public paths, labeled by the matched public path prefix
+	// and the outcome of the authentication
+	PublicPathRequests *prometheus.CounterVec
+	// IDPCircuitBreakerState is the state of the circuit breaker of each IDP: 0 closed, 1 open, 2 half-open
+	IDPCircuitBreakerState *prometheus.GaugeVec
+	// IDPCircuitBreakerRejections counts the requests to each IDP rejected by its circuit breaker
+	IDPCircuitBreakerRejections *prometheus.CounterVec
 }
 
 // New initializes the available metrics.
@@ -47,11 +59,46 @@ func New() *Metrics {
 			Name:      "build_info",
 			Help:      "Build Information",
 		}, []string{"versions"}),
+		AuthenticatorDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "authenticator_duration_seconds",
+			Help:      "time spent in an authenticator in seconds",
+		}, []string{"authenticator", "outcome"}),
+		AuthenticationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "authentication_duration_seconds",
+			Help:      "time spent authenticating a request in seconds",
+		}, []string{"authenticator", "outcome"}),
+		PublicPathRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "public_path_requests_total",
+			Help:      "How many requests to public paths were processed",
+		}, []string{"prefix", "outcome"}),
+		IDPCircuitBreakerState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "idp_circuit_breaker_state",
+			Help:      "State of the IDP circuit breaker, 0 closed, 1 open, 2 half-open",
+		}, []string{"issuer"}),
+		IDPCircuitBreakerRejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "idp_circuit_breaker_rejections_total",
+			Help:      "How many requests to the IDP were rejected by the circuit breaker",
+		}, []string{"issuer"}),
 	}
 
 	_ = prometheus.Register(m.Counter)
 	_ = prometheus.Register(m.Latency)
 	_ = prometheus.Register(m.Duration)
 	_ = prometheus.Register(m.BuildInfo)
+	_ = prometheus.Register(m.AuthenticatorDuration)
+	_ = prometheus.Register(m.AuthenticationDuration)
+	_ = prometheus.Register(m.PublicPathRequests)
+	_ = prometheus.Register(m.IDPCircuitBreakerState)
+	_ = prometheus.Register(m.IDPCircuitBreakerRejections)
 	return m
 }
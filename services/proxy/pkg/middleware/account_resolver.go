@@ -22,7 +22,7 @@ func AccountResolver(optionSetters ...Option) func(next http.Handler) http.Handl
 			next:                  next,
 			logger:                logger,
 			userProvider:          options.UserProvider,
-			userOIDCClaim:         options.UserOIDCClaim,
+			userOIDCClaims:        append([]string{options.UserOIDCClaim}, options.UserOIDCClaimFallbacks...),
 			userCS3Claim:          options.UserCS3Claim,
 			autoProvisionAccounts: options.AutoprovisionAccounts,
 		}
@@ -34,7 +34,7 @@ type accountResolver struct {
 	logger                log.Logger
 	userProvider          backend.UserBackend
 	autoProvisionAccounts bool
-	userOIDCClaim         string
+	userOIDCClaims        []string // ordered by precedence
 	userCS3Claim          string
 }
 
@@ -54,10 +54,9 @@ func (m accountResolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	if user == nil && claims != nil {
 
 		var err error
-		var value string
-		var ok bool
-		if value, ok = claims[m.userOIDCClaim].(string); !ok || value == "" {
-			m.logger.Error().Str("claim", m.userOIDCClaim).Interface("claims", claims).Msg("claim not set or empty")
+		claim, value, ok := m.readUserClaim(claims)
+		if !ok {
+			m.logger.Error().Strs("configured_claims", m.userOIDCClaims).Interface("claims", claims).Msg("none of the configured claims is set")
 			w.WriteHeader(http.StatusInternalServerError) // admin needs to make the idp send the right claim
 			return
 		}
@@ -65,7 +64,7 @@ func (m accountResolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		user, token, err = m.userProvider.GetUserByClaims(req.Context(), m.userCS3Claim, value, true)
 
 		if errors.Is(err, backend.ErrAccountNotFound) {
-			m.logger.Debug().Str("claim", m.userOIDCClaim).Str("value", value).Msg("User by claim not found")
+			m.logger.Debug().Str("claim", claim).Str("value", value).Msg("User by claim not found")
 			if !m.autoProvisionAccounts {
 				m.logger.Debug().Interface("claims", claims).Msg("Autoprovisioning disabled")
 				w.WriteHeader(http.StatusUnauthorized)
@@ -120,3 +119,13 @@ func (m accountResolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 	m.next.ServeHTTP(w, req)
 }
+
+// readUserClaim returns the first of the configured claims that is set to a non-empty string.
+func (m accountResolver) readUserClaim(claims map[string]interface{}) (string, string, bool) {
+	for _, claim := range m.userOIDCClaims {
+		if value, ok := claims[claim].(string); ok && value != "" {
+			return claim, value, true
+		}
+	}
+	return "", "", false
+}
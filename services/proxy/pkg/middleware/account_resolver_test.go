@@ -107,6 +107,54 @@ func TestInternalServerErrorOnMissingMailAndUsername(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, rw.Code)
 }
 
+func TestFallbackClaims(t *testing.T) {
+	var resolvedWith string
+	mock := &test.UserBackendMock{
+		GetUserByClaimsFunc: func(ctx context.Context, claim string, value string, withRoles bool) (*userv1beta1.User, string, error) {
+			resolvedWith = value
+			return &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "123"}}, "token", nil
+		},
+	}
+	sut := AccountResolver(
+		Logger(log.NewLogger()),
+		UserProvider(mock),
+		UserOIDCClaim("oid"),
+		UserOIDCClaimFallbacks(oidc.PreferredUsername, oidc.Sub),
+		UserCS3Claim("username"),
+	)(mockHandler{})
+
+	// the first present claim is used
+	req, rw := mockRequest(map[string]interface{}{
+		oidc.Iss:               "https://idx.example.com",
+		oidc.PreferredUsername: "",
+		oidc.Sub:               "from-sub",
+	})
+	sut.ServeHTTP(rw, req)
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "from-sub", resolvedWith)
+	assert.Equal(t, "token", req.Header.Get(revactx.TokenHeader))
+
+	// the primary claim takes precedence
+	req, rw = mockRequest(map[string]interface{}{
+		"oid":                  "from-oid",
+		oidc.PreferredUsername: "from-username",
+	})
+	sut.ServeHTTP(rw, req)
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "from-oid", resolvedWith)
+
+	// none of the claims is present
+	resolvedWith = ""
+	req, rw = mockRequest(map[string]interface{}{
+		oidc.Iss:   "https://idx.example.com",
+		oidc.Email: "foo@example.com",
+	})
+	sut.ServeHTTP(rw, req)
+	assert.Equal(t, http.StatusInternalServerError, rw.Code)
+	assert.Empty(t, resolvedWith)
+	assert.Empty(t, req.Header.Get(revactx.TokenHeader))
+}
+
 func newMockAccountResolver(userBackendResult *userv1beta1.User, userBackendErr error, oidcclaim, cs3claim string) http.Handler {
 	tokenManager, _ := jwt.New(map[string]interface{}{
 		"secret":  "change-me",
@@ -1,11 +1,22 @@
 package middleware
 
 import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/webdav"
 	"golang.org/x/text/cases"
@@ -13,9 +24,6 @@ import (
 )
 
 var (
-	// SupportedAuthStrategies stores configured challenges.
-	SupportedAuthStrategies []string
-
 	// ProxyWwwAuthenticate is a list of endpoints that do not rely on reva underlying authentication, such as ocs.
 	// services that fallback to reva authentication are declared in the "frontend" command on oCIS. It is a list of
 	// regexp.Regexp which are safe to use concurrently.
@@ -33,6 +41,8 @@ var (
 const (
 	// WwwAuthenticate captures the Www-Authenticate header string.
 	WwwAuthenticate = "Www-Authenticate"
+	// MaintenanceTokenHeader is the header carrying the token to bypass the maintenance mode.
+	MaintenanceTokenHeader = "X-Maintenance-Token"
 )
 
 // Authenticator is the common interface implemented by all request authenticators.
@@ -41,73 +51,415 @@ type Authenticator interface {
 	// The Authenticator may augment the request with user info or anything related to the
 	// authentication and return the augmented request.
 	Authenticate(*http.Request) (*http.Request, bool)
+	// Name identifies the authenticator in logs and metrics.
+	Name() string
 }
 
 // Authentication is a higher order authentication middleware.
 func Authentication(auths []Authenticator, opts ...Option) func(next http.Handler) http.Handler {
+	return NewReloadableAuthentication(auths, opts...).Handler
+}
+
+// authenticationState is the set of authenticators and options of an authentication middleware.
+type authenticationState struct {
+	authenticators []Authenticator
+	options        Options
+	// strategies are the challenges of the configured authentication strategies
+	strategies []string
+	// securityHeaders are added to all responses
+	securityHeaders []securityHeader
+}
+
+func newAuthenticationState(auths []Authenticator, opts ...Option) *authenticationState {
 	options := newOptions(opts...)
-	configureSupportedChallenges(options)
-
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ri := router.ContextRoutingInfo(r.Context())
-			if isOIDCTokenAuth(r) || ri.IsRouteUnprotected() {
-				// Either this is a request that does not need any authentication or
-				// the authentication for this request is handled by the IdP.
-				next.ServeHTTP(w, r)
+	return &authenticationState{
+		authenticators:  auths,
+		options:         options,
+		strategies:      supportedChallenges(options),
+		securityHeaders: securityHeaderList(options.SecurityHeaders),
+	}
+}
+
+// ReloadableAuthentication is an authentication middleware whose authenticators and options can be replaced
+// while it is handling requests, e.g. to rotate the OIDC client secret without a restart.
+type ReloadableAuthentication struct {
+	// state holds the current *authenticationState
+	state atomic.Value
+}
+
+// NewReloadableAuthentication returns an authentication middleware using the given authenticators and options.
+func NewReloadableAuthentication(auths []Authenticator, opts ...Option) *ReloadableAuthentication {
+	a := &ReloadableAuthentication{}
+	a.state.Store(newAuthenticationState(auths, opts...))
+	return a
+}
+
+// Reload atomically replaces the authenticators and options. Requests already being handled finish with
+// the previous ones.
+func (a *ReloadableAuthentication) Reload(auths []Authenticator, opts ...Option) {
+	a.state.Store(newAuthenticationState(auths, opts...))
+}
+
+// Handler is the authentication middleware.
+func (a *ReloadableAuthentication) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a request is handled with the authenticators and options it started with, even if they are reloaded meanwhile
+		state := a.state.Load().(*authenticationState)
+		options := state.options
+		if len(state.securityHeaders) > 0 {
+			w = &securityHeadersWriter{ResponseWriter: w, headers: state.securityHeaders}
+		}
+
+		if options.Maintenance.Enabled && !bypassesMaintenance(r, options.Maintenance.BypassToken) {
+			w.Header().Set("Retry-After", strconv.FormatUint(options.Maintenance.RetryAfter, 10))
+			if writeErrorPage(w, r, options.ErrorPages.ServiceUnavailable, http.StatusServiceUnavailable, state.strategies, options) {
 				return
 			}
+			http.Error(w, "service in maintenance", http.StatusServiceUnavailable)
+			return
+		}
+		// the token must not be passed on to the services
+		r.Header.Del(MaintenanceTokenHeader)
+
+		// public paths are observed separately, the outcome is set where the request is handled
+		outcome := authOutcomeFailure
+		if prefix, ok := publicPathPrefix(r.URL.Path); ok {
+			var wrap chimiddleware.WrapResponseWriter
+			if options.PublicPathAccessLog {
+				wrap = chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+				w = wrap
+			}
+			start := time.Now()
+			defer func() {
+				observePublicPath(options, r, wrap, prefix, outcome, start)
+			}()
+		}
+
+		ri := router.ContextRoutingInfo(r.Context())
+		if isOIDCTokenAuth(r) || ri.IsRouteUnprotected() {
+			outcome = authOutcomeUnprotected
+			// Either this is a request that does not need any authentication or
+			// the authentication for this request is handled by the IdP.
+			r.Header.Del(InternalSecretHeader)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		authenticators, strategies, realm := state.authenticators, state.strategies, r.Host
+		if options.Tenants != nil {
+			tenant, ok := options.Tenants.Resolve(r)
+			if !ok {
+				http.Error(w, "unknown tenant", http.StatusBadRequest)
+				return
+			}
+			authenticators, strategies = tenant.Authenticators, tenant.Strategies
+			if tenant.Realm != "" {
+				realm = tenant.Realm
+			}
+		}
+
+		upgrade := isWebSocketUpgrade(r)
+		if upgrade {
+			useWebSocketToken(r, options.WebSocketAuth)
+		}
+		if err := normalizeAuthorization(r.Header, options.MultipleAuthorization); err != nil {
+			options.Logger.Debug().Err(err).Str("path", r.URL.Path).Msg("rejecting the authorization headers")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 
-			for _, a := range auths {
-				if req, ok := a.Authenticate(r); ok {
-					next.ServeHTTP(w, req)
+		start := time.Now()
+		for _, a := range authenticators {
+			if r.Context().Err() != nil {
+				break
+			}
+			if req, ok := observeAuthenticate(options.Metrics, a, r, authenticatorTimeout(options, a)); ok {
+				observeAuthentication(options.Metrics, a.Name(), authOutcomeSuccess, start)
+				outcome = authOutcomeSuccess
+				if options.Authorizer != nil && !options.Authorizer.Authorize(req) {
+					outcome = authOutcomeForbidden
+					options.Logger.Debug().Str("authenticator", a.Name()).Str("path", r.URL.Path).Msg("the authorizer denied the request")
+					writeForbidden(w, options.ForbiddenBody)
 					return
 				}
+				// the secret of internal requests must not be passed on to the services, whichever authenticator
+				// accepted the request
+				req.Header.Del(InternalSecretHeader)
+				next.ServeHTTP(w, req)
+				return
 			}
-			if !isPublicPath(r.URL.Path) {
-				// Failed basic authentication attempts receive the Www-Authenticate header in the response
-				var touch bool
-				caser := cases.Title(language.Und)
-				for k, v := range options.CredentialsByUserAgent {
-					if strings.Contains(k, r.UserAgent()) {
-						removeSuperfluousAuthenticate(w)
-						w.Header().Add("Www-Authenticate", fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(v), r.Host))
-						touch = true
-						break
-					}
-				}
-
-				// if the request is not bound to any user agent, write all available challenges
-				if !touch &&
-					// This is a temporary hack... Before the authentication middleware rewrite all
-					// unauthenticated requests were still handled. The reva http services then did add
-					// the supported authentication headers to the response. Since we are not allowing the
-					// requests to continue so far we have to do it here. But we shouldn't do it for the graph service.
-					// That's the reason for this hard check here.
-					!strings.HasPrefix(r.URL.Path, "/graph") {
-					writeSupportedAuthenticateHeader(w, r)
+		}
+		if err := r.Context().Err(); err != nil {
+			observeAuthentication(options.Metrics, "", authOutcomeCancelled, start)
+			outcome = authOutcomeCancelled
+			// the client is gone, there is nobody to send a response to
+			options.Logger.Debug().Err(err).Str("path", r.URL.Path).Msg("request cancelled during authentication")
+			return
+		}
+		observeAuthentication(options.Metrics, "", authOutcomeFailure, start)
+		if upgrade {
+			writeWebSocketUnauthorized(w, strategies, realm)
+			return
+		}
+		if options.LoginRedirectURL != "" && isHTMLNavigation(r) {
+			http.Redirect(w, r, options.LoginRedirectURL, http.StatusFound)
+			return
+		}
+		if !options.PublicPathMethods.isPublic(r) {
+			// Failed basic authentication attempts receive the Www-Authenticate header in the response
+			var touch bool
+			caser := cases.Title(language.Und)
+			for k, v := range options.CredentialsByUserAgent {
+				if strings.Contains(k, r.UserAgent()) {
+					removeSuperfluousAuthenticate(w)
+					w.Header().Add("Www-Authenticate", fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(v), realm))
+					touch = true
+					break
 				}
 			}
 
-			for _, s := range SupportedAuthStrategies {
-				userAgentAuthenticateLockIn(w, r, options.CredentialsByUserAgent, s)
+			// if the request is not bound to any user agent, write all available challenges
+			if !touch &&
+				// This is a temporary hack... Before the authentication middleware rewrite all
+				// unauthenticated requests were still handled. The reva http services then did add
+				// the supported authentication headers to the response. Since we are not allowing the
+				// requests to continue so far we have to do it here. But we shouldn't do it for the graph service.
+				// That's the reason for this hard check here.
+				!strings.HasPrefix(r.URL.Path, "/graph") {
+				writeSupportedAuthenticateHeader(w, strategies, realm)
 			}
-			w.WriteHeader(http.StatusUnauthorized)
-			// if the request is a PROPFIND return a WebDAV error code.
-			// TODO: The proxy has to be smart enough to detect when a request is directed towards a webdav server
-			// and react accordingly.
-			if webdav.IsWebdavRequest(r) {
-				b, err := webdav.Marshal(webdav.Exception{
-					Code:    webdav.SabredavPermissionDenied,
-					Message: "Authentication error",
-				})
-
-				webdav.HandleWebdavError(w, b, err)
-			}
-		})
+		}
+
+		for _, s := range strategies {
+			userAgentAuthenticateLockIn(w, r, options.CredentialsByUserAgent, s, realm)
+		}
+		if options.SuppressXHRBasicChallenge && isXHR(r) {
+			// browsers would show their login dialog instead of letting the application handle the login
+			removeBasicChallenge(w)
+		}
+		if writeErrorPage(w, r, options.ErrorPages.Unauthorized, http.StatusUnauthorized, strategies, options) {
+			return
+		}
+		writeJSON := !webdav.IsWebdavRequest(r) && acceptsJSON(r)
+		if writeJSON {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		if webdav.IsWebdavRequest(r) {
+			drainBody(w, r, options.WebDAVBodyDrainLimit)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		// if the request is a PROPFIND return a WebDAV error code.
+		// TODO: The proxy has to be smart enough to detect when a request is directed towards a webdav server
+		// and react accordingly.
+		if webdav.IsWebdavRequest(r) {
+			b, err := webdav.Marshal(webdav.Exception{
+				Code:    webdav.SabredavPermissionDenied,
+				Message: "Authentication error",
+			})
+
+			webdav.HandleWebdavError(w, b, err)
+		}
+		if writeJSON {
+			writeUnauthenticatedJSON(w, r)
+		}
+	})
+}
+
+// drainBody reads and discards up to limit bytes of the request body, so WebDAV clients can send their next
+// request on the same connection. Larger bodies are not read, the connection is closed after the response instead.
+// Nothing is drained for a limit of 0, the http server drains small bodies itself then.
+func drainBody(w http.ResponseWriter, r *http.Request, limit int64) {
+	if limit <= 0 || r.Body == nil || r.Body == http.NoBody {
+		return
+	}
+	if r.ContentLength > limit {
+		w.Header().Set("Connection", "close")
+		return
+	}
+	n, err := io.CopyN(io.Discard, r.Body, limit+1)
+	if n > limit || (err != nil && err != io.EOF) {
+		w.Header().Set("Connection", "close")
 	}
 }
 
+// outcomes of authentications in the metrics
+const (
+	authOutcomeSuccess   = "success"
+	authOutcomeFailure   = "failure"
+	authOutcomeTimeout   = "timeout"
+	authOutcomeCancelled = "cancelled"
+	// authOutcomeUnprotected is only used for public paths on unprotected routes
+	authOutcomeUnprotected = "unprotected"
+	// authOutcomeForbidden is only used for public paths, the authentication succeeded but the authorizer denied the request
+	authOutcomeForbidden = "forbidden"
+)
+
+// observeAuthenticate runs the authenticator like authenticate and observes its duration.
+func observeAuthenticate(m *metrics.Metrics, a Authenticator, r *http.Request, timeout time.Duration) (*http.Request, bool) {
+	if m == nil {
+		return authenticate(a, r, timeout)
+	}
+	start := time.Now()
+	req, ok := authenticate(a, r, timeout)
+	outcome := authOutcomeSuccess
+	switch {
+	case ok:
+	case r.Context().Err() != nil:
+		outcome = authOutcomeCancelled
+	case timeout > 0 && time.Since(start) >= timeout:
+		outcome = authOutcomeTimeout
+	default:
+		outcome = authOutcomeFailure
+	}
+	m.AuthenticatorDuration.WithLabelValues(a.Name(), outcome).Observe(time.Since(start).Seconds())
+	return req, ok
+}
+
+// observeAuthentication observes the time spent in the authentication middleware since start.
+// The authenticator is only known for successful authentications.
+func observeAuthentication(m *metrics.Metrics, authenticator, outcome string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.AuthenticationDuration.WithLabelValues(authenticator, outcome).Observe(time.Since(start).Seconds())
+}
+
+// observePublicPath counts a request to a public path and writes its access log if enabled. The response
+// writer is only set if the access log is enabled.
+func observePublicPath(options Options, r *http.Request, w chimiddleware.WrapResponseWriter, prefix, outcome string, start time.Time) {
+	if options.Metrics != nil {
+		options.Metrics.PublicPathRequests.WithLabelValues(prefix, outcome).Inc()
+	}
+	if w == nil {
+		return
+	}
+	options.Logger.Info().
+		Str(log.RequestIDString, chimiddleware.GetReqID(r.Context())).
+		Str("remote-addr", r.RemoteAddr).
+		Str("method", r.Method).
+		Str("path", r.URL.Path).
+		Str("prefix", prefix).
+		Str("outcome", outcome).
+		Int("status", w.Status()).
+		Dur("duration", time.Since(start)).
+		Msg("public-path-access")
+}
+
+// authenticatorTimeout returns the timeout of the authenticator, the timeout configured for its name takes
+// precedence over the timeout of all authenticators.
+func authenticatorTimeout(options Options, a Authenticator) time.Duration {
+	if d, ok := options.AuthenticatorTimeouts[a.Name()]; ok {
+		return d
+	}
+	return options.AuthenticatorTimeout
+}
+
+// authenticate runs the authenticator and gives up as soon as the request is cancelled or the timeout is exceeded.
+// A timeout of 0 disables the timeout.
+func authenticate(a Authenticator, r *http.Request, timeout time.Duration) (*http.Request, bool) {
+	ctx := r.Context()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		// stops the outgoing requests of an authenticator we stopped waiting for
+		defer cancel()
+	}
+	if ctx.Done() == nil {
+		return a.Authenticate(r)
+	}
+
+	type result struct {
+		req *http.Request
+		ok  bool
+	}
+	done := make(chan result, 1)
+	// an authenticator we stopped waiting for keeps running, it must not change the headers of the request
+	// which is passed on to the next authenticator
+	go func() {
+		req, ok := a.Authenticate(r.Clone(ctx))
+		done <- result{req: req, ok: ok}
+	}()
+
+	select {
+	case res := <-done:
+		if !res.ok {
+			return nil, false
+		}
+		// the timeout only applies to the authentication, not to the handlers of the request
+		return res.req.WithContext(authenticatedContext{Context: r.Context(), values: res.req.Context()}), true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+// authenticatedContext carries the values added by an authenticator while keeping the
+// cancellation and deadline of the original request context.
+type authenticatedContext struct {
+	context.Context
+	values context.Context
+}
+
+// Value returns the value from the context of the authenticated request.
+func (c authenticatedContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
+
+// bypassesMaintenance checks if the request carries the maintenance bypass token.
+func bypassesMaintenance(r *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get(MaintenanceTokenHeader)), []byte(token)) == 1
+}
+
+// isHTMLNavigation checks if the request is a browser navigation, as opposed to an XHR or fetch
+// request from a script or a request from an API client.
+func isHTMLNavigation(r *http.Request) bool {
+	if r.Method != http.MethodGet || r.Header.Get("X-Requested-With") == "XMLHttpRequest" {
+		return false
+	}
+	if mode := r.Header.Get("Sec-Fetch-Mode"); mode != "" && mode != "navigate" {
+		return false
+	}
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// isXHR checks if the request was sent by a browser script via XHR or fetch. Only headers set by browsers are
+// considered, so API and WebDAV clients always receive the Basic challenge.
+func isXHR(r *http.Request) bool {
+	if r.Header.Get("X-Requested-With") == "XMLHttpRequest" {
+		return true
+	}
+	switch r.Header.Get("Sec-Fetch-Mode") {
+	case "cors", "same-origin":
+		return true
+	}
+	return false
+}
+
+func acceptsJSON(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "application/json") || r.Header.Get("X-Requested-With") == "XMLHttpRequest"
+}
+
+// writeUnauthenticatedJSON writes an error body in the format used by the graph API.
+// The status code has already been written.
+func writeUnauthenticatedJSON(w http.ResponseWriter, r *http.Request) {
+	b, err := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    "unauthenticated",
+			"message": "Authentication error",
+			"innererror": map[string]interface{}{
+				"date":       time.Now().UTC().Format(time.RFC3339),
+				"request-id": chimiddleware.GetReqID(r.Context()),
+			},
+		},
+	})
+	if err != nil {
+		return
+	}
+	_, _ = w.Write(b)
+}
+
 // The token auth endpoint uses basic auth for clients, see https://openid.net/specs/openid-connect-basic-1_0.html#TokenRequest
 // > The Client MUST authenticate to the Token Endpoint using the HTTP Basic method, as described in 2.3.1 of OAuth 2.0.
 func isOIDCTokenAuth(req *http.Request) bool {
@@ -115,29 +467,60 @@ func isOIDCTokenAuth(req *http.Request) bool {
 }
 
 func isPublicPath(p string) bool {
-	for _, pp := range _publicPaths {
-		if strings.HasPrefix(p, pp) {
+	_, ok := publicPathPrefix(p)
+	return ok
+}
+
+// PublicMethods restricts the methods of the requests to a public path prefix that are handled without
+// authentication. Public paths without an entry are public for all methods.
+type PublicMethods map[string][]string
+
+// isPublic returns true if the request is made to a public path with one of its public methods.
+func (m PublicMethods) isPublic(r *http.Request) bool {
+	prefix, ok := publicPathPrefix(r.URL.Path)
+	if !ok {
+		return false
+	}
+	methods, ok := m[prefix]
+	if !ok {
+		return true
+	}
+	for _, method := range methods {
+		if strings.EqualFold(method, r.Method) {
 			return true
 		}
 	}
 	return false
 }
 
-// configureSupportedChallenges adds known authentication challenges to the current session.
-func configureSupportedChallenges(options Options) {
-	if options.OIDCIss != "" {
-		SupportedAuthStrategies = append(SupportedAuthStrategies, "bearer")
+// ValidatePublicPathMethods checks that the methods are configured for known public paths only.
+func ValidatePublicPathMethods(m map[string][]string) error {
+	for prefix, methods := range m {
+		if p, ok := publicPathPrefix(prefix); !ok || p != prefix {
+			return fmt.Errorf("'%s' is not a public path, use one of %s", prefix, strings.Join(_publicPaths[:], ", "))
+		}
+		for _, method := range methods {
+			if method == "" || strings.ContainsAny(method, " ,") {
+				return fmt.Errorf("invalid method '%s' for the public path %s", method, prefix)
+			}
+		}
 	}
+	return nil
+}
 
-	if options.EnableBasicAuth {
-		SupportedAuthStrategies = append(SupportedAuthStrategies, "basic")
+// publicPathPrefix returns the public path prefix the path starts with.
+func publicPathPrefix(p string) (string, bool) {
+	for _, pp := range _publicPaths {
+		if strings.HasPrefix(p, pp) {
+			return pp, true
+		}
 	}
+	return "", false
 }
 
-func writeSupportedAuthenticateHeader(w http.ResponseWriter, r *http.Request) {
-	caser := cases.Title(language.Und)
-	for _, s := range SupportedAuthStrategies {
-		w.Header().Add(WwwAuthenticate, fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(s), r.Host))
+func writeSupportedAuthenticateHeader(w http.ResponseWriter, strategies []string, realm string) {
+	for _, s := range strategies {
+		w.Header().Add(WwwAuthenticate, fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", challengeScheme(s), realm))
 	}
 }
 
@@ -145,6 +528,17 @@ func removeSuperfluousAuthenticate(w http.ResponseWriter) {
 	w.Header().Del(WwwAuthenticate)
 }
 
+// removeBasicChallenge removes the Basic challenges from the Www-Authenticate header and keeps all others.
+func removeBasicChallenge(w http.ResponseWriter) {
+	challenges := w.Header().Values(WwwAuthenticate)
+	w.Header().Del(WwwAuthenticate)
+	for _, c := range challenges {
+		if !strings.HasPrefix(strings.ToLower(c), "basic ") {
+			w.Header().Add(WwwAuthenticate, c)
+		}
+	}
+}
+
 // userAgentLocker aids in dependency injection for helper methods. The set of fields is arbitrary and the only relation
 // they share is to fulfill their duty and lock a User-Agent to its correct challenge if configured.
 type userAgentLocker struct {
@@ -152,17 +546,19 @@ type userAgentLocker struct {
 	r        *http.Request
 	locks    map[string]string // locks represents a reva user-agent:challenge mapping.
 	fallback string
+	realm    string
 }
 
 // userAgentAuthenticateLockIn sets Www-Authenticate according to configured user agents. This is useful for the case of
 // legacy clients that do not support protocols like OIDC or OAuth and want to lock a given user agent to a challenge
 // such as basic. For more context check https://github.com/cs3org/reva/pull/1350
-func userAgentAuthenticateLockIn(w http.ResponseWriter, r *http.Request, locks map[string]string, fallback string) {
+func userAgentAuthenticateLockIn(w http.ResponseWriter, r *http.Request, locks map[string]string, fallback, realm string) {
 	u := userAgentLocker{
 		w:        w,
 		r:        r,
 		locks:    locks,
 		fallback: fallback,
+		realm:    realm,
 	}
 
 	for _, r := range ProxyWwwAuthenticate {
@@ -178,9 +574,9 @@ func evalRequestURI(l userAgentLocker, r regexp.Regexp) {
 	for k, v := range l.locks {
 		if strings.Contains(k, l.r.UserAgent()) {
 			removeSuperfluousAuthenticate(l.w)
-			l.w.Header().Add(WwwAuthenticate, fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(v), l.r.Host))
+			l.w.Header().Add(WwwAuthenticate, fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(v), l.realm))
 			return
 		}
 	}
-	l.w.Header().Add(WwwAuthenticate, fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(l.fallback), l.r.Host))
+	l.w.Header().Add(WwwAuthenticate, fmt.Sprintf("%v realm=\"%s\", charset=\"UTF-8\"", caser.String(l.fallback), l.realm))
 }
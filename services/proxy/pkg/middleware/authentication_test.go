@@ -1,8 +1,26 @@
 package middleware
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/rs/zerolog"
 )
 
 var _ = Describe("authentication helpers", func() {
@@ -18,3 +36,876 @@ var _ = Describe("authentication helpers", func() {
 		Entry("capabilities", "/ocs/v1.php/cloud/capabilities", true),
 	)
 })
+
+type failingAuthenticator struct{}
+
+func (failingAuthenticator) Authenticate(*http.Request) (*http.Request, bool) {
+	return nil, false
+}
+
+func (failingAuthenticator) Name() string {
+	return "failing"
+}
+
+var _ = Describe("unauthenticated requests", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		handler = Authentication(
+			[]Authenticator{failingAuthenticator{}},
+			LoginRedirectURL("/login"),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Fail("the request must not be forwarded")
+		}))
+	})
+
+	serve := func(method, accept string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("redirects browser navigations to the login page", func() {
+		rec := serve(http.MethodGet, "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", map[string]string{
+			"Sec-Fetch-Mode": "navigate",
+		})
+		Expect(rec.Code).To(Equal(http.StatusFound))
+		Expect(rec.Header().Get("Location")).To(Equal("/login"))
+	})
+
+	It("returns a JSON error to API clients", func() {
+		rec := serve(http.MethodGet, "application/json", nil)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		body := map[string]map[string]interface{}{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &body)).To(Succeed())
+		Expect(body["error"]["code"]).To(Equal("unauthenticated"))
+	})
+
+	It("doesn't redirect XHR and fetch requests accepting HTML", func() {
+		rec := serve(http.MethodGet, "text/html", map[string]string{"X-Requested-With": "XMLHttpRequest"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+
+		rec = serve(http.MethodGet, "text/html", map[string]string{"Sec-Fetch-Mode": "cors"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+
+		rec = serve(http.MethodPost, "text/html", nil)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("doesn't redirect when no login URL is configured", func() {
+		handler = Authentication([]Authenticator{failingAuthenticator{}})(http.NotFoundHandler())
+		rec := serve(http.MethodGet, "text/html", nil)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Body.Len()).To(BeZero())
+	})
+})
+
+type funcAuthenticator func(*http.Request) (*http.Request, bool)
+
+func (f funcAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
+	return f(r)
+}
+
+func (f funcAuthenticator) Name() string {
+	return "func"
+}
+
+// blockingAuthenticator waits until the request is cancelled, like an authenticator waiting for a slow IDP
+var blockingAuthenticator = funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+	<-r.Context().Done()
+	return nil, false
+})
+
+type testContextKey struct{}
+
+var _ = Describe("authentication with slow authenticators", func() {
+	newRequest := func(ctx context.Context) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		return req.WithContext(router.SetRoutingInfo(ctx, router.RoutingInfo{}))
+	}
+
+	It("skips the remaining authenticators when the request is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		handler := Authentication(
+			[]Authenticator{
+				funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+					cancel()
+					return blockingAuthenticator.Authenticate(r)
+				}),
+				funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+					Fail("the authenticator must not be called after the request was cancelled")
+					return nil, false
+				}),
+			},
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Fail("the request must not be forwarded")
+		}))
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(ctx))
+		Expect(rec.Body.Len()).To(BeZero())
+		Expect(rec.Header()).ToNot(HaveKey(WwwAuthenticate))
+	})
+
+	It("gives up on authenticators exceeding the timeout", func() {
+		var forwarded *http.Request
+		handler := Authentication(
+			[]Authenticator{
+				blockingAuthenticator,
+				funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+					return r.WithContext(context.WithValue(r.Context(), testContextKey{}, "einstein")), true
+				}),
+			},
+			AuthenticatorTimeout(10*time.Millisecond),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			forwarded = r
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest(context.Background()))
+		Expect(forwarded).ToNot(BeNil())
+		Expect(forwarded.Context().Value(testContextKey{})).To(Equal("einstein"))
+
+		// the timeout of the authenticator doesn't cancel the authenticated request
+		time.Sleep(20 * time.Millisecond)
+		Expect(forwarded.Context().Err()).ToNot(HaveOccurred())
+	})
+
+	It("applies the timeout configured for the authenticator", func() {
+		var forwarded bool
+		handler := Authentication(
+			[]Authenticator{
+				namedAuthenticator{name: "slow", funcAuthenticator: func(r *http.Request) (*http.Request, bool) {
+					time.Sleep(20 * time.Millisecond)
+					return r, true
+				}},
+			},
+			AuthenticatorTimeout(10*time.Millisecond),
+			AuthenticatorTimeouts(map[string]time.Duration{"slow": time.Second}),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			forwarded = true
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest(context.Background()))
+		Expect(forwarded).To(BeTrue())
+	})
+
+	It("doesn't let authenticators exceeding the timeout change the request", func() {
+		release := make(chan struct{})
+		changed := make(chan struct{})
+		var header http.Header
+		handler := Authentication(
+			[]Authenticator{
+				funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+					<-release
+					r.Header.Set("Authorization", "Bearer injected")
+					close(changed)
+					return nil, false
+				}),
+				funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+					return r, true
+				}),
+			},
+			AuthenticatorTimeout(10*time.Millisecond),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest(context.Background()))
+		close(release)
+		<-changed
+		Expect(header).ToNot(BeNil())
+		Expect(header.Get("Authorization")).To(BeEmpty())
+	})
+})
+
+// tokenAuthenticator authenticates requests with the bearer token as the user
+func tokenAuthenticator(token string) Authenticator {
+	return funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			return nil, false
+		}
+		return r.WithContext(context.WithValue(r.Context(), testContextKey{}, token)), true
+	})
+}
+
+var _ = Describe("reloading the authentication", func() {
+	serve := func(handler http.Handler, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/remote.php/dav/files/einstein", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	user := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Context().Value(testContextKey{}).(string))
+	})
+
+	It("replaces the authenticators and options", func() {
+		auth := NewReloadableAuthentication([]Authenticator{tokenAuthenticator("old")}, OIDCIss("https://idp.example.com"))
+		handler := auth.Handler(user)
+		Expect(serve(handler, "old").Code).To(Equal(http.StatusOK))
+
+		auth.Reload([]Authenticator{tokenAuthenticator("new")}, EnableBasicAuth(true))
+		rec := serve(handler, "old")
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Values(WwwAuthenticate)).To(ConsistOf(`Basic realm="cloud.example.com", charset="UTF-8"`))
+		rec = serve(handler, "new")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(Equal("new"))
+	})
+
+	It("finishes in-flight requests with the previous authenticators", func() {
+		entered, release := make(chan struct{}), make(chan struct{})
+		auth := NewReloadableAuthentication([]Authenticator{
+			funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+				close(entered)
+				<-release
+				return tokenAuthenticator("old").Authenticate(r)
+			}),
+		})
+		handler := auth.Handler(user)
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() {
+			done <- serve(handler, "old")
+		}()
+		<-entered
+		auth.Reload([]Authenticator{tokenAuthenticator("new")})
+		close(release)
+
+		rec := <-done
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(Equal("old"))
+		Expect(serve(handler, "old").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("handles every request with one consistent set of authenticators while reloading", func() {
+		auth := NewReloadableAuthentication([]Authenticator{tokenAuthenticator("a")})
+		handler := auth.Handler(user)
+
+		type result struct {
+			token string
+			rec   *httptest.ResponseRecorder
+		}
+		results := make(chan result, 8*100)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					token := []string{"a", "b"}[(i+j)%2]
+					results <- result{token: token, rec: serve(handler, token)}
+				}
+			}(i)
+		}
+		for i := 0; i < 50; i++ {
+			auth.Reload([]Authenticator{tokenAuthenticator([]string{"b", "a"}[i%2])})
+		}
+		wg.Wait()
+		close(results)
+
+		for res := range results {
+			Expect(res.rec.Code).To(BeElementOf(http.StatusOK, http.StatusUnauthorized))
+			if res.rec.Code == http.StatusOK {
+				Expect(res.rec.Body.String()).To(Equal(res.token))
+			}
+		}
+	})
+})
+
+type namedAuthenticator struct {
+	funcAuthenticator
+	name string
+}
+
+func (a namedAuthenticator) Name() string {
+	return a.name
+}
+
+var _ = Describe("authentication metrics", func() {
+	var m *metrics.Metrics
+
+	BeforeEach(func() {
+		m = metrics.New()
+	})
+
+	sampleCount := func(h *prometheus.HistogramVec, labels ...string) uint64 {
+		out := &dto.Metric{}
+		Expect(h.WithLabelValues(labels...).(prometheus.Metric).Write(out)).To(Succeed())
+		return out.GetHistogram().GetSampleCount()
+	}
+	serve := func(handler http.Handler) {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	It("observes each authenticator and the authentication", func() {
+		handler := Authentication(
+			[]Authenticator{
+				namedAuthenticator{name: "slow", funcAuthenticator: blockingAuthenticator},
+				namedAuthenticator{name: "rejecting", funcAuthenticator: failingAuthenticator{}.Authenticate},
+				namedAuthenticator{name: "accepting", funcAuthenticator: func(r *http.Request) (*http.Request, bool) {
+					return r, true
+				}},
+			},
+			AuthenticatorTimeout(10*time.Millisecond),
+			Metrics(m),
+		)(http.NotFoundHandler())
+		serve(handler)
+
+		Expect(sampleCount(m.AuthenticatorDuration, "slow", "timeout")).To(Equal(uint64(1)))
+		Expect(sampleCount(m.AuthenticatorDuration, "rejecting", "failure")).To(Equal(uint64(1)))
+		Expect(sampleCount(m.AuthenticatorDuration, "accepting", "success")).To(Equal(uint64(1)))
+		Expect(sampleCount(m.AuthenticationDuration, "accepting", "success")).To(Equal(uint64(1)))
+	})
+
+	It("observes failed authentications", func() {
+		handler := Authentication([]Authenticator{failingAuthenticator{}}, Metrics(m))(http.NotFoundHandler())
+		serve(handler)
+		serve(handler)
+
+		Expect(sampleCount(m.AuthenticatorDuration, "failing", "failure")).To(Equal(uint64(2)))
+		Expect(sampleCount(m.AuthenticationDuration, "", "failure")).To(Equal(uint64(2)))
+	})
+
+	It("counts the requests to public paths by prefix", func() {
+		count := func(prefix, outcome string) float64 {
+			out := &dto.Metric{}
+			Expect(m.PublicPathRequests.WithLabelValues(prefix, outcome).Write(out)).To(Succeed())
+			return out.GetCounter().GetValue()
+		}
+		logs := &bytes.Buffer{}
+		handler := Authentication(
+			[]Authenticator{funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+				return r, r.Header.Get("public-token") == "secret"
+			})},
+			Metrics(m),
+			PublicPathAccessLog(true),
+			Logger(log.Logger{Logger: zerolog.New(logs)}),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusMultiStatus)
+		}))
+		serve := func(path, token string) {
+			req := httptest.NewRequest("PROPFIND", "https://cloud.example.com"+path, nil)
+			req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+			if token != "" {
+				req.Header.Set("public-token", token)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		serve("/remote.php/dav/public-files/abc/file.txt", "secret")
+		serve("/remote.php/dav/public-files/abc/file.txt", "wrong")
+		serve("/dav/public-files/abc", "")
+		// authenticated requests to other paths are not counted
+		serve("/remote.php/dav/files/einstein", "secret")
+
+		Expect(count("/remote.php/dav/public-files/", "success")).To(Equal(float64(1)))
+		Expect(count("/remote.php/dav/public-files/", "failure")).To(Equal(float64(1)))
+		Expect(count("/dav/public-files/", "failure")).To(Equal(float64(1)))
+		Expect(count("/dav/public-files/", "success")).To(BeZero())
+
+		lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+		Expect(lines).To(HaveLen(3))
+		entry := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(lines[0]), &entry)).To(Succeed())
+		Expect(entry).To(HaveKeyWithValue("message", "public-path-access"))
+		Expect(entry).To(HaveKeyWithValue("prefix", "/remote.php/dav/public-files/"))
+		Expect(entry).To(HaveKeyWithValue("outcome", "success"))
+		Expect(entry).To(HaveKeyWithValue("status", float64(http.StatusMultiStatus)))
+	})
+})
+
+var _ = Describe("maintenance mode", func() {
+	var forwarded *http.Request
+
+	newHandler := func(m config.Maintenance) http.Handler {
+		forwarded = nil
+		return Authentication(
+			[]Authenticator{funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+				return r, true
+			})},
+			Maintenance(m),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			forwarded = r
+		}))
+	}
+
+	serve := func(handler http.Handler, token string, unprotected bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		if unprotected {
+			req = httptest.NewRequest(http.MethodGet, "https://cloud.example.com/konnect/v1/token", nil)
+		}
+		if token != "" {
+			req.Header.Set(MaintenanceTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("rejects requests with a 503 when enabled", func() {
+		handler := newHandler(config.Maintenance{Enabled: true, BypassToken: "secret", RetryAfter: 120})
+
+		for _, rec := range []*httptest.ResponseRecorder{
+			serve(handler, "", false),
+			serve(handler, "wrong", false),
+			serve(handler, "", true),
+		} {
+			Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(rec.Header().Get("Retry-After")).To(Equal("120"))
+		}
+		Expect(forwarded).To(BeNil())
+	})
+
+	It("lets requests with the bypass token pass without forwarding the token", func() {
+		handler := newHandler(config.Maintenance{Enabled: true, BypassToken: "secret", RetryAfter: 120})
+
+		rec := serve(handler, "secret", false)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(forwarded).ToNot(BeNil())
+		Expect(forwarded.Header.Get(MaintenanceTokenHeader)).To(BeEmpty())
+	})
+
+	It("doesn't accept an empty bypass token", func() {
+		handler := newHandler(config.Maintenance{Enabled: true})
+
+		rec := serve(handler, "", false)
+		Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(forwarded).To(BeNil())
+	})
+
+	It("lets all requests pass when disabled", func() {
+		handler := newHandler(config.Maintenance{BypassToken: "secret"})
+
+		rec := serve(handler, "", false)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(forwarded).ToNot(BeNil())
+
+		rec = serve(handler, "secret", false)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(forwarded.Header.Get(MaintenanceTokenHeader)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("challenges of XHR requests", func() {
+	newHandler := func(suppress bool) http.Handler {
+		return Authentication(
+			[]Authenticator{failingAuthenticator{}},
+			SuppressXHRBasicChallenge(suppress),
+			OIDCIss("https://idp.example.com"),
+			EnableBasicAuth(true),
+		)(http.NotFoundHandler())
+	}
+
+	serve := func(handler http.Handler, method string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "https://cloud.example.com/remote.php/dav/files/einstein", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	DescribeTable("removes only the Basic challenge of XHR and fetch requests",
+		func(method string, headers map[string]string) {
+			rec := serve(newHandler(true), method, headers)
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+			Expect(rec.Header().Values(WwwAuthenticate)).To(ConsistOf(`Bearer realm="cloud.example.com", charset="UTF-8"`))
+		},
+		Entry("XMLHttpRequest", http.MethodGet, map[string]string{"X-Requested-With": "XMLHttpRequest"}),
+		Entry("fetch", http.MethodGet, map[string]string{"Sec-Fetch-Mode": "cors"}),
+		Entry("same origin fetch", http.MethodGet, map[string]string{"Sec-Fetch-Mode": "same-origin"}),
+		Entry("WebDAV request from a script", "PROPFIND", map[string]string{"X-Requested-With": "XMLHttpRequest"}),
+	)
+
+	DescribeTable("keeps the Basic challenge",
+		func(suppress bool, method string, headers map[string]string) {
+			rec := serve(newHandler(suppress), method, headers)
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+			Expect(rec.Header().Values(WwwAuthenticate)).To(ContainElement(`Basic realm="cloud.example.com", charset="UTF-8"`))
+		},
+		Entry("for browser navigations", true, http.MethodGet, map[string]string{"Sec-Fetch-Mode": "navigate", "Accept": "text/html"}),
+		Entry("for WebDAV clients", true, "PROPFIND", map[string]string{"Accept": "application/json"}),
+		Entry("for clients without hints", true, http.MethodGet, nil),
+		Entry("for JSON API clients", true, http.MethodGet, map[string]string{"Accept": "application/json"}),
+		Entry("for no-cors requests", true, http.MethodGet, map[string]string{"Sec-Fetch-Mode": "no-cors"}),
+		Entry("when disabled", false, http.MethodGet, map[string]string{"X-Requested-With": "XMLHttpRequest"}),
+	)
+})
+
+var _ = Describe("order of the challenges", func() {
+	challenge := func(scheme string) string {
+		return scheme + ` realm="cloud.example.com", charset="UTF-8"`
+	}
+
+	DescribeTable("lists the challenges of the enabled strategies in the configured order",
+		func(opts []Option, expected []string) {
+			opts = append(opts, OIDCIss("https://idp.example.com"), EnableBasicAuth(true))
+			handler := Authentication([]Authenticator{failingAuthenticator{}}, opts...)(http.NotFoundHandler())
+			req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/remote.php/dav/files/einstein", nil)
+			req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+			Expect(rec.Header().Values(WwwAuthenticate)).To(Equal(expected))
+		},
+		Entry("without configuration", nil, []string{challenge("Bearer"), challenge("Basic")}),
+		Entry("basic first", []Option{Challenges(config.Challenges{
+			Order: []string{StrategyBasic, StrategyBearer}, Bearer: true, Basic: true,
+		})}, []string{challenge("Basic"), challenge("Bearer")}),
+		Entry("all strategies", []Option{
+			Challenges(config.Challenges{
+				Order:  []string{StrategyMTLS, StrategySignedURL, StrategyBasic, StrategyBearer},
+				Bearer: true, Basic: true, SignedURL: true, MTLS: true,
+			}),
+			PreSignedURLConfig(config.PreSignedURL{Enabled: true}),
+		}, []string{challenge("mTLS"), challenge("Signed-URL"), challenge("Basic"), challenge("Bearer")}),
+		Entry("disabled strategies", []Option{Challenges(config.Challenges{
+			Order: []string{StrategyBearer, StrategyBasic, StrategyMTLS}, Bearer: false, Basic: true, MTLS: true,
+		})}, []string{challenge("Basic"), challenge("mTLS")}),
+		Entry("signed URLs not enabled", []Option{Challenges(config.Challenges{
+			Order: []string{StrategySignedURL, StrategyBearer}, Bearer: true, SignedURL: true,
+		})}, []string{challenge("Bearer")}),
+		Entry("strategies not listed", []Option{Challenges(config.Challenges{
+			Order: []string{StrategyBasic}, Bearer: true, Basic: true,
+		})}, []string{challenge("Basic")}),
+	)
+
+	It("validates the order", func() {
+		Expect(ValidateChallenges(config.Challenges{Order: []string{StrategyBearer, StrategyMTLS}})).To(Succeed())
+		Expect(ValidateChallenges(config.Challenges{Order: []string{"negotiate"}})).ToNot(Succeed())
+		Expect(ValidateChallenges(config.Challenges{Order: []string{StrategyBasic, StrategyBasic}})).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("authorization hook", func() {
+	var (
+		authorized *http.Request
+		forwarded  bool
+	)
+
+	BeforeEach(func() {
+		authorized, forwarded = nil, false
+	})
+
+	serve := func(allow bool, opts ...Option) *httptest.ResponseRecorder {
+		opts = append(opts, AuthorizationHook(AuthorizerFunc(func(r *http.Request) bool {
+			authorized = r
+			return allow
+		})))
+		handler := Authentication([]Authenticator{funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+			return r.WithContext(context.WithValue(r.Context(), testContextKey{}, "einstein")), true
+		})}, opts...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			forwarded = true
+		}))
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("passes allowed requests on", func() {
+		rec := serve(true)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(forwarded).To(BeTrue())
+		// the hook gets the request augmented by the authenticator
+		Expect(authorized.Context().Value(testContextKey{})).To(Equal("einstein"))
+	})
+
+	It("rejects denied requests", func() {
+		rec := serve(false)
+		Expect(rec.Code).To(Equal(http.StatusForbidden))
+		Expect(rec.Body.String()).To(Equal("Forbidden"))
+		Expect(forwarded).To(BeFalse())
+	})
+
+	It("sends the configured body", func() {
+		rec := serve(false, ForbiddenBody("Only members of the staff can use this instance."))
+		Expect(rec.Code).To(Equal(http.StatusForbidden))
+		Expect(rec.Body.String()).To(Equal("Only members of the staff can use this instance."))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+	})
+
+	It("isn't called for unauthenticated requests", func() {
+		called := false
+		handler := Authentication([]Authenticator{failingAuthenticator{}}, AuthorizationHook(AuthorizerFunc(func(r *http.Request) bool {
+			called = true
+			return true
+		})))(http.NotFoundHandler())
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(called).To(BeFalse())
+	})
+})
+
+// countingReader counts the bytes read from the body of a request
+type countingReader struct {
+	r    io.Reader
+	read int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.read += n
+	return n, err
+}
+
+var _ = Describe("bodies of unauthenticated WebDAV requests", func() {
+	handler := Authentication([]Authenticator{failingAuthenticator{}}, WebDAVBodyDrainLimit(1024))(http.NotFoundHandler())
+
+	serve := func(size int, length int64) (*httptest.ResponseRecorder, *countingReader) {
+		body := &countingReader{r: strings.NewReader(strings.Repeat("a", size))}
+		req := httptest.NewRequest("PROPFIND", "https://cloud.example.com/remote.php/dav/files/einstein", body)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		req.ContentLength = length
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec, body
+	}
+
+	It("drains small bodies and keeps the connection", func() {
+		rec, body := serve(512, 512)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(body.read).To(Equal(512))
+		Expect(rec.Header().Get("Connection")).To(BeEmpty())
+	})
+
+	It("closes the connection instead of reading oversized bodies", func() {
+		rec, body := serve(1024*1024, 1024*1024)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Body.String()).To(ContainSubstring("Authentication error"))
+		Expect(body.read).To(BeZero())
+		Expect(rec.Header().Get("Connection")).To(Equal("close"))
+	})
+
+	It("stops reading chunked bodies at the limit", func() {
+		rec, body := serve(1024*1024, -1)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(body.read).To(Equal(1025))
+		Expect(rec.Header().Get("Connection")).To(Equal("close"))
+	})
+})
+
+var _ = Describe("security headers", func() {
+	headers := config.SecurityHeaders{
+		StrictTransportSecurity: "max-age=31536000",
+		ContentTypeOptions:      "nosniff",
+		ReferrerPolicy:          "strict-origin-when-cross-origin",
+	}
+
+	newHandler := func(h config.SecurityHeaders, next http.HandlerFunc) http.Handler {
+		return Authentication(
+			[]Authenticator{funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+				return r, r.Header.Get("Authorization") != ""
+			})},
+			SecurityHeaders(h),
+		)(next)
+	}
+
+	serve := func(handler http.Handler, authenticated bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		if authenticated {
+			req.Header.Set("Authorization", "Bearer token")
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("adds the headers to authenticated and rejected responses", func() {
+		handler := newHandler(headers, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, "drives")
+		})
+
+		for _, rec := range []*httptest.ResponseRecorder{serve(handler, true), serve(handler, false)} {
+			Expect(rec.Header().Values("Strict-Transport-Security")).To(Equal([]string{"max-age=31536000"}))
+			Expect(rec.Header().Values("X-Content-Type-Options")).To(Equal([]string{"nosniff"}))
+			Expect(rec.Header().Values("Referrer-Policy")).To(Equal([]string{"strict-origin-when-cross-origin"}))
+		}
+	})
+
+	It("doesn't duplicate the headers set by the services", func() {
+		handler := newHandler(headers, func(w http.ResponseWriter, r *http.Request) {
+			// the reverse proxy adds the headers of the upstream response
+			w.Header().Add("Strict-Transport-Security", "max-age=60")
+			w.Header().Add("Referrer-Policy", "no-referrer")
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		rec := serve(handler, true)
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+		Expect(rec.Header().Values("Strict-Transport-Security")).To(Equal([]string{"max-age=60"}))
+		Expect(rec.Header().Values("Referrer-Policy")).To(Equal([]string{"no-referrer"}))
+		Expect(rec.Header().Values("X-Content-Type-Options")).To(Equal([]string{"nosniff"}))
+	})
+
+	It("leaves out disabled headers", func() {
+		handler := newHandler(config.SecurityHeaders{ContentTypeOptions: "nosniff"}, func(w http.ResponseWriter, r *http.Request) {
+			w.(http.Flusher).Flush()
+		})
+
+		rec := serve(handler, true)
+		Expect(rec.Flushed).To(BeTrue())
+		Expect(rec.Header().Values("X-Content-Type-Options")).To(Equal([]string{"nosniff"}))
+		Expect(rec.Header()).ToNot(HaveKey("Strict-Transport-Security"))
+		Expect(rec.Header()).ToNot(HaveKey("Referrer-Policy"))
+	})
+})
+
+var _ = Describe("websocket upgrade requests", func() {
+	var forwarded *http.Request
+	handler := Authentication(
+		[]Authenticator{funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+			return r, r.Header.Get("Authorization") == "Bearer token"
+		})},
+		WebSocketAuth(config.WebSocketAuth{TokenQueryParameter: "access_token", TokenSubprotocolPrefix: "access_token."}),
+		LoginRedirectURL("/login"),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r
+		w.WriteHeader(http.StatusSwitchingProtocols)
+	}))
+
+	BeforeEach(func() {
+		forwarded = nil
+	})
+
+	serve := func(target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		req.Header.Set("Connection", "keep-alive, Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		req.Header.Set("Accept", "text/html")
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("authenticates the token in the query", func() {
+		rec := serve("https://cloud.example.com/notifications?access_token=token&channel=1", nil)
+		Expect(rec.Code).To(Equal(http.StatusSwitchingProtocols))
+		Expect(forwarded.URL.Query()).To(Equal(url.Values{"channel": []string{"1"}}))
+		Expect(forwarded.RequestURI).ToNot(ContainSubstring("token"))
+	})
+
+	It("authenticates the token in the subprotocols", func() {
+		rec := serve("https://cloud.example.com/notifications", http.Header{"Sec-Websocket-Protocol": []string{"access_token.token, chat"}})
+		Expect(rec.Code).To(Equal(http.StatusSwitchingProtocols))
+		Expect(forwarded.Header.Get("Sec-WebSocket-Protocol")).To(Equal("chat"))
+	})
+
+	It("keeps the Authorization header of the request", func() {
+		rec := serve("https://cloud.example.com/notifications?access_token=other", http.Header{"Authorization": []string{"Bearer token"}})
+		Expect(rec.Code).To(Equal(http.StatusSwitchingProtocols))
+		Expect(forwarded.URL.Query().Get("access_token")).To(Equal("other"))
+	})
+
+	It("rejects unauthenticated requests without upgrading", func() {
+		for _, target := range []string{
+			"https://cloud.example.com/notifications",
+			"https://cloud.example.com/notifications?access_token=invalid",
+		} {
+			rec := serve(target, nil)
+			Expect(forwarded).To(BeNil())
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+			// unlike browser navigations, upgrade requests are not redirected to the login page
+			Expect(rec.Header().Get("Location")).To(BeEmpty())
+			Expect(rec.Header().Get("Connection")).To(Equal("close"))
+			Expect(rec.Header().Get("Upgrade")).To(BeEmpty())
+		}
+	})
+
+	It("doesn't read tokens from the query of other requests", func() {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me?access_token=token", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(forwarded).To(BeNil())
+	})
+})
+
+var _ = Describe("methods of public paths", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		methods := PublicMethods{"/remote.php/dav/public-files/": {"GET", "PROPFIND"}}
+		handler = Authentication(
+			[]Authenticator{
+				BasicAuthenticator{Logger: log.NopLogger(), PublicMethods: methods},
+				PublicShareAuthenticator{Logger: log.NopLogger(), PublicMethods: methods},
+			},
+			EnableBasicAuth(true),
+			PublicPathMethods(methods),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "https://cloud.example.com"+path, nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("passes on anonymous requests with a public method", func() {
+		Expect(serve(http.MethodGet, "/remote.php/dav/public-files/abc/file.txt").Code).To(Equal(http.StatusOK))
+		Expect(serve("PROPFIND", "/remote.php/dav/public-files/abc").Code).To(Equal(http.StatusOK))
+	})
+
+	It("challenges anonymous requests with other methods", func() {
+		for _, method := range []string{http.MethodPut, http.MethodDelete, "MOVE"} {
+			rec := serve(method, "/remote.php/dav/public-files/abc/file.txt")
+			Expect(rec.Code).To(Equal(http.StatusUnauthorized), method)
+			Expect(rec.Header().Values(WwwAuthenticate)).To(ConsistOf(`Basic realm="cloud.example.com", charset="UTF-8"`), method)
+		}
+	})
+
+	It("keeps public paths without configured methods public for all methods", func() {
+		Expect(serve(http.MethodPut, "/dav/public-files/abc/file.txt").Code).To(Equal(http.StatusOK))
+	})
+
+	DescribeTable("validates the configured public paths",
+		func(m map[string][]string, valid bool) {
+			err := ValidatePublicPathMethods(m)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("public path", map[string][]string{"/dav/public-files/": {"GET"}}, true),
+		Entry("no methods", map[string][]string{"/dav/public-files/": {}}, true),
+		Entry("unknown path", map[string][]string{"/graph/": {"GET"}}, false),
+		Entry("path below a public path", map[string][]string{"/dav/public-files/abc": {"GET"}}, false),
+		Entry("empty method", map[string][]string{"/dav/public-files/": {""}}, false),
+	)
+})
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+var (
+	// ErrMultipleAuthorization is returned for requests with multiple Authorization headers if these are rejected.
+	ErrMultipleAuthorization = errors.New("multiple Authorization headers are not allowed")
+	// ErrAmbiguousAuthorization is returned for requests with multiple Authorization headers if it is unclear which to use.
+	ErrAmbiguousAuthorization = errors.New("the Authorization headers contain different credentials and not exactly one bearer token")
+)
+
+// Authorizer decides if an authenticated request may access the instance at all, e.g. to only let the members of
+// some groups in. It is called with the request returned by the authenticator. The authorization of single resources
+// is left to the services.
+type Authorizer interface {
+	Authorize(*http.Request) bool
+}
+
+// AuthorizerFunc adapts a function to the Authorizer interface.
+type AuthorizerFunc func(*http.Request) bool
+
+// Authorize implements the Authorizer interface.
+func (f AuthorizerFunc) Authorize(r *http.Request) bool {
+	return f(r)
+}
+
+// writeForbidden rejects a request denied by the authorizer with the configured body.
+func writeForbidden(w http.ResponseWriter, body string) {
+	if body == "" {
+		body = http.StatusText(http.StatusForbidden)
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusForbidden)
+	_, _ = w.Write([]byte(body))
+}
+
+// bearerToken returns the token of an Authorization header value using the bearer scheme.
+// Like all authentication schemes, the scheme is case-insensitive.
+func bearerToken(value string) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(value), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// normalizeAuthorization reduces the Authorization headers of a request to one header using the canonical
+// "Bearer" scheme for bearer tokens. With the 'pick_bearer' policy the bearer token is used if the headers contain
+// exactly one, repeated headers with the same value are sent by some clients and are merged.
+func normalizeAuthorization(h http.Header, policy string) error {
+	values := h.Values(_headerAuthorization)
+	if len(values) == 0 {
+		return nil
+	}
+	if len(values) > 1 && policy == config.MultipleAuthorizationReject {
+		return ErrMultipleAuthorization
+	}
+
+	var tokens, others []string
+	for _, v := range values {
+		if token, ok := bearerToken(v); ok {
+			tokens = appendUnique(tokens, token)
+		} else {
+			others = appendUnique(others, strings.TrimSpace(v))
+		}
+	}
+	switch {
+	case len(tokens) == 1:
+		h.Set(_headerAuthorization, _bearerPrefix+tokens[0])
+	case len(tokens) == 0 && len(others) == 1:
+		h.Set(_headerAuthorization, others[0])
+	default:
+		return ErrAmbiguousAuthorization
+	}
+	return nil
+}
+
+func appendUnique(values []string, v string) []string {
+	for _, existing := range values {
+		if existing == v {
+			return values
+		}
+	}
+	return append(values, v)
+}
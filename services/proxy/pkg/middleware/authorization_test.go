@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	gOidc "github.com/coreos/go-oidc/v3/oidc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("Authorization headers", func() {
+	var (
+		idp    *httptest.Server
+		claims map[string]interface{}
+	)
+
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("einstein:relativity"))
+
+	BeforeEach(func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":            idp.URL,
+				"userinfo_endpoint": idp.URL + "/userinfo",
+			})
+		})
+		mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+			// the proxy always sends the canonical scheme
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"sub": token})
+		})
+		idp = httptest.NewServer(mux)
+		claims = nil
+
+		DeferCleanup(idp.Close)
+	})
+
+	newHandler := func(policy string) http.Handler {
+		authenticator := NewOIDCAuthenticator(log.NewLogger(), 0, idp.Client(), idp.URL, func() (OIDCProvider, error) {
+			return gOidc.NewProvider(context.WithValue(context.Background(), oauth2.HTTPClient, idp.Client()), idp.URL)
+		}, config.JWKS{}, config.AccessTokenVerificationNone, "", config.TokenExchange{})
+		return Authentication([]Authenticator{authenticator}, MultipleAuthorization(policy))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims = oidc.FromContext(r.Context())
+		}))
+	}
+
+	serve := func(handler http.Handler, values ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/remote.php/dav/files/einstein", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		for _, v := range values {
+			req.Header.Add("Authorization", v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	DescribeTable("with the pick_bearer policy",
+		func(status int, sub string, values ...string) {
+			rec := serve(newHandler(config.MultipleAuthorizationPickBearer), values...)
+			Expect(rec.Code).To(Equal(status))
+			if sub != "" {
+				Expect(claims).To(HaveKeyWithValue(oidc.Sub, sub))
+			} else {
+				Expect(claims).To(BeNil())
+			}
+		},
+		Entry("Bearer", http.StatusOK, "einstein", "Bearer einstein"),
+		Entry("bearer", http.StatusOK, "einstein", "bearer einstein"),
+		Entry("BEARER with extra whitespace", http.StatusOK, "einstein", "  BEARER   einstein "),
+		Entry("bearer without a token", http.StatusUnauthorized, "", "bearer "),
+		Entry("basic and bearer", http.StatusOK, "einstein", basic, "Bearer einstein"),
+		Entry("duplicate bearer headers", http.StatusOK, "einstein", "Bearer einstein", "bearer einstein"),
+		Entry("different bearer tokens", http.StatusBadRequest, "", "Bearer einstein", "Bearer marie"),
+		Entry("basic and different bearer tokens", http.StatusBadRequest, "", basic, "Bearer einstein", "Bearer marie"),
+		Entry("different basic credentials", http.StatusBadRequest, "", basic, "Basic bWFyaWU6cmFkaW9hY3Rpdml0eQ=="),
+	)
+
+	It("rejects multiple headers with the reject policy", func() {
+		handler := newHandler(config.MultipleAuthorizationReject)
+
+		rec := serve(handler, "bearer einstein")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(claims).To(HaveKeyWithValue(oidc.Sub, "einstein"))
+		claims = nil
+
+		for _, values := range [][]string{
+			{"Bearer einstein", "Bearer einstein"},
+			{basic, "Bearer einstein"},
+		} {
+			rec = serve(handler, values...)
+			Expect(rec.Code).To(Equal(http.StatusBadRequest))
+			Expect(rec.Body.String()).To(ContainSubstring(ErrMultipleAuthorization.Error()))
+			Expect(claims).To(BeNil())
+		}
+	})
+
+	It("passes on a single header with the canonical scheme", func() {
+		var header []string
+		handler := Authentication([]Authenticator{funcAuthenticator(func(r *http.Request) (*http.Request, bool) {
+			header = r.Header.Values("Authorization")
+			return r, true
+		})})(http.NotFoundHandler())
+
+		serve(handler, basic, "bearer einstein")
+		Expect(header).To(Equal([]string{"Bearer einstein"}))
+
+		serve(handler, basic, basic)
+		Expect(header).To(Equal([]string{basic}))
+	})
+})
@@ -14,11 +14,18 @@ type BasicAuthenticator struct {
 	UserProvider  backend.UserBackend
 	UserCS3Claim  string
 	UserOIDCClaim string
+	// PublicMethods restricts the methods of the requests to public paths that are left to the public share authenticator
+	PublicMethods PublicMethods
+}
+
+// Name implements the authenticator interface.
+func (BasicAuthenticator) Name() string {
+	return "basic"
 }
 
 // Authenticate implements the authenticator interface to authenticate requests via basic auth.
 func (m BasicAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
-	if isPublicPath(r.URL.Path) {
+	if m.PublicMethods.isPublic(r) {
 		// The authentication of public path requests is handled by another authenticator.
 		// Since we can't guarantee the order of execution of the authenticators, we better
 		// implement an early return here for paths we can't authenticate in this authenticator.
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// challengeSchemes are the authentication schemes of the challenges of the strategies.
+var challengeSchemes = map[string]string{
+	StrategyBearer:    "Bearer",
+	StrategyBasic:     "Basic",
+	StrategySignedURL: "Signed-URL",
+	StrategyMTLS:      "mTLS",
+}
+
+// defaultChallenges are used if no order is configured, they send the challenges of bearer and basic auth.
+var defaultChallenges = config.Challenges{
+	Order:  []string{StrategyBearer, StrategyBasic, StrategySignedURL, StrategyMTLS},
+	Bearer: true,
+	Basic:  true,
+}
+
+// ValidateChallenges checks that the order only lists known strategies, each of them once.
+func ValidateChallenges(c config.Challenges) error {
+	seen := make(map[string]struct{}, len(c.Order))
+	for _, s := range c.Order {
+		if _, ok := challengeSchemes[s]; !ok {
+			return fmt.Errorf("unknown strategy '%s', use '%s', '%s', '%s' or '%s'", s, StrategyBearer, StrategyBasic, StrategySignedURL, StrategyMTLS)
+		}
+		if _, ok := seen[s]; ok {
+			return fmt.Errorf("the strategy %s is listed more than once", s)
+		}
+		seen[s] = struct{}{}
+	}
+	return nil
+}
+
+// supportedChallenges returns the challenges of the authentication strategies enabled in the options, in the
+// configured order.
+func supportedChallenges(options Options) []string {
+	c := options.Challenges
+	if len(c.Order) == 0 {
+		c = defaultChallenges
+	}
+	enabled := map[string]bool{
+		StrategyBearer:    c.Bearer && options.OIDCIss != "",
+		StrategyBasic:     c.Basic && options.EnableBasicAuth,
+		StrategySignedURL: c.SignedURL && options.PreSignedURLConfig.Enabled,
+		StrategyMTLS:      c.MTLS,
+	}
+	var strategies []string
+	for _, s := range c.Order {
+		if enabled[s] {
+			strategies = append(strategies, s)
+		}
+	}
+	return strategies
+}
+
+// challengeScheme returns the authentication scheme of the challenge of a strategy.
+func challengeScheme(strategy string) string {
+	if scheme, ok := challengeSchemes[strategy]; ok {
+		return scheme
+	}
+	return cases.Title(language.Und).String(strategy)
+}
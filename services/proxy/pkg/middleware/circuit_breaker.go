@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
+)
+
+// states of a circuit breaker, the values are reported by the state metric
+const (
+	CircuitClosed = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+// ErrCircuitOpen is returned for requests which were rejected by an open circuit breaker.
+var ErrCircuitOpen = errors.New("the circuit breaker of the IDP is open")
+
+// CircuitBreaker is an http.RoundTripper which fails fast while the IDP is failing, instead of letting every
+// request run into the timeout. It opens after the configured number of consecutive failures. After the open
+// duration, a limited number of probe requests is sent, the breaker closes if all of them succeed.
+type CircuitBreaker struct {
+	next    http.RoundTripper
+	cfg     config.CircuitBreaker
+	issuer  string
+	logger  log.Logger
+	metrics *metrics.Metrics
+
+	lock     sync.Mutex
+	state    int
+	failures int
+	openedAt time.Time
+	// round counts the half-open phases, so probes of an earlier phase are not counted
+	round   int
+	probing int
+	probed  int
+
+	// timeNow is used to mock the current time during tests
+	timeNow func() time.Time
+}
+
+// NewCircuitBreaker wraps the transport of the IDP of the issuer, http.DefaultTransport is used if it is nil.
+// The metrics are optional.
+func NewCircuitBreaker(next http.RoundTripper, cfg config.CircuitBreaker, issuer string, logger log.Logger, m *metrics.Metrics) *CircuitBreaker {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if cfg.HalfOpenProbes < 1 {
+		cfg.HalfOpenProbes = 1
+	}
+	cb := &CircuitBreaker{
+		next:    next,
+		cfg:     cfg,
+		issuer:  issuer,
+		logger:  logger,
+		metrics: m,
+		timeNow: time.Now,
+	}
+	cb.reportState()
+	return cb
+}
+
+// WrapHTTPClient returns a copy of the client which sends its requests through a circuit breaker. The client
+// is returned as it is if the circuit breaker is disabled.
+func WrapHTTPClient(c *http.Client, cfg config.CircuitBreaker, issuer string, logger log.Logger, m *metrics.Metrics) *http.Client {
+	if cfg.FailureThreshold <= 0 {
+		return c
+	}
+	wrapped := *c
+	wrapped.Transport = NewCircuitBreaker(c.Transport, cfg, issuer, logger, m)
+	return &wrapped
+}
+
+// State returns the current state of the circuit breaker.
+func (cb *CircuitBreaker) State() int {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	return cb.state
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (cb *CircuitBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	round, err := cb.allow()
+	if err != nil {
+		if cb.metrics != nil {
+			cb.metrics.IDPCircuitBreakerRejections.WithLabelValues(cb.issuer).Inc()
+		}
+		return nil, err
+	}
+	res, err := cb.next.RoundTrip(req)
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		// the client gave up, that says nothing about the IDP
+		cb.abort(round)
+	case err != nil || res.StatusCode >= http.StatusInternalServerError:
+		cb.record(round, false)
+	default:
+		cb.record(round, true)
+	}
+	return res, err
+}
+
+// allow checks if a request may be sent. It returns the half-open round of probes, 0 if the request is no probe.
+func (cb *CircuitBreaker) allow() (int, error) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	if cb.state == CircuitOpen {
+		if cb.timeNow().Sub(cb.openedAt) < time.Duration(cb.cfg.OpenDuration)*time.Second {
+			return 0, ErrCircuitOpen
+		}
+		cb.setState(CircuitHalfOpen)
+		cb.round++
+		cb.probing, cb.probed = 0, 0
+	}
+	if cb.state == CircuitHalfOpen {
+		if cb.probing+cb.probed >= cb.cfg.HalfOpenProbes {
+			return 0, ErrCircuitOpen
+		}
+		cb.probing++
+		return cb.round, nil
+	}
+	return 0, nil
+}
+
+func (cb *CircuitBreaker) record(round int, success bool) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	probe := cb.isProbe(round)
+	if probe {
+		cb.probing--
+	}
+	switch {
+	case !probe && cb.state != CircuitClosed:
+		// a request sent before the breaker opened or a probe of an earlier phase, only the current probes decide
+	case cb.state == CircuitHalfOpen && success:
+		cb.probed++
+		if cb.probed >= cb.cfg.HalfOpenProbes {
+			cb.failures = 0
+			cb.setState(CircuitClosed)
+			cb.logger.Info().Str("issuer", cb.issuer).Msg("The IDP is available again, closed the circuit breaker")
+		}
+	case cb.state == CircuitHalfOpen:
+		cb.open()
+	case cb.state == CircuitClosed && success:
+		cb.failures = 0
+	case cb.state == CircuitClosed:
+		cb.failures++
+		if cb.failures >= cb.cfg.FailureThreshold {
+			cb.open()
+		}
+	}
+}
+
+// abort releases a probe without recording an outcome.
+func (cb *CircuitBreaker) abort(round int) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	if cb.isProbe(round) {
+		cb.probing--
+	}
+}
+
+// isProbe checks if the request of the round is a probe of the current half-open phase.
+func (cb *CircuitBreaker) isProbe(round int) bool {
+	return round != 0 && round == cb.round && cb.state == CircuitHalfOpen
+}
+
+func (cb *CircuitBreaker) open() {
+	cb.openedAt = cb.timeNow()
+	cb.setState(CircuitOpen)
+	cb.logger.Warn().Str("issuer", cb.issuer).Int("open_duration", cb.cfg.OpenDuration).Msg("The IDP is failing, opened the circuit breaker")
+}
+
+func (cb *CircuitBreaker) setState(state int) {
+	cb.state = state
+	cb.reportState()
+}
+
+func (cb *CircuitBreaker) reportState() {
+	if cb.metrics != nil {
+		cb.metrics.IDPCircuitBreakerState.WithLabelValues(cb.issuer).Set(float64(cb.state))
+	}
+}
@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// roundTripperFunc implements the http.RoundTripper interface with a function
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+var _ = Describe("IDP circuit breaker", func() {
+	var (
+		cb     *CircuitBreaker
+		m      *metrics.Metrics
+		clock  *testClock
+		status int
+		err    error
+		calls  int
+	)
+
+	BeforeEach(func() {
+		m = metrics.New()
+		clock = &testClock{now: time.Now()}
+		status, err, calls = http.StatusOK, nil, 0
+		cb = NewCircuitBreaker(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{StatusCode: status, Body: http.NoBody}, nil
+		}), config.CircuitBreaker{
+			FailureThreshold: 3,
+			OpenDuration:     30,
+			HalfOpenProbes:   2,
+		}, "https://idp.example.com", log.NopLogger(), m)
+		cb.timeNow = clock.Now
+	})
+
+	send := func(ctx context.Context) error {
+		req := httptest.NewRequest(http.MethodGet, "https://idp.example.com/userinfo", nil).WithContext(ctx)
+		res, err := cb.RoundTrip(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+	gauge := func() float64 {
+		out := &dto.Metric{}
+		Expect(m.IDPCircuitBreakerState.WithLabelValues("https://idp.example.com").Write(out)).To(Succeed())
+		return out.GetGauge().GetValue()
+	}
+	rejections := func() float64 {
+		out := &dto.Metric{}
+		Expect(m.IDPCircuitBreakerRejections.WithLabelValues("https://idp.example.com").Write(out)).To(Succeed())
+		return out.GetCounter().GetValue()
+	}
+	trip := func() {
+		status = http.StatusBadGateway
+		for i := 0; i < 3; i++ {
+			Expect(send(context.Background())).To(Succeed())
+		}
+		Expect(cb.State()).To(Equal(CircuitOpen))
+	}
+
+	It("opens after consecutive failures and fails fast", func() {
+		err = errors.New("connection refused")
+		Expect(send(context.Background())).To(MatchError(err))
+		Expect(send(context.Background())).To(MatchError(err))
+		Expect(cb.State()).To(Equal(CircuitClosed))
+		Expect(send(context.Background())).To(MatchError(err))
+		Expect(cb.State()).To(Equal(CircuitOpen))
+		Expect(gauge()).To(Equal(float64(CircuitOpen)))
+
+		Expect(send(context.Background())).To(MatchError(ErrCircuitOpen))
+		Expect(calls).To(Equal(3))
+		Expect(rejections()).To(Equal(float64(1)))
+	})
+
+	It("only counts consecutive failures", func() {
+		status = http.StatusInternalServerError
+		Expect(send(context.Background())).To(Succeed())
+		Expect(send(context.Background())).To(Succeed())
+		status = http.StatusUnauthorized
+		Expect(send(context.Background())).To(Succeed())
+		status = http.StatusInternalServerError
+		Expect(send(context.Background())).To(Succeed())
+		Expect(send(context.Background())).To(Succeed())
+		Expect(cb.State()).To(Equal(CircuitClosed))
+	})
+
+	It("doesn't count requests cancelled by the client", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err = context.Canceled
+		for i := 0; i < 3; i++ {
+			Expect(send(ctx)).To(MatchError(context.Canceled))
+		}
+		Expect(cb.State()).To(Equal(CircuitClosed))
+	})
+
+	It("closes after the probes succeeded", func() {
+		trip()
+		clock.Advance(29 * time.Second)
+		Expect(send(context.Background())).To(MatchError(ErrCircuitOpen))
+
+		clock.Advance(time.Second)
+		status = http.StatusOK
+		Expect(send(context.Background())).To(Succeed())
+		Expect(cb.State()).To(Equal(CircuitHalfOpen))
+		Expect(gauge()).To(Equal(float64(CircuitHalfOpen)))
+		Expect(send(context.Background())).To(Succeed())
+		Expect(cb.State()).To(Equal(CircuitClosed))
+		Expect(gauge()).To(Equal(float64(CircuitClosed)))
+	})
+
+	It("opens again if a probe fails", func() {
+		trip()
+		clock.Advance(30 * time.Second)
+		Expect(send(context.Background())).To(Succeed())
+		Expect(cb.State()).To(Equal(CircuitOpen))
+		Expect(send(context.Background())).To(MatchError(ErrCircuitOpen))
+
+		// the open duration starts again
+		clock.Advance(30 * time.Second)
+		status = http.StatusOK
+		Expect(send(context.Background())).To(Succeed())
+		Expect(send(context.Background())).To(Succeed())
+		Expect(cb.State()).To(Equal(CircuitClosed))
+	})
+
+	It("limits the number of probes in flight", func() {
+		trip()
+		clock.Advance(30 * time.Second)
+		first, err := cb.allow()
+		Expect(err).ToNot(HaveOccurred())
+		second, err := cb.allow()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cb.allow()
+		Expect(err).To(MatchError(ErrCircuitOpen))
+
+		cb.abort(first)
+		third, err := cb.allow()
+		Expect(err).ToNot(HaveOccurred())
+		cb.record(second, true)
+		cb.record(third, true)
+		Expect(cb.State()).To(Equal(CircuitClosed))
+	})
+
+	It("isn't used if it is disabled", func() {
+		c := &http.Client{}
+		Expect(WrapHTTPClient(c, config.CircuitBreaker{}, "https://idp.example.com", log.NopLogger(), nil)).To(BeIdenticalTo(c))
+
+		wrapped := WrapHTTPClient(c, config.CircuitBreaker{FailureThreshold: 1}, "https://idp.example.com", log.NopLogger(), nil)
+		Expect(wrapped).ToNot(BeIdenticalTo(c))
+		Expect(wrapped.Transport).To(BeAssignableToTypeOf(&CircuitBreaker{}))
+		Expect(c.Transport).To(BeNil())
+	})
+})
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/webdav"
+)
+
+// ErrorPageTemplates holds the parsed error page templates. Responses without a template are not changed.
+type ErrorPageTemplates struct {
+	Unauthorized       *template.Template
+	ServiceUnavailable *template.Template
+}
+
+// ErrorPageData is passed to the error page templates.
+type ErrorPageData struct {
+	// Path of the rejected request
+	Path string
+	// Host the request was sent to
+	Host string
+	// Strategies are the supported authentication strategies, e.g. 'bearer' and 'basic'
+	Strategies []string
+	// RequestID to correlate the response with the logs
+	RequestID string
+	// Status code of the response
+	Status int
+	// RetryAfter is the number of seconds after which the maintenance is expected to be over
+	RetryAfter uint64
+}
+
+// ParseErrorPages reads and parses the configured error page templates.
+func ParseErrorPages(cfg config.ErrorPages) (ErrorPageTemplates, error) {
+	var (
+		t   ErrorPageTemplates
+		err error
+	)
+	if t.Unauthorized, err = parseErrorPage("unauthorized", cfg.UnauthorizedPath, cfg.Unauthorized); err != nil {
+		return ErrorPageTemplates{}, err
+	}
+	if t.ServiceUnavailable, err = parseErrorPage("service_unavailable", cfg.ServiceUnavailablePath, cfg.ServiceUnavailable); err != nil {
+		return ErrorPageTemplates{}, err
+	}
+	return t, nil
+}
+
+func parseErrorPage(name, path, inline string) (*template.Template, error) {
+	switch {
+	case path != "" && inline != "":
+		return nil, fmt.Errorf("only one of '%s_path' and '%s' can be set", name, name)
+	case path != "":
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the %s error page: %w", name, err)
+		}
+		inline = string(b)
+	case inline == "":
+		return nil, nil
+	}
+	t, err := template.New(name).Parse(inline)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the %s error page: %w", name, err)
+	}
+	return t, nil
+}
+
+// acceptsHTML checks if the request was sent by a browser which displays the response, as opposed to
+// scripts and WebDAV clients which rely on the JSON and WebDAV errors.
+func acceptsHTML(r *http.Request) bool {
+	return !webdav.IsWebdavRequest(r) && !isXHR(r) && strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// writeErrorPage renders the template with the given status if the request accepts HTML. It returns false
+// if nothing was written, the response has to be written as usual then.
+func writeErrorPage(w http.ResponseWriter, r *http.Request, t *template.Template, status int, strategies []string, options Options) bool {
+	if t == nil || !acceptsHTML(r) {
+		return false
+	}
+	data := ErrorPageData{
+		Path:       r.URL.Path,
+		Host:       r.Host,
+		Strategies: strategies,
+		RequestID:  chimiddleware.GetReqID(r.Context()),
+		Status:     status,
+		RetryAfter: options.Maintenance.RetryAfter,
+	}
+	// rendering into a buffer first allows falling back to the plain response
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		options.Logger.Error().Err(err).Str("template", t.Name()).Msg("could not render the error page")
+		return false
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+	w.WriteHeader(status)
+	_, _ = w.Write(b.Bytes())
+	return true
+}
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+)
+
+var _ = Describe("error pages", func() {
+	const (
+		browserAccept = "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"
+		unauthorized  = `<h1>Please log in to {{.Host}}</h1><p>{{.Path}} {{range .Strategies}}[{{.}}]{{end}} {{.Status}}</p>`
+		maintenance   = `<h1>Back in {{.RetryAfter}} seconds</h1>`
+	)
+
+	newHandler := func(pages config.ErrorPages, opts ...Option) http.Handler {
+		templates, err := ParseErrorPages(pages)
+		Expect(err).ToNot(HaveOccurred())
+		return Authentication(
+			[]Authenticator{failingAuthenticator{}},
+			append(opts, ErrorPages(templates))...,
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Fail("the request must not be forwarded")
+		}))
+	}
+
+	serve := func(handler http.Handler, method string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "https://cloud.example.com/graph/v1.0/me/drives", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("renders the unauthorized page for browsers", func() {
+		handler := newHandler(config.ErrorPages{Unauthorized: unauthorized}, EnableBasicAuth(true))
+
+		rec := serve(handler, http.MethodGet, map[string]string{"Accept": browserAccept, "Sec-Fetch-Mode": "navigate"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+		Expect(rec.Body.String()).To(Equal("<h1>Please log in to cloud.example.com</h1><p>/graph/v1.0/me/drives [basic] 401</p>"))
+	})
+
+	It("keeps the JSON and WebDAV errors for other clients", func() {
+		handler := newHandler(config.ErrorPages{Unauthorized: unauthorized})
+
+		rec := serve(handler, http.MethodGet, map[string]string{"Accept": "application/json"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		rec = serve(handler, http.MethodGet, map[string]string{"Accept": "text/html", "X-Requested-With": "XMLHttpRequest"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		rec = serve(handler, "PROPFIND", map[string]string{"Accept": "text/html"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Get("Content-Type")).ToNot(ContainSubstring("text/html"))
+	})
+
+	It("prefers the login redirect for browser navigations", func() {
+		handler := newHandler(config.ErrorPages{Unauthorized: unauthorized}, LoginRedirectURL("/login"))
+
+		rec := serve(handler, http.MethodGet, map[string]string{"Accept": browserAccept})
+		Expect(rec.Code).To(Equal(http.StatusFound))
+	})
+
+	It("renders the maintenance page from a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "maintenance.html")
+		Expect(os.WriteFile(path, []byte(maintenance), 0600)).To(Succeed())
+		handler := newHandler(
+			config.ErrorPages{ServiceUnavailablePath: path},
+			Maintenance(config.Maintenance{Enabled: true, RetryAfter: 120}),
+		)
+
+		rec := serve(handler, http.MethodGet, map[string]string{"Accept": browserAccept})
+		Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+		Expect(rec.Header().Get("Retry-After")).To(Equal("120"))
+		Expect(rec.Body.String()).To(Equal("<h1>Back in 120 seconds</h1>"))
+
+		rec = serve(handler, http.MethodGet, map[string]string{"Accept": "application/json"})
+		Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+	})
+
+	It("escapes the request values", func() {
+		handler := newHandler(config.ErrorPages{Unauthorized: `<p>{{.Host}}</p>`})
+
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		req.Host = "<script>"
+		req.Header.Set("Accept", "text/html")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		Expect(rec.Body.String()).To(Equal("<p>&lt;script&gt;</p>"))
+	})
+
+	It("falls back to the plain response when rendering fails", func() {
+		handler := newHandler(config.ErrorPages{Unauthorized: `{{.Missing}}`})
+
+		rec := serve(handler, http.MethodGet, map[string]string{"Accept": "text/html"})
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Get("Content-Type")).ToNot(ContainSubstring("text/html"))
+	})
+
+	DescribeTable("rejects invalid configurations",
+		func(pages config.ErrorPages) {
+			_, err := ParseErrorPages(pages)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("path and inline template", config.ErrorPages{Unauthorized: "<p></p>", UnauthorizedPath: "/etc/hostname"}),
+		Entry("missing file", config.ErrorPages{ServiceUnavailablePath: "/does/not/exist.html"}),
+		Entry("invalid template", config.ErrorPages{Unauthorized: "{{.Path"}),
+	)
+})
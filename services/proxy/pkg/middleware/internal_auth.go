@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend"
+)
+
+// InternalSecretHeader is the header carrying the shared secret of internal requests of other services.
+const InternalSecretHeader = "X-Internal-Secret"
+
+// InternalAuthenticator authenticates the requests of other services carrying the shared secret as the configured
+// service user, without asking the IDP. Only requests from the trusted networks are accepted.
+type InternalAuthenticator struct {
+	Logger          log.Logger
+	UserProvider    backend.UserBackend
+	Secret          string
+	UserID          string
+	TrustedNetworks []*net.IPNet
+}
+
+// Name implements the authenticator interface.
+func (InternalAuthenticator) Name() string {
+	return "internal"
+}
+
+// Authenticate implements the authenticator interface to authenticate internal requests with the shared secret.
+func (a InternalAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
+	secret := r.Header.Get(InternalSecretHeader)
+	if secret == "" || a.Secret == "" {
+		return nil, false
+	}
+	peer := remoteIP(r.RemoteAddr)
+	if !isTrusted(peer, a.TrustedNetworks) {
+		a.Logger.Warn().
+			Str("authenticator", "internal").
+			Str("remote_addr", peer).
+			Str("path", r.URL.Path).
+			Msg("rejecting an internal request from an untrusted network")
+		return nil, false
+	}
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(a.Secret)) != 1 {
+		a.Logger.Warn().
+			Str("authenticator", "internal").
+			Str("remote_addr", peer).
+			Str("path", r.URL.Path).
+			Msg("rejecting an internal request with an invalid secret")
+		return nil, false
+	}
+
+	user, _, err := a.UserProvider.GetUserByClaims(r.Context(), "userid", a.UserID, true)
+	if err != nil {
+		a.Logger.Error().
+			Err(err).
+			Str("authenticator", "internal").
+			Str("userid", a.UserID).
+			Msg("could not get the service user")
+		return nil, false
+	}
+
+	a.Logger.Info().
+		Str("authenticator", "internal").
+		Str("remote_addr", peer).
+		Str("method", r.Method).
+		Str("path", r.URL.Path).
+		Str("userid", a.UserID).
+		Msg("internal request bypassed the authentication")
+	// the secret must not be passed on to the services, the middleware removes it from other requests
+	r = r.Clone(revactx.ContextSetUser(r.Context(), user))
+	r.Header.Del(InternalSecretHeader)
+	return r, true
+}
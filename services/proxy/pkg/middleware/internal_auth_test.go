@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend/test"
+)
+
+var _ = Describe("Authenticating internal requests", Label("InternalAuthenticator"), func() {
+	var authenticator Authenticator
+	BeforeEach(func() {
+		_, network, err := net.ParseCIDR("10.0.0.0/8")
+		Expect(err).ToNot(HaveOccurred())
+		authenticator = InternalAuthenticator{
+			Logger: log.NopLogger(),
+			UserProvider: &test.UserBackendMock{
+				GetUserByClaimsFunc: func(ctx context.Context, claim, value string, withRoles bool) (*userv1beta1.User, string, error) {
+					if claim == "userid" && value == "service-user" {
+						return &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "service-user"}, Username: "service"}, "", nil
+					}
+					return nil, "", backend.ErrAccountNotFound
+				},
+			},
+			Secret:          "internal-secret",
+			UserID:          "service-user",
+			TrustedNetworks: []*net.IPNet{network},
+		}
+	})
+
+	newRequest := func(remoteAddr, secret string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/graph/v1.0/users", http.NoBody)
+		req.RemoteAddr = remoteAddr
+		if secret != "" {
+			req.Header.Set(InternalSecretHeader, secret)
+		}
+		return req
+	}
+
+	It("authenticates requests with the secret as the service user", func() {
+		req, ok := authenticator.Authenticate(newRequest("10.1.2.3:4711", "internal-secret"))
+		Expect(ok).To(BeTrue())
+		user, ok := revactx.ContextGetUser(req.Context())
+		Expect(ok).To(BeTrue())
+		Expect(user.Id.OpaqueId).To(Equal("service-user"))
+		Expect(req.Header.Get(InternalSecretHeader)).To(BeEmpty())
+	})
+
+	It("ignores requests without the secret", func() {
+		_, ok := authenticator.Authenticate(newRequest("10.1.2.3:4711", ""))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("rejects requests with an invalid secret", func() {
+		_, ok := authenticator.Authenticate(newRequest("10.1.2.3:4711", "wrong-secret"))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("rejects requests from untrusted networks", func() {
+		_, ok := authenticator.Authenticate(newRequest("192.168.1.1:4711", "internal-secret"))
+		Expect(ok).To(BeFalse())
+	})
+
+	Describe("in the authentication middleware", func() {
+		var forwarded http.Header
+		serveFrom := func(remoteAddr, secret, token string) *httptest.ResponseRecorder {
+			forwarded = nil
+			handler := Authentication(
+				[]Authenticator{authenticator, tokenAuthenticator("user-token")},
+				EnableBasicAuth(true),
+			)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				forwarded = r.Header
+				if user, ok := revactx.ContextGetUser(r.Context()); ok {
+					_, _ = w.Write([]byte(user.Username))
+				} else {
+					_, _ = w.Write([]byte(r.Context().Value(testContextKey{}).(string)))
+				}
+			}))
+			req := newRequest(remoteAddr, secret)
+			req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec
+		}
+		serve := func(secret, token string) *httptest.ResponseRecorder {
+			return serveFrom("10.1.2.3:4711", secret, token)
+		}
+
+		It("bypasses the other authenticators with a valid secret", func() {
+			rec := serve("internal-secret", "")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(rec.Body.String()).To(Equal("service"))
+		})
+
+		It("uses the other authenticators with an invalid or missing secret", func() {
+			rec := serve("wrong-secret", "user-token")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(rec.Body.String()).To(Equal("user-token"))
+
+			rec = serve("", "user-token")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(rec.Body.String()).To(Equal("user-token"))
+
+			Expect(serve("wrong-secret", "").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("never passes the secret on to the services", func() {
+			Expect(serve("internal-secret", "").Code).To(Equal(http.StatusOK))
+			Expect(forwarded).ToNot(HaveKey(InternalSecretHeader))
+
+			Expect(serve("wrong-secret", "user-token").Code).To(Equal(http.StatusOK))
+			Expect(forwarded).ToNot(BeNil())
+			Expect(forwarded).ToNot(HaveKey(InternalSecretHeader))
+
+			Expect(serveFrom("192.168.1.1:4711", "internal-secret", "user-token").Code).To(Equal(http.StatusOK))
+			Expect(forwarded).ToNot(BeNil())
+			Expect(forwarded).ToNot(HaveKey(InternalSecretHeader))
+		})
+	})
+})
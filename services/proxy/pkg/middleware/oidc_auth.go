@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +24,9 @@ import (
 const (
 	_headerAuthorization = "Authorization"
 	_bearerPrefix        = "Bearer "
+
+	_jwksMinRetryBackoff = time.Second
+	_jwksMaxRetryBackoff = 5 * time.Minute
 )
 
 // OIDCProvider used to mock the oidc provider during tests
@@ -32,8 +36,9 @@ type OIDCProvider interface {
 
 // NewOIDCAuthenticator returns a ready to use authenticator which can handle OIDC authentication.
 func NewOIDCAuthenticator(logger log.Logger, tokenCacheTTL int, oidcHTTPClient *http.Client, oidcIss string, providerFunc func() (OIDCProvider, error),
-	jwksOptions config.JWKS, accessTokenVerifyMethod string) *OIDCAuthenticator {
+	jwksOptions config.JWKS, accessTokenVerifyMethod string, accessTokenCookie string, tokenExchange config.TokenExchange) *OIDCAuthenticator {
 	tokenCache := osync.NewCache(tokenCacheTTL)
+	exchangeCache := osync.NewCache(tokenExchange.CacheSize)
 	return &OIDCAuthenticator{
 		Logger:                  logger,
 		tokenCache:              &tokenCache,
@@ -43,8 +48,12 @@ func NewOIDCAuthenticator(logger log.Logger, tokenCacheTTL int, oidcHTTPClient *
 		ProviderFunc:            providerFunc,
 		JWKSOptions:             jwksOptions,
 		AccessTokenVerifyMethod: accessTokenVerifyMethod,
+		AccessTokenCookie:       accessTokenCookie,
+		TokenExchange:           tokenExchange,
+		exchangeCache:           &exchangeCache,
 		providerLock:            &sync.Mutex{},
 		jwksLock:                &sync.Mutex{},
+		tokenEndpointLock:       &sync.Mutex{},
 	}
 }
 
@@ -57,22 +66,46 @@ type OIDCAuthenticator struct {
 	TokenCacheTTL           time.Duration
 	ProviderFunc            func() (OIDCProvider, error)
 	AccessTokenVerifyMethod string
+	AccessTokenCookie       string
 	JWKSOptions             config.JWKS
+	TokenExchange           config.TokenExchange
+	// Revocations is consulted for every verified access token if set
+	Revocations *RevocationList
+	// PublicMethods restricts the methods of the requests to public paths that are left to the public share authenticator
+	PublicMethods PublicMethods
 
 	providerLock *sync.Mutex
 	provider     OIDCProvider
 
-	jwksLock *sync.Mutex
-	JWKS     *keyfunc.JWKS
+	jwksLock        *sync.Mutex
+	JWKS            *keyfunc.JWKS
+	jwksFetchedAt   time.Time
+	jwksNextAttempt time.Time
+	jwksBackoff     time.Duration
+	jwksRefreshing  bool
+
+	exchangeCache     *osync.Cache
+	tokenEndpointLock *sync.Mutex
+	tokenEndpoint     string
+
+	// timeNow is used to mock the current time during tests
+	timeNow func() time.Time
 }
 
-func (m *OIDCAuthenticator) getClaims(token string, req *http.Request) (map[string]interface{}, error) {
+// cachedToken is the entry of the token cache.
+type cachedToken struct {
+	claims map[string]interface{}
+	access jwt.RegisteredClaims
+}
+
+// getClaims returns the userinfo claims and the claims of the verified access token.
+func (m *OIDCAuthenticator) getClaims(token string, req *http.Request) (map[string]interface{}, jwt.RegisteredClaims, error) {
 	var claims map[string]interface{}
 	hit := m.tokenCache.Load(token)
 	if hit == nil {
 		aClaims, err := m.verifyAccessToken(token)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to verify access token")
+			return nil, aClaims, errors.Wrap(err, "failed to verify access token")
 		}
 
 		oauth2Token := &oauth2.Token{
@@ -84,28 +117,46 @@ func (m *OIDCAuthenticator) getClaims(token string, req *http.Request) (map[stri
 			oauth2.StaticTokenSource(oauth2Token),
 		)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get userinfo")
+			return nil, aClaims, errors.Wrap(err, "failed to get userinfo")
 		}
 		if err := userInfo.Claims(&claims); err != nil {
-			return nil, errors.Wrap(err, "failed to unmarshal userinfo claims")
+			return nil, aClaims, errors.Wrap(err, "failed to unmarshal userinfo claims")
 		}
 
 		expiration := m.extractExpiration(aClaims)
-		m.tokenCache.Store(token, claims, expiration)
+		m.tokenCache.Store(token, cachedToken{claims: claims, access: aClaims}, expiration)
 
 		m.Logger.Debug().Interface("claims", claims).Interface("userInfo", userInfo).Time("expiration", expiration.UTC()).Msg("unmarshalled and cached userinfo")
-		return claims, nil
+		return claims, aClaims, nil
 	}
 
-	var ok bool
-	if claims, ok = hit.V.(map[string]interface{}); !ok {
-		return nil, errors.New("failed to cast claims from the cache")
+	cached, ok := hit.V.(cachedToken)
+	if !ok {
+		return nil, jwt.RegisteredClaims{}, errors.New("failed to cast claims from the cache")
 	}
-	m.Logger.Debug().Interface("claims", claims).Msg("cache hit for userinfo")
-	return claims, nil
+	m.Logger.Debug().Interface("claims", cached.claims).Msg("cache hit for userinfo")
+	return cached.claims, cached.access, nil
+}
+
+// isRevoked checks the subject and the token id of the access token against the revocation list.
+// Tokens are considered revoked if the list can't be loaded.
+func (m *OIDCAuthenticator) isRevoked(ctx context.Context, claims map[string]interface{}, access jwt.RegisteredClaims) bool {
+	if m.Revocations == nil {
+		return false
+	}
+	subject := access.Subject
+	if sub, ok := claims[oidc.Sub].(string); ok && sub != "" {
+		subject = sub
+	}
+	revoked, err := m.Revocations.IsRevoked(ctx, subject, access.ID)
+	if err != nil {
+		m.Logger.Error().Err(err).Msg("could not check the revocation list, rejecting the access token")
+		return true
+	}
+	return revoked
 }
 
-func (m OIDCAuthenticator) verifyAccessToken(token string) (jwt.RegisteredClaims, error) {
+func (m *OIDCAuthenticator) verifyAccessToken(token string) (jwt.RegisteredClaims, error) {
 	switch m.AccessTokenVerifyMethod {
 	case config.AccessTokenVerificationJWT:
 		return m.verifyAccessTokenJWT(token)
@@ -119,7 +170,7 @@ func (m OIDCAuthenticator) verifyAccessToken(token string) (jwt.RegisteredClaims
 }
 
 // verifyAccessTokenJWT tries to parse and verify the access token as a JWT.
-func (m OIDCAuthenticator) verifyAccessTokenJWT(token string) (jwt.RegisteredClaims, error) {
+func (m *OIDCAuthenticator) verifyAccessTokenJWT(token string) (jwt.RegisteredClaims, error) {
 	var claims jwt.RegisteredClaims
 	jwks := m.getKeyfunc()
 	if jwks == nil {
@@ -161,62 +212,201 @@ func (m OIDCAuthenticator) shouldServe(req *http.Request) bool {
 	}
 
 	header := req.Header.Get(_headerAuthorization)
-	return strings.HasPrefix(header, _bearerPrefix)
+	_, isBearer := bearerToken(header)
+	return isBearer || (header == "" && m.cookieToken(req) != "")
+}
+
+// cookieToken returns the access token from the configured cookie. To prevent cross-site request
+// forgery the cookie is only accepted on requests which the browser marks as not cross-site.
+func (m OIDCAuthenticator) cookieToken(req *http.Request) string {
+	if m.AccessTokenCookie == "" {
+		return ""
+	}
+	cookie, err := req.Cookie(m.AccessTokenCookie)
+	if err != nil || cookie.Value == "" {
+		return ""
+	}
+	if !isSameSiteRequest(req) {
+		m.Logger.Debug().Str("path", req.URL.Path).Msg("ignoring access token cookie on cross-site request")
+		return ""
+	}
+	return cookie.Value
+}
+
+// isSameSiteRequest checks the Sec-Fetch-Site header, falling back to the Origin header for
+// browsers that don't send it. Requests without any of them are not considered same-site.
+func isSameSiteRequest(req *http.Request) bool {
+	switch req.Header.Get("Sec-Fetch-Site") {
+	case "same-origin", "same-site", "none":
+		// "none" is a navigation initiated by the user, e.g. by opening a bookmark
+		return true
+	case "":
+		origin, err := url.Parse(req.Header.Get("Origin"))
+		return err == nil && origin.Host != "" && origin.Host == req.Host
+	default:
+		return false
+	}
+}
+
+// removeCookie removes the cookie with the given name from the request
+func removeCookie(req *http.Request, name string) {
+	cookies := req.Cookies()
+	req.Header.Del("Cookie")
+	for _, c := range cookies {
+		if c.Name != name {
+			req.AddCookie(c)
+		}
+	}
 }
 
 type jwksJSON struct {
 	JWKSURL string `json:"jwks_uri"`
 }
 
+// getKeyfunc returns the JWKS used to verify access tokens. The JWKS is fetched on first use and
+// refreshed after the configured refresh interval. When a refresh fails the last known JWKS is
+// served for the configured stale grace period while the refresh is retried with an exponential backoff.
 func (m *OIDCAuthenticator) getKeyfunc() *keyfunc.JWKS {
 	m.jwksLock.Lock()
 	defer m.jwksLock.Unlock()
-	if m.JWKS == nil {
-		wellKnown := strings.TrimSuffix(m.OIDCIss, "/") + "/.well-known/openid-configuration"
 
-		resp, err := m.HTTPClient.Get(wellKnown)
-		if err != nil {
-			m.Logger.Error().Err(err).Msg("Failed to set request for .well-known/openid-configuration")
-			return nil
-		}
-		defer resp.Body.Close()
+	now := m.now()
+	if m.JWKS != nil && m.jwksIsFresh(now) {
+		return m.JWKS
+	}
 
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			m.Logger.Error().Err(err).Msg("unable to read discovery response body")
-			return nil
+	if m.JWKS != nil && m.jwksIsUsable(now) {
+		// refresh in the background so requests don't have to wait for an unresponsive IDP
+		if !m.jwksRefreshing && !now.Before(m.jwksNextAttempt) {
+			m.jwksRefreshing = true
+			go func() {
+				jwks, err := m.fetchJWKS()
+
+				m.jwksLock.Lock()
+				defer m.jwksLock.Unlock()
+				m.jwksRefreshing = false
+				m.updateJWKS(jwks, err, m.now())
+			}()
 		}
+		return m.JWKS
+	}
 
-		if resp.StatusCode != http.StatusOK {
-			m.Logger.Error().Str("status", resp.Status).Str("body", string(body)).Msg("error requesting openid-configuration")
-			return nil
-		}
+	if m.jwksRefreshing || now.Before(m.jwksNextAttempt) {
+		return nil
+	}
 
-		var j jwksJSON
-		err = json.Unmarshal(body, &j)
-		if err != nil {
-			m.Logger.Error().Err(err).Msg("failed to decode provider openid-configuration")
-			return nil
+	jwks, err := m.fetchJWKS()
+	m.updateJWKS(jwks, err, now)
+	if m.JWKS == nil || !m.jwksIsUsable(now) {
+		return nil
+	}
+	return m.JWKS
+}
+
+// updateJWKS stores the result of a JWKS refresh. Failed refreshes increase the backoff for the next attempt.
+// Must be called with the jwksLock held.
+func (m *OIDCAuthenticator) updateJWKS(jwks *keyfunc.JWKS, err error, now time.Time) {
+	if err != nil {
+		m.jwksBackoff *= 2
+		if m.jwksBackoff < _jwksMinRetryBackoff {
+			m.jwksBackoff = _jwksMinRetryBackoff
 		}
-		m.Logger.Debug().Str("jwks", j.JWKSURL).Msg("discovered jwks endpoint")
-		options := keyfunc.Options{
-			Client: m.HTTPClient,
-			RefreshErrorHandler: func(err error) {
-				m.Logger.Error().Err(err).Msg("There was an error with the jwt.Keyfunc")
-			},
-			RefreshInterval:   time.Minute * time.Duration(m.JWKSOptions.RefreshInterval),
-			RefreshRateLimit:  time.Second * time.Duration(m.JWKSOptions.RefreshRateLimit),
-			RefreshTimeout:    time.Second * time.Duration(m.JWKSOptions.RefreshTimeout),
-			RefreshUnknownKID: m.JWKSOptions.RefreshUnknownKID,
+		if m.jwksBackoff > _jwksMaxRetryBackoff {
+			m.jwksBackoff = _jwksMaxRetryBackoff
 		}
-		m.JWKS, err = keyfunc.Get(j.JWKSURL, options)
-		if err != nil {
-			m.JWKS = nil
-			m.Logger.Error().Err(err).Msg("Failed to create JWKS from resource at the given URL.")
-			return nil
+		m.jwksNextAttempt = now.Add(m.jwksBackoff)
+
+		if m.JWKS != nil && m.jwksIsUsable(now) {
+			m.Logger.Warn().Err(err).
+				Time("fetched", m.jwksFetchedAt).
+				Dur("retry_in", m.jwksBackoff).
+				Msg("Failed to refresh the JWKS, serving the stale one")
+			return
 		}
+		m.Logger.Error().Err(err).Dur("retry_in", m.jwksBackoff).Msg("Failed to fetch the JWKS")
+		return
 	}
-	return m.JWKS
+
+	if m.JWKS != nil {
+		m.JWKS.EndBackground()
+	}
+	m.JWKS = jwks
+	m.jwksFetchedAt = now
+	m.jwksBackoff = 0
+	m.jwksNextAttempt = time.Time{}
+}
+
+// jwksIsFresh returns true if the JWKS doesn't need to be refreshed yet.
+func (m *OIDCAuthenticator) jwksIsFresh(now time.Time) bool {
+	if m.JWKSOptions.RefreshInterval == 0 {
+		return true
+	}
+	return now.Before(m.jwksFetchedAt.Add(time.Minute * time.Duration(m.JWKSOptions.RefreshInterval)))
+}
+
+// jwksIsUsable returns true if the JWKS is either fresh or within the stale grace period.
+func (m *OIDCAuthenticator) jwksIsUsable(now time.Time) bool {
+	if m.jwksIsFresh(now) {
+		return true
+	}
+	expiry := m.jwksFetchedAt.Add(time.Minute * time.Duration(m.JWKSOptions.RefreshInterval+m.JWKSOptions.StaleGracePeriod))
+	return now.Before(expiry)
+}
+
+func (m *OIDCAuthenticator) now() time.Time {
+	if m.timeNow != nil {
+		return m.timeNow()
+	}
+	return time.Now()
+}
+
+// fetchJWKS discovers the jwks_uri of the IDP and loads the JWKS from it.
+func (m *OIDCAuthenticator) fetchJWKS() (*keyfunc.JWKS, error) {
+	var j jwksJSON
+	if err := m.fetchDiscovery(&j); err != nil {
+		return nil, err
+	}
+	m.Logger.Debug().Str("jwks", j.JWKSURL).Msg("discovered jwks endpoint")
+	// the periodic refresh is handled by getKeyfunc, keyfunc only takes care of unknown key ids
+	options := keyfunc.Options{
+		Client: m.HTTPClient,
+		RefreshErrorHandler: func(err error) {
+			m.Logger.Error().Err(err).Msg("There was an error with the jwt.Keyfunc")
+		},
+		RefreshRateLimit:  time.Second * time.Duration(m.JWKSOptions.RefreshRateLimit),
+		RefreshTimeout:    time.Second * time.Duration(m.JWKSOptions.RefreshTimeout),
+		RefreshUnknownKID: m.JWKSOptions.RefreshUnknownKID,
+	}
+	jwks, err := keyfunc.Get(j.JWKSURL, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create JWKS from resource at the given URL")
+	}
+	return jwks, nil
+}
+
+// fetchDiscovery decodes the .well-known/openid-configuration of the IDP into v.
+func (m *OIDCAuthenticator) fetchDiscovery(v interface{}) error {
+	wellKnown := strings.TrimSuffix(m.OIDCIss, "/") + "/.well-known/openid-configuration"
+
+	resp, err := m.HTTPClient.Get(wellKnown)
+	if err != nil {
+		return errors.Wrap(err, "failed to request .well-known/openid-configuration")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "unable to read discovery response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("error requesting openid-configuration: %s", resp.Status)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return errors.Wrap(err, "failed to decode provider openid-configuration")
+	}
+	return nil
 }
 
 func (m *OIDCAuthenticator) getProvider() OIDCProvider {
@@ -239,10 +429,15 @@ func (m *OIDCAuthenticator) getProvider() OIDCProvider {
 	return m.provider
 }
 
+// Name implements the authenticator interface.
+func (*OIDCAuthenticator) Name() string {
+	return "oidc"
+}
+
 // Authenticate implements the authenticator interface to authenticate requests via oidc auth.
 func (m *OIDCAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
 	// there is no bearer token on the request,
-	if !m.shouldServe(r) || isPublicPath(r.URL.Path) {
+	if !m.shouldServe(r) || m.PublicMethods.isPublic(r) {
 		// The authentication of public path requests is handled by another authenticator.
 		// Since we can't guarantee the order of execution of the authenticators, we better
 		// implement an early return here for paths we can't authenticate in this authenticator.
@@ -257,9 +452,14 @@ func (m *OIDCAuthenticator) Authenticate(r *http.Request) (*http.Request, bool)
 	if m.AccessTokenVerifyMethod == config.AccessTokenVerificationJWT && m.getKeyfunc() == nil {
 		return nil, false
 	}
-	token := strings.TrimPrefix(r.Header.Get(_headerAuthorization), _bearerPrefix)
+	var token string
+	var fromCookie bool
+	var isBearer bool
+	if token, isBearer = bearerToken(r.Header.Get(_headerAuthorization)); !isBearer {
+		token, fromCookie = m.cookieToken(r), true
+	}
 
-	claims, err := m.getClaims(token, r)
+	claims, access, err := m.getClaims(token, r)
 	if err != nil {
 		m.Logger.Error().
 			Err(err).
@@ -268,9 +468,33 @@ func (m *OIDCAuthenticator) Authenticate(r *http.Request) (*http.Request, bool)
 			Msg("failed to authenticate the request")
 		return nil, false
 	}
+	if m.isRevoked(r.Context(), claims, access) {
+		m.Logger.Info().
+			Str("authenticator", "oidc").
+			Str("path", r.URL.Path).
+			Msg("rejected a revoked access token")
+		return nil, false
+	}
 	m.Logger.Debug().
 		Str("authenticator", "oidc").
 		Str("path", r.URL.Path).
 		Msg("successfully authenticated request")
-	return r.WithContext(oidc.NewContext(r.Context(), claims)), true
+	r = r.WithContext(oidc.NewContext(r.Context(), claims))
+	if m.TokenExchange.Enabled {
+		exchanged, err := m.exchangeToken(r.Context(), token, claims)
+		if err != nil {
+			m.Logger.Error().
+				Err(err).
+				Str("authenticator", "oidc").
+				Str("path", r.URL.Path).
+				Msg("failed to exchange the access token")
+			return nil, false
+		}
+		r.Header.Set(_headerAuthorization, _bearerPrefix+exchanged)
+	}
+	if fromCookie {
+		// the token must not leak to the services behind the proxy
+		removeCookie(r, m.AccessTokenCookie)
+	}
+	return r, true
 }
@@ -0,0 +1,261 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gOidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"golang.org/x/oauth2"
+)
+
+type testClock struct {
+	l   sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.l.Lock()
+	defer c.l.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.now = c.now.Add(d)
+}
+
+var _ = Describe("Fetching the JWKS", Label("OIDCAuthenticator"), func() {
+	var (
+		authenticator *OIDCAuthenticator
+		idp           *httptest.Server
+		clock         *testClock
+		token         string
+		failing       int32
+		jwksRequests  int32
+	)
+
+	refreshing := func() bool {
+		authenticator.jwksLock.Lock()
+		defer authenticator.jwksLock.Unlock()
+		return authenticator.jwksRefreshing
+	}
+
+	BeforeEach(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		atomic.StoreInt32(&failing, 0)
+		atomic.StoreInt32(&jwksRequests, 0)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&failing) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(jwksJSON{JWKSURL: idp.URL + "/jwks"})
+		})
+		mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&jwksRequests, 1)
+			if atomic.LoadInt32(&failing) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kty": "RSA",
+					"kid": "test-key",
+					"use": "sig",
+					"alg": "RS256",
+					"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+				}},
+			})
+		})
+		idp = httptest.NewServer(mux)
+
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{Issuer: idp.URL})
+		t.Header["kid"] = "test-key"
+		token, err = t.SignedString(key)
+		Expect(err).ToNot(HaveOccurred())
+
+		clock = &testClock{now: time.Now()}
+		authenticator = NewOIDCAuthenticator(log.NewLogger(), 0, idp.Client(), idp.URL, nil, config.JWKS{
+			RefreshInterval:  60,
+			RefreshTimeout:   10,
+			StaleGracePeriod: 30,
+		}, config.AccessTokenVerificationJWT, "", config.TokenExchange{})
+		authenticator.timeNow = clock.Now
+
+		// warm up the cache
+		Expect(authenticator.getKeyfunc()).ToNot(BeNil())
+	})
+
+	AfterEach(func() {
+		idp.Close()
+	})
+
+	It("serves the stale JWKS within the grace period when the refresh fails", func() {
+		atomic.StoreInt32(&failing, 1)
+		clock.Advance(61 * time.Minute)
+
+		Expect(authenticator.getKeyfunc()).ToNot(BeNil())
+		Eventually(refreshing).Should(BeFalse())
+
+		_, err := authenticator.verifyAccessTokenJWT(token)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects tokens after the grace period", func() {
+		atomic.StoreInt32(&failing, 1)
+		clock.Advance(91 * time.Minute)
+
+		Expect(authenticator.getKeyfunc()).To(BeNil())
+		_, err := authenticator.verifyAccessTokenJWT(token)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("backs off between failing refresh attempts", func() {
+		requests := atomic.LoadInt32(&jwksRequests)
+		atomic.StoreInt32(&failing, 1)
+		clock.Advance(61 * time.Minute)
+
+		Expect(authenticator.getKeyfunc()).ToNot(BeNil())
+		Eventually(refreshing).Should(BeFalse())
+		// the discovery request fails, so the jwks endpoint is not contacted
+		Expect(atomic.LoadInt32(&jwksRequests)).To(Equal(requests))
+
+		// the next attempt is only made after the backoff
+		atomic.StoreInt32(&failing, 0)
+		Expect(authenticator.getKeyfunc()).ToNot(BeNil())
+		Consistently(refreshing, 100*time.Millisecond).Should(BeFalse())
+		Expect(atomic.LoadInt32(&jwksRequests)).To(Equal(requests))
+
+		clock.Advance(_jwksMinRetryBackoff)
+		Expect(authenticator.getKeyfunc()).ToNot(BeNil())
+		Eventually(refreshing).Should(BeFalse())
+		Expect(atomic.LoadInt32(&jwksRequests)).To(Equal(requests + 1))
+
+		// the refreshed JWKS is fresh again
+		clock.Advance(59 * time.Minute)
+		Expect(authenticator.getKeyfunc()).ToNot(BeNil())
+		Expect(refreshing()).To(BeFalse())
+		Expect(atomic.LoadInt32(&jwksRequests)).To(Equal(requests + 1))
+	})
+})
+
+var _ = Describe("Reading the access token from a cookie", Label("OIDCAuthenticator"), func() {
+	var (
+		authenticator *OIDCAuthenticator
+		idp           *httptest.Server
+		tokens        chan string
+	)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me", nil)
+		req.AddCookie(&http.Cookie{Name: "access_token", Value: "cookie-token"})
+		req.AddCookie(&http.Cookie{Name: "other", Value: "kept"})
+		return req
+	}
+
+	BeforeEach(func() {
+		tokens = make(chan string, 1)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":            idp.URL,
+				"userinfo_endpoint": idp.URL + "/userinfo",
+			})
+		})
+		mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+			tokens <- strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"sub":"einstein"}`))
+		})
+		idp = httptest.NewServer(mux)
+
+		authenticator = NewOIDCAuthenticator(log.NewLogger(), 0, idp.Client(), idp.URL, func() (OIDCProvider, error) {
+			return gOidc.NewProvider(context.WithValue(context.Background(), oauth2.HTTPClient, idp.Client()), idp.URL)
+		}, config.JWKS{}, config.AccessTokenVerificationNone, "access_token", config.TokenExchange{})
+	})
+
+	AfterEach(func() {
+		idp.Close()
+	})
+
+	It("authenticates same-site requests and strips the cookie", func() {
+		req := newRequest()
+		req.Header.Set("Sec-Fetch-Site", "same-origin")
+
+		authenticated, ok := authenticator.Authenticate(req)
+		Expect(ok).To(BeTrue())
+		Expect(<-tokens).To(Equal("cookie-token"))
+
+		_, err := authenticated.Cookie("access_token")
+		Expect(err).To(Equal(http.ErrNoCookie))
+		other, err := authenticated.Cookie("other")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(other.Value).To(Equal("kept"))
+	})
+
+	It("accepts a matching origin from browsers without fetch metadata", func() {
+		req := newRequest()
+		req.Header.Set("Origin", "https://cloud.example.com")
+
+		_, ok := authenticator.Authenticate(req)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("ignores the cookie on cross-site requests", func() {
+		req := newRequest()
+		req.Header.Set("Sec-Fetch-Site", "cross-site")
+		_, ok := authenticator.Authenticate(req)
+		Expect(ok).To(BeFalse())
+
+		req = newRequest()
+		req.Header.Set("Origin", "https://evil.example.org")
+		_, ok = authenticator.Authenticate(req)
+		Expect(ok).To(BeFalse())
+
+		// without any hint the request is not considered same-site
+		_, ok = authenticator.Authenticate(newRequest())
+		Expect(ok).To(BeFalse())
+		Expect(tokens).To(BeEmpty())
+	})
+
+	It("prefers the authorization header", func() {
+		req := newRequest()
+		req.Header.Set("Sec-Fetch-Site", "same-origin")
+		req.Header.Set("Authorization", "Bearer header-token")
+
+		authenticated, ok := authenticator.Authenticate(req)
+		Expect(ok).To(BeTrue())
+		Expect(<-tokens).To(Equal("header-token"))
+		_, err := authenticated.Cookie("access_token")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("is disabled by default", func() {
+		authenticator.AccessTokenCookie = ""
+		req := newRequest()
+		req.Header.Set("Sec-Fetch-Site", "same-origin")
+
+		_, ok := authenticator.Authenticate(req)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -12,6 +12,7 @@ import (
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
 )
 
 // Option defines a single option function.
@@ -43,6 +44,8 @@ type Options struct {
 	PreSignedURLConfig config.PreSignedURL
 	// UserOIDCClaim to read from the oidc claims
 	UserOIDCClaim string
+	// UserOIDCClaimFallbacks to read from the oidc claims, in this order, if the UserOIDCClaim is not set
+	UserOIDCClaimFallbacks []string
 	// UserCS3Claim to use when looking up a user in the CS3 API
 	UserCS3Claim string
 	// AutoprovisionAccounts when an accountResolver does not exist.
@@ -55,11 +58,45 @@ type Options struct {
 	UserinfoCacheTTL time.Duration
 	// CredentialsByUserAgent sets the auth challenges on a per user-agent basis
 	CredentialsByUserAgent map[string]string
+	// LoginRedirectURL is the URL unauthenticated browser navigations are redirected to
+	LoginRedirectURL string
+	// AuthenticatorTimeout is the maximum time a single authenticator may take
+	AuthenticatorTimeout time.Duration
+	// AuthenticatorTimeouts overrides the AuthenticatorTimeout for the authenticators with the given names
+	AuthenticatorTimeouts map[string]time.Duration
+	// SuppressXHRBasicChallenge removes the Basic challenge from 401 responses to XHR and fetch requests
+	SuppressXHRBasicChallenge bool
+	// MultipleAuthorization defines how requests with multiple Authorization headers are handled
+	MultipleAuthorization string
+	// Maintenance configures the maintenance mode of the authentication middleware
+	Maintenance config.Maintenance
+	// Tenants selects the authenticators and challenges by the tenant of the request, all requests use the same if not set
+	Tenants *TenantResolver
+	// ErrorPages are rendered for browsers instead of the plain 401 and 503 responses
+	ErrorPages ErrorPageTemplates
+	// Authorizer decides if authenticated requests may access the instance at all, all are allowed if not set
+	Authorizer Authorizer
+	// ForbiddenBody is the body of the 403 responses to requests denied by the authorizer
+	ForbiddenBody string
+	// WebDAVBodyDrainLimit is the maximum number of bytes of the body of unauthenticated WebDAV requests that is drained
+	WebDAVBodyDrainLimit int64
+	// SecurityHeaders are added to the responses unless they are set already
+	SecurityHeaders config.SecurityHeaders
+	// Metrics to observe the authentication durations in, nothing is observed if not set
+	Metrics *metrics.Metrics
+	// PublicPathAccessLog logs the requests to public paths with the matched prefix
+	PublicPathAccessLog bool
+	// PublicPathMethods restricts the methods of the requests to public paths which are not challenged
+	PublicPathMethods PublicMethods
 	// AccessTokenVerifyMethod configures how access_tokens should be verified but the oidc_auth middleware.
 	// Possible values currently: "jwt" and "none"
 	AccessTokenVerifyMethod string
 	// JWKS sets the options for fetching the JWKS from the IDP
 	JWKS config.JWKS
+	// WebSocketAuth configures where WebSocket upgrade requests may carry their token
+	WebSocketAuth config.WebSocketAuth
+	// Challenges configures the order and the strategies of the challenges sent to unauthenticated clients
+	Challenges config.Challenges
 }
 
 // newOptions initializes the available default options.
@@ -150,6 +187,13 @@ func PreSignedURLConfig(cfg config.PreSignedURL) Option {
 	}
 }
 
+// UserOIDCClaimFallbacks provides a function to set the UserOIDCClaimFallbacks config
+func UserOIDCClaimFallbacks(val ...string) Option {
+	return func(o *Options) {
+		o.UserOIDCClaimFallbacks = val
+	}
+}
+
 // UserOIDCClaim provides a function to set the UserClaim config
 func UserOIDCClaim(val string) Option {
 	return func(o *Options) {
@@ -192,6 +236,125 @@ func TokenCacheTTL(ttl time.Duration) Option {
 	}
 }
 
+// LoginRedirectURL provides a function to set the LoginRedirectURL option.
+func LoginRedirectURL(url string) Option {
+	return func(o *Options) {
+		o.LoginRedirectURL = url
+	}
+}
+
+// AuthenticatorTimeout provides a function to set the AuthenticatorTimeout option.
+func AuthenticatorTimeout(d time.Duration) Option {
+	return func(o *Options) {
+		o.AuthenticatorTimeout = d
+	}
+}
+
+// AuthenticatorTimeouts provides a function to set the AuthenticatorTimeouts option.
+func AuthenticatorTimeouts(timeouts map[string]time.Duration) Option {
+	return func(o *Options) {
+		o.AuthenticatorTimeouts = timeouts
+	}
+}
+
+// SuppressXHRBasicChallenge provides a function to set the SuppressXHRBasicChallenge option.
+func SuppressXHRBasicChallenge(val bool) Option {
+	return func(o *Options) {
+		o.SuppressXHRBasicChallenge = val
+	}
+}
+
+// MultipleAuthorization provides a function to set the MultipleAuthorization option.
+func MultipleAuthorization(val string) Option {
+	return func(o *Options) {
+		o.MultipleAuthorization = val
+	}
+}
+
+// Tenants provides a function to set the Tenants option.
+func Tenants(tr *TenantResolver) Option {
+	return func(o *Options) {
+		o.Tenants = tr
+	}
+}
+
+// ErrorPages provides a function to set the ErrorPages option.
+func ErrorPages(t ErrorPageTemplates) Option {
+	return func(o *Options) {
+		o.ErrorPages = t
+	}
+}
+
+// AuthorizationHook provides a function to set the Authorizer option.
+func AuthorizationHook(a Authorizer) Option {
+	return func(o *Options) {
+		o.Authorizer = a
+	}
+}
+
+// ForbiddenBody provides a function to set the ForbiddenBody option.
+func ForbiddenBody(body string) Option {
+	return func(o *Options) {
+		o.ForbiddenBody = body
+	}
+}
+
+// WebDAVBodyDrainLimit provides a function to set the WebDAVBodyDrainLimit option.
+func WebDAVBodyDrainLimit(limit int64) Option {
+	return func(o *Options) {
+		o.WebDAVBodyDrainLimit = limit
+	}
+}
+
+// SecurityHeaders provides a function to set the SecurityHeaders option.
+func SecurityHeaders(h config.SecurityHeaders) Option {
+	return func(o *Options) {
+		o.SecurityHeaders = h
+	}
+}
+
+// WebSocketAuth provides a function to set the WebSocketAuth option.
+func WebSocketAuth(c config.WebSocketAuth) Option {
+	return func(o *Options) {
+		o.WebSocketAuth = c
+	}
+}
+
+// Challenges provides a function to set the Challenges option.
+func Challenges(c config.Challenges) Option {
+	return func(o *Options) {
+		o.Challenges = c
+	}
+}
+
+// Maintenance provides a function to set the Maintenance option.
+func Maintenance(m config.Maintenance) Option {
+	return func(o *Options) {
+		o.Maintenance = m
+	}
+}
+
+// PublicPathAccessLog provides a function to set the PublicPathAccessLog option.
+func PublicPathAccessLog(enabled bool) Option {
+	return func(o *Options) {
+		o.PublicPathAccessLog = enabled
+	}
+}
+
+// PublicPathMethods provides a function to set the PublicPathMethods option.
+func PublicPathMethods(m map[string][]string) Option {
+	return func(o *Options) {
+		o.PublicPathMethods = m
+	}
+}
+
+// Metrics provides a function to set the Metrics option.
+func Metrics(m *metrics.Metrics) Option {
+	return func(o *Options) {
+		o.Metrics = m
+	}
+}
+
 // UserProvider sets the accounts user provider
 func UserProvider(up backend.UserBackend) Option {
 	return func(o *Options) {
@@ -23,6 +23,8 @@ const (
 type PublicShareAuthenticator struct {
 	Logger            log.Logger
 	RevaGatewayClient gateway.GatewayAPIClient
+	// PublicMethods restricts the methods of the requests to public paths that are passed on without a share token
+	PublicMethods PublicMethods
 }
 
 // The archiver is able to create archives from public shares in which case it needs to use the
@@ -47,6 +49,11 @@ func isPublicShareAppOpen(r *http.Request) bool {
 		(r.URL.Query().Get(headerShareToken) != "" || r.Header.Get(headerShareToken) != "")
 }
 
+// Name implements the authenticator interface.
+func (PublicShareAuthenticator) Name() string {
+	return "public_share"
+}
+
 // Authenticate implements the authenticator interface to authenticate requests via public share auth.
 func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
 	if !isPublicPath(r.URL.Path) && !isPublicShareArchive(r) && !isPublicShareAppOpen(r) {
@@ -60,6 +67,10 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 	}
 
 	if shareToken == "" {
+		if !a.PublicMethods.isPublic(r) {
+			// the method is not public, the request needs to be authenticated by another authenticator
+			return nil, false
+		}
 		// If the share token is not set then we don't need to inject the user to
 		// the request context so we can just continue with the request.
 		return r, true
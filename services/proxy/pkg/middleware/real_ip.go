@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ParseTrustedProxy parses an IP address or a CIDR range into a network. A
+// single address is treated as a /32 (IPv4) or /128 (IPv6) network.
+func ParseTrustedProxy(s string) (*net.IPNet, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		_, n, err := net.ParseCIDR(s)
+		return n, err
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("not an IP address or CIDR range")
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+// ParseTrustedProxies parses a list of IP addresses and CIDR ranges.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		n, err := ParseTrustedProxy(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': %w", p, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// ClientIP returns the IP address of the client that sent the request.
+//
+// The Forwarded, X-Forwarded-For and X-Real-IP headers can be set by anyone and
+// are only taken into account when the immediate peer is a trusted proxy. The
+// forwarding chain is then walked from right to left and the first address that
+// is not a trusted proxy is returned.
+func ClientIP(r *http.Request, trusted []*net.IPNet) string {
+	peer := remoteIP(r.RemoteAddr)
+	if !isTrusted(peer, trusted) {
+		return peer
+	}
+
+	chain := forwardedFor(r.Header)
+	if len(chain) == 0 {
+		if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+			return ip.String()
+		}
+		return peer
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		ip := net.ParseIP(chain[i])
+		if ip == nil {
+			// a malformed hop can't be trusted, stop at the last valid address
+			break
+		}
+		peer = ip.String()
+		if !isTrusted(peer, trusted) {
+			break
+		}
+	}
+	return peer
+}
+
+// RealIP is a middleware that sets the RemoteAddr of the request to the client IP
+// resolved by ClientIP.
+func RealIP(trusted []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(trusted) > 0 {
+				r.RemoteAddr = ClientIP(r, trusted)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedFor returns the addresses of the forwarding chain, preferring the
+// standardized Forwarded header (RFC 7239) over X-Forwarded-For.
+func forwardedFor(h http.Header) []string {
+	var chain []string
+	for _, v := range h.Values("Forwarded") {
+		for _, elem := range strings.Split(v, ",") {
+			for _, pair := range strings.Split(elem, ";") {
+				k, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+				if !ok || !strings.EqualFold(k, "for") {
+					continue
+				}
+				chain = append(chain, remoteIP(strings.Trim(val, `"`)))
+			}
+		}
+	}
+	if len(chain) > 0 {
+		return chain
+	}
+
+	for _, v := range h.Values("X-Forwarded-For") {
+		for _, addr := range strings.Split(v, ",") {
+			chain = append(chain, strings.TrimSpace(addr))
+		}
+	}
+	return chain
+}
+
+// remoteIP strips the port and the IPv6 brackets from an address.
+func remoteIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+}
+
+func isTrusted(addr string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
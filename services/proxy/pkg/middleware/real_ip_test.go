@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resolving the client IP", Label("RealIP"), func() {
+	var (
+		req         *http.Request
+		trustedNets []*net.IPNet
+	)
+
+	trusted := func(proxies ...string) {
+		nets, err := ParseTrustedProxies(proxies)
+		Expect(err).ToNot(HaveOccurred())
+		trustedNets = nets
+	}
+
+	BeforeEach(func() {
+		req = httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+		trustedNets = nil
+	})
+
+	Context("with an untrusted peer", func() {
+		BeforeEach(func() {
+			trusted("10.0.0.0/8")
+			req.RemoteAddr = "203.0.113.7:4711"
+		})
+
+		It("ignores a spoofed X-Forwarded-For header", func() {
+			req.Header.Set("X-Forwarded-For", "127.0.0.1")
+			Expect(ClientIP(req, trustedNets)).To(Equal("203.0.113.7"))
+		})
+
+		It("ignores a spoofed Forwarded header", func() {
+			req.Header.Set("Forwarded", "for=127.0.0.1")
+			Expect(ClientIP(req, trustedNets)).To(Equal("203.0.113.7"))
+		})
+
+		It("ignores a spoofed X-Real-IP header", func() {
+			req.Header.Set("X-Real-IP", "127.0.0.1")
+			Expect(ClientIP(req, trustedNets)).To(Equal("203.0.113.7"))
+		})
+	})
+
+	Context("with a trusted peer", func() {
+		BeforeEach(func() {
+			trusted("10.0.0.0/8", "192.0.2.1")
+			req.RemoteAddr = "10.1.2.3:4711"
+		})
+
+		It("uses the last untrusted address of the X-Forwarded-For chain", func() {
+			req.Header.Set("X-Forwarded-For", "127.0.0.1, 198.51.100.4, 192.0.2.1")
+			Expect(ClientIP(req, trustedNets)).To(Equal("198.51.100.4"))
+		})
+
+		It("combines multiple X-Forwarded-For headers", func() {
+			req.Header.Add("X-Forwarded-For", "198.51.100.4")
+			req.Header.Add("X-Forwarded-For", "10.0.0.2")
+			Expect(ClientIP(req, trustedNets)).To(Equal("198.51.100.4"))
+		})
+
+		It("prefers the Forwarded header", func() {
+			req.Header.Set("Forwarded", `for="[2001:db8::1]:4711";proto=https, for=10.0.0.2`)
+			req.Header.Set("X-Forwarded-For", "198.51.100.4")
+			Expect(ClientIP(req, trustedNets)).To(Equal("2001:db8::1"))
+		})
+
+		It("falls back to the X-Real-IP header", func() {
+			req.Header.Set("X-Real-IP", "198.51.100.4")
+			Expect(ClientIP(req, trustedNets)).To(Equal("198.51.100.4"))
+		})
+
+		It("stops at a malformed hop", func() {
+			req.Header.Set("X-Forwarded-For", "198.51.100.4, garbage, 10.0.0.2")
+			Expect(ClientIP(req, trustedNets)).To(Equal("10.0.0.2"))
+		})
+
+		It("uses the peer when there are no forwarding headers", func() {
+			Expect(ClientIP(req, trustedNets)).To(Equal("10.1.2.3"))
+		})
+	})
+
+	It("rewrites the remote address of the request", func() {
+		trusted("10.0.0.0/8")
+		req.RemoteAddr = "10.1.2.3:4711"
+		req.Header.Set("X-Forwarded-For", "198.51.100.4")
+
+		var remoteAddr string
+		RealIP(trustedNets)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remoteAddr = r.RemoteAddr
+		})).ServeHTTP(httptest.NewRecorder(), req)
+		Expect(remoteAddr).To(Equal("198.51.100.4"))
+	})
+
+	It("rejects invalid trusted proxies", func() {
+		_, err := ParseTrustedProxies([]string{"10.0.0.0/33"})
+		Expect(err).To(HaveOccurred())
+		_, err = ParseTrustedProxies([]string{"proxy.example.com"})
+		Expect(err).To(HaveOccurred())
+	})
+})
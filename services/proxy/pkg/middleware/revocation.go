@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	"github.com/pkg/errors"
+)
+
+// prefixes of the revocation entries
+const (
+	RevokedSubjectPrefix = "sub:"
+	RevokedTokenIDPrefix = "jti:"
+)
+
+// RevocationSource provides the entries of a revocation list. An entry is the subject or the token id (jti)
+// of revoked access tokens, prefixed with 'sub:' or 'jti:'.
+type RevocationSource interface {
+	Revocations(ctx context.Context) ([]string, error)
+}
+
+// FileRevocationSource reads the revocation list from a file with one entry per line.
+// Empty lines and lines starting with '#' are ignored.
+type FileRevocationSource struct {
+	Path string
+}
+
+// Revocations implements the RevocationSource interface.
+func (s FileRevocationSource) Revocations(_ context.Context) ([]string, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	return entries, scanner.Err()
+}
+
+// StoreRevocationSource reads the revocation list from the keys of the 'revocations' table of the store service.
+type StoreRevocationSource struct {
+	Store storesvc.StoreService
+}
+
+// Revocations implements the RevocationSource interface.
+func (s StoreRevocationSource) Revocations(ctx context.Context) ([]string, error) {
+	stream, err := s.Store.List(ctx, &storesvc.ListRequest{
+		Options: &storemsg.ListOptions{
+			Database: "proxy",
+			Table:    "revocations",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	var entries []string
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res.Keys...)
+	}
+}
+
+// timeouts of loading the revocation list
+const (
+	// revocationLoadTimeout limits the time spent on loading the list, the load is not canceled with the request
+	// which started it
+	revocationLoadTimeout = 10 * time.Second
+	// revocationRetryInterval is the time to wait before loading a list which was never loaded again
+	revocationRetryInterval = 2 * time.Second
+)
+
+// RevocationList caches the entries of a revocation source for the configured TTL.
+type RevocationList struct {
+	source RevocationSource
+	ttl    time.Duration
+	logger log.Logger
+
+	lock      sync.Mutex
+	revoked   map[string]struct{}
+	fetchedAt time.Time
+	retryAt   time.Time
+	loadErr   error
+	// loading is closed when the running load of the list finished, it is nil if no load is running
+	loading chan struct{}
+
+	// timeNow is used to mock the current time during tests
+	timeNow func() time.Time
+}
+
+// NewRevocationList returns a revocation list which reloads the entries of the source after the TTL.
+func NewRevocationList(source RevocationSource, ttl time.Duration, logger log.Logger) *RevocationList {
+	return &RevocationList{
+		source:  source,
+		ttl:     ttl,
+		logger:  logger,
+		timeNow: time.Now,
+	}
+}
+
+// IsRevoked checks if the subject or the token id were revoked. Empty values are never revoked.
+// The list is loaded once for all concurrent requests, which wait for it to finish.
+// When reloading the list fails, the last loaded list is used until the next attempt after the TTL.
+// It returns an error if the list was never loaded, the load is retried after a short interval then.
+func (l *RevocationList) IsRevoked(ctx context.Context, subject, tokenID string) (bool, error) {
+	l.lock.Lock()
+	if l.loading == nil && l.outdated(l.timeNow()) {
+		l.loading = make(chan struct{})
+		go l.load(l.loading)
+	}
+	loading := l.loading
+	l.lock.Unlock()
+
+	if loading != nil {
+		select {
+		case <-loading:
+		case <-ctx.Done():
+			return false, errors.Wrap(ctx.Err(), "failed to wait for the revocation list")
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.loadErr != nil {
+		return false, l.loadErr
+	}
+
+	if _, ok := l.revoked[RevokedSubjectPrefix+subject]; ok && subject != "" {
+		return true, nil
+	}
+	if _, ok := l.revoked[RevokedTokenIDPrefix+tokenID]; ok && tokenID != "" {
+		return true, nil
+	}
+	return false, nil
+}
+
+// outdated checks if the list needs to be loaded. The caller must hold the lock.
+func (l *RevocationList) outdated(now time.Time) bool {
+	if l.revoked == nil {
+		return !now.Before(l.retryAt)
+	}
+	return now.Sub(l.fetchedAt) >= l.ttl
+}
+
+// load loads the entries of the source and closes done afterwards.
+func (l *RevocationList) load(done chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), revocationLoadTimeout)
+	defer cancel()
+	entries, err := l.source.Revocations(ctx)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := l.timeNow()
+	switch {
+	case err != nil && l.revoked == nil:
+		retry := revocationRetryInterval
+		if l.ttl < retry {
+			retry = l.ttl
+		}
+		l.retryAt = now.Add(retry)
+		l.loadErr = errors.Wrap(err, "failed to load the revocation list")
+	case err != nil:
+		// failed reloads are not repeated before the TTL, so an unavailable source isn't hammered
+		l.fetchedAt = now
+		l.logger.Warn().Err(err).Msg("Failed to reload the revocation list, using the last loaded one")
+	default:
+		l.revoked = make(map[string]struct{}, len(entries))
+		for _, e := range entries {
+			l.revoked[e] = struct{}{}
+		}
+		l.fetchedAt = now
+		l.loadErr = nil
+	}
+	l.loading = nil
+	close(done)
+}
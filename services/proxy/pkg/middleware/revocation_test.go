@@ -0,0 +1,220 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	gOidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"golang.org/x/oauth2"
+)
+
+// revocationSourceFunc implements the RevocationSource interface with a function
+type revocationSourceFunc func(ctx context.Context) ([]string, error)
+
+func (f revocationSourceFunc) Revocations(ctx context.Context) ([]string, error) {
+	return f(ctx)
+}
+
+var _ = Describe("Rejecting revoked access tokens", Label("OIDCAuthenticator"), func() {
+	var (
+		authenticator *OIDCAuthenticator
+		idp           *httptest.Server
+		key           *rsa.PrivateKey
+		clock         *testClock
+		listFile      string
+	)
+
+	newToken := func(subject, id string) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{Issuer: idp.URL, Subject: subject, ID: id})
+		t.Header["kid"] = "test-key"
+		token, err := t.SignedString(key)
+		Expect(err).ToNot(HaveOccurred())
+		return token
+	}
+	authenticate := func(token string) bool {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		_, ok := authenticator.Authenticate(req)
+		return ok
+	}
+	revoke := func(entries ...string) {
+		Expect(os.WriteFile(listFile, []byte("# revoked tokens\n\n"+strings.Join(entries, "\n")), 0600)).To(Succeed())
+	}
+	useSource := func(source RevocationSource) {
+		authenticator.Revocations = NewRevocationList(source, 10*time.Second, log.NewLogger())
+		authenticator.Revocations.timeNow = clock.Now
+	}
+
+	BeforeEach(func() {
+		var err error
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":            idp.URL,
+				"userinfo_endpoint": idp.URL + "/userinfo",
+				"jwks_uri":          idp.URL + "/jwks",
+			})
+		})
+		mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kty": "RSA",
+					"kid": "test-key",
+					"use": "sig",
+					"alg": "RS256",
+					"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+				}},
+			})
+		})
+		mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+			var claims jwt.RegisteredClaims
+			_, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), &claims)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"sub": claims.Subject})
+		})
+		idp = httptest.NewServer(mux)
+
+		authenticator = NewOIDCAuthenticator(log.NewLogger(), 60, idp.Client(), idp.URL, func() (OIDCProvider, error) {
+			return gOidc.NewProvider(context.WithValue(context.Background(), oauth2.HTTPClient, idp.Client()), idp.URL)
+		}, config.JWKS{}, config.AccessTokenVerificationJWT, "", config.TokenExchange{})
+
+		clock = &testClock{now: time.Now()}
+		listFile = filepath.Join(GinkgoT().TempDir(), "revocations")
+		revoke()
+		useSource(FileRevocationSource{Path: listFile})
+	})
+
+	AfterEach(func() {
+		idp.Close()
+	})
+
+	It("rejects the tokens of a revoked subject after the cache TTL", func() {
+		einstein, marie := newToken("einstein", "1"), newToken("marie", "2")
+		Expect(authenticate(einstein)).To(BeTrue())
+		Expect(authenticate(marie)).To(BeTrue())
+
+		revoke("sub:einstein")
+		// the list is cached
+		Expect(authenticate(einstein)).To(BeTrue())
+
+		clock.Advance(11 * time.Second)
+		// the claims of the token are cached as well, the revocation is checked anyway
+		Expect(authenticate(einstein)).To(BeFalse())
+		Expect(authenticate(newToken("einstein", "3"))).To(BeFalse())
+		Expect(authenticate(marie)).To(BeTrue())
+
+		revoke()
+		clock.Advance(11 * time.Second)
+		Expect(authenticate(einstein)).To(BeTrue())
+	})
+
+	It("rejects tokens with a revoked token id", func() {
+		revoke("jti:stolen")
+
+		Expect(authenticate(newToken("einstein", "stolen"))).To(BeFalse())
+		Expect(authenticate(newToken("einstein", "fresh"))).To(BeTrue())
+	})
+
+	It("keeps the last loaded list when reloading fails", func() {
+		var fail bool
+		loads := 0
+		useSource(revocationSourceFunc(func(context.Context) ([]string, error) {
+			loads++
+			if fail {
+				return nil, errors.New("store unavailable")
+			}
+			return []string{"sub:einstein"}, nil
+		}))
+
+		Expect(authenticate(newToken("einstein", "1"))).To(BeFalse())
+		Expect(authenticate(newToken("marie", "2"))).To(BeTrue())
+		Expect(loads).To(Equal(1))
+
+		fail = true
+		clock.Advance(11 * time.Second)
+		Expect(authenticate(newToken("einstein", "1"))).To(BeFalse())
+		Expect(authenticate(newToken("marie", "2"))).To(BeTrue())
+		Expect(loads).To(Equal(2))
+	})
+
+	It("rejects all tokens until the list could be loaded", func() {
+		Expect(os.Remove(listFile)).To(Succeed())
+		useSource(FileRevocationSource{Path: listFile})
+
+		Expect(authenticate(newToken("marie", "2"))).To(BeFalse())
+
+		revoke()
+		Expect(authenticate(newToken("marie", "2"))).To(BeFalse())
+		// the load is retried before the TTL
+		clock.Advance(3 * time.Second)
+		Expect(authenticate(newToken("marie", "2"))).To(BeTrue())
+	})
+
+	It("loads the list once for concurrent requests, independent of their context", func() {
+		var loads int32
+		started, release := make(chan struct{}), make(chan struct{})
+		var loadCtxErr error
+		useSource(revocationSourceFunc(func(ctx context.Context) ([]string, error) {
+			if atomic.AddInt32(&loads, 1) == 1 {
+				close(started)
+			}
+			<-release
+			loadCtxErr = ctx.Err()
+			return []string{"sub:einstein"}, nil
+		}))
+
+		// the request which started the load goes away
+		ctx, cancel := context.WithCancel(context.Background())
+		canceled := make(chan error, 1)
+		go func() {
+			_, err := authenticator.Revocations.IsRevoked(ctx, "einstein", "1")
+			canceled <- err
+		}()
+		Eventually(started).Should(BeClosed())
+		cancel()
+		Eventually(canceled).Should(Receive(MatchError(ContainSubstring("context canceled"))))
+
+		results := make(chan bool, 4)
+		for i := 0; i < 4; i++ {
+			go func() {
+				defer GinkgoRecover()
+				revoked, err := authenticator.Revocations.IsRevoked(context.Background(), "einstein", "1")
+				Expect(err).ToNot(HaveOccurred())
+				results <- revoked
+			}()
+		}
+		Consistently(results, 100*time.Millisecond).ShouldNot(Receive())
+
+		close(release)
+		for i := 0; i < 4; i++ {
+			Eventually(results).Should(Receive(BeTrue()))
+		}
+		Expect(atomic.LoadInt32(&loads)).To(Equal(int32(1)))
+		Expect(loadCtxErr).ToNot(HaveOccurred())
+	})
+})
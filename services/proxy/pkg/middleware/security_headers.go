@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+// securityHeader is a header added to the responses of the authentication middleware.
+type securityHeader struct {
+	name  string
+	value string
+}
+
+// securityHeaderList returns the configured security headers, headers with an empty value are disabled.
+func securityHeaderList(c config.SecurityHeaders) []securityHeader {
+	headers := make([]securityHeader, 0, 3)
+	for _, h := range []securityHeader{
+		{"Strict-Transport-Security", c.StrictTransportSecurity},
+		{"X-Content-Type-Options", c.ContentTypeOptions},
+		{"Referrer-Policy", c.ReferrerPolicy},
+	} {
+		if h.value != "" {
+			headers = append(headers, h)
+		}
+	}
+	return headers
+}
+
+// securityHeadersWriter adds the security headers when the response header is written. Headers set by the
+// services or by an earlier handler take precedence, so they are never sent twice.
+type securityHeadersWriter struct {
+	http.ResponseWriter
+	headers     []securityHeader
+	wroteHeader bool
+}
+
+func (w *securityHeadersWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		h := w.ResponseWriter.Header()
+		for _, s := range w.headers {
+			if _, ok := h[s.name]; !ok {
+				h.Set(s.name, s.value)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *securityHeadersWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher, streamed responses are flushed by the reverse proxy.
+func (w *securityHeadersWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker, e.g. for websocket upgrades.
+func (w *securityHeadersWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("the response writer doesn't support hijacking")
+}
+
+// Unwrap returns the wrapped response writer for http.ResponseController.
+func (w *securityHeadersWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
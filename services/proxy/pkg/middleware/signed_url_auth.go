@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/hex"
 	"errors"
@@ -26,6 +27,7 @@ const (
 	_paramOCDate       = "OC-Date"
 	_paramOCExpires    = "OC-Expires"
 	_paramOCVerb       = "OC-Verb"
+	_paramOCNonce      = "OC-Nonce"
 )
 
 var (
@@ -44,6 +46,8 @@ type SignedURLAuthenticator struct {
 	PreSignedURLConfig config.PreSignedURL
 	UserProvider       backend.UserBackend
 	Store              storesvc.StoreService
+	// Nonces enables the replay protection, signed URLs need a nonce and are only accepted once then
+	Nonces NonceStore
 }
 
 func (m SignedURLAuthenticator) shouldServe(req *http.Request) bool {
@@ -76,6 +80,11 @@ func (m SignedURLAuthenticator) validate(req *http.Request) (err error) {
 		return err
 	}
 
+	// the nonce is only recorded for valid URLs, so invalid requests can't use it up
+	if ok, err := m.nonceIsUnused(req.Context(), query); !ok {
+		return err
+	}
+
 	return nil
 }
 
@@ -138,6 +147,37 @@ func (m SignedURLAuthenticator) urlIsExpired(query url.Values, now func() time.T
 	return !(now().After(validFrom) && now().Before(validTo)), nil
 }
 
+func (m SignedURLAuthenticator) nonceIsUnused(ctx context.Context, query url.Values) (ok bool, err error) {
+	// the nonce is part of the signed URL, so it can't be replaced without invalidating the signature
+	if m.Nonces == nil {
+		return true, nil
+	}
+	nonce := query.Get(_paramOCNonce)
+	if nonce == "" {
+		return false, fmt.Errorf("required %s parameter not found", _paramOCNonce)
+	}
+	validFrom, err := time.Parse(time.RFC3339, query.Get(_paramOCDate))
+	if err != nil {
+		return false, err
+	}
+	expiry, err := time.ParseDuration(query.Get(_paramOCExpires) + "s")
+	if err != nil {
+		return false, err
+	}
+
+	// nonces are only unique per user, the hash can be used as a key of the store
+	sum := sha256.Sum256([]byte(query.Get(_paramOCCredential) + "\x00" + nonce))
+	unused, err := m.Nonces.Use(ctx, hex.EncodeToString(sum[:]), validFrom.Add(expiry))
+	if err != nil {
+		m.Logger.Error().Err(err).Msg("could not record the nonce of the signed url")
+		return false, err
+	}
+	if !unused {
+		return false, errors.New("the signed url has already been used")
+	}
+	return true, nil
+}
+
 func (m SignedURLAuthenticator) signatureIsValid(req *http.Request) (ok bool, err error) {
 	u := revactx.ContextMustGetUser(req.Context())
 	signingKey, err := m.getSigningKey(req.Context(), u.Id.OpaqueId)
@@ -186,6 +226,11 @@ func (m SignedURLAuthenticator) getSigningKey(ctx context.Context, ocisID string
 	return res.Records[0].Value, nil
 }
 
+// Name implements the authenticator interface.
+func (SignedURLAuthenticator) Name() string {
+	return "signed_url"
+}
+
 // Authenticate implements the authenticator interface to authenticate requests via signed URL auth.
 func (m SignedURLAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
 	if !m.shouldServe(r) {
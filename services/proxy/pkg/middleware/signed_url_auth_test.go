@@ -1,9 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"net/http/httptest"
 	"testing"
 	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	"go-micro.dev/v4/client"
 )
 
 func TestSignedURLAuth_shouldServe(t *testing.T) {
@@ -134,3 +139,87 @@ func TestSignedURLAuth_createSignature(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestSignedURLAuth_nonceIsUnused(t *testing.T) {
+	nonces := NewMemoryNonceStore()
+	pua := SignedURLAuthenticator{Logger: log.NopLogger(), Nonces: nonces}
+	// expired nonces are forgotten, the url has to be valid
+	baseURL := "https://example.com/example.jpg?OC-Date=" + time.Now().UTC().Format(time.RFC3339) + "&OC-Expires=60&"
+
+	tests := []struct {
+		params   string
+		expected bool
+	}{
+		{"OC-Credential=alice&OC-Nonce=abc", true},
+		{"OC-Credential=alice&OC-Nonce=abc", false},
+		{"OC-Credential=alice&OC-Nonce=def", true},
+		{"OC-Credential=bob&OC-Nonce=abc", true},
+		{"OC-Credential=alice", false},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest("", baseURL+tt.params, nil)
+		unused, _ := pua.nonceIsUnused(context.Background(), r.URL.Query())
+		if unused != tt.expected {
+			t.Errorf("with %s expected %t got %t", tt.params, tt.expected, unused)
+		}
+	}
+
+	pua.Nonces = nil
+	r := httptest.NewRequest("", baseURL+"OC-Credential=alice", nil)
+	if unused, _ := pua.nonceIsUnused(context.Background(), r.URL.Query()); !unused {
+		t.Error("expected the nonce to be ignored without replay protection")
+	}
+}
+
+func TestMemoryNonceStore_forgetsExpiredNonces(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2020-02-02T12:30:00.000Z")
+	nonces := NewMemoryNonceStore()
+	nonces.timeNow = func() time.Time { return now }
+
+	if unused, _ := nonces.Use(context.Background(), "abc", now.Add(time.Minute)); !unused {
+		t.Fatal("expected the first use to succeed")
+	}
+	if unused, _ := nonces.Use(context.Background(), "abc", now.Add(time.Minute)); unused {
+		t.Fatal("expected the second use to be rejected")
+	}
+
+	now = now.Add(time.Minute)
+	if unused, _ := nonces.Use(context.Background(), "def", now.Add(time.Minute)); !unused {
+		t.Fatal("expected the first use to succeed")
+	}
+	if len(nonces.nonces) != 1 {
+		t.Errorf("expected the expired nonce to be removed, got %d nonces", len(nonces.nonces))
+	}
+}
+
+// appendingStore records the elements appended by the StoreNonceStore and the expiry of the records
+type appendingStore struct {
+	storesvc.StoreService
+	records map[string]time.Duration
+}
+
+func (s appendingStore) AppendElements(_ context.Context, req *storesvc.AppendElementsRequest, _ ...client.CallOption) (*storesvc.AppendElementsResponse, error) {
+	key := req.Options.Database + "/" + req.Options.Table + "/" + req.Key
+	if _, ok := s.records[key]; ok {
+		return &storesvc.AppendElementsResponse{Value: []byte("[true]")}, nil
+	}
+	s.records[key] = time.Duration(req.Expiry)
+	return &storesvc.AppendElementsResponse{Value: []byte("[true]"), Changed: 1}, nil
+}
+
+func TestStoreNonceStore_Use(t *testing.T) {
+	store := appendingStore{records: map[string]time.Duration{}}
+	nonces := StoreNonceStore{Store: store}
+
+	if unused, err := nonces.Use(context.Background(), "abc", time.Now().Add(time.Minute)); err != nil || !unused {
+		t.Fatalf("expected the first use to succeed, got %t, %v", unused, err)
+	}
+	if unused, err := nonces.Use(context.Background(), "abc", time.Now().Add(time.Minute)); err != nil || unused {
+		t.Fatalf("expected the second use to be rejected, got %t, %v", unused, err)
+	}
+	// the record expires with the URL, so the store removes it afterwards
+	if expiry := store.records["proxy/signed-url-nonces/abc"]; expiry <= 0 || expiry > time.Minute {
+		t.Errorf("expected the nonce to expire with the URL, got an expiry of %s", expiry)
+	}
+}
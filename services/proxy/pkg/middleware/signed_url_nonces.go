@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+)
+
+// NonceStore records the nonces of signed URLs, so replayed URLs can be rejected.
+type NonceStore interface {
+	// Use records the nonce and returns false if it has been used before. The nonce only has to be
+	// remembered until the URL expires.
+	Use(ctx context.Context, nonce string, expires time.Time) (bool, error)
+}
+
+// MemoryNonceStore remembers the used nonces in memory until they expire.
+type MemoryNonceStore struct {
+	lock   sync.Mutex
+	nonces map[string]time.Time
+
+	// timeNow is used to mock the current time during tests
+	timeNow func() time.Time
+}
+
+// NewMemoryNonceStore returns an empty in-memory nonce store.
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{
+		nonces:  map[string]time.Time{},
+		timeNow: time.Now,
+	}
+}
+
+// Use implements the NonceStore interface.
+func (s *MemoryNonceStore) Use(_ context.Context, nonce string, expires time.Time) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.timeNow()
+	// expired nonces are removed on every use, so the set only contains the nonces of valid URLs
+	for n, e := range s.nonces {
+		if !now.Before(e) {
+			delete(s.nonces, n)
+		}
+	}
+	if _, ok := s.nonces[nonce]; ok {
+		return false, nil
+	}
+	s.nonces[nonce] = expires
+	return true, nil
+}
+
+// StoreNonceStore records the used nonces in the 'signed-url-nonces' table of the store service, so they are
+// shared by all proxies. The nonces are recorded atomically by the store, a nonce is only accepted once even
+// if it is used at the same time.
+type StoreNonceStore struct {
+	Store storesvc.StoreService
+}
+
+// Use implements the NonceStore interface.
+func (s StoreNonceStore) Use(ctx context.Context, nonce string, expires time.Time) (bool, error) {
+	ttl := time.Until(expires)
+	if ttl <= 0 {
+		// the URL expired while it was checked, there is nothing left to protect
+		ttl = time.Nanosecond
+	}
+	// a unique append only changes the record the first time, the store removes the record once the URL expired
+	res, err := s.Store.AppendElements(ctx, &storesvc.AppendElementsRequest{
+		Key:      nonce,
+		Elements: [][]byte{[]byte("true")},
+		Unique:   true,
+		Expiry:   int64(ttl),
+		Options: &storemsg.WriteOptions{
+			Database: "proxy",
+			Table:    "signed-url-nonces",
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Changed > 0, nil
+}
@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+// strategies of the authentication challenges
+const (
+	StrategyBearer    = "bearer"
+	StrategyBasic     = "basic"
+	StrategySignedURL = "signed-url"
+	StrategyMTLS      = "mtls"
+)
+
+// Tenant is the authentication configuration of a tenant.
+type Tenant struct {
+	Name string
+	// Realm of the challenges, the host of the request if empty
+	Realm string
+	// Strategies are the challenges sent to unauthenticated clients
+	Strategies []string
+	// Authenticators are run for the requests of the tenant
+	Authenticators []Authenticator
+}
+
+// TenantResolver selects the tenant of a request by a header or by the host of the request.
+type TenantResolver struct {
+	header   string
+	byName   map[string]*Tenant
+	byHost   map[string]*Tenant
+	fallback *Tenant
+}
+
+// ValidateTenants checks the tenant configuration.
+func ValidateTenants(cfg config.Tenants) error {
+	names := make(map[string]struct{}, len(cfg.Tenants))
+	hosts := map[string]string{}
+	for _, t := range cfg.Tenants {
+		if t.Name == "" {
+			return fmt.Errorf("a tenant has no name")
+		}
+		if _, ok := names[t.Name]; ok {
+			return fmt.Errorf("duplicate tenant %s", t.Name)
+		}
+		names[t.Name] = struct{}{}
+		if t.Issuer == "" {
+			return fmt.Errorf("tenant %s has no issuer", t.Name)
+		}
+		if cfg.Header == "" && len(t.Hosts) == 0 {
+			return fmt.Errorf("tenant %s has no hosts, tenants are selected by host if no header is configured", t.Name)
+		}
+		for _, h := range t.Hosts {
+			h = strings.ToLower(h)
+			if other, ok := hosts[h]; ok {
+				return fmt.Errorf("host %s is used by the tenants %s and %s", h, other, t.Name)
+			}
+			hosts[h] = t.Name
+		}
+		for _, s := range t.Strategies {
+			if s != StrategyBearer && s != StrategyBasic {
+				return fmt.Errorf("unknown strategy '%s' of tenant %s, use '%s' or '%s'", s, t.Name, StrategyBearer, StrategyBasic)
+			}
+		}
+	}
+	if _, ok := names[cfg.Default]; cfg.Default != "" && !ok {
+		return fmt.Errorf("the default tenant %s doesn't exist", cfg.Default)
+	}
+	return nil
+}
+
+// NewTenantResolver creates a resolver for the configured tenants. The authenticators of a tenant are created
+// by the given function, the strategies of the tenant passed to it default to 'bearer'.
+func NewTenantResolver(cfg config.Tenants, authenticators func(config.Tenant) []Authenticator) (*TenantResolver, error) {
+	if err := ValidateTenants(cfg); err != nil {
+		return nil, err
+	}
+	tr := &TenantResolver{
+		header: cfg.Header,
+		byName: make(map[string]*Tenant, len(cfg.Tenants)),
+		byHost: map[string]*Tenant{},
+	}
+	for _, t := range cfg.Tenants {
+		if len(t.Strategies) == 0 {
+			t.Strategies = []string{StrategyBearer}
+		}
+		tenant := &Tenant{
+			Name:           t.Name,
+			Realm:          t.Realm,
+			Strategies:     t.Strategies,
+			Authenticators: authenticators(t),
+		}
+		tr.byName[t.Name] = tenant
+		for _, h := range t.Hosts {
+			tr.byHost[strings.ToLower(h)] = tenant
+		}
+	}
+	tr.fallback = tr.byName[cfg.Default]
+	return tr, nil
+}
+
+// Resolve returns the tenant of the request or the default tenant. It returns false if the tenant is
+// unknown and there is no default tenant.
+func (tr *TenantResolver) Resolve(r *http.Request) (*Tenant, bool) {
+	var t *Tenant
+	if tr.header != "" {
+		t = tr.byName[r.Header.Get(tr.header)]
+	} else {
+		t = tr.byHost[strings.ToLower(hostname(r.Host))]
+	}
+	if t == nil {
+		t = tr.fallback
+	}
+	return t, t != nil
+}
+
+// hostname strips the port from the host of a request.
+func hostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
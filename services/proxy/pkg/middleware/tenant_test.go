@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	gOidc "github.com/coreos/go-oidc/v3/oidc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("tenant routing", func() {
+	var (
+		idps       map[string]*httptest.Server
+		claims     *map[string]interface{}
+		tenants    config.Tenants
+		newHandler func() http.Handler
+	)
+
+	// newIDP creates an IDP stub which only accepts access tokens with its prefix
+	newIDP := func(prefix string) *httptest.Server {
+		var idp *httptest.Server
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":            idp.URL,
+				"userinfo_endpoint": idp.URL + "/userinfo",
+			})
+		})
+		mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !strings.HasPrefix(token, prefix) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"sub": token})
+		})
+		idp = httptest.NewServer(mux)
+		return idp
+	}
+
+	BeforeEach(func() {
+		idps = map[string]*httptest.Server{
+			"a": newIDP("a-"),
+			"b": newIDP("b-"),
+		}
+		tenants = config.Tenants{
+			Tenants: []config.Tenant{
+				{Name: "a", Hosts: []string{"a.example.com"}, Issuer: idps["a"].URL, Realm: "Tenant A"},
+				{Name: "b", Hosts: []string{"B.example.com"}, Issuer: idps["b"].URL},
+			},
+		}
+		claims = nil
+
+		newHandler = func() http.Handler {
+			tr, err := NewTenantResolver(tenants, func(t config.Tenant) []Authenticator {
+				idp := idps[t.Name]
+				return []Authenticator{NewOIDCAuthenticator(log.NewLogger(), 0, idp.Client(), t.Issuer, func() (OIDCProvider, error) {
+					return gOidc.NewProvider(context.WithValue(context.Background(), oauth2.HTTPClient, idp.Client()), t.Issuer)
+				}, config.JWKS{}, config.AccessTokenVerificationNone, "", config.TokenExchange{})}
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return Authentication(nil, Tenants(tr))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c := oidc.FromContext(r.Context())
+				claims = &c
+			}))
+		}
+
+		DeferCleanup(func() {
+			for _, idp := range idps {
+				idp.Close()
+			}
+		})
+	})
+
+	serve := func(handler http.Handler, host, token string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "https://"+host+"/remote.php/dav/files/einstein", nil)
+		req = req.WithContext(router.SetRoutingInfo(req.Context(), router.RoutingInfo{}))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("authenticates the requests of each host with the issuer of its tenant", func() {
+		handler := newHandler()
+
+		rec := serve(handler, "a.example.com", "a-einstein", nil)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(*claims).To(HaveKeyWithValue(oidc.Sub, "a-einstein"))
+
+		rec = serve(handler, "b.example.com:9200", "b-marie", nil)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(*claims).To(HaveKeyWithValue(oidc.Sub, "b-marie"))
+	})
+
+	It("rejects tokens of the issuers of other tenants", func() {
+		handler := newHandler()
+
+		rec := serve(handler, "b.example.com", "a-einstein", nil)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Values(WwwAuthenticate)).To(ConsistOf(`Bearer realm="b.example.com", charset="UTF-8"`))
+
+		rec = serve(handler, "a.example.com", "b-marie", nil)
+		Expect(rec.Code).To(Equal(http.StatusUnauthorized))
+		Expect(rec.Header().Values(WwwAuthenticate)).To(ConsistOf(`Bearer realm="Tenant A", charset="UTF-8"`))
+	})
+
+	It("rejects requests of unknown tenants", func() {
+		handler := newHandler()
+
+		rec := serve(handler, "c.example.com", "a-einstein", nil)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(claims).To(BeNil())
+	})
+
+	It("uses the default tenant for unknown tenants", func() {
+		tenants.Default = "b"
+		handler := newHandler()
+
+		rec := serve(handler, "c.example.com", "b-marie", nil)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(*claims).To(HaveKeyWithValue(oidc.Sub, "b-marie"))
+	})
+
+	It("selects the tenant by the configured header", func() {
+		tenants.Header = "X-Tenant"
+		handler := newHandler()
+
+		rec := serve(handler, "a.example.com", "b-marie", map[string]string{"X-Tenant": "b"})
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(*claims).To(HaveKeyWithValue(oidc.Sub, "b-marie"))
+
+		// the host is ignored
+		rec = serve(handler, "a.example.com", "a-einstein", nil)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	DescribeTable("validating the tenants",
+		func(cfg config.Tenants, valid bool) {
+			err := ValidateTenants(cfg)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("no tenants", config.Tenants{}, true),
+		Entry("header without hosts", config.Tenants{Header: "X-Tenant", Tenants: []config.Tenant{{Name: "a", Issuer: "https://a"}}}, true),
+		Entry("missing name", config.Tenants{Tenants: []config.Tenant{{Hosts: []string{"a"}, Issuer: "https://a"}}}, false),
+		Entry("missing issuer", config.Tenants{Tenants: []config.Tenant{{Name: "a", Hosts: []string{"a"}}}}, false),
+		Entry("missing hosts", config.Tenants{Tenants: []config.Tenant{{Name: "a", Issuer: "https://a"}}}, false),
+		Entry("duplicate name", config.Tenants{Tenants: []config.Tenant{
+			{Name: "a", Hosts: []string{"a"}, Issuer: "https://a"},
+			{Name: "a", Hosts: []string{"b"}, Issuer: "https://b"},
+		}}, false),
+		Entry("duplicate host", config.Tenants{Tenants: []config.Tenant{
+			{Name: "a", Hosts: []string{"a"}, Issuer: "https://a"},
+			{Name: "b", Hosts: []string{"A"}, Issuer: "https://b"},
+		}}, false),
+		Entry("unknown strategy", config.Tenants{Tenants: []config.Tenant{{Name: "a", Hosts: []string{"a"}, Issuer: "https://a", Strategies: []string{"negotiate"}}}}, false),
+		Entry("unknown default", config.Tenants{Default: "b", Tenants: []config.Tenant{{Name: "a", Hosts: []string{"a"}, Issuer: "https://a"}}}, false),
+	)
+})
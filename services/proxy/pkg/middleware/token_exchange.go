@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/pkg/errors"
+)
+
+const (
+	_grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	_tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+
+	// _tokenExchangeExpiryLeeway makes sure cached tokens don't expire on their way to the services
+	_tokenExchangeExpiryLeeway = 10 * time.Second
+)
+
+type tokenEndpointJSON struct {
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+type tokenExchangeResponse struct {
+	AccessToken      string `json:"access_token"`
+	IssuedTokenType  string `json:"issued_token_type"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchangeToken exchanges the verified access token at the IDP for a token issued for the configured
+// audience (RFC 8693). Exchanged tokens are cached by subject and audience until they expire.
+func (m *OIDCAuthenticator) exchangeToken(ctx context.Context, token string, claims map[string]interface{}) (string, error) {
+	sub, _ := claims[oidc.Sub].(string)
+	key := sub + "\x00" + m.TokenExchange.Audience
+	if sub != "" {
+		if hit := m.exchangeCache.Load(key); hit != nil {
+			if exchanged, ok := hit.V.(string); ok {
+				return exchanged, nil
+			}
+		}
+	}
+
+	endpoint, err := m.getTokenEndpoint()
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":           {_grantTypeTokenExchange},
+		"subject_token":        {token},
+		"subject_token_type":   {_tokenTypeAccessToken},
+		"requested_token_type": {_tokenTypeAccessToken},
+	}
+	if m.TokenExchange.Audience != "" {
+		form.Set("audience", m.TokenExchange.Audience)
+	}
+	if m.TokenExchange.Scope != "" {
+		form.Set("scope", m.TokenExchange.Scope)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the token exchange request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(m.TokenExchange.ClientID), url.QueryEscape(m.TokenExchange.ClientSecret))
+
+	resp, err := m.HTTPClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to request the token exchange")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to read the token exchange response body")
+	}
+
+	var res tokenExchangeResponse
+	if err := json.Unmarshal(body, &res); err != nil && resp.StatusCode == http.StatusOK {
+		return "", errors.Wrap(err, "failed to decode the token exchange response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		if res.Error != "" {
+			return "", errors.Errorf("token exchange rejected: %s: %s", res.Error, res.ErrorDescription)
+		}
+		return "", errors.Errorf("error requesting the token exchange: %s", resp.Status)
+	}
+	if res.AccessToken == "" {
+		return "", errors.New("token exchange response contains no access token")
+	}
+	if res.IssuedTokenType != "" && res.IssuedTokenType != _tokenTypeAccessToken {
+		return "", errors.Errorf("unexpected issued token type '%s'", res.IssuedTokenType)
+	}
+
+	// tokens without a lifetime are not cached, the IDP may revoke them at any time
+	if sub != "" && res.ExpiresIn > 0 {
+		expiration := time.Now().Add(time.Duration(res.ExpiresIn)*time.Second - _tokenExchangeExpiryLeeway)
+		m.exchangeCache.Store(key, res.AccessToken, expiration)
+	}
+	m.Logger.Debug().Str("sub", sub).Str("audience", m.TokenExchange.Audience).Int64("expires_in", res.ExpiresIn).Msg("exchanged access token")
+	return res.AccessToken, nil
+}
+
+// getTokenEndpoint returns the configured token endpoint or discovers it on first use.
+func (m *OIDCAuthenticator) getTokenEndpoint() (string, error) {
+	if m.TokenExchange.TokenEndpoint != "" {
+		return m.TokenExchange.TokenEndpoint, nil
+	}
+
+	m.tokenEndpointLock.Lock()
+	defer m.tokenEndpointLock.Unlock()
+	if m.tokenEndpoint != "" {
+		return m.tokenEndpoint, nil
+	}
+
+	var j tokenEndpointJSON
+	if err := m.fetchDiscovery(&j); err != nil {
+		return "", err
+	}
+	if j.TokenEndpoint == "" {
+		return "", errors.New("the IDP doesn't announce a token_endpoint")
+	}
+	m.Logger.Debug().Str("token_endpoint", j.TokenEndpoint).Msg("discovered token endpoint")
+	m.tokenEndpoint = j.TokenEndpoint
+	return m.tokenEndpoint, nil
+}
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	gOidc "github.com/coreos/go-oidc/v3/oidc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("Exchanging the access token", Label("OIDCAuthenticator"), func() {
+	var (
+		authenticator *OIDCAuthenticator
+		idp           *httptest.Server
+
+		l         sync.Mutex
+		exchanges []url.Values
+		expiresIn int64
+		failing   bool
+	)
+
+	newRequest := func(token string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "https://cloud.example.com/graph/v1.0/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		return req
+	}
+
+	exchanged := func() []url.Values {
+		l.Lock()
+		defer l.Unlock()
+		return exchanges
+	}
+
+	BeforeEach(func() {
+		exchanges = nil
+		expiresIn = 300
+		failing = false
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":            idp.URL,
+				"userinfo_endpoint": idp.URL + "/userinfo",
+				"token_endpoint":    idp.URL + "/token",
+			})
+		})
+		mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+			// the stub uses the access token as subject
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"sub": strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+			})
+		})
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			l.Lock()
+			defer l.Unlock()
+			if id, secret, ok := r.BasicAuth(); !ok || id != "ocis-proxy" || secret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			Expect(r.ParseForm()).To(Succeed())
+			exchanges = append(exchanges, r.PostForm)
+
+			w.Header().Set("Content-Type", "application/json")
+			if failing {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid_target","error_description":"unknown audience"}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":      "exchanged-" + r.PostForm.Get("subject_token"),
+				"issued_token_type": _tokenTypeAccessToken,
+				"token_type":        "Bearer",
+				"expires_in":        expiresIn,
+			})
+		})
+		idp = httptest.NewServer(mux)
+
+		authenticator = NewOIDCAuthenticator(log.NewLogger(), 0, idp.Client(), idp.URL, func() (OIDCProvider, error) {
+			return gOidc.NewProvider(context.WithValue(context.Background(), oauth2.HTTPClient, idp.Client()), idp.URL)
+		}, config.JWKS{}, config.AccessTokenVerificationNone, "", config.TokenExchange{
+			Enabled:      true,
+			ClientID:     "ocis-proxy",
+			ClientSecret: "secret",
+			Audience:     "ocis",
+			Scope:        "openid profile",
+			CacheSize:    16,
+		})
+	})
+
+	AfterEach(func() {
+		idp.Close()
+	})
+
+	It("forwards the exchanged token", func() {
+		authenticated, ok := authenticator.Authenticate(newRequest("einstein"))
+		Expect(ok).To(BeTrue())
+		Expect(authenticated.Header.Get("Authorization")).To(Equal("Bearer exchanged-einstein"))
+
+		Expect(exchanged()).To(HaveLen(1))
+		form := exchanged()[0]
+		Expect(form.Get("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:token-exchange"))
+		Expect(form.Get("subject_token")).To(Equal("einstein"))
+		Expect(form.Get("subject_token_type")).To(Equal("urn:ietf:params:oauth:token-type:access_token"))
+		Expect(form.Get("requested_token_type")).To(Equal("urn:ietf:params:oauth:token-type:access_token"))
+		Expect(form.Get("audience")).To(Equal("ocis"))
+		Expect(form.Get("scope")).To(Equal("openid profile"))
+	})
+
+	It("caches the exchanged token by subject", func() {
+		for i := 0; i < 3; i++ {
+			authenticated, ok := authenticator.Authenticate(newRequest("einstein"))
+			Expect(ok).To(BeTrue())
+			Expect(authenticated.Header.Get("Authorization")).To(Equal("Bearer exchanged-einstein"))
+		}
+		Expect(exchanged()).To(HaveLen(1))
+
+		authenticated, ok := authenticator.Authenticate(newRequest("marie"))
+		Expect(ok).To(BeTrue())
+		Expect(authenticated.Header.Get("Authorization")).To(Equal("Bearer exchanged-marie"))
+		Expect(exchanged()).To(HaveLen(2))
+	})
+
+	It("doesn't cache tokens without a lifetime", func() {
+		l.Lock()
+		expiresIn = 0
+		l.Unlock()
+		for i := 0; i < 2; i++ {
+			_, ok := authenticator.Authenticate(newRequest("einstein"))
+			Expect(ok).To(BeTrue())
+		}
+		Expect(exchanged()).To(HaveLen(2))
+	})
+
+	It("rejects the request when the exchange fails", func() {
+		l.Lock()
+		failing = true
+		l.Unlock()
+		_, ok := authenticator.Authenticate(newRequest("einstein"))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("uses the configured token endpoint", func() {
+		authenticator.TokenExchange.TokenEndpoint = idp.URL + "/other"
+		_, ok := authenticator.Authenticate(newRequest("einstein"))
+		Expect(ok).To(BeFalse())
+		Expect(exchanged()).To(BeEmpty())
+	})
+
+	It("forwards the original token when disabled", func() {
+		authenticator.TokenExchange.Enabled = false
+		authenticated, ok := authenticator.Authenticate(newRequest("einstein"))
+		Expect(ok).To(BeTrue())
+		Expect(authenticated.Header.Get("Authorization")).To(Equal("Bearer einstein"))
+		Expect(exchanged()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+const _headerWebSocketProtocol = "Sec-WebSocket-Protocol"
+
+// isWebSocketUpgrade checks if the request asks to upgrade the connection to a WebSocket.
+func isWebSocketUpgrade(r *http.Request) bool {
+	return headerContainsToken(r.Header, "Connection", "upgrade") && strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// headerContainsToken checks if one of the comma separated values of the header is the token.
+func headerContainsToken(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// useWebSocketToken moves the token of a WebSocket upgrade request from the query or the subprotocols to the
+// Authorization header, where the authenticators expect it. The token is removed from its original place, so it
+// is neither passed on to the services nor logged with the URL. Requests with an Authorization header are left as
+// they are.
+func useWebSocketToken(r *http.Request, c config.WebSocketAuth) {
+	if r.Header.Get(_headerAuthorization) != "" {
+		return
+	}
+	if c.TokenQueryParameter != "" {
+		q := r.URL.Query()
+		if token := q.Get(c.TokenQueryParameter); token != "" {
+			q.Del(c.TokenQueryParameter)
+			u := *r.URL
+			u.RawQuery = q.Encode()
+			r.URL = &u
+			r.RequestURI = u.RequestURI()
+			r.Header.Set(_headerAuthorization, _bearerPrefix+token)
+			return
+		}
+	}
+	if c.TokenSubprotocolPrefix != "" {
+		var token string
+		protocols := make([]string, 0, 2)
+		for _, v := range r.Header.Values(_headerWebSocketProtocol) {
+			for _, p := range strings.Split(v, ",") {
+				p = strings.TrimSpace(p)
+				if t := strings.TrimPrefix(p, c.TokenSubprotocolPrefix); token == "" && t != p && t != "" {
+					token = t
+					continue
+				}
+				if p != "" {
+					protocols = append(protocols, p)
+				}
+			}
+		}
+		if token == "" {
+			return
+		}
+		if len(protocols) == 0 {
+			r.Header.Del(_headerWebSocketProtocol)
+		} else {
+			r.Header.Set(_headerWebSocketProtocol, strings.Join(protocols, ", "))
+		}
+		r.Header.Set(_headerAuthorization, _bearerPrefix+token)
+	}
+}
+
+// writeWebSocketUnauthorized rejects an unauthenticated upgrade request. The connection is not upgraded and closed
+// after the response, browsers neither show login dialogs nor error pages for WebSockets, so there is no redirect.
+func writeWebSocketUnauthorized(w http.ResponseWriter, strategies []string, realm string) {
+	writeSupportedAuthenticateHeader(w, strategies, realm)
+	w.Header().Set("Connection", "close")
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
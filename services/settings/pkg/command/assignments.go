@@ -0,0 +1,60 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
+	ogrpc "github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config/parser"
+	"github.com/urfave/cli/v2"
+	"go-micro.dev/v4/metadata"
+)
+
+// RebuildAssignmentIndex rebuilds the role assignment index of the running service instances.
+func RebuildAssignmentIndex(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:     "rebuild-assignment-index",
+		Usage:    "read the stored role assignments again and rebuild the index of the running service",
+		Category: "maintenance",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "account-uuid",
+				Usage: "uuid of the account with the role management permission running the rebuild, defaults to the admin user",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			err := ogrpc.Configure(ogrpc.GetClientOptions(cfg.GRPCClientTLS)...)
+			if err != nil {
+				return err
+			}
+
+			accountUUID := c.String("account-uuid")
+			if accountUUID == "" {
+				accountUUID = cfg.AdminUserID
+			}
+			if accountUUID == "" {
+				return fmt.Errorf("the account-uuid is required if no admin user is configured")
+			}
+
+			ctx := metadata.Set(c.Context, middleware.AccountID, accountUUID)
+			client := settingssvc.NewRoleService(cfg.GRPC.Namespace+"."+cfg.Service.Name, ogrpc.DefaultClient())
+			res, err := client.RebuildAssignmentIndex(ctx, &settingssvc.RebuildAssignmentIndexRequest{})
+			if err != nil {
+				return fmt.Errorf("could not rebuild the role assignment index: %w", err)
+			}
+
+			fmt.Printf("Accounts: %d\n", res.Accounts)
+			fmt.Printf("Assignments: %d\n", res.Assignments)
+			fmt.Printf("Added: %d\n", res.Added)
+			fmt.Printf("Removed: %d\n", res.Removed)
+			fmt.Printf("Invalid: %d\n", res.Invalid)
+			return nil
+		},
+	}
+}
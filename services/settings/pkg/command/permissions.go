@@ -0,0 +1,97 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	tw "github.com/olekukonko/tablewriter"
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
+	ogrpc "github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config/parser"
+	"github.com/urfave/cli/v2"
+	"go-micro.dev/v4/metadata"
+)
+
+// Permissions prints the effective permissions of an account on a setting, as evaluated by the running service.
+func Permissions(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:     "permissions",
+		Usage:    "print the effective permissions of an account on a setting and the roles granting them",
+		Category: "interaction",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "account-uuid",
+				Usage:    "uuid of the account",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "setting-id",
+				Usage:    "id of the setting",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "as",
+				Usage: "uuid of the account with the settings management permission asking for the permissions, defaults to the admin user",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			err := ogrpc.Configure(ogrpc.GetClientOptions(cfg.GRPCClientTLS)...)
+			if err != nil {
+				return err
+			}
+
+			asAccountUUID := c.String("as")
+			if asAccountUUID == "" {
+				asAccountUUID = cfg.AdminUserID
+			}
+			if asAccountUUID == "" {
+				return fmt.Errorf("the as flag is required if no admin user is configured")
+			}
+
+			ctx := metadata.Set(c.Context, middleware.AccountID, asAccountUUID)
+			client := settingssvc.NewPermissionService(cfg.GRPC.Namespace+"."+cfg.Service.Name, ogrpc.DefaultClient())
+			e, err := client.ExplainPermission(ctx, &settingssvc.ExplainPermissionRequest{
+				AccountUuid: c.String("account-uuid"),
+				SettingId:   c.String("setting-id"),
+			})
+			if err != nil {
+				return fmt.Errorf("could not evaluate the permissions: %w", err)
+			}
+
+			fmt.Printf("Account: %s\n", e.AccountUuid)
+			fmt.Printf("Setting: %s (%s) in bundle %s (%s)\n", e.Setting.Name, e.Setting.Id, e.Bundle.Name, e.Bundle.Id)
+			fmt.Printf("Roles: %v\n", e.RoleIds)
+			fmt.Printf("Effective operation: %s\n", e.Operation)
+			if e.Reason != "" {
+				fmt.Printf("Access denied: %s\n", e.Reason)
+			}
+			fmt.Println("")
+
+			if len(e.Grants) == 0 {
+				fmt.Println("No permissions granted by the roles of the account.")
+				return nil
+			}
+
+			table := tw.NewWriter(os.Stdout)
+			table.SetHeader([]string{"Role", "Role Id", "Resource", "Operation", "Constraint"})
+			table.SetAutoFormatHeaders(false)
+			for _, g := range e.Grants {
+				table.Append([]string{
+					g.RoleDisplayName,
+					g.RoleId,
+					g.Resource.Type.String(),
+					g.Permission.Operation.String(),
+					g.Permission.Constraint.String(),
+				})
+			}
+			table.Render()
+			return nil
+		},
+	}
+}
@@ -18,6 +18,10 @@ func GetCommands(cfg *config.Config) cli.Commands {
 		Server(cfg),
 
 		// interaction with this service
+		Permissions(cfg),
+
+		// maintenance of this service
+		RebuildAssignmentIndex(cfg),
 
 		// infos about this service
 		Health(cfg),
@@ -21,25 +21,55 @@ type Config struct {
 
 	GRPCClientTLS *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
 
-	StoreType string   `yaml:"store_type" env:"SETTINGS_STORE_TYPE" desc:"Store type configures the persistency driver. Supported values are \"metadata\" and \"filesystem\"."`
-	DataPath  string   `yaml:"data_path" env:"SETTINGS_DATA_PATH" desc:"The directory where the filesystem storage will store ocis settings. If not definied, the root directory derives from $OCIS_BASE_DATA_PATH:/settings."`
-	Metadata  Metadata `yaml:"metadata_config"`
+	StoreType   string   `yaml:"store_type" env:"SETTINGS_STORE_TYPE" desc:"Store type configures the persistency driver. Supported values are \"metadata\" and \"filesystem\"."`
+	DataPath    string   `yaml:"data_path" env:"SETTINGS_DATA_PATH" desc:"The directory where the filesystem storage will store ocis settings. If not definied, the root directory derives from $OCIS_BASE_DATA_PATH:/settings."`
+	BundleCache bool     `yaml:"bundle_cache" env:"SETTINGS_BUNDLE_CACHE" desc:"Keep the parsed bundles of the filesystem storage in a binary cache file in the data path, so they are loaded with a single read on startup. Bundles whose files changed since they were cached are parsed again. Only used with the 'filesystem' store type."`
+	Metadata    Metadata `yaml:"metadata_config"`
 
 	AdminUserID string `yaml:"admin_user_id" env:"OCIS_ADMIN_USER_ID;SETTINGS_ADMIN_USER_ID" desc:"ID of the user that should receive admin privileges."`
 
 	Asset        Asset         `yaml:"asset"`
 	TokenManager *TokenManager `yaml:"token_manager"`
 
+	ValueWriteRateLimit ValueWriteRateLimit `yaml:"value_write_rate_limit"`
+
+	LongPollTimeout int `yaml:"long_poll_timeout" env:"SETTINGS_LONG_POLL_TIMEOUT" desc:"Maximum number of seconds ListValuesModifiedSince waits for a change of the values when no value was modified since the requested time and the request asks to wait. Clients can request shorter waits. Changes are only noticed right away if they were made by the same settings instance, otherwise the changes are returned by the next poll. Set to 0 to disable long polling."`
+
+	DefaultOverrides []string `yaml:"default_overrides" env:"SETTINGS_DEFAULT_OVERRIDES" desc:"A semicolon-separated list of 'setting id=value' pairs overriding the defaults of built-in settings. The options of a multi choice setting are separated by commas. Values saved by the users take precedence over the overridden defaults. The service doesn't start if a value doesn't match the constraints of its setting."`
+
+	DefaultExpressionEnv []string `yaml:"default_expression_env" env:"SETTINGS_DEFAULT_EXPRESSION_ENV" desc:"A semicolon-separated list of the environment variables the default expressions of settings can reference. Saving a bundle with a default expression referencing another environment variable fails, so that the expressions can't expose secrets of the deployment."`
+
+	ParentProvider string `yaml:"parent_provider" env:"SETTINGS_PARENT_PROVIDER" desc:"The name of the registered provider of the groups accounts inherit setting values from. A value of the account takes precedence over the values of its groups, which take precedence over the system value and the default. Leave empty to only inherit values of the group given in the request."`
+
+	ReadOnly bool `yaml:"read_only" env:"SETTINGS_READ_ONLY" desc:"Run the service as a read-only replica to scale reads. All reads are served from the configured store, which has to be shared with or replicated from a writable instance. Saving bundles, values and role assignments is rejected with a 'service is read-only' error, and the default roles, role assignments and migrations are not written on startup."`
+
+	Events Events `yaml:"events"`
+
 	SetupDefaultAssignments bool `yaml:"set_default_assignments" env:"SETTINGS_SETUP_DEFAULT_ASSIGNMENTS;ACCOUNTS_DEMO_USERS_AND_GROUPS" desc:"The default role assignments the demo users should be setup."`
 
 	Context context.Context `yaml:"-"`
 }
 
+// Events combines the configuration options for the event bus.
+type Events struct {
+	Endpoint             string `yaml:"endpoint" env:"SETTINGS_EVENTS_ENDPOINT" desc:"The address of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture. The settings service emits an event when the values and role assignments of an account are purged, which is written to the audit log by the audit service. Set to a empty string to disable emitting events."`
+	Cluster              string `yaml:"cluster" env:"SETTINGS_EVENTS_CLUSTER" desc:"The clusterID of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture."`
+	TLSInsecure          bool   `yaml:"tls_insecure" env:"OCIS_INSECURE;SETTINGS_EVENTS_TLS_INSECURE" desc:"Whether to verify the server TLS certificates."`
+	TLSRootCACertificate string `yaml:"tls_root_ca_certificate" env:"SETTINGS_EVENTS_TLS_ROOT_CA_CERTIFICATE" desc:"The root CA certificate used to validate the server's TLS certificate. If provided SETTINGS_EVENTS_TLS_INSECURE will be seen as false."`
+	EnableTLS            bool   `yaml:"enable_tls" env:"OCIS_EVENTS_ENABLE_TLS;SETTINGS_EVENTS_ENABLE_TLS" desc:"Enable TLS for the connection to the events broker. The events broker is the ocis service which receives and delivers events between the services."`
+}
+
 // Asset defines the available asset configuration.
 type Asset struct {
 	Path string `yaml:"path" env:"SETTINGS_ASSET_PATH" desc:"Serve settings Web UI assets from a path on the filesystem instead of the builtin assets. Can be used for development and customization."`
 }
 
+// ValueWriteRateLimit limits how many values an account can save within a time window.
+type ValueWriteRateLimit struct {
+	Limit  int `yaml:"limit" env:"SETTINGS_VALUE_WRITE_RATE_LIMIT" desc:"Maximum number of values an account can save within the window. Requests exceeding the limit are rejected with a 429 status. The writes are counted in memory by each settings instance, with several instances an account can save this number of values on each of them. The limit is disabled by default or when set to 0."`
+	Window int `yaml:"window" env:"SETTINGS_VALUE_WRITE_RATE_LIMIT_WINDOW" desc:"Length of the rate limit window in seconds."`
+}
+
 // Metadata configures the metadata store to use
 type Metadata struct {
 	GatewayAddress string `yaml:"gateway_addr" env:"STORAGE_GATEWAY_GRPC_ADDR" desc:"GRPC address of the STORAGE-SYSTEM service."`
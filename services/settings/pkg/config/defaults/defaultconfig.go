@@ -49,6 +49,17 @@ func DefaultConfig() *config.Config {
 		Asset: config.Asset{
 			Path: "",
 		},
+		// the rate limit is disabled unless a limit is configured
+		ValueWriteRateLimit: config.ValueWriteRateLimit{
+			Limit:  0,
+			Window: 60,
+		},
+		LongPollTimeout: 30,
+		Events: config.Events{
+			Endpoint:  "127.0.0.1:9233",
+			Cluster:   "ocis-cluster",
+			EnableTLS: false,
+		},
 		SetupDefaultAssignments: false,
 		Metadata: config.Metadata{
 			GatewayAddress: "127.0.0.1:9215", // system storage
@@ -107,6 +118,8 @@ func EnsureDefaults(cfg *config.Config) {
 		if cfg.Commons != nil && cfg.Commons.GRPCClientTLS != nil {
 			cfg.GRPCClientTLS.Mode = cfg.Commons.GRPCClientTLS.Mode
 			cfg.GRPCClientTLS.CACert = cfg.Commons.GRPCClientTLS.CACert
+			cfg.GRPCClientTLS.Cert = cfg.Commons.GRPCClientTLS.Cert
+			cfg.GRPCClientTLS.Key = cfg.Commons.GRPCClientTLS.Key
 		}
 	}
 	if cfg.GRPC.TLS == nil {
@@ -6,4 +6,6 @@ type Tracing struct {
 	Type      string `yaml:"type" env:"OCIS_TRACING_TYPE;SETTINGS_TRACING_TYPE" desc:"The type of tracing. Defaults to \"\", which is the same as \"jaeger\". Allowed tracing types are \"jaeger\" and \"\" as of now."`
 	Endpoint  string `yaml:"endpoint" env:"OCIS_TRACING_ENDPOINT;SETTINGS_TRACING_ENDPOINT" desc:"The endpoint of the tracing agent."`
 	Collector string `yaml:"collector" env:"OCIS_TRACING_COLLECTOR;SETTINGS_TRACING_COLLECTOR" desc:"The HTTP endpoint for sending spans directly to a collector, i.e. http://jaeger-collector:14268/api/traces. Only used if the tracing endpoint is unset."`
+	// RedactAccountUUIDs replaces the account uuids in the span attributes with a hash.
+	RedactAccountUUIDs bool `yaml:"redact_account_uuids" env:"SETTINGS_TRACING_REDACT_ACCOUNT_UUIDS" desc:"Replace the account uuids in the span attributes of the store operations with a truncated SHA-256 hash."`
 }
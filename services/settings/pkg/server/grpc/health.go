@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	"go-micro.dev/v4/server"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// RegisterHealthServiceHandler registers the standard grpc health service (grpc.health.v1.Health) on the go-micro server.
+// The serving status of the individual services is managed via the given health server.
+func RegisterHealthServiceHandler(s server.Server, hs *health.Server, opts ...server.HandlerOption) error {
+	// the go-micro grpc server resolves handlers by the last segment of the grpc service name
+	type Health struct {
+		*healthHandler
+	}
+	return s.Handle(s.NewHandler(&Health{&healthHandler{hs}}, opts...))
+}
+
+type healthHandler struct {
+	hs *health.Server
+}
+
+func (h *healthHandler) Check(ctx context.Context, req *healthpb.HealthCheckRequest, res *healthpb.HealthCheckResponse) error {
+	r, err := h.hs.Check(ctx, req)
+	if r != nil {
+		res.Status = r.Status
+	}
+	return err
+}
+
+func (h *healthHandler) Watch(ctx context.Context, stream server.Stream) error {
+	req := &healthpb.HealthCheckRequest{}
+	if err := stream.Recv(req); err != nil {
+		return err
+	}
+	return h.hs.Watch(req, &healthWatchServer{microServerStream{ctx: ctx, stream: stream}})
+}
+
+type healthWatchServer struct {
+	microServerStream
+}
+
+func (s *healthWatchServer) Send(res *healthpb.HealthCheckResponse) error {
+	return s.stream.Send(res)
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	mgrpcs "github.com/go-micro/plugins/v4/server/grpc"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/registry"
+	"go-micro.dev/v4/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func startTestServer(t *testing.T, hs *health.Server) *grpc.ClientConn {
+	srv := mgrpcs.NewServer(
+		server.Name("com.owncloud.api.settings-test"),
+		server.Address("127.0.0.1:0"),
+		server.Registry(registry.NewMemoryRegistry()),
+	)
+	require.NoError(t, RegisterHealthServiceHandler(srv, hs))
+	files := []protoreflect.FileDescriptor{settingssvc.File_ocis_services_settings_v0_settings_proto}
+	require.NoError(t, RegisterReflectionServiceHandler(srv, files))
+	require.NoError(t, srv.Start())
+	t.Cleanup(func() { _ = srv.Stop() })
+
+	conn, err := grpc.Dial(srv.Options().Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestHealthService(t *testing.T) {
+	hs := health.NewServer()
+	conn := startTestServer(t, hs)
+	client := healthpb.NewHealthClient(conn)
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "ocis.services.settings.v0.BundleService"})
+	assert.Error(t, err, "unknown services are reported as not found")
+
+	hs.SetServingStatus("ocis.services.settings.v0.BundleService", healthpb.HealthCheckResponse_SERVING)
+	res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "ocis.services.settings.v0.BundleService"})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status)
+
+	// the overall status defaults to serving
+	res, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status)
+}
+
+func TestReflectionService(t *testing.T) {
+	conn := startTestServer(t, health.NewServer())
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+
+	err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	names := make([]string, 0, len(res.GetListServicesResponse().GetService()))
+	for _, s := range res.GetListServicesResponse().GetService() {
+		names = append(names, s.Name)
+	}
+	assert.Contains(t, names, "ocis.services.settings.v0.BundleService")
+	assert.Contains(t, names, "ocis.services.settings.v0.ValueService")
+	assert.Contains(t, names, "grpc.health.v1.Health")
+
+	err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: "ocis.services.settings.v0.ValueService",
+		},
+	})
+	require.NoError(t, err)
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.GetFileDescriptorResponse().GetFileDescriptorProto())
+	require.NoError(t, stream.CloseSend())
+}
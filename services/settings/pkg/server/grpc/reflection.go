@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"go-micro.dev/v4/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RegisterReflectionServiceHandler registers the grpc server reflection service (grpc.reflection.v1alpha.ServerReflection)
+// on the go-micro server. The services of the given files are advertised, their descriptors are resolved from the global registry.
+func RegisterReflectionServiceHandler(s server.Server, files []protoreflect.FileDescriptor, opts ...server.HandlerOption) error {
+	names := serviceNames{
+		"grpc.health.v1.Health":                    {},
+		"grpc.reflection.v1alpha.ServerReflection": {},
+	}
+	for _, f := range files {
+		for i := 0; i < f.Services().Len(); i++ {
+			names[string(f.Services().Get(i).FullName())] = grpc.ServiceInfo{}
+		}
+	}
+
+	type ServerReflection struct {
+		*reflectionHandler
+	}
+	rs := reflection.NewServer(reflection.ServerOptions{Services: names})
+	return s.Handle(s.NewHandler(&ServerReflection{&reflectionHandler{rs}}, opts...))
+}
+
+// serviceNames implements the reflection.ServiceInfoProvider, only the names are used by the reflection service
+type serviceNames map[string]grpc.ServiceInfo
+
+func (n serviceNames) GetServiceInfo() map[string]grpc.ServiceInfo {
+	return n
+}
+
+type reflectionHandler struct {
+	rs rpb.ServerReflectionServer
+}
+
+func (h *reflectionHandler) ServerReflectionInfo(ctx context.Context, stream server.Stream) error {
+	return h.rs.ServerReflectionInfo(&reflectionInfoServer{microServerStream{ctx: ctx, stream: stream}})
+}
+
+type reflectionInfoServer struct {
+	microServerStream
+}
+
+func (s *reflectionInfoServer) Send(res *rpb.ServerReflectionResponse) error {
+	return s.stream.Send(res)
+}
+
+func (s *reflectionInfoServer) Recv() (*rpb.ServerReflectionRequest, error) {
+	req := &rpb.ServerReflectionRequest{}
+	if err := s.stream.Recv(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// microServerStream adapts a go-micro stream to the grpc.ServerStream expected by the grpc service implementations.
+// Headers and trailers are not supported by go-micro streams and are dropped.
+type microServerStream struct {
+	ctx    context.Context
+	stream server.Stream
+}
+
+func (s microServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s microServerStream) SendHeader(metadata.MD) error { return nil }
+func (s microServerStream) SetTrailer(metadata.MD)       {}
+func (s microServerStream) Context() context.Context     { return s.ctx }
+func (s microServerStream) SendMsg(m interface{}) error  { return s.stream.Send(m) }
+func (s microServerStream) RecvMsg(m interface{}) error  { return s.stream.Recv(m) }
@@ -7,9 +7,14 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
 	"github.com/owncloud/ocis/v2/ocis-pkg/version"
 	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	settingschangessvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settingschanges/v0"
 	svc "github.com/owncloud/ocis/v2/services/settings/pkg/service/v0"
 	"go-micro.dev/v4/api"
 	"go-micro.dev/v4/server"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 // Server initializes a new go-micro service ready to run
@@ -35,6 +40,10 @@ func Server(opts ...Option) grpc.Service {
 	}
 
 	handle := svc.NewService(options.Config, options.Logger)
+	// the servers are started after they are all initialized, the requests only see migrated values
+	if err := handle.RunMigrations(); err != nil {
+		options.Logger.Error().Err(err).Msg("could not run settings migrations")
+	}
 	if err := settingssvc.RegisterBundleServiceHandler(service.Server(), handle); err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not register Bundle service handler")
 	}
@@ -47,11 +56,38 @@ func Server(opts ...Option) grpc.Service {
 	if err := settingssvc.RegisterPermissionServiceHandler(service.Server(), handle); err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not register Permission service handler")
 	}
+	if err := settingschangessvc.RegisterChangeServiceHandler(service.Server(), handle); err != nil {
+		options.Logger.Fatal().Err(err).Msg("could not register Change service handler")
+	}
 
 	if err := RegisterCS3PermissionsServiceHandler(service.Server(), handle); err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not register CS3 Permission service handler")
 	}
 
+	files := []protoreflect.FileDescriptor{
+		settingssvc.File_ocis_services_settings_v0_settings_proto,
+		settingschangessvc.File_ocis_services_settingschanges_v0_settingschanges_proto,
+	}
+	// the cs3 apis are generated with the legacy protobuf api and only available via the registry
+	if fd, err := protoregistry.GlobalFiles.FindFileByPath("cs3/permissions/v1beta1/permissions_api.proto"); err == nil {
+		files = append(files, fd)
+	}
+	if err := RegisterReflectionServiceHandler(service.Server(), files); err != nil {
+		options.Logger.Fatal().Err(err).Msg("could not register reflection service handler")
+	}
+
+	healthServer := health.NewServer()
+	if err := RegisterHealthServiceHandler(service.Server(), healthServer); err != nil {
+		options.Logger.Fatal().Err(err).Msg("could not register health service handler")
+	}
+	// all handlers are registered, report them as serving. The empty name stands for the server as a whole.
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for _, f := range files {
+		for i := 0; i < f.Services().Len(); i++ {
+			healthServer.SetServingStatus(string(f.Services().Get(i).FullName()), healthpb.HealthCheckResponse_SERVING)
+		}
+	}
+
 	return service
 }
 
@@ -0,0 +1,224 @@
+package svc
+
+import (
+	"fmt"
+	"sort"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+)
+
+// analyzeRolePermissions groups the permission settings of the roles by resource, resolves the effective grant
+// per resource and reports the pairs of permission settings which conflict or overlap.
+func analyzeRolePermissions(roles []*settingsmsg.Bundle) ([]*settingssvc.ResourcePermissions, []*settingssvc.PermissionConflict) {
+	var resources []*settingssvc.ResourcePermissions
+	byResource := map[string]*settingssvc.ResourcePermissions{}
+	for _, role := range roles {
+		for _, s := range role.Settings {
+			pv, ok := s.Value.(*settingsmsg.Setting_PermissionValue)
+			if !ok || s.Resource == nil {
+				continue
+			}
+			key := s.Resource.Type.String() + "/" + s.Resource.Id
+			rp, ok := byResource[key]
+			if !ok {
+				rp = &settingssvc.ResourcePermissions{Resource: s.Resource}
+				byResource[key] = rp
+				resources = append(resources, rp)
+			}
+			rp.Sources = append(rp.Sources, &settingssvc.PermissionSource{
+				RoleId:      role.Id,
+				SettingId:   s.Id,
+				SettingName: s.Name,
+				Permission:  pv.PermissionValue,
+			})
+		}
+	}
+
+	var conflicts []*settingssvc.PermissionConflict
+	for _, rp := range resources {
+		rp.Effective = effectivePermissions(rp.Sources)
+		for i, first := range rp.Sources {
+			for _, second := range rp.Sources[i+1:] {
+				if c := comparePermissionSources(first, second); c != nil {
+					c.Resource = rp.Resource
+					conflicts = append(conflicts, c)
+				}
+			}
+		}
+	}
+	return resources, conflicts
+}
+
+// effectivePermissions returns the granted permissions which aren't covered by another granted permission.
+func effectivePermissions(sources []*settingssvc.PermissionSource) []*settingsmsg.Permission {
+	var effective []*settingsmsg.Permission
+	for i, s := range sources {
+		if !grantsAccess(s.Permission) {
+			continue
+		}
+		covered := false
+		for j, other := range sources {
+			if i == j || !grantsAccess(other.Permission) || !coversPermission(other.Permission, s.Permission) {
+				continue
+			}
+			// of two equal permissions only the first one is kept
+			if !coversPermission(s.Permission, other.Permission) || j < i {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			effective = append(effective, s.Permission)
+		}
+	}
+	return effective
+}
+
+// effectiveGrants returns the effective permissions of an account with all of the roles assigned.
+func effectiveGrants(roles []*settingsmsg.Bundle) []*settingssvc.PermissionGrant {
+	resources, _ := analyzeRolePermissions(roles)
+	var grants []*settingssvc.PermissionGrant
+	for _, rp := range resources {
+		for _, p := range rp.Effective {
+			grants = append(grants, &settingssvc.PermissionGrant{Resource: rp.Resource, Permission: p})
+		}
+	}
+	return grants
+}
+
+// diffGrants compares the effective permissions before and after a change. A grant is unchanged only if the
+// same operation and constraint are granted on the same resource, a changed operation or constraint shows up
+// as a removed and an added grant.
+func diffGrants(before, after []*settingssvc.PermissionGrant) (added, removed, unchanged []*settingssvc.PermissionGrant) {
+	key := func(g *settingssvc.PermissionGrant) string {
+		return g.Resource.Type.String() + "/" + g.Resource.Id + "/" + g.Permission.Operation.String() + "/" + g.Permission.Constraint.String()
+	}
+	kept := make(map[string]bool, len(after))
+	for _, g := range after {
+		kept[key(g)] = true
+	}
+	granted := make(map[string]bool, len(before))
+	for _, g := range before {
+		granted[key(g)] = true
+		if kept[key(g)] {
+			unchanged = append(unchanged, g)
+		} else {
+			removed = append(removed, g)
+		}
+	}
+	for _, g := range after {
+		if !granted[key(g)] {
+			added = append(added, g)
+		}
+	}
+	return added, removed, unchanged
+}
+
+// comparePermissionSources returns the conflict between two permission settings on the same resource,
+// nil if they neither conflict nor overlap.
+func comparePermissionSources(first, second *settingssvc.PermissionSource) *settingssvc.PermissionConflict {
+	firstGrants, secondGrants := grantsAccess(first.Permission), grantsAccess(second.Permission)
+	switch {
+	case firstGrants != secondGrants:
+		denying, granting := first, second
+		if firstGrants {
+			denying, granting = second, first
+		}
+		return &settingssvc.PermissionConflict{
+			Kind:   settingssvc.PermissionConflict_KIND_CONFLICT,
+			First:  first,
+			Second: second,
+			Reason: fmt.Sprintf("%s grants nothing, but %s grants %s. Permissions are only ever added up, the permission which grants nothing is ignored",
+				describeSource(denying), describeSource(granting), describePermission(granting.Permission)),
+		}
+	case !firstGrants:
+		return nil
+	case coversPermission(first.Permission, second.Permission):
+		return overlap(first, second, first, second)
+	case coversPermission(second.Permission, first.Permission):
+		return overlap(first, second, second, first)
+	}
+	return nil
+}
+
+func overlap(first, second, covering, covered *settingssvc.PermissionSource) *settingssvc.PermissionConflict {
+	return &settingssvc.PermissionConflict{
+		Kind:   settingssvc.PermissionConflict_KIND_OVERLAP,
+		First:  first,
+		Second: second,
+		Reason: fmt.Sprintf("%s grants %s, which covers %s of %s",
+			describeSource(covering), describePermission(covering.Permission), describePermission(covered.Permission), describeSource(covered)),
+	}
+}
+
+// grantsAccess checks if a permission grants anything, permissions without an operation or constraint are never fulfilled.
+func grantsAccess(p *settingsmsg.Permission) bool {
+	return p.GetOperation() != settingsmsg.Permission_OPERATION_UNKNOWN && p.GetConstraint() != settingsmsg.Permission_CONSTRAINT_UNKNOWN
+}
+
+// coversPermission checks if everything granted by b is granted by a as well.
+func coversPermission(a, b *settingsmsg.Permission) bool {
+	return coversOperation(a.Operation, b.Operation) && (a.Constraint == b.Constraint || a.Constraint == settingsmsg.Permission_CONSTRAINT_ALL)
+}
+
+// coversOperation checks if operation a includes operation b, `READWRITE` includes all other operations
+// and `WRITE` includes creating, updating and deleting.
+func coversOperation(a, b settingsmsg.Permission_Operation) bool {
+	switch {
+	case a == b:
+		return true
+	case a == settingsmsg.Permission_OPERATION_READWRITE:
+		return true
+	case a == settingsmsg.Permission_OPERATION_WRITE:
+		return b == settingsmsg.Permission_OPERATION_CREATE ||
+			b == settingsmsg.Permission_OPERATION_UPDATE ||
+			b == settingsmsg.Permission_OPERATION_DELETE
+	}
+	return false
+}
+
+func describeSource(s *settingssvc.PermissionSource) string {
+	return fmt.Sprintf("permission %s of role %s", s.SettingName, s.RoleId)
+}
+
+func describePermission(p *settingsmsg.Permission) string {
+	return fmt.Sprintf("%s with %s", p.Operation, p.Constraint)
+}
+
+// permissionMatrix flattens the permission settings of the roles into one entry per role and permission setting.
+// Only permissions on the resource are listed if it is set, a resource without an id matches all resources of its type.
+func permissionMatrix(roles []*settingsmsg.Bundle, resource *settingsmsg.Resource) []*settingssvc.PermissionMatrixEntry {
+	sorted := make([]*settingsmsg.Bundle, len(roles))
+	copy(sorted, roles)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	var entries []*settingssvc.PermissionMatrixEntry
+	for _, role := range sorted {
+		for _, s := range role.Settings {
+			pv, ok := s.Value.(*settingsmsg.Setting_PermissionValue)
+			if !ok || s.Resource == nil {
+				continue
+			}
+			if resource != nil && (s.Resource.Type != resource.Type || (resource.Id != "" && s.Resource.Id != resource.Id)) {
+				continue
+			}
+			entries = append(entries, &settingssvc.PermissionMatrixEntry{
+				RoleId:       role.Id,
+				RoleName:     role.Name,
+				ResourceType: s.Resource.Type,
+				ResourceId:   s.Resource.Id,
+				SettingId:    s.Id,
+				SettingName:  s.Name,
+				Operation:    pv.PermissionValue.GetOperation(),
+				Constraint:   pv.PermissionValue.GetConstraint(),
+			})
+		}
+	}
+	return entries
+}
@@ -0,0 +1,103 @@
+package svc
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+)
+
+// maxBulkAccounts limits the accounts of a single bulk role change.
+const maxBulkAccounts = 1000
+
+// assignmentLocks serialize the changes of the role assignments of an account. They need to be global as the
+// `Service` is instantiated twice (for grpc and http).
+var assignmentLocks [64]sync.Mutex
+
+// lockAssignments locks the role assignments of the account and returns the unlock function.
+func lockAssignments(accountUUID string) func() {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(accountUUID))
+	l := &assignmentLocks[h.Sum32()%uint32(len(assignmentLocks))]
+	l.Lock()
+	return l.Unlock
+}
+
+// BulkAssignRole implements the RoleServiceHandler interface
+func (g Service) BulkAssignRole(ctx context.Context, req *settingssvc.BulkRoleAssignmentRequest, res *settingssvc.BulkRoleAssignmentResponse) error {
+	return g.bulkRoleChange(ctx, req, res, func(accountUUID string) (*settingsmsg.UserRoleAssignment, error) {
+		return g.store(ctx).WriteRoleAssignment(accountUUID, req.RoleId)
+	})
+}
+
+// BulkRemoveRole implements the RoleServiceHandler interface
+func (g Service) BulkRemoveRole(ctx context.Context, req *settingssvc.BulkRoleAssignmentRequest, res *settingssvc.BulkRoleAssignmentResponse) error {
+	return g.bulkRoleChange(ctx, req, res, func(accountUUID string) (*settingsmsg.UserRoleAssignment, error) {
+		assignments, err := g.store(ctx).ListRoleAssignments(accountUUID)
+		if err != nil {
+			return nil, err
+		}
+		var removed *settingsmsg.UserRoleAssignment
+		for _, a := range assignments {
+			if a.RoleId != req.RoleId {
+				continue
+			}
+			if err := g.store(ctx).RemoveRoleAssignment(a.Id); err != nil {
+				return nil, err
+			}
+			removed = a
+		}
+		return removed, nil
+	})
+}
+
+// bulkRoleChange checks the request and the role once and applies the change to every account. The changes of
+// an account are serialized with the other changes of its assignments, a failed change doesn't stop the others.
+func (g Service) bulkRoleChange(ctx context.Context, req *settingssvc.BulkRoleAssignmentRequest, res *settingssvc.BulkRoleAssignmentResponse, change func(accountUUID string) (*settingsmsg.UserRoleAssignment, error)) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	if validationError := validateBulkRoleAssignment(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	ownAccountUUID, ok := metadata.Get(ctx, middleware.AccountID)
+	if !ok {
+		g.logger.Debug().Str("id", g.id).Msg("user not in context")
+		return merrors.InternalServerError(g.id, "user not in context")
+	}
+
+	role, err := g.store(ctx).ReadBundle(req.RoleId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if role.Type != settingsmsg.Bundle_TYPE_ROLE {
+		return merrors.BadRequest(g.id, "bundle %s is not a role", req.RoleId)
+	}
+
+	res.Results = make([]*settingssvc.BulkRoleAssignmentResult, 0, len(req.AccountUuids))
+	for _, accountUUID := range req.AccountUuids {
+		result := &settingssvc.BulkRoleAssignmentResult{AccountUuid: accountUUID}
+		res.Results = append(res.Results, result)
+		if accountUUID == ownAccountUUID {
+			result.Error = "Changing own role assignment forbidden"
+			continue
+		}
+
+		unlock := lockAssignments(accountUUID)
+		result.Assignment, err = change(accountUUID)
+		unlock()
+		if err != nil {
+			g.logger.Debug().Err(err).Str("id", g.id).Str("account", accountUUID).Msg("bulk role change failed")
+			result.Error = err.Error()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package svc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bundleETag computes a strong etag of the bundle as it is returned to the user, including the ids of the settings
+// hidden by their conditions. Permissions and visibility change the returned settings, so it can't be derived
+// from the stored bundle alone.
+func bundleETag(bundle *settingsmsg.Bundle, hidden ...string) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(bundle)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write(b)
+	for _, id := range hidden {
+		h.Write([]byte("\x00" + id))
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)) + `"`, nil
+}
+
+// notModified evaluates the conditions of a conditional request like an HTTP server evaluates
+// If-None-Match and If-Modified-Since. The latter is ignored if the client sent etags.
+func notModified(ifNoneMatch string, ifModifiedSince *timestamppb.Timestamp, etag string, lastModified *timestamppb.Timestamp) bool {
+	if ifNoneMatch != "" {
+		return etagMatches(ifNoneMatch, etag)
+	}
+	if ifModifiedSince == nil || lastModified == nil {
+		return false
+	}
+	// HTTP dates only have a precision of seconds
+	return !lastModified.AsTime().Truncate(time.Second).After(ifModifiedSince.AsTime())
+}
+
+// etagMatches checks if the etag is in the comma separated list of etags, using weak comparison.
+func etagMatches(list, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
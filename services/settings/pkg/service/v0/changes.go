@@ -0,0 +1,189 @@
+package svc
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingschangessvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settingschanges/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+	merrors "go-micro.dev/v4/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// changeHub wakes up the long polls waiting for changes of the values of an account and streams the
+// changed values to the watchers of their settings. It only knows about the changes made by this instance,
+// with several settings instances the long polls still return when their timeout elapses.
+type changeHub struct {
+	mu       sync.Mutex
+	waiters  map[string]map[chan struct{}]struct{}
+	watchers map[*valueWatcher]struct{}
+}
+
+func newChangeHub() *changeHub {
+	return &changeHub{
+		waiters: map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+// subscribe returns a channel that is closed on the next change of the values of the account and a
+// function to cancel the subscription. The channel of a nil changeHub is never closed.
+func (h *changeHub) subscribe(accountUUID string) (<-chan struct{}, func()) {
+	if h == nil {
+		return nil, func() {}
+	}
+	ch := make(chan struct{})
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.waiters[accountUUID] == nil {
+		h.waiters[accountUUID] = map[chan struct{}]struct{}{}
+	}
+	h.waiters[accountUUID][ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.waiters[accountUUID][ch]; ok {
+			delete(h.waiters[accountUUID], ch)
+			if len(h.waiters[accountUUID]) == 0 {
+				delete(h.waiters, accountUUID)
+			}
+		}
+	}
+}
+
+// notify wakes up all subscribers of the account.
+func (h *changeHub) notify(accountUUID string) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.waiters[accountUUID] {
+		close(ch)
+	}
+	delete(h.waiters, accountUUID)
+}
+
+// valueChangesBuffer is the number of changes buffered for a value watcher. Watchers whose buffer is full
+// are dropped, so a slow subscriber can't hold back the writes of values.
+const valueChangesBuffer = 64
+
+// valueChange is a written or deleted value.
+type valueChange struct {
+	value   *settingsmsg.Value
+	deleted bool
+}
+
+// valueWatcher receives the changes of the values of some settings.
+type valueWatcher struct {
+	settings map[string]struct{}
+	// accountUUID limits the changes to the values of the account and the system values, all accounts are watched if it is empty
+	accountUUID string
+	changes     chan valueChange
+	// dropped is closed when the watcher was dropped because it didn't keep up with the changes
+	dropped chan struct{}
+}
+
+// watch returns a watcher of the values of the settings and a function to cancel it. The watcher of a nil
+// changeHub never receives changes.
+func (h *changeHub) watch(accountUUID string, settingIDs []string) (*valueWatcher, func()) {
+	w := &valueWatcher{
+		settings:    make(map[string]struct{}, len(settingIDs)),
+		accountUUID: accountUUID,
+		changes:     make(chan valueChange, valueChangesBuffer),
+		dropped:     make(chan struct{}),
+	}
+	for _, id := range settingIDs {
+		w.settings[id] = struct{}{}
+	}
+	if h == nil {
+		return w, func() {}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.watchers == nil {
+		h.watchers = map[*valueWatcher]struct{}{}
+	}
+	h.watchers[w] = struct{}{}
+
+	return w, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.watchers, w)
+	}
+}
+
+// publish sends a changed value to the watchers of its setting and wakes up the long polls of its account.
+func (h *changeHub) publish(value *settingsmsg.Value, deleted bool) {
+	if h == nil {
+		return
+	}
+	h.notify(value.GetAccountUuid())
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var change *valueChange
+	for w := range h.watchers {
+		if _, ok := w.settings[value.GetSettingId()]; !ok {
+			continue
+		}
+		if w.accountUUID != "" && w.accountUUID != value.GetAccountUuid() && value.GetAccountUuid() != settings.SystemAccountUUID {
+			continue
+		}
+		if change == nil {
+			// the watchers read the value while the caller keeps using it
+			change = &valueChange{value: proto.Clone(value).(*settingsmsg.Value), deleted: deleted}
+		}
+		select {
+		case w.changes <- *change:
+		default:
+			delete(h.watchers, w)
+			close(w.dropped)
+		}
+	}
+}
+
+// SubscribeValueChanges implements the ChangeServiceHandler interface
+// It streams the changes of the values of the requested settings until the client disconnects. Subscribers that
+// don't keep up with the changes are disconnected with an error, they have to read the values again after
+// subscribing anew.
+func (g Service) SubscribeValueChanges(ctx context.Context, req *settingschangessvc.SubscribeValueChangesRequest, stream settingschangessvc.ChangeService_SubscribeValueChangesStream) error {
+	if req.AllAccounts {
+		if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+			return merrors.Forbidden(g.id, "can't subscribe to the values of all accounts without the settings management permission")
+		}
+		req.AccountUuid = ""
+	} else {
+		req.AccountUuid = getValidatedAccountUUID(ctx, req.AccountUuid)
+		if !g.isCurrentUser(ctx, req.AccountUuid) && !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+			return merrors.Forbidden(g.id, "can't subscribe to the values of another user")
+		}
+	}
+	if validationError := validateSubscribeValueChanges(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	w, cancel := g.changes.watch(req.AccountUuid, req.SettingIds)
+	defer cancel()
+	for {
+		select {
+		case change := <-w.changes:
+			value, err := g.getValueWithIdentifier(change.value)
+			if err != nil {
+				// the bundle or setting was removed in the meantime
+				value = &settingsmsg.ValueWithIdentifier{Value: change.value}
+			}
+			if err := stream.Send(&settingschangessvc.SubscribeValueChangesResponse{Value: value, Deleted: change.deleted}); err != nil {
+				return err
+			}
+		case <-w.dropped:
+			return merrors.New(g.id, "the subscriber didn't keep up with the changes, subscribe again and read the values", http.StatusTooManyRequests)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
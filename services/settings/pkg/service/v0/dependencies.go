@@ -0,0 +1,48 @@
+package svc
+
+import (
+	"fmt"
+	"strings"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+// checkBundleDependencies makes sure that all bundles the given bundle depends on exist
+// and that saving the bundle doesn't introduce a dependency cycle.
+func (g Service) checkBundleDependencies(bundle *settingsmsg.Bundle) error {
+	return checkDependencies(bundle, g.manager.ReadBundle)
+}
+
+// checkDependencies checks the dependencies of the bundle with the bundles returned by read.
+func checkDependencies(bundle *settingsmsg.Bundle, read func(id string) (*settingsmsg.Bundle, error)) error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[string]int{}
+
+	var visit func(id string, dependsOn []string, path []string) error
+	visit = func(id string, dependsOn []string, path []string) error {
+		state[id] = visiting
+		path = append(path, id)
+		for _, dep := range dependsOn {
+			switch state[dep] {
+			case visiting:
+				return fmt.Errorf("dependency cycle: %s", strings.Join(append(path, dep), " -> "))
+			case visited:
+				continue
+			}
+			// the stored version of the bundle being saved is replaced, so it is never read here
+			b, err := read(dep)
+			if err != nil {
+				return fmt.Errorf("bundle %s depends on missing bundle %s", id, dep)
+			}
+			if err := visit(dep, b.DependsOn, path); err != nil {
+				return err
+			}
+		}
+		state[id] = visited
+		return nil
+	}
+	return visit(bundle.Id, bundle.DependsOn, nil)
+}
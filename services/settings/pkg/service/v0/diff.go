@@ -0,0 +1,186 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	merrors "go-micro.dev/v4/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ignoredBundleFields differ between any two bundles, they are left out of the bundle changes.
+// The settings are compared one by one.
+var ignoredBundleFields = map[string]bool{
+	"id":         true,
+	"settings":   true,
+	"updated_at": true,
+	"updated_by": true,
+}
+
+// DiffBundles implements the BundleServiceHandler interface
+func (g Service) DiffBundles(ctx context.Context, req *settingssvc.DiffBundlesRequest, res *settingssvc.DiffBundlesResponse) error {
+	if validationError := validateDiffBundles(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no settings management permission")
+	}
+	base, err := g.store(ctx).ReadBundle(req.BaseBundleId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	target := req.TargetBundle
+	if req.TargetBundleId != "" {
+		if target, err = g.store(ctx).ReadBundle(req.TargetBundleId); err != nil {
+			return merrors.NotFound(g.id, "%s", err)
+		}
+	}
+	if (base.Type == settingsmsg.Bundle_TYPE_ROLE || target.Type == settingsmsg.Bundle_TYPE_ROLE) && !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+
+	res.BundleChanges, err = diffFields(base, target, ignoredBundleFields)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	res.SettingChanges, err = diffSettings(base.Settings, target.Settings)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	return nil
+}
+
+// diffSettings matches the settings by id, the remaining ones by name, and returns the changes ordered by name and id.
+func diffSettings(base, target []*settingsmsg.Setting) ([]*settingssvc.SettingChange, error) {
+	targetByID := make(map[string]*settingsmsg.Setting, len(target))
+	targetByName := make(map[string]*settingsmsg.Setting, len(target))
+	for _, s := range target {
+		targetByID[s.Id] = s
+		if _, ok := targetByName[s.Name]; !ok {
+			targetByName[s.Name] = s
+		}
+	}
+	baseIDs := make(map[string]bool, len(base))
+	for _, s := range base {
+		baseIDs[s.Id] = true
+	}
+
+	changes := []*settingssvc.SettingChange{}
+	matched := make(map[*settingsmsg.Setting]bool, len(target))
+	for _, b := range base {
+		t, ok := targetByID[b.Id]
+		if !ok {
+			// settings created independently in two environments have different ids
+			if t, ok = targetByName[b.Name]; ok && (matched[t] || baseIDs[t.Id]) {
+				t, ok = nil, false
+			}
+		}
+		if !ok {
+			changes = append(changes, &settingssvc.SettingChange{
+				Type:      settingssvc.SettingChange_TYPE_REMOVED,
+				SettingId: b.Id,
+				Name:      b.Name,
+				Base:      b,
+			})
+			continue
+		}
+		matched[t] = true
+		fields, err := diffFields(b, t, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		changes = append(changes, &settingssvc.SettingChange{
+			Type:      settingssvc.SettingChange_TYPE_MODIFIED,
+			SettingId: t.Id,
+			Name:      t.Name,
+			Fields:    fields,
+			Base:      b,
+			Target:    t,
+		})
+	}
+	for _, t := range target {
+		if matched[t] {
+			continue
+		}
+		changes = append(changes, &settingssvc.SettingChange{
+			Type:      settingssvc.SettingChange_TYPE_ADDED,
+			SettingId: t.Id,
+			Name:      t.Name,
+			Target:    t,
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].SettingId < changes[j].SettingId
+	})
+	return changes, nil
+}
+
+// diffFields returns the fields of the messages with different values, ordered by their name. The values are
+// compared by their JSON encoding, so a field set to its zero value doesn't differ from an unset field.
+func diffFields(base, target proto.Message, ignored map[string]bool) ([]*settingssvc.FieldChange, error) {
+	old, err := jsonFields(base)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := jsonFields(target)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(old)+len(updated))
+	for name := range old {
+		names = append(names, name)
+	}
+	for name := range updated {
+		if _, ok := old[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []*settingssvc.FieldChange{}
+	for _, name := range names {
+		if ignored[name] || old[name] == updated[name] {
+			continue
+		}
+		changes = append(changes, &settingssvc.FieldChange{
+			Field:    name,
+			OldValue: old[name],
+			NewValue: updated[name],
+		})
+	}
+	return changes, nil
+}
+
+// jsonFields returns the JSON encoded values of the populated fields of the message. protojson doesn't
+// guarantee a stable output, the values are encoded again with encoding/json, which sorts the object keys.
+func jsonFields(m proto.Message) (map[string]string, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string, len(raw))
+	for name, v := range raw {
+		enc, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = string(enc)
+	}
+	return fields, nil
+}
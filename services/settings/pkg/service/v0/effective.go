@@ -0,0 +1,177 @@
+package svc
+
+import (
+	"context"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+	merrors "go-micro.dev/v4/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// effectiveSetting holds what the effective values of a setting have in common for all accounts of a batch, so the
+// bundle and the defaults are only read and parsed once.
+type effectiveSetting struct {
+	bundle   *settingsmsg.Bundle
+	setting  *settingsmsg.Setting
+	resolver settings.ValueResolverFunc
+	// system is the system value, nil if there is none
+	system *settingsmsg.Value
+	// override is the configured default, nil if it isn't overridden
+	override *settingsmsg.Value
+	// static is the default of the setting, nil if the setting has no default
+	static *settingsmsg.Value
+}
+
+// groupSetting identifies the value of a group for a setting.
+type groupSetting struct {
+	group     string
+	settingID string
+}
+
+// BatchGetEffectiveValues implements the ValueServiceHandler interface
+// The values are resolved like GetValueByUniqueIdentifiers resolves them, the static default is returned if no other
+// value applies.
+func (g Service) BatchGetEffectiveValues(ctx context.Context, req *settingssvc.BatchGetEffectiveValuesRequest, res *settingssvc.BatchGetEffectiveValuesResponse) error {
+	if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no settings management permission")
+	}
+	if validationError := validateBatchGetEffectiveValues(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	var (
+		bundle *settingsmsg.Bundle
+		err    error
+	)
+	if req.SettingId != "" {
+		bundle, err = g.store(ctx).ReadBundleBySetting(req.SettingId)
+	} else {
+		bundle, err = g.store(ctx).ReadBundle(req.BundleId)
+	}
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if bundle.Type != settingsmsg.Bundle_TYPE_DEFAULT {
+		return merrors.BadRequest(g.id, "the settings of bundle %s have no values", bundle.Id)
+	}
+
+	prepared := make([]effectiveSetting, 0, len(bundle.Settings))
+	for _, s := range bundle.Settings {
+		if req.SettingId != "" && s.Id != req.SettingId {
+			continue
+		}
+		prepared = append(prepared, g.prepareEffectiveSetting(ctx, bundle, s))
+	}
+
+	// the values of a group apply to all of its members, they are read once per batch
+	groupValues := map[groupSetting]*settingsmsg.Value{}
+	res.Values = make([]*settingssvc.EffectiveValue, 0, len(req.AccountUuids)*len(prepared))
+	for _, accountUUID := range req.AccountUuids {
+		var groups []string
+		if accountUUID != settings.SystemAccountUUID && !settings.IsGroupAccount(accountUUID) {
+			if groups, err = g.parentGroups(ctx, accountUUID, ""); err != nil {
+				return merrors.InternalServerError(g.id, "%s", err)
+			}
+		}
+		for _, e := range prepared {
+			value, err := g.effectiveValue(ctx, accountUUID, e, groups, groupValues)
+			if err != nil {
+				return merrors.InternalServerError(g.id, "%s", err)
+			}
+			res.Values = append(res.Values, value)
+		}
+	}
+	return nil
+}
+
+func (g Service) prepareEffectiveSetting(ctx context.Context, bundle *settingsmsg.Bundle, setting *settingsmsg.Setting) effectiveSetting {
+	e := effectiveSetting{
+		bundle:   bundle,
+		setting:  setting,
+		resolver: settings.ValueResolvers[setting.Id],
+	}
+	if v, err := g.store(ctx).ReadValueByUniqueIdentifiers(settings.SystemAccountUUID, setting.Id); err == nil {
+		e.system = v
+	}
+	if o, ok := g.defaultOverrides[setting.Id]; ok {
+		e.override = o.value
+	}
+	// settings without a default, like permissions, have no value
+	if v, err := parseSettingValue(formatDefault(setting), setting); err == nil {
+		e.static = v
+	}
+	return e
+}
+
+// effectiveValue returns the value of the setting that applies to the account. The value of the account takes
+// precedence over the values of its groups, the system value and the defaults.
+func (g Service) effectiveValue(ctx context.Context, accountUUID string, e effectiveSetting, groups []string, groupValues map[groupSetting]*settingsmsg.Value) (*settingssvc.EffectiveValue, error) {
+	res := &settingssvc.EffectiveValue{
+		AccountUuid: accountUUID,
+		SettingId:   e.setting.Id,
+	}
+	if e.resolver != nil {
+		value, err := resolveSettingValue(ctx, e.resolver, accountUUID, e.setting.Resource, e.bundle, e.setting)
+		if err != nil {
+			return nil, err
+		}
+		res.Value, res.Origin = value, settingssvc.EffectiveValue_ORIGIN_COMPUTED
+		return res, nil
+	}
+
+	if v, err := g.store(ctx).ReadValueByUniqueIdentifiers(accountUUID, e.setting.Id); err == nil {
+		g.auditRead(ctx, "BatchGetEffectiveValues", e.bundle, v)
+		res.Value, res.Origin = identifySettingValue(e.bundle, e.setting, v), settingssvc.EffectiveValue_ORIGIN_STORED
+		return res, nil
+	}
+	for _, group := range groups {
+		key := groupSetting{group: group, settingID: e.setting.Id}
+		v, ok := groupValues[key]
+		if !ok {
+			v, _ = g.store(ctx).ReadValueByUniqueIdentifiers(settings.GroupAccountUUID(group), e.setting.Id)
+			groupValues[key] = v
+		}
+		if v != nil {
+			res.Value, res.Origin = identifySettingValue(e.bundle, e.setting, proto.Clone(v).(*settingsmsg.Value)), settingssvc.EffectiveValue_ORIGIN_GROUP
+			return res, nil
+		}
+	}
+	if e.system != nil && accountUUID != settings.SystemAccountUUID {
+		res.Value, res.Origin = identifySettingValue(e.bundle, e.setting, proto.Clone(e.system).(*settingsmsg.Value)), settingssvc.EffectiveValue_ORIGIN_SYSTEM
+		return res, nil
+	}
+
+	if e.override != nil {
+		res.Value = identifyDefault(e.bundle, e.setting, proto.Clone(e.override).(*settingsmsg.Value), accountUUID)
+		res.Origin = settingssvc.EffectiveValue_ORIGIN_DEFAULT
+		return res, nil
+	}
+	derived, ok, err := g.settingExpressionValue(ctx, accountUUID, e.bundle, e.setting)
+	switch {
+	case err != nil:
+		g.logger.Error().Err(err).Str("setting", e.setting.Id).Msg("could not evaluate the default expression")
+	case ok:
+		res.Value, res.Origin = derived, settingssvc.EffectiveValue_ORIGIN_DEFAULT
+		return res, nil
+	}
+	if e.static != nil {
+		res.Value = identifyDefault(e.bundle, e.setting, proto.Clone(e.static).(*settingsmsg.Value), accountUUID)
+		res.Origin = settingssvc.EffectiveValue_ORIGIN_DEFAULT
+	}
+	return res, nil
+}
+
+// identifySettingValue adds the identifier to a stored value of the setting, like identifyValue without reading the
+// setting again.
+func identifySettingValue(bundle *settingsmsg.Bundle, setting *settingsmsg.Setting, value *settingsmsg.Value) *settingsmsg.ValueWithIdentifier {
+	return &settingsmsg.ValueWithIdentifier{
+		Identifier: &settingsmsg.Identifier{
+			Extension: bundle.Extension,
+			Bundle:    bundle.Name,
+			Setting:   setting.Name,
+		},
+		Value: value,
+	}
+}
@@ -0,0 +1,59 @@
+package svc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/events/server"
+	"github.com/go-micro/plugins/v4/events/natsjs"
+	ociscrypto "github.com/owncloud/ocis/v2/ocis-pkg/crypto"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
+)
+
+// newEventsPublisher connects to the event bus, it returns nil if emitting events is disabled.
+func newEventsPublisher(cfg config.Events) (events.Publisher, error) {
+	if cfg.Endpoint == "" {
+		return nil, nil
+	}
+
+	var tlsConf *tls.Config
+	if cfg.EnableTLS {
+		var rootCAPool *x509.CertPool
+		if cfg.TLSRootCACertificate != "" {
+			rootCrtFile, err := os.Open(cfg.TLSRootCACertificate)
+			if err != nil {
+				return nil, err
+			}
+
+			rootCAPool, err = ociscrypto.NewCertPoolFromPEM(rootCrtFile)
+			if err != nil {
+				return nil, err
+			}
+			cfg.TLSInsecure = false
+		}
+
+		tlsConf = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.TLSInsecure, //nolint:gosec
+			RootCAs:            rootCAPool,
+		}
+	}
+	return server.NewNatsStream(
+		natsjs.TLSConfig(tlsConf),
+		natsjs.Address(cfg.Endpoint),
+		natsjs.ClusterID(cfg.Cluster),
+	)
+}
+
+// publish emits the event if a publisher is configured. The event is informational,
+// a failure is logged and doesn't fail the request.
+func (g Service) publish(ev interface{}) {
+	if g.eventsPublisher == nil {
+		return
+	}
+	if err := events.Publish(g.eventsPublisher, ev); err != nil {
+		g.logger.Error().Err(err).Interface("event", ev).Msg("could not publish event")
+	}
+}
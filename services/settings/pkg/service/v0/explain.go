@@ -0,0 +1,182 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// PermissionExplanation describes if and why an account can read a setting.
+type PermissionExplanation struct {
+	AccountUUID string
+	Setting     *settingsmsg.Setting
+	Bundle      *settingsmsg.Bundle
+	RoleIDs     []string
+	// Visible is false if the setting is hidden from the roles of the account
+	Visible bool
+	// Operation is the strongest operation granted to the account, OPERATION_UNKNOWN if none is granted
+	Operation settingsmsg.Permission_Operation
+	// Grants lists all permissions of the roles of the account on the setting and its bundle
+	Grants []PermissionGrant
+	// Reason explains why the setting can't be read, it is empty if it can be read
+	Reason string
+}
+
+// PermissionGrant is a permission on a setting or its bundle granted by a role.
+type PermissionGrant struct {
+	Role       *settingsmsg.Bundle
+	Resource   *settingsmsg.Resource
+	Permission *settingsmsg.Permission
+}
+
+// ExplainPermission implements the PermissionServiceHandler interface
+// Accounts can explain their own permissions, explaining the permissions of other accounts needs the settings
+// management permission.
+func (g Service) ExplainPermission(ctx context.Context, req *settingssvc.ExplainPermissionRequest, res *settingssvc.ExplainPermissionResponse) error {
+	if validationError := validateExplainPermission(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	if accountUUID, _ := metadata.Get(ctx, middleware.AccountID); accountUUID != req.AccountUuid &&
+		!g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no settings management permission")
+	}
+
+	e, err := g.explainPermission(req.AccountUuid, req.SettingId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	res.AccountUuid = e.AccountUUID
+	res.Setting = e.Setting
+	res.Bundle = e.Bundle
+	res.RoleIds = e.RoleIDs
+	res.Visible = e.Visible
+	res.Operation = e.Operation
+	res.Reason = e.Reason
+	res.Grants = make([]*settingssvc.RoleGrant, 0, len(e.Grants))
+	for _, grant := range e.Grants {
+		res.Grants = append(res.Grants, &settingssvc.RoleGrant{
+			RoleId:          grant.Role.Id,
+			RoleName:        grant.Role.Name,
+			RoleDisplayName: grant.Role.DisplayName,
+			Resource:        grant.Resource,
+			Permission:      grant.Permission,
+		})
+	}
+	return nil
+}
+
+// explainPermission evaluates the permissions of an account on a setting the same way requests
+// of the account are evaluated and reports which roles granted them.
+func (g Service) explainPermission(accountUUID, settingID string) (*PermissionExplanation, error) {
+	bundle, setting, err := g.findSetting(settingID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := g.manager.ListRoleAssignments(accountUUID)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs := make([]string, 0, len(assignments))
+	for _, a := range assignments {
+		roleIDs = append(roleIDs, a.RoleId)
+	}
+
+	e := &PermissionExplanation{
+		AccountUUID: accountUUID,
+		Setting:     setting,
+		Bundle:      bundle,
+		RoleIDs:     roleIDs,
+		Visible:     isSettingVisible(roleIDs, setting),
+	}
+
+	resources := []*settingsmsg.Resource{
+		{Type: settingsmsg.Resource_TYPE_BUNDLE, Id: bundle.Id},
+		{Type: settingsmsg.Resource_TYPE_SETTING, Id: setting.Id},
+	}
+	for _, roleID := range roleIDs {
+		role, err := g.manager.ReadBundle(roleID)
+		if err != nil {
+			role = &settingsmsg.Bundle{Id: roleID}
+		}
+		for _, resource := range resources {
+			permissions, err := g.manager.ListPermissionsByResource(resource, []string{roleID})
+			if err != nil {
+				continue
+			}
+			for _, p := range permissions {
+				e.Grants = append(e.Grants, PermissionGrant{Role: role, Resource: resource, Permission: p})
+			}
+		}
+	}
+
+	// the bundle is filtered just like it is for GetBundle and ListBundles
+	filtered := g.getFilteredBundle(roleIDs, proto.Clone(bundle).(*settingsmsg.Bundle))
+	readable := false
+	for _, s := range filtered.Settings {
+		readable = readable || s.Id == settingID
+	}
+
+	readWrite := []settingsmsg.Permission_Operation{settingsmsg.Permission_OPERATION_READWRITE}
+	switch {
+	case len(roleIDs) == 0:
+		e.Reason = "the account has no roles assigned"
+	case !e.Visible:
+		e.Reason = "the setting is only visible to other roles"
+	case !readable:
+		e.Reason = "none of the roles grants reading the setting or its bundle for the own account"
+	case g.hasPermission(roleIDs, resources[0], readWrite, settingsmsg.Permission_CONSTRAINT_OWN) ||
+		g.hasPermission(roleIDs, resources[1], readWrite, settingsmsg.Permission_CONSTRAINT_OWN):
+		e.Operation = settingsmsg.Permission_OPERATION_READWRITE
+	default:
+		e.Operation = settingsmsg.Permission_OPERATION_READ
+	}
+	return e, nil
+}
+
+// roleGrants cross-references the permissions of all roles with the settings of a bundle. A permission on
+// the bundle is reported for each of its settings. Every setting has an entry, even if no role grants anything on it.
+func (g Service) roleGrants(bundleID string, settings []*settingsmsg.Setting) (map[string]*settingssvc.RoleGrants, error) {
+	roles, err := g.manager.ListBundles(settingsmsg.Bundle_TYPE_ROLE, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make(map[string]*settingssvc.RoleGrants, len(settings))
+	for _, s := range settings {
+		grants[s.Id] = &settingssvc.RoleGrants{}
+	}
+	for _, role := range roles {
+		for _, p := range role.Settings {
+			pv, ok := p.Value.(*settingsmsg.Setting_PermissionValue)
+			if !ok || p.Resource == nil {
+				continue
+			}
+			grant := &settingssvc.RoleGrant{
+				RoleId:          role.Id,
+				RoleName:        role.Name,
+				RoleDisplayName: role.DisplayName,
+				Resource:        p.Resource,
+				Permission:      pv.PermissionValue,
+			}
+			switch p.Resource.Type {
+			case settingsmsg.Resource_TYPE_BUNDLE:
+				if p.Resource.Id == bundleID {
+					for _, rg := range grants {
+						rg.Grants = append(rg.Grants, grant)
+					}
+				}
+			case settingsmsg.Resource_TYPE_SETTING:
+				if rg, ok := grants[p.Resource.Id]; ok {
+					rg.Grants = append(rg.Grants, grant)
+				}
+			}
+		}
+	}
+	return grants, nil
+}
@@ -0,0 +1,339 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+)
+
+const (
+	expressionEnv     = "env"
+	expressionSetting = "setting"
+
+	// maxExpressionDepth limits the chains of settings whose default expressions reference each other.
+	maxExpressionDepth = 8
+)
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// expressionPart is either literal text or a reference of a default expression.
+type expressionPart struct {
+	literal string
+	// kind is expressionEnv or expressionSetting for references
+	kind string
+	name string
+}
+
+// parseDefaultExpression splits a default expression into literal text and ${env:NAME} and ${setting:ID} references.
+// Nothing else is evaluated, the expressions can't call functions or nest references.
+func parseDefaultExpression(expr string) ([]expressionPart, error) {
+	var (
+		parts   []expressionPart
+		literal strings.Builder
+	)
+	for i := 0; i < len(expr); i++ {
+		if expr[i] != '$' {
+			literal.WriteByte(expr[i])
+			continue
+		}
+		switch {
+		case strings.HasPrefix(expr[i+1:], "$"):
+			literal.WriteByte('$')
+			i++
+		case strings.HasPrefix(expr[i+1:], "{"):
+			end := strings.IndexByte(expr[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated reference at offset %d", i)
+			}
+			ref := expr[i+2 : i+end]
+			kind, name, _ := strings.Cut(ref, ":")
+			switch kind {
+			case expressionEnv:
+				if !envNamePattern.MatchString(name) {
+					return nil, fmt.Errorf("invalid environment variable name in ${%s}", ref)
+				}
+			case expressionSetting:
+				if err := validation.Validate(name, validation.Required, is.UUID); err != nil {
+					return nil, fmt.Errorf("invalid setting id in ${%s}", ref)
+				}
+			default:
+				return nil, fmt.Errorf("invalid reference ${%s}, expected ${env:NAME} or ${setting:ID}", ref)
+			}
+			if literal.Len() > 0 {
+				parts = append(parts, expressionPart{literal: literal.String()})
+				literal.Reset()
+			}
+			parts = append(parts, expressionPart{kind: kind, name: name})
+			i += end
+		default:
+			return nil, fmt.Errorf("unescaped $ at offset %d, use $$ for a literal $", i)
+		}
+	}
+	if literal.Len() > 0 {
+		parts = append(parts, expressionPart{literal: literal.String()})
+	}
+	return parts, nil
+}
+
+// validateDefaultExpression checks the syntax of the default expression of a setting.
+func validateDefaultExpression(setting *settingsmsg.Setting) error {
+	if setting.DefaultExpression == "" {
+		return nil
+	}
+	switch setting.Value.(type) {
+	case *settingsmsg.Setting_PermissionValue, nil:
+		return validation.Errors{"default_expression": fmt.Errorf("the setting has no default value")}
+	}
+	if _, err := parseDefaultExpression(setting.DefaultExpression); err != nil {
+		return validation.Errors{"default_expression": err}
+	}
+	return nil
+}
+
+// expressionEnvAllowed checks if the environment variable is configured to be used in default expressions.
+func (g Service) expressionEnvAllowed(name string) bool {
+	if g.config == nil {
+		return false
+	}
+	for _, allowed := range g.config.DefaultExpressionEnv {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDefaultExpressions checks that the default expressions of the bundle only reference the configured
+// environment variables and existing settings, and that the references don't form a cycle.
+func (g Service) checkDefaultExpressions(bundle *settingsmsg.Bundle) error {
+	byID := make(map[string]*settingsmsg.Setting, len(bundle.Settings))
+	for _, s := range bundle.Settings {
+		if s.Id != "" {
+			byID[s.Id] = s
+		}
+	}
+	lookup := func(id string) (*settingsmsg.Setting, error) {
+		if s, ok := byID[id]; ok {
+			return s, nil
+		}
+		return g.manager.ReadSetting(id)
+	}
+	for _, s := range bundle.Settings {
+		if s.DefaultExpression == "" {
+			continue
+		}
+		if err := g.checkExpressionReferences(s, lookup, map[string]bool{}); err != nil {
+			return fmt.Errorf("invalid default expression of setting %s: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+func (g Service) checkExpressionReferences(setting *settingsmsg.Setting, lookup func(string) (*settingsmsg.Setting, error), visiting map[string]bool) error {
+	if len(visiting) >= maxExpressionDepth {
+		return fmt.Errorf("the references are nested deeper than %d settings", maxExpressionDepth)
+	}
+	parts, err := parseDefaultExpression(setting.DefaultExpression)
+	if err != nil {
+		return err
+	}
+	visiting[setting.Id] = true
+	defer delete(visiting, setting.Id)
+	for _, p := range parts {
+		switch p.kind {
+		case expressionEnv:
+			if !g.expressionEnvAllowed(p.name) {
+				return fmt.Errorf("the environment variable %s can't be referenced", p.name)
+			}
+		case expressionSetting:
+			if visiting[p.name] {
+				return fmt.Errorf("the reference of setting %s forms a cycle", p.name)
+			}
+			ref, err := lookup(p.name)
+			if err != nil {
+				return fmt.Errorf("the referenced setting %s does not exist", p.name)
+			}
+			if ref.DefaultExpression == "" {
+				continue
+			}
+			if err := g.checkExpressionReferences(ref, lookup, visiting); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expressionValue returns the default of the setting for the account derived from its default expression.
+// It returns false if the setting has no default expression.
+func (g Service) expressionValue(ctx context.Context, accountUUID, settingID string) (*settingsmsg.ValueWithIdentifier, bool, error) {
+	bundle, setting := g.bundleSetting(ctx, settingID)
+	return g.settingExpressionValue(ctx, accountUUID, bundle, setting)
+}
+
+// settingExpressionValue is expressionValue for a setting of the given bundle.
+func (g Service) settingExpressionValue(ctx context.Context, accountUUID string, bundle *settingsmsg.Bundle, setting *settingsmsg.Setting) (*settingsmsg.ValueWithIdentifier, bool, error) {
+	if setting.GetDefaultExpression() == "" {
+		return nil, false, nil
+	}
+	raw, err := g.evaluateExpression(ctx, accountUUID, setting, map[string]bool{})
+	if err != nil {
+		return nil, false, err
+	}
+	value, err := parseSettingValue(raw, setting)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := validateValueForSetting(value, setting); err != nil {
+		return nil, false, err
+	}
+	return identifyDefault(bundle, setting, value, accountUUID), true, nil
+}
+
+// evaluateExpression replaces the references of the default expression of the setting with their values.
+// The settings being evaluated are tracked in visiting to stop cycles of settings saved before the check.
+func (g Service) evaluateExpression(ctx context.Context, accountUUID string, setting *settingsmsg.Setting, visiting map[string]bool) (string, error) {
+	if visiting[setting.Id] {
+		return "", fmt.Errorf("the default expression of setting %s references itself", setting.Id)
+	}
+	if len(visiting) >= maxExpressionDepth {
+		return "", fmt.Errorf("the references are nested deeper than %d settings", maxExpressionDepth)
+	}
+	parts, err := parseDefaultExpression(setting.DefaultExpression)
+	if err != nil {
+		return "", err
+	}
+	visiting[setting.Id] = true
+	defer delete(visiting, setting.Id)
+
+	var b strings.Builder
+	for _, p := range parts {
+		switch p.kind {
+		case expressionEnv:
+			// variables can be removed from the configuration after the expression was saved
+			if !g.expressionEnvAllowed(p.name) {
+				return "", fmt.Errorf("the environment variable %s can't be referenced", p.name)
+			}
+			b.WriteString(os.Getenv(p.name))
+		case expressionSetting:
+			v, err := g.referencedValue(ctx, accountUUID, p.name, visiting)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(v)
+		default:
+			b.WriteString(p.literal)
+		}
+	}
+	return b.String(), nil
+}
+
+// referencedValue returns the value of a setting referenced by a default expression, like GetValueByUniqueIdentifiers
+// it is the value of the account, of its groups, the system value or the default.
+func (g Service) referencedValue(ctx context.Context, accountUUID, settingID string, visiting map[string]bool) (string, error) {
+	shared := accountUUID == settings.SystemAccountUUID || settings.IsGroupAccount(accountUUID)
+	v, err := g.store(ctx).ReadValueByUniqueIdentifiers(accountUUID, settingID)
+	if err != nil && !shared {
+		groups, gerr := g.parentGroups(ctx, accountUUID, "")
+		if gerr != nil {
+			return "", gerr
+		}
+		v, err = g.inheritedValue(groups, settingID)
+	}
+	if err != nil && accountUUID != settings.SystemAccountUUID {
+		v, err = g.store(ctx).ReadValueByUniqueIdentifiers(settings.SystemAccountUUID, settingID)
+	}
+	if err == nil {
+		return formatValue(v), nil
+	}
+	if o, ok := g.defaultOverrides[settingID]; ok {
+		return formatValue(o.value), nil
+	}
+	_, setting := g.bundleSetting(ctx, settingID)
+	if setting == nil {
+		return "", fmt.Errorf("the referenced setting %s does not exist", settingID)
+	}
+	if setting.DefaultExpression != "" {
+		return g.evaluateExpression(ctx, accountUUID, setting, visiting)
+	}
+	return formatDefault(setting), nil
+}
+
+// bundleSetting returns the setting and its bundle, or nil if the setting doesn't exist.
+func (g Service) bundleSetting(ctx context.Context, settingID string) (*settingsmsg.Bundle, *settingsmsg.Setting) {
+	bundle, err := g.store(ctx).ReadBundleBySetting(settingID)
+	if err != nil {
+		return nil, nil
+	}
+	for _, s := range bundle.Settings {
+		if s.Id == settingID {
+			return bundle, s
+		}
+	}
+	return nil, nil
+}
+
+// formatValue returns the string representation of a value as it is parsed by parseSettingValue.
+func formatValue(value *settingsmsg.Value) string {
+	switch v := value.GetValue().(type) {
+	case *settingsmsg.Value_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *settingsmsg.Value_StringValue:
+		return v.StringValue
+	case *settingsmsg.Value_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *settingsmsg.Value_JsonValue:
+		return v.JsonValue
+	case *settingsmsg.Value_ListValue:
+		options := make([]string, 0, len(v.ListValue.GetValues()))
+		for _, o := range v.ListValue.GetValues() {
+			options = append(options, formatListOption(o))
+		}
+		return strings.Join(options, ",")
+	}
+	return ""
+}
+
+// formatDefault returns the string representation of the static default of a setting.
+func formatDefault(setting *settingsmsg.Setting) string {
+	var options []*settingsmsg.ListOption
+	switch s := setting.Value.(type) {
+	case *settingsmsg.Setting_IntValue:
+		return strconv.FormatInt(s.IntValue.GetDefault(), 10)
+	case *settingsmsg.Setting_StringValue:
+		return s.StringValue.GetDefault()
+	case *settingsmsg.Setting_BoolValue:
+		return strconv.FormatBool(s.BoolValue.GetDefault())
+	case *settingsmsg.Setting_JsonValue:
+		return s.JsonValue.GetDefault()
+	case *settingsmsg.Setting_SingleChoiceValue:
+		options = s.SingleChoiceValue.GetOptions()
+	case *settingsmsg.Setting_MultiChoiceValue:
+		options = s.MultiChoiceValue.GetOptions()
+	}
+	var selected []string
+	for _, o := range options {
+		if o.Default {
+			selected = append(selected, formatListOption(o.GetValue()))
+		}
+	}
+	return strings.Join(selected, ",")
+}
+
+func formatListOption(o *settingsmsg.ListOptionValue) string {
+	switch v := o.GetOption().(type) {
+	case *settingsmsg.ListOptionValue_StringValue:
+		return v.StringValue
+	case *settingsmsg.ListOptionValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+)
+
+// errNoInheritedValue is returned if none of the groups of an account has a value of the setting.
+var errNoInheritedValue = errors.New("no inherited value")
+
+// parentGroups returns the groups the account inherits values from. The group of the request takes
+// precedence over the configured parent provider.
+func (g Service) parentGroups(ctx context.Context, accountUUID, requested string) ([]string, error) {
+	if requested != "" {
+		return []string{requested}, nil
+	}
+	if g.config == nil || g.config.ParentProvider == "" {
+		return nil, nil
+	}
+	provider, ok := settings.ParentProviders[g.config.ParentProvider]
+	if !ok {
+		return nil, fmt.Errorf("unknown parent provider %s", g.config.ParentProvider)
+	}
+	groups, err := provider(ctx, accountUUID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the parent groups of account %s: %w", accountUUID, err)
+	}
+	return groups, nil
+}
+
+// inheritedValue returns the value of the first group having a value of the setting.
+func (g Service) inheritedValue(groups []string, settingID string) (*settingsmsg.Value, error) {
+	for _, group := range groups {
+		if v, err := g.manager.ReadValueByUniqueIdentifiers(settings.GroupAccountUUID(group), settingID); err == nil {
+			return v, nil
+		}
+	}
+	return nil, errNoInheritedValue
+}
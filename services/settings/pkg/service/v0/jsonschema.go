@@ -0,0 +1,374 @@
+package svc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsonSchemaAnnotations are the keywords of a JSON Schema that don't affect the validation.
+var jsonSchemaAnnotations = map[string]struct{}{
+	"$schema": {}, "$id": {}, "$comment": {}, "title": {}, "description": {}, "default": {}, "examples": {},
+}
+
+// jsonSchemaTypes are the types of the type keyword.
+var jsonSchemaTypes = map[string]struct{}{
+	"object": {}, "array": {}, "string": {}, "number": {}, "integer": {}, "boolean": {}, "null": {},
+}
+
+// jsonSchema is a compiled JSON Schema. It supports the keywords type, enum, const, properties, required,
+// additionalProperties, items, minItems, maxItems, minLength, maxLength, pattern, minimum and maximum,
+// schemas using other keywords are rejected.
+type jsonSchema struct {
+	types                []string
+	enum                 []interface{}
+	properties           map[string]*jsonSchema
+	required             []string
+	additionalProperties *jsonSchema
+	noAdditional         bool
+	items                *jsonSchema
+	minItems, maxItems   *int
+	minLength, maxLength *int
+	pattern              *regexp.Regexp
+	minimum, maximum     *float64
+}
+
+// decodeJSON decodes a single JSON document, numbers are kept as json.Number.
+func decodeJSON(doc string) (interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(doc))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err == nil {
+		return nil, errors.New("unexpected data after the document")
+	}
+	return v, nil
+}
+
+// compileJSONSchema parses a JSON Schema.
+func compileJSONSchema(raw string) (*jsonSchema, error) {
+	v, err := decodeJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return newJSONSchema(v, "")
+}
+
+func newJSONSchema(v interface{}, path string) (*jsonSchema, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema %s must be an object", pointer(path))
+	}
+	s := &jsonSchema{}
+	keywords := make([]string, 0, len(m))
+	for k := range m {
+		keywords = append(keywords, k)
+	}
+	// report the same error for the same schema
+	sort.Strings(keywords)
+	for _, k := range keywords {
+		if err := s.set(k, m[k], path); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// set compiles a keyword of the schema.
+func (s *jsonSchema) set(keyword string, v interface{}, path string) error {
+	invalid := func(expected string) error {
+		return fmt.Errorf("%s of schema %s must be %s", keyword, pointer(path), expected)
+	}
+	var err error
+	switch keyword {
+	case "type":
+		switch t := v.(type) {
+		case string:
+			s.types = []string{t}
+		case []interface{}:
+			for _, e := range t {
+				name, ok := e.(string)
+				if !ok {
+					return invalid("a type or a list of types")
+				}
+				s.types = append(s.types, name)
+			}
+		default:
+			return invalid("a type or a list of types")
+		}
+		for _, t := range s.types {
+			if _, ok := jsonSchemaTypes[t]; !ok {
+				return fmt.Errorf("unknown type %s in schema %s", t, pointer(path))
+			}
+		}
+	case "enum":
+		enum, ok := v.([]interface{})
+		if !ok {
+			return invalid("a list")
+		}
+		s.enum = enum
+	case "const":
+		s.enum = []interface{}{v}
+	case "properties":
+		props, ok := v.(map[string]interface{})
+		if !ok {
+			return invalid("an object")
+		}
+		s.properties = make(map[string]*jsonSchema, len(props))
+		for name, p := range props {
+			if s.properties[name], err = newJSONSchema(p, path+"/properties/"+escapePointer(name)); err != nil {
+				return err
+			}
+		}
+	case "required":
+		required, ok := v.([]interface{})
+		if !ok {
+			return invalid("a list of property names")
+		}
+		for _, r := range required {
+			name, ok := r.(string)
+			if !ok {
+				return invalid("a list of property names")
+			}
+			s.required = append(s.required, name)
+		}
+	case "additionalProperties":
+		if b, ok := v.(bool); ok {
+			s.noAdditional = !b
+			return nil
+		}
+		s.additionalProperties, err = newJSONSchema(v, path+"/additionalProperties")
+		return err
+	case "items":
+		s.items, err = newJSONSchema(v, path+"/items")
+		return err
+	case "minItems", "maxItems", "minLength", "maxLength":
+		n, ok := v.(json.Number)
+		if !ok {
+			return invalid("a non-negative integer")
+		}
+		i, err := strconv.Atoi(n.String())
+		if err != nil || i < 0 {
+			return invalid("a non-negative integer")
+		}
+		switch keyword {
+		case "minItems":
+			s.minItems = &i
+		case "maxItems":
+			s.maxItems = &i
+		case "minLength":
+			s.minLength = &i
+		case "maxLength":
+			s.maxLength = &i
+		}
+	case "minimum", "maximum":
+		n, ok := v.(json.Number)
+		if !ok {
+			return invalid("a number")
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return invalid("a number")
+		}
+		if keyword == "minimum" {
+			s.minimum = &f
+		} else {
+			s.maximum = &f
+		}
+	case "pattern":
+		p, ok := v.(string)
+		if !ok {
+			return invalid("a regular expression")
+		}
+		// unlike the pattern of string settings, the pattern of a schema matches anywhere in the string
+		if s.pattern, err = regexp.Compile(p); err != nil {
+			return fmt.Errorf("invalid pattern of schema %s: %w", pointer(path), err)
+		}
+	default:
+		if _, ok := jsonSchemaAnnotations[keyword]; !ok {
+			return fmt.Errorf("unsupported keyword %s in schema %s", keyword, pointer(path))
+		}
+	}
+	return nil
+}
+
+// validate checks the decoded document against the schema.
+func (s *jsonSchema) validate(v interface{}, path string) error {
+	if len(s.types) > 0 && !s.hasType(v) {
+		return fmt.Errorf("%s must be of type %s", pointer(path), strings.Join(s.types, " or "))
+	}
+	if s.enum != nil {
+		found := false
+		for _, e := range s.enum {
+			if equalJSON(e, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s must be one of the allowed values", pointer(path))
+		}
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for _, name := range s.required {
+			if _, ok := t[name]; !ok {
+				return fmt.Errorf("%s must have the property %s", pointer(path), name)
+			}
+		}
+		names := make([]string, 0, len(t))
+		for name := range t {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			p := path + "/" + escapePointer(name)
+			if ps, ok := s.properties[name]; ok {
+				if err := ps.validate(t[name], p); err != nil {
+					return err
+				}
+				continue
+			}
+			if s.noAdditional {
+				return fmt.Errorf("%s must not have the property %s", pointer(path), name)
+			}
+			if s.additionalProperties != nil {
+				if err := s.additionalProperties.validate(t[name], p); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if s.minItems != nil && len(t) < *s.minItems {
+			return fmt.Errorf("%s must have at least %d items", pointer(path), *s.minItems)
+		}
+		if s.maxItems != nil && len(t) > *s.maxItems {
+			return fmt.Errorf("%s must have at most %d items", pointer(path), *s.maxItems)
+		}
+		if s.items != nil {
+			for i, item := range t {
+				if err := s.items.validate(item, path+"/"+strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		n := utf8.RuneCountInString(t)
+		if s.minLength != nil && n < *s.minLength {
+			return fmt.Errorf("%s must be at least %d characters long", pointer(path), *s.minLength)
+		}
+		if s.maxLength != nil && n > *s.maxLength {
+			return fmt.Errorf("%s must be at most %d characters long", pointer(path), *s.maxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(t) {
+			return fmt.Errorf("%s must match the pattern %s", pointer(path), s.pattern)
+		}
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return fmt.Errorf("%s is not a valid number", pointer(path))
+		}
+		if s.minimum != nil && f < *s.minimum {
+			return fmt.Errorf("%s must be at least %v", pointer(path), *s.minimum)
+		}
+		if s.maximum != nil && f > *s.maximum {
+			return fmt.Errorf("%s must be at most %v", pointer(path), *s.maximum)
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) hasType(v interface{}) bool {
+	for _, t := range s.types {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if t == "object" {
+				return true
+			}
+		case []interface{}:
+			if t == "array" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case json.Number:
+			if t == "number" {
+				return true
+			}
+			if f, err := v.Float64(); err == nil && t == "integer" && f == math.Trunc(f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// equalJSON compares two decoded documents, numbers are equal if they have the same value.
+func equalJSON(a, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		n, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		fa, erra := a.Float64()
+		fb, errb := n.Float64()
+		return erra == nil && errb == nil && fa == fb
+	case map[string]interface{}:
+		m, ok := b.(map[string]interface{})
+		if !ok || len(m) != len(a) {
+			return false
+		}
+		for k, v := range a {
+			if w, ok := m[k]; !ok || !equalJSON(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := b.([]interface{})
+		if !ok || len(l) != len(a) {
+			return false
+		}
+		for i := range a {
+			if !equalJSON(a[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// escapePointer escapes a property name for a JSON pointer.
+func escapePointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
+
+// pointer returns the JSON pointer of a path, the empty path is the whole document.
+func pointer(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
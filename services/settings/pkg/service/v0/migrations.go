@@ -0,0 +1,111 @@
+package svc
+
+import (
+	"fmt"
+	"sort"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunMigrations applies the registered migrations to the values of all bundles whose
+// values have not been migrated to the current bundle version yet. Every applied
+// migration is recorded, so running them again is a noop. Read-only instances don't migrate values.
+// The migrations have to be run before the service handles requests, which would see partly migrated values.
+func (g Service) RunMigrations() error {
+	if len(settings.Migrations) == 0 || g.readOnly() {
+		return nil
+	}
+
+	// the migrations of role bundles are applied like the ones of default bundles
+	for _, t := range []settingsmsg.Bundle_Type{settingsmsg.Bundle_TYPE_DEFAULT, settingsmsg.Bundle_TYPE_ROLE} {
+		bundles, err := g.manager.ListBundles(t, []string{})
+		if err != nil {
+			return err
+		}
+
+		for _, bundle := range bundles {
+			if len(settings.Migrations[bundle.Id]) == 0 {
+				continue
+			}
+			if err := g.migrateBundle(bundle); err != nil {
+				return fmt.Errorf("could not migrate bundle %s: %w", bundle.Id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (g Service) migrateBundle(bundle *settingsmsg.Bundle) error {
+	applied, err := g.manager.ReadMigrationVersion(bundle.Id)
+	if err != nil {
+		return err
+	}
+	if applied >= bundle.Version {
+		return nil
+	}
+
+	migrations := make([]settings.Migration, len(settings.Migrations[bundle.Id]))
+	copy(migrations, settings.Migrations[bundle.Id])
+	sort.SliceStable(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	var values []*settingsmsg.Value
+	for _, m := range migrations {
+		if m.Version <= applied || m.Version > bundle.Version {
+			continue
+		}
+
+		if values == nil {
+			if values, err = g.manager.ListBundleValues(bundle.Id); err != nil {
+				return err
+			}
+		}
+
+		for i, value := range values {
+			migrated, err := m.Migrate(proto.Clone(value).(*settingsmsg.Value), bundle)
+			if err != nil {
+				return fmt.Errorf("migration to version %d failed for value %s: %w", m.Version, value.Id, err)
+			}
+			if proto.Equal(migrated, value) {
+				continue
+			}
+			// clients syncing incrementally need to pick up the migrated value
+			migrated.UpdatedAt = timestamppb.Now()
+			if values[i], err = g.manager.WriteValue(migrated); err != nil {
+				return err
+			}
+			g.changes.publish(values[i], false)
+		}
+
+		if err := g.manager.WriteMigrationVersion(bundle.Id, m.Version); err != nil {
+			return err
+		}
+		applied = m.Version
+		g.logger.Info().Str("bundle", bundle.Id).Uint32("version", m.Version).Int("values", len(values)).Msg("migrated settings values")
+	}
+	return nil
+}
+
+// initMigrationVersion marks a bundle without any stored values as migrated to its
+// current version. Values created from now on already follow that schema and must
+// not be migrated again on the next start.
+func (g Service) initMigrationVersion(bundle *settingsmsg.Bundle) error {
+	if bundle.Version == 0 {
+		return nil
+	}
+
+	applied, err := g.manager.ReadMigrationVersion(bundle.Id)
+	if err != nil || applied >= bundle.Version {
+		return err
+	}
+
+	values, err := g.manager.ListBundleValues(bundle.Id)
+	if err != nil || len(values) > 0 {
+		return err
+	}
+	return g.manager.WriteMigrationVersion(bundle.Id, bundle.Version)
+}
@@ -0,0 +1,165 @@
+package svc
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultOverride is the default value of a built-in setting configured by the operator.
+type defaultOverride struct {
+	bundle  *settingsmsg.Bundle
+	setting *settingsmsg.Setting
+	value   *settingsmsg.Value
+}
+
+// parseDefaultOverrides parses the configured 'setting id=value' pairs and validates the values
+// against the settings of the given bundles.
+func parseDefaultOverrides(overrides []string, bundles []*settingsmsg.Bundle) (map[string]defaultOverride, error) {
+	parsed := make(map[string]defaultOverride, len(overrides))
+	for _, o := range overrides {
+		settingID, raw, ok := strings.Cut(o, "=")
+		settingID = strings.TrimSpace(settingID)
+		if !ok || settingID == "" {
+			return nil, fmt.Errorf("invalid default override '%s', expected 'setting id=value'", o)
+		}
+		if _, ok := parsed[settingID]; ok {
+			return nil, fmt.Errorf("default of setting %s is overridden more than once", settingID)
+		}
+		bundle, setting := findBundleSetting(settingID, bundles)
+		if setting == nil {
+			return nil, fmt.Errorf("default override for unknown setting %s", settingID)
+		}
+		value, err := parseSettingValue(raw, setting)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default override for setting %s: %w", settingID, err)
+		}
+		if err := validateValueForSetting(value, setting); err != nil {
+			return nil, fmt.Errorf("invalid default override for setting %s: %w", settingID, err)
+		}
+		parsed[settingID] = defaultOverride{bundle: bundle, setting: setting, value: value}
+	}
+	return parsed, nil
+}
+
+func findBundleSetting(settingID string, bundles []*settingsmsg.Bundle) (*settingsmsg.Bundle, *settingsmsg.Setting) {
+	for _, b := range bundles {
+		if b.GetType() != settingsmsg.Bundle_TYPE_DEFAULT {
+			continue
+		}
+		for _, s := range b.GetSettings() {
+			if s.GetId() == settingID {
+				return b, s
+			}
+		}
+	}
+	return nil, nil
+}
+
+// parseSettingValue converts the string representation of a value into a value of the given setting.
+// The options of a multi choice value are separated by commas.
+func parseSettingValue(raw string, setting *settingsmsg.Setting) (*settingsmsg.Value, error) {
+	switch s := setting.GetValue().(type) {
+	case *settingsmsg.Setting_IntValue:
+		v, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an int", raw)
+		}
+		return &settingsmsg.Value{Value: &settingsmsg.Value_IntValue{IntValue: v}}, nil
+	case *settingsmsg.Setting_StringValue:
+		return &settingsmsg.Value{Value: &settingsmsg.Value_StringValue{StringValue: raw}}, nil
+	case *settingsmsg.Setting_BoolValue:
+		v, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a bool", raw)
+		}
+		return &settingsmsg.Value{Value: &settingsmsg.Value_BoolValue{BoolValue: v}}, nil
+	case *settingsmsg.Setting_SingleChoiceValue:
+		return parseListValue([]string{raw}, s.SingleChoiceValue.GetOptions()), nil
+	case *settingsmsg.Setting_MultiChoiceValue:
+		var parts []string
+		if strings.TrimSpace(raw) != "" {
+			parts = strings.Split(raw, ",")
+		}
+		return parseListValue(parts, s.MultiChoiceValue.GetOptions()), nil
+	case *settingsmsg.Setting_JsonValue:
+		return &settingsmsg.Value{Value: &settingsmsg.Value_JsonValue{JsonValue: raw}}, nil
+	default:
+		return nil, fmt.Errorf("the setting has no default value")
+	}
+}
+
+// parseListValue selects the options matching the given strings. Strings without a matching option are kept
+// as string options, so that the validation reports them.
+func parseListValue(selected []string, options []*settingsmsg.ListOption) *settingsmsg.Value {
+	list := &settingsmsg.ListValue{}
+	for _, sel := range selected {
+		sel = strings.TrimSpace(sel)
+		lov := &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: sel}}
+		for _, o := range options {
+			switch v := o.GetValue().GetOption().(type) {
+			case *settingsmsg.ListOptionValue_StringValue:
+				if v.StringValue == sel {
+					lov = o.GetValue()
+				}
+			case *settingsmsg.ListOptionValue_IntValue:
+				if strconv.FormatInt(v.IntValue, 10) == sel {
+					lov = o.GetValue()
+				}
+			}
+		}
+		list.Values = append(list.Values, lov)
+	}
+	return &settingsmsg.Value{Value: &settingsmsg.Value_ListValue{ListValue: list}}
+}
+
+// overriddenValue returns the overridden default of the setting for the given account.
+func (g Service) overriddenValue(accountUUID, settingID string) (*settingsmsg.ValueWithIdentifier, bool) {
+	o, ok := g.defaultOverrides[settingID]
+	if !ok {
+		return nil, false
+	}
+	return identifyDefault(o.bundle, o.setting, proto.Clone(o.value).(*settingsmsg.Value), accountUUID), true
+}
+
+// identifyDefault adds the ids and the identifier of the setting to a default value of the account.
+func identifyDefault(bundle *settingsmsg.Bundle, setting *settingsmsg.Setting, value *settingsmsg.Value, accountUUID string) *settingsmsg.ValueWithIdentifier {
+	value.BundleId = bundle.GetId()
+	value.SettingId = setting.GetId()
+	value.AccountUuid = accountUUID
+	value.Resource = setting.GetResource()
+	return &settingsmsg.ValueWithIdentifier{
+		Identifier: &settingsmsg.Identifier{
+			Extension: bundle.GetExtension(),
+			Bundle:    bundle.GetName(),
+			Setting:   setting.GetName(),
+		},
+		Value: value,
+	}
+}
+
+// appendOverriddenValues appends the overridden defaults of the settings in the given bundle, or in all
+// bundles if no bundle is given, which have no value yet.
+func (g Service) appendOverriddenValues(values []*settingsmsg.ValueWithIdentifier, bundleID, accountUUID string) []*settingsmsg.ValueWithIdentifier {
+	hasValue := make(map[string]bool, len(values))
+	for _, v := range values {
+		hasValue[v.GetValue().GetSettingId()] = true
+	}
+	settingIDs := make([]string, 0, len(g.defaultOverrides))
+	for id, o := range g.defaultOverrides {
+		if !hasValue[id] && (bundleID == "" || o.bundle.GetId() == bundleID) {
+			settingIDs = append(settingIDs, id)
+		}
+	}
+	sort.Strings(settingIDs)
+
+	for _, id := range settingIDs {
+		value, _ := g.overriddenValue(accountUUID, id)
+		values = append(values, value)
+	}
+	return values
+}
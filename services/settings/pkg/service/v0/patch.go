@@ -0,0 +1,120 @@
+package svc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// patchBundle applies a JSON merge patch (RFC 7386) to a bundle and returns the patched copy.
+// The patch uses the JSON field names of the API. Unlike in a plain merge patch, the settings can be
+// patched by id with an object mapping setting ids to patches: a patch for an unknown id adds the
+// setting, null removes it. New settings are appended in the order of their ids.
+func patchBundle(bundle *settingsmsg.Bundle, patch []byte) (*settingsmsg.Bundle, error) {
+	var p map[string]interface{}
+	if err := json.Unmarshal(patch, &p); err != nil || p == nil {
+		return nil, errors.New("the patch must be a JSON object")
+	}
+
+	b, err := protojson.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	if sp, ok := p["settings"].(map[string]interface{}); ok {
+		delete(p, "settings")
+		if doc["settings"], err = patchSettings(doc["settings"], sp); err != nil {
+			return nil, err
+		}
+	}
+	if b, err = json.Marshal(mergePatch(doc, p)); err != nil {
+		return nil, err
+	}
+	res := &settingsmsg.Bundle{}
+	if err := protojson.Unmarshal(b, res); err != nil {
+		return nil, fmt.Errorf("invalid patch: %w", err)
+	}
+	if res.Id != bundle.Id {
+		return nil, errors.New("the id of the bundle can't be changed")
+	}
+	return res, nil
+}
+
+// patchSettings applies the patches keyed by setting id to a list of settings.
+func patchSettings(current interface{}, patches map[string]interface{}) ([]interface{}, error) {
+	list, _ := current.([]interface{})
+	res := make([]interface{}, 0, len(list)+len(patches))
+	patch := func(id string, setting, p interface{}) error {
+		patched, ok := mergePatch(setting, p).(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("the patch of setting %s must be an object", id)
+		}
+		if patched["id"] != id {
+			return fmt.Errorf("the id of setting %s can't be changed", id)
+		}
+		res = append(res, patched)
+		return nil
+	}
+
+	patchedIDs := make(map[string]bool, len(patches))
+	for _, s := range list {
+		m, _ := s.(map[string]interface{})
+		id, _ := m["id"].(string)
+		p, ok := patches[id]
+		if !ok {
+			res = append(res, s)
+			continue
+		}
+		patchedIDs[id] = true
+		if p == nil {
+			// removed
+			continue
+		}
+		if err := patch(id, s, p); err != nil {
+			return nil, err
+		}
+	}
+
+	added := make([]string, 0, len(patches))
+	for id, p := range patches {
+		if !patchedIDs[id] && p != nil {
+			added = append(added, id)
+		}
+	}
+	sort.Strings(added)
+	for _, id := range added {
+		if err := patch(id, map[string]interface{}{"id": id}, patches[id]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// mergePatch applies a JSON merge patch to a decoded JSON document as defined in RFC 7386.
+// The target is modified.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
@@ -0,0 +1,109 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+	merrors "go-micro.dev/v4/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxPlanBundles limits the bundles of a single import plan.
+const maxPlanBundles = 1000
+
+// PlanImport implements the BundleServiceHandler interface
+func (g Service) PlanImport(ctx context.Context, req *settingssvc.PlanImportRequest, res *settingssvc.PlanImportResponse) error {
+	if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no settings management permission")
+	}
+	if validationError := validatePlanImport(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	// the bundles are planned in the order they would be saved, so they may depend on the bundles before them
+	planned := make(map[string]*settingsmsg.Bundle, len(req.Bundles))
+	read := func(id string) (*settingsmsg.Bundle, error) {
+		if b, ok := planned[id]; ok {
+			return b, nil
+		}
+		return g.store(ctx).ReadBundle(id)
+	}
+
+	seen := make(map[string]bool, len(req.Bundles))
+	res.Entries = make([]*settingssvc.ImportPlanEntry, 0, len(req.Bundles))
+	for _, b := range req.Bundles {
+		entry := &settingssvc.ImportPlanEntry{
+			BundleId:  b.Id,
+			Extension: b.Extension,
+			Name:      b.Name,
+		}
+		res.Entries = append(res.Entries, entry)
+		if b.Id != "" && seen[b.Id] {
+			entry.Action = settingssvc.ImportPlanEntry_ACTION_REJECT
+			entry.Error = fmt.Sprintf("bundle %s is listed more than once", b.Id)
+			continue
+		}
+		seen[b.Id] = true
+		if err := g.planBundle(ctx, b, entry, read); err != nil {
+			entry.Action = settingssvc.ImportPlanEntry_ACTION_REJECT
+			entry.Error = err.Error()
+			continue
+		}
+		if b.Id != "" {
+			planned[b.Id] = b
+		}
+	}
+	return nil
+}
+
+// planBundle runs the checks of saving the bundle and sets the action of the entry, compared with the saved bundle.
+// Nothing is written.
+func (g Service) planBundle(ctx context.Context, bundle *settingsmsg.Bundle, entry *settingssvc.ImportPlanEntry, read func(id string) (*settingsmsg.Bundle, error)) error {
+	bundle = proto.Clone(bundle).(*settingsmsg.Bundle)
+	cleanUpResource(ctx, bundle.Resource)
+	if bundle.Type == settingsmsg.Bundle_TYPE_ROLE && !g.canManageRoles(ctx) {
+		return errors.New("user has no role management permission")
+	}
+	if validationError := validateSaveBundle(&settingssvc.SaveBundleRequest{Bundle: bundle}); validationError != nil {
+		return validationError
+	}
+	existing, err := g.store(ctx).ReadBundleByName(bundle.Extension, bundle.Name)
+	switch {
+	case err == nil && existing.Id != bundle.Id:
+		return fmt.Errorf("bundle %s of extension %s already exists", bundle.Name, bundle.Extension)
+	case err != nil && !errors.Is(err, settings.ErrBundleNotFound):
+		return err
+	}
+	if err := checkDependencies(bundle, read); err != nil {
+		return err
+	}
+	if err := g.checkDefaultExpressions(bundle); err != nil {
+		return err
+	}
+
+	if bundle.Id == "" {
+		entry.Action = settingssvc.ImportPlanEntry_ACTION_CREATE
+		return nil
+	}
+	current, err := g.store(ctx).ReadBundle(bundle.Id)
+	if err != nil {
+		entry.Action = settingssvc.ImportPlanEntry_ACTION_CREATE
+		return nil
+	}
+	// the saved bundle is replaced, the changes are the ones DiffBundles reports for it
+	if entry.BundleChanges, err = diffFields(current, bundle, ignoredBundleFields); err != nil {
+		return err
+	}
+	if entry.SettingChanges, err = diffSettings(current.Settings, bundle.Settings); err != nil {
+		return err
+	}
+	entry.Action = settingssvc.ImportPlanEntry_ACTION_SKIP
+	if len(entry.BundleChanges) > 0 || len(entry.SettingChanges) > 0 {
+		entry.Action = settingssvc.ImportPlanEntry_ACTION_UPDATE
+	}
+	return nil
+}
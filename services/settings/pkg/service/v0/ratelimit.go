@@ -0,0 +1,66 @@
+package svc
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter counts requests per account in fixed time windows. The counters only live
+// in the memory of this instance, with several settings instances the limit applies to each of them.
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu       sync.Mutex
+	counters map[string]*rateCounter
+}
+
+type rateCounter struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:    limit,
+		window:   window,
+		now:      time.Now,
+		counters: map[string]*rateCounter{},
+	}
+}
+
+// allow counts a request of the account. If the limit is exceeded it returns false and the time
+// until the next window starts. A nil rateLimiter allows all requests.
+func (l *rateLimiter) allow(accountUUID string) (time.Duration, bool) {
+	if l == nil {
+		return 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	c, ok := l.counters[accountUUID]
+	if !ok || now.Sub(c.start) >= l.window {
+		if !ok && len(l.counters) >= 1000 {
+			l.expire(now)
+		}
+		c = &rateCounter{start: now}
+		l.counters[accountUUID] = c
+	}
+	if c.count >= l.limit {
+		return c.start.Add(l.window).Sub(now), false
+	}
+	c.count++
+	return 0, true
+}
+
+// expire removes the counters of past windows, so that accounts which stopped writing don't pile up.
+func (l *rateLimiter) expire(now time.Time) {
+	for id, c := range l.counters {
+		if now.Sub(c.start) >= l.window {
+			delete(l.counters, id)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package svc
+
+import merrors "go-micro.dev/v4/errors"
+
+// readOnly checks if the service is a read-only replica. Replicas serve the reads from a shared or replicated store
+// and reject all writes, the default roles and migrations are left to the writable instance.
+func (g Service) readOnly() bool {
+	return g.config != nil && g.config.ReadOnly
+}
+
+// checkWritable rejects writes to a read-only replica.
+func (g Service) checkWritable() error {
+	if g.readOnly() {
+		return merrors.MethodNotAllowed(g.id, "service is read-only")
+	}
+	return nil
+}
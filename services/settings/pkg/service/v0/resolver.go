@@ -0,0 +1,75 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+)
+
+// resolveValue computes the value of a setting with a registered resolver. It returns false if the
+// setting has no resolver, the value has to be read from the store then. If no resource is given,
+// the resource of the setting is used.
+func (g Service) resolveValue(ctx context.Context, accountUUID string, resource *settingsmsg.Resource, settingID string) (*settingsmsg.ValueWithIdentifier, bool, error) {
+	resolver, ok := settings.ValueResolvers[settingID]
+	if !ok {
+		return nil, false, nil
+	}
+	bundle, setting, err := g.findSetting(settingID)
+	if err != nil {
+		return nil, true, err
+	}
+	if resource == nil {
+		resource = setting.Resource
+	}
+	value, err := resolveSettingValue(ctx, resolver, accountUUID, resource, bundle, setting)
+	return value, true, err
+}
+
+// resolveSettingValue computes the value of the setting of the given bundle with its resolver.
+func resolveSettingValue(ctx context.Context, resolver settings.ValueResolverFunc, accountUUID string, resource *settingsmsg.Resource, bundle *settingsmsg.Bundle, setting *settingsmsg.Setting) (*settingsmsg.ValueWithIdentifier, error) {
+	value, err := resolver(ctx, accountUUID, resource, setting)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve value of setting %s: %w", setting.Id, err)
+	}
+	value.BundleId = bundle.Id
+	value.SettingId = setting.Id
+	value.AccountUuid = accountUUID
+	value.Resource = resource
+	value.ReadOnly = true
+	return &settingsmsg.ValueWithIdentifier{
+		Identifier: &settingsmsg.Identifier{
+			Extension: bundle.Extension,
+			Bundle:    bundle.Name,
+			Setting:   setting.Name,
+		},
+		Value: value,
+	}, nil
+}
+
+// findSetting returns the setting with the given id together with the bundle containing it.
+func (g Service) findSetting(settingID string) (*settingsmsg.Bundle, *settingsmsg.Setting, error) {
+	bundles, err := g.manager.ListBundles(settingsmsg.Bundle_TYPE_DEFAULT, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, b := range bundles {
+		for _, s := range b.Settings {
+			if s.Id == settingID {
+				return b, s, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("setting %s not found", settingID)
+}
+
+// validateWritable rejects values of settings with a registered resolver, they are computed and can't be saved.
+func validateWritable(value *settingsmsg.Value) error {
+	if _, ok := settings.ValueResolvers[value.SettingId]; ok {
+		return validation.Errors{"setting_id": errors.New("the value of the setting is computed and read-only")}
+	}
+	return nil
+}
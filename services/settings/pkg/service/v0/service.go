@@ -4,10 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
-
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	permissions "github.com/cs3org/go-cs3apis/cs3/permissions/v1beta1"
 	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/events"
 	"github.com/cs3org/reva/v2/pkg/rgrpc/status"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofrs/uuid"
+	ocisevents "github.com/owncloud/ocis/v2/ocis-pkg/events"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
 	"github.com/owncloud/ocis/v2/ocis-pkg/roles"
@@ -15,11 +27,15 @@ import (
 	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/store/defaults"
 	filestore "github.com/owncloud/ocis/v2/services/settings/pkg/store/filesystem"
 	metastore "github.com/owncloud/ocis/v2/services/settings/pkg/store/metadata"
 	merrors "go-micro.dev/v4/errors"
 	"go-micro.dev/v4/metadata"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Service represents a service.
@@ -28,14 +44,43 @@ type Service struct {
 	config  *config.Config
 	logger  log.Logger
 	manager settings.Manager
+	// writeLimiter limits the value writes per account, it is nil if the limit is disabled
+	writeLimiter *rateLimiter
+	// defaultOverrides are the configured defaults of built-in settings, keyed by the setting id
+	defaultOverrides map[string]defaultOverride
+	// changes wakes up the long polls of ListValuesModifiedSince
+	changes *changeHub
+	// eventsPublisher emits the events of the service, it is nil if events are disabled
+	eventsPublisher events.Publisher
 }
 
 // NewService returns a service implementation for Service.
 func NewService(cfg *config.Config, logger log.Logger) Service {
 	service := Service{
-		id:     "ocis-settings",
-		config: cfg,
-		logger: logger,
+		id:      "ocis-settings",
+		config:  cfg,
+		logger:  logger,
+		changes: newChangeHub(),
+	}
+	if l := cfg.ValueWriteRateLimit; l.Limit > 0 && l.Window > 0 {
+		service.writeLimiter = newRateLimiter(l.Limit, time.Duration(l.Window)*time.Second)
+	}
+	// the default bundles are saved by the stores, they are validated like saved bundles
+	if err := validateDefaultBundles(defaults.GenerateBundlesDefaultRoles()); err != nil {
+		logger.Fatal().Err(err).Msg("invalid default bundles")
+	}
+	overrides, err := parseDefaultOverrides(cfg.DefaultOverrides, defaults.GenerateBundlesDefaultRoles())
+	if err != nil {
+		logger.Fatal().Err(err).Msg("invalid default overrides")
+	}
+	service.defaultOverrides = overrides
+	publisher, err := newEventsPublisher(cfg.Events)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("could not initialize events publisher")
+	}
+	service.eventsPublisher = publisher
+	if _, ok := settings.ParentProviders[cfg.ParentProvider]; cfg.ParentProvider != "" && !ok {
+		logger.Fatal().Str("parent_provider", cfg.ParentProvider).Msg("unknown parent provider")
 	}
 
 	switch cfg.StoreType {
@@ -45,8 +90,10 @@ func NewService(cfg *config.Config, logger log.Logger) Service {
 		service.manager = metastore.New(cfg)
 	case "filesystem":
 		service.manager = filestore.New(cfg)
-		// TODO: if we want to further support filesystem store it should use default permissions from store/defaults/defaults.go instead using this duplicate
-		service.RegisterDefaultRoles()
+		// replicas read the default roles registered by the writable instance
+		if !cfg.ReadOnly {
+			service.RegisterDefaultRoles()
+		}
 	}
 	return service
 }
@@ -64,7 +111,7 @@ func (g Service) CheckPermission(ctx context.Context, req *permissions.CheckPerm
 		accountID = ref.GroupId.OpaqueId
 	}
 
-	assignments, err := g.manager.ListRoleAssignments(accountID)
+	assignments, err := g.store(ctx).ListRoleAssignments(accountID)
 	if err != nil {
 		return &permissions.CheckPermissionResponse{
 			Status: status.NewInternal(ctx, err.Error()),
@@ -76,7 +123,7 @@ func (g Service) CheckPermission(ctx context.Context, req *permissions.CheckPerm
 		roleIDs = append(roleIDs, a.RoleId)
 	}
 
-	permission, err := g.manager.ReadPermissionByName(req.Permission, roleIDs)
+	permission, err := g.store(ctx).ReadPermissionByName(req.Permission, roleIDs)
 	if err != nil {
 		if !errors.Is(err, settings.ErrPermissionNotFound) {
 			return &permissions.CheckPermissionResponse{
@@ -98,36 +145,26 @@ func (g Service) CheckPermission(ctx context.Context, req *permissions.CheckPerm
 	}, nil
 }
 
-// RegisterDefaultRoles composes default roles and saves them. Skipped if the roles already exist.
+// RegisterDefaultRoles saves the embedded default bundles like SaveBundle. Existing bundles are kept as they are,
+// only the settings missing in them, e.g. permissions added by an update, are added.
 func (g Service) RegisterDefaultRoles() {
 	// FIXME: we're writing default roles per service start (i.e. twice at the moment, for http and grpc server). has to happen only once.
-	for _, role := range generateBundlesDefaultRoles() {
-		bundleID := role.Extension + "." + role.Id
+	for _, bundle := range defaults.GenerateBundlesDefaultRoles() {
+		bundleID := bundle.Extension + "." + bundle.Id
 		// check if the role already exists
-		bundle, _ := g.manager.ReadBundle(role.Id)
-		if bundle != nil {
-			g.logger.Debug().Str("bundleID", bundleID).Msg("bundle already exists. skipping.")
-			continue
+		if existing, _ := g.manager.ReadBundle(bundle.Id); existing != nil {
+			if bundle = withMissingSettings(existing, bundle); bundle == nil {
+				g.logger.Debug().Str("bundleID", bundleID).Msg("bundle already exists. skipping.")
+				continue
+			}
 		}
-		// create the role
-		_, err := g.manager.WriteBundle(role)
-		if err != nil {
+		if _, err := g.saveBundle(&settingssvc.SaveBundleRequest{Bundle: bundle}); err != nil {
 			g.logger.Error().Err(err).Str("bundleID", bundleID).Msg("failed to register bundle")
+			continue
 		}
 		g.logger.Debug().Str("bundleID", bundleID).Msg("successfully registered bundle")
 	}
 
-	for _, req := range generatePermissionRequests() {
-		_, err := g.manager.AddSettingToBundle(req.GetBundleId(), req.GetSetting())
-		if err != nil {
-			g.logger.Error().
-				Err(err).
-				Str("bundleID", req.GetBundleId()).
-				Interface("setting", req.GetSetting()).
-				Msg("failed to register permission")
-		}
-	}
-
 	if g.config.SetupDefaultAssignments {
 		for _, req := range g.defaultRoleAssignments() {
 			if _, err := g.manager.WriteRoleAssignment(req.AccountUuid, req.RoleId); err != nil {
@@ -137,23 +174,149 @@ func (g Service) RegisterDefaultRoles() {
 	}
 }
 
+// withMissingSettings returns a copy of the existing bundle with the settings of the default bundle it lacks, or nil
+// if it has all of them. The settings of the existing bundle are not changed.
+func withMissingSettings(existing, defaultBundle *settingsmsg.Bundle) *settingsmsg.Bundle {
+	ids := make(map[string]bool, len(existing.Settings))
+	for _, s := range existing.Settings {
+		ids[s.Id] = true
+	}
+	var missing []*settingsmsg.Setting
+	for _, s := range defaultBundle.Settings {
+		if !ids[s.Id] {
+			missing = append(missing, s)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	merged := proto.Clone(existing).(*settingsmsg.Bundle)
+	merged.Settings = append(merged.Settings, missing...)
+	return merged
+}
+
 // TODO: check permissions on every request
 
 // SaveBundle implements the BundleServiceHandler interface
 func (g Service) SaveBundle(ctx context.Context, req *settingssvc.SaveBundleRequest, res *settingssvc.SaveBundleResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
 	cleanUpResource(ctx, req.Bundle.Resource)
 	if err := g.checkStaticPermissionsByBundleType(ctx, req.Bundle.Type); err != nil {
 		return err
 	}
+	// like reads, the account saving the bundle is only recorded for bundles with auditing
+	req.Bundle.UpdatedBy = ""
+	if req.Bundle.GetAuditReads() {
+		req.Bundle.UpdatedBy, _ = metadata.Get(ctx, middleware.AccountID)
+	}
+	r, err := g.saveBundle(req)
+	if err != nil {
+		return err
+	}
+	res.Bundle = r
+	return nil
+}
+
+// saveBundle validates and writes the bundle of the request, the permissions have to be checked by the caller.
+func (g Service) saveBundle(req *settingssvc.SaveBundleRequest) (*settingsmsg.Bundle, error) {
 	if validationError := validateSaveBundle(req); validationError != nil {
-		return merrors.BadRequest(g.id, "%s", validationError)
+		return nil, merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	existing, err := g.manager.ReadBundleByName(req.Bundle.Extension, req.Bundle.Name)
+	switch {
+	case err == nil && existing.Id != req.Bundle.Id:
+		return nil, merrors.Conflict(g.id, "bundle %s of extension %s already exists", req.Bundle.Name, req.Bundle.Extension)
+	case err != nil && !errors.Is(err, settings.ErrBundleNotFound):
+		return nil, merrors.InternalServerError(g.id, "%s", err)
+	}
+	if err := g.checkBundleDependencies(req.Bundle); err != nil {
+		return nil, merrors.BadRequest(g.id, "%s", err)
+	}
+	if err := g.checkDefaultExpressions(req.Bundle); err != nil {
+		return nil, merrors.BadRequest(g.id, "%s", err)
 	}
 
 	r, err := g.manager.WriteBundle(req.Bundle)
+	if err != nil {
+		return nil, merrors.BadRequest(g.id, "%s", err)
+	}
+	if err := g.initMigrationVersion(r); err != nil {
+		g.logger.Error().Err(err).Str("bundle", r.Id).Msg("could not initialize the migration version")
+	}
+	return r, nil
+}
+
+// UpdateBundle implements the BundleServiceHandler interface
+// It applies the patch to the stored bundle and saves the result like SaveBundle.
+func (g Service) UpdateBundle(ctx context.Context, req *settingssvc.UpdateBundleRequest, res *settingssvc.UpdateBundleResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
+	if validationError := validateUpdateBundle(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	bundle, err := g.store(ctx).ReadBundle(req.BundleId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if err := g.checkStaticPermissionsByBundleType(ctx, bundle.Type); err != nil {
+		return err
+	}
+	patched, err := patchBundle(bundle, []byte(req.Patch))
 	if err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
-	res.Bundle = r
+	if proto.Equal(bundle, patched) {
+		// nothing to write
+		res.Bundle = bundle
+		return nil
+	}
+
+	sres := &settingssvc.SaveBundleResponse{}
+	if err := g.SaveBundle(ctx, &settingssvc.SaveBundleRequest{Bundle: patched}, sres); err != nil {
+		return err
+	}
+	res.Bundle = sres.Bundle
+	return nil
+}
+
+// CloneBundle implements the BundleServiceHandler interface
+// It saves a copy of the bundle under a new id and name like SaveBundle.
+func (g Service) CloneBundle(ctx context.Context, req *settingssvc.CloneBundleRequest, res *settingssvc.CloneBundleResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
+	if validationError := validateCloneBundle(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	source, err := g.store(ctx).ReadBundle(req.BundleId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+
+	clone := proto.Clone(source).(*settingsmsg.Bundle)
+	clone.Id = uuid.Must(uuid.NewV4()).String()
+	clone.Name = req.Name
+	clone.Extension = req.Extension
+	clone.DisplayName = req.DisplayName
+	clone.UpdatedAt = nil
+	// the migrations are registered for the source bundle, there are no values of the clone to migrate
+	clone.Version = 0
+	if clone.Type != settingsmsg.Bundle_TYPE_ROLE {
+		// setting ids are unique across bundles, the ids of the permissions of a role identify the permission
+		for _, s := range clone.Settings {
+			s.Id = uuid.Must(uuid.NewV4()).String()
+		}
+	}
+
+	sres := &settingssvc.SaveBundleResponse{}
+	if err := g.SaveBundle(ctx, &settingssvc.SaveBundleRequest{Bundle: clone}, sres); err != nil {
+		return err
+	}
+	res.Bundle = sres.Bundle
 	return nil
 }
 
@@ -162,29 +325,152 @@ func (g Service) GetBundle(ctx context.Context, req *settingssvc.GetBundleReques
 	if validationError := validateGetBundle(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
-	bundle, err := g.manager.ReadBundle(req.BundleId)
+	if req.IncludeRoleGrants && !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	var bundle *settingsmsg.Bundle
+	var err error
+	if req.At != nil {
+		// older versions are only needed for debugging, they may contain settings removed for good reasons
+		if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+			return merrors.Forbidden(g.id, "user has no settings management permission")
+		}
+		bundle, err = g.store(ctx).ReadBundleVersion(req.BundleId, req.At.AsTime())
+	} else {
+		bundle, err = g.store(ctx).ReadBundle(req.BundleId)
+	}
 	if err != nil {
 		return merrors.NotFound(g.id, "%s", err)
 	}
+	// the conditions are evaluated against all settings, the controlling settings might be filtered
+	hidden, err := g.conditionallyHiddenSettings(ctx, bundle)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
 	filteredBundle := g.getFilteredBundle(g.getRoleIDs(ctx), bundle)
 	if len(filteredBundle.Settings) == 0 {
 		err = fmt.Errorf("could not read bundle: %s", req.BundleId)
 		return merrors.NotFound(g.id, "%s", err)
 	}
+	for _, setting := range filteredBundle.Settings {
+		g.resolveOptions(ctx, setting)
+	}
+
+	g.auditRead(ctx, "GetBundle", bundle, nil)
+
+	etag, err := bundleETag(filteredBundle, hidden...)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	res.HiddenSettingIds = hidden
+	res.Etag = etag
+	res.LastModified = filteredBundle.UpdatedAt
+	if req.IncludeRoleGrants {
+		// the etag doesn't cover the roles, so the conditions can't be evaluated
+		if res.RoleGrants, err = g.roleGrants(bundle.Id, filteredBundle.Settings); err != nil {
+			return merrors.InternalServerError(g.id, "%s", err)
+		}
+	} else if notModified(req.IfNoneMatch, req.IfModifiedSince, etag, filteredBundle.UpdatedAt) {
+		res.NotModified = true
+		return nil
+	}
 	res.Bundle = filteredBundle
 	return nil
 }
 
+// ListPublicBundles implements the BundleServiceHandler interface. The bundles are read without authentication,
+// so only public bundles and the system values of their settings are returned, never values of accounts.
+func (g Service) ListPublicBundles(ctx context.Context, req *settingssvc.ListPublicBundlesRequest, res *settingssvc.ListPublicBundlesResponse) error {
+	bundles, err := g.store(ctx).ListBundles(settingsmsg.Bundle_TYPE_DEFAULT, []string{})
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	for _, bundle := range bundles {
+		if !bundle.Public || bundle.AuditReads {
+			continue
+		}
+		// settings restricted to roles are never public
+		bundle.Settings = filterVisibleSettings(nil, bundle.Settings)
+		for _, setting := range bundle.Settings {
+			if v, err := g.store(ctx).ReadValueByUniqueIdentifiers(settings.SystemAccountUUID, setting.Id); err == nil {
+				res.Values = append(res.Values, &settingsmsg.ValueWithIdentifier{
+					Identifier: &settingsmsg.Identifier{Extension: bundle.Extension, Bundle: bundle.Name, Setting: setting.Name},
+					Value:      v,
+				})
+			} else if overridden, ok := g.overriddenValue(settings.SystemAccountUUID, setting.Id); ok {
+				res.Values = append(res.Values, overridden)
+			}
+		}
+		res.Bundles = append(res.Bundles, bundle)
+	}
+	return nil
+}
+
+// GetSetting implements the BundleServiceHandler interface
+func (g Service) GetSetting(ctx context.Context, req *settingssvc.GetSettingRequest, res *settingssvc.GetSettingResponse) error {
+	if validationError := validateGetSetting(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	bundle, err := g.store(ctx).ReadBundleBySetting(req.SettingId)
+	switch {
+	case errors.Is(err, settings.ErrSettingNotFound):
+		return merrors.NotFound(g.id, "%s", err)
+	case err != nil:
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	// settings the user can't read in the bundle are not found either
+	filteredBundle := g.getFilteredBundle(g.getRoleIDs(ctx), bundle)
+	for _, setting := range filteredBundle.Settings {
+		if setting.Id != req.SettingId {
+			continue
+		}
+		g.resolveOptions(ctx, setting)
+		g.auditRead(ctx, "GetSetting", bundle, nil)
+		res.Setting = setting
+		res.BundleId = bundle.Id
+		return nil
+	}
+	return merrors.NotFound(g.id, "could not read setting: %s", req.SettingId)
+}
+
+// conditionallyHiddenSettings evaluates the visible_when conditions of the settings of the bundle against the values
+// of the authenticated account.
+func (g Service) conditionallyHiddenSettings(ctx context.Context, bundle *settingsmsg.Bundle) ([]string, error) {
+	conditional := false
+	for _, s := range bundle.Settings {
+		conditional = conditional || s.VisibleWhen != nil
+	}
+	if !conditional {
+		return nil, nil
+	}
+	var values []*settingsmsg.Value
+	if accountUUID := getValidatedAccountUUID(ctx, "me"); accountUUID != "" {
+		var err error
+		if values, err = g.store(ctx).ListValues(bundle.Id, accountUUID); err != nil {
+			return nil, err
+		}
+	}
+	return hiddenSettings(bundle.Settings, values), nil
+}
+
 // ListBundles implements the BundleServiceHandler interface
 func (g Service) ListBundles(ctx context.Context, req *settingssvc.ListBundlesRequest, res *settingssvc.ListBundlesResponse) error {
 	// fetch all bundles
 	if validationError := validateListBundles(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
-	bundles, err := g.manager.ListBundles(settingsmsg.Bundle_TYPE_DEFAULT, req.BundleIds)
+	bundles, err := g.store(ctx).ListBundles(settingsmsg.Bundle_TYPE_DEFAULT, req.BundleIds)
 	if err != nil {
 		return merrors.NotFound(g.id, "%s", err)
 	}
+	if len(req.Tags) > 0 {
+		bundles = filterBundlesByTags(bundles, req.Tags, req.MatchAllTags)
+	}
+	if req.HasValues {
+		if bundles, err = g.filterBundlesWithValues(ctx, bundles); err != nil {
+			return merrors.InternalServerError(g.id, "%s", err)
+		}
+	}
 	roleIDs := g.getRoleIDs(ctx)
 
 	// filter settings in bundles that are allowed according to roles
@@ -192,6 +478,9 @@ func (g Service) ListBundles(ctx context.Context, req *settingssvc.ListBundlesRe
 	for _, bundle := range bundles {
 		filteredBundle := g.getFilteredBundle(roleIDs, bundle)
 		if len(filteredBundle.Settings) > 0 {
+			for _, setting := range filteredBundle.Settings {
+				g.resolveOptions(ctx, setting)
+			}
 			filteredBundles = append(filteredBundles, filteredBundle)
 		}
 	}
@@ -200,7 +489,54 @@ func (g Service) ListBundles(ctx context.Context, req *settingssvc.ListBundlesRe
 	return nil
 }
 
+// filterBundlesByTags keeps the bundles tagged with any of the given tags, or with all of them if matchAll is set.
+func filterBundlesByTags(bundles []*settingsmsg.Bundle, tags []string, matchAll bool) []*settingsmsg.Bundle {
+	filtered := make([]*settingsmsg.Bundle, 0, len(bundles))
+	for _, b := range bundles {
+		bundleTags := make(map[string]bool, len(b.Tags))
+		for _, t := range b.Tags {
+			bundleTags[t] = true
+		}
+		matches := 0
+		for _, t := range tags {
+			if bundleTags[t] {
+				matches++
+			}
+		}
+		if (matchAll && matches == len(tags)) || (!matchAll && matches > 0) {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered
+}
+
+// filterBundlesWithValues keeps the bundles in which the authenticated account has stored values.
+func (g Service) filterBundlesWithValues(ctx context.Context, bundles []*settingsmsg.Bundle) ([]*settingsmsg.Bundle, error) {
+	accountUUID := getValidatedAccountUUID(ctx, "me")
+	if accountUUID == "" {
+		return nil, nil
+	}
+	bundleIDs, err := g.store(ctx).ListBundleIDsWithValues(accountUUID)
+	if err != nil {
+		return nil, err
+	}
+	hasValues := make(map[string]bool, len(bundleIDs))
+	for _, id := range bundleIDs {
+		hasValues[id] = true
+	}
+	filtered := make([]*settingsmsg.Bundle, 0, len(bundleIDs))
+	for _, b := range bundles {
+		if hasValues[b.Id] {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered, nil
+}
+
 func (g Service) getFilteredBundle(roleIDs []string, bundle *settingsmsg.Bundle) *settingsmsg.Bundle {
+	// hide settings that are not meant for the roles of the user, regardless of permissions
+	bundle.Settings = filterVisibleSettings(roleIDs, bundle.Settings)
+
 	// check if full bundle is whitelisted
 	bundleResource := &settingsmsg.Resource{
 		Type: settingsmsg.Resource_TYPE_BUNDLE,
@@ -235,8 +571,37 @@ func (g Service) getFilteredBundle(roleIDs []string, bundle *settingsmsg.Bundle)
 	return bundle
 }
 
+// filterVisibleSettings drops the settings which are restricted to roles none of the given roleIDs match.
+func filterVisibleSettings(roleIDs []string, settings []*settingsmsg.Setting) []*settingsmsg.Setting {
+	visible := make([]*settingsmsg.Setting, 0, len(settings))
+	for _, setting := range settings {
+		if isSettingVisible(roleIDs, setting) {
+			visible = append(visible, setting)
+		}
+	}
+	return visible
+}
+
+// isSettingVisible checks if the setting is visible to any of the given roleIDs.
+func isSettingVisible(roleIDs []string, setting *settingsmsg.Setting) bool {
+	if len(setting.VisibleToRoles) == 0 {
+		return true
+	}
+	for _, roleID := range roleIDs {
+		for _, visibleTo := range setting.VisibleToRoles {
+			if roleID == visibleTo {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // AddSettingToBundle implements the BundleServiceHandler interface
 func (g Service) AddSettingToBundle(ctx context.Context, req *settingssvc.AddSettingToBundleRequest, res *settingssvc.AddSettingToBundleResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
 	cleanUpResource(ctx, req.Setting.Resource)
 	if err := g.checkStaticPermissionsByBundleID(ctx, req.BundleId); err != nil {
 		return err
@@ -244,8 +609,28 @@ func (g Service) AddSettingToBundle(ctx context.Context, req *settingssvc.AddSet
 	if validationError := validateAddSettingToBundle(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
+	if req.Setting.GroupId != "" || req.Setting.VisibleWhen != nil {
+		bundle, err := g.store(ctx).ReadBundle(req.BundleId)
+		if err != nil {
+			bundle = &settingsmsg.Bundle{}
+		}
+		// the setting replaces a stored setting with the same id
+		settings := []*settingsmsg.Setting{req.Setting}
+		for _, s := range bundle.Settings {
+			if s.Id != req.Setting.Id || s.Id == "" {
+				settings = append(settings, s)
+			}
+		}
+		if err := validateVisibleWhen(settings); err != nil {
+			return merrors.BadRequest(g.id, "%s", err)
+		}
+		bundle.Settings = []*settingsmsg.Setting{req.Setting}
+		if err := validateSettingGroups(bundle); err != nil {
+			return merrors.BadRequest(g.id, "%s", err)
+		}
+	}
 
-	r, err := g.manager.AddSettingToBundle(req.BundleId, req.Setting)
+	r, err := g.store(ctx).AddSettingToBundle(req.BundleId, req.Setting)
 	if err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
@@ -255,6 +640,9 @@ func (g Service) AddSettingToBundle(ctx context.Context, req *settingssvc.AddSet
 
 // RemoveSettingFromBundle implements the BundleServiceHandler interface
 func (g Service) RemoveSettingFromBundle(ctx context.Context, req *settingssvc.RemoveSettingFromBundleRequest, _ *emptypb.Empty) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
 	if err := g.checkStaticPermissionsByBundleID(ctx, req.BundleId); err != nil {
 		return err
 	}
@@ -262,30 +650,159 @@ func (g Service) RemoveSettingFromBundle(ctx context.Context, req *settingssvc.R
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
 
-	if err := g.manager.RemoveSettingFromBundle(req.BundleId, req.SettingId); err != nil {
+	if err := g.store(ctx).RemoveSettingFromBundle(req.BundleId, req.SettingId); err != nil {
+		return merrors.BadRequest(g.id, "%s", err)
+	}
+
+	return nil
+}
+
+// ReorderSettings implements the BundleServiceHandler interface
+// It sorts the settings of the bundle like the given ids, which have to be the ids of all settings of the bundle.
+func (g Service) ReorderSettings(ctx context.Context, req *settingssvc.ReorderSettingsRequest, res *settingssvc.ReorderSettingsResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
+	if validationError := validateReorderSettings(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	bundle, err := g.store(ctx).ReadBundle(req.BundleId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if err := g.checkStaticPermissionsByBundleType(ctx, bundle.Type); err != nil {
+		return err
+	}
+	settings, err := reorderSettings(bundle.Settings, req.SettingIds)
+	if err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
+	bundle.Settings = settings
+
+	sres := &settingssvc.SaveBundleResponse{}
+	if err := g.SaveBundle(ctx, &settingssvc.SaveBundleRequest{Bundle: bundle}, sres); err != nil {
+		return err
+	}
+	res.Bundle = sres.Bundle
+	return nil
+}
+
+// reorderSettings sorts the settings like the given ids. It fails if an id is missing, unknown or repeated.
+func reorderSettings(settings []*settingsmsg.Setting, ids []string) ([]*settingsmsg.Setting, error) {
+	byID := make(map[string]*settingsmsg.Setting, len(settings))
+	for _, s := range settings {
+		byID[s.Id] = s
+	}
+	reordered := make([]*settingsmsg.Setting, 0, len(ids))
+	for _, id := range ids {
+		s, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("setting %s is not part of the bundle or listed twice", id)
+		}
+		delete(byID, id)
+		reordered = append(reordered, s)
+	}
+	for _, s := range settings {
+		if _, ok := byID[s.Id]; ok {
+			return nil, fmt.Errorf("setting %s of the bundle is missing", s.Id)
+		}
+	}
+	return reordered, nil
+}
 
+// ListAudit implements the BundleServiceHandler interface
+func (g Service) ListAudit(ctx context.Context, req *settingssvc.ListAuditRequest, res *settingssvc.ListAuditResponse) error {
+	if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no settings management permission")
+	}
+	if validationError := validateListAudit(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	entries, err := g.store(ctx).ListReadAuditEntries(req.BundleId)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	res.Entries = entries
+	return nil
+}
+
+// ListChangedBundles implements the BundleServiceHandler interface
+// It returns the bundles saved after the given time, role bundles are only listed with the role management permission.
+func (g Service) ListChangedBundles(ctx context.Context, req *settingssvc.ListChangedBundlesRequest, res *settingssvc.ListChangedBundlesResponse) error {
+	if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no settings management permission")
+	}
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+	bundles, err := g.store(ctx).ListChangedBundles(since)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+
+	canManageRoles := g.canManageRoles(ctx)
+	changed := make([]*settingsmsg.Bundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		if bundle.Type == settingsmsg.Bundle_TYPE_ROLE && !canManageRoles {
+			continue
+		}
+		changed = append(changed, bundle)
+	}
+	sort.Slice(changed, func(i, j int) bool {
+		ti, tj := changed[i].GetUpdatedAt().AsTime(), changed[j].GetUpdatedAt().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return changed[i].Id < changed[j].Id
+	})
+	res.Bundles = changed
 	return nil
 }
 
 // SaveValue implements the ValueServiceHandler interface
-func (g Service) SaveValue(ctx context.Context, req *settingssvc.SaveValueRequest, res *settingssvc.SaveValueResponse) error {
+func (g Service) SaveValue(ctx context.Context, req *settingssvc.SaveValueRequest, res *settingssvc.SaveValueResponse) (err error) {
+	ctx, span := g.startSpan(ctx, "Settings.SaveValue", bundleAttribute(req.GetValue().GetBundleId()), settingAttribute(req.GetValue().GetSettingId()))
+	defer func() { endSpan(span, err) }()
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
 	req.Value.AccountUuid = getValidatedAccountUUID(ctx, req.Value.AccountUuid)
+	span.SetAttributes(g.accountAttribute(req.Value.AccountUuid))
 
-	if !g.isCurrentUser(ctx, req.Value.AccountUuid) {
+	switch {
+	case req.Value.AccountUuid == settings.SystemAccountUUID:
+		if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+			return merrors.Forbidden(g.id, "can't save system value without the settings management permission")
+		}
+	case settings.IsGroupAccount(req.Value.AccountUuid):
+		if !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+			return merrors.Forbidden(g.id, "can't save group value without the settings management permission")
+		}
+	case !g.isCurrentUser(ctx, req.Value.AccountUuid):
 		return merrors.Forbidden(g.id, "can't save value for another user")
 	}
+	if retryAfter, ok := g.writeLimiter.allow(req.Value.AccountUuid); !ok {
+		return merrors.New(g.id, fmt.Sprintf("too many value writes, retry in %d seconds", int(math.Ceil(retryAfter.Seconds()))), http.StatusTooManyRequests)
+	}
 
 	cleanUpResource(ctx, req.Value.Resource)
+	req.Comment = sanitizeComment(req.Comment)
 	// TODO: we need to check, if the authenticated user has permission to write the value for the specified resource (e.g. global, file with id xy, ...)
-	if validationError := validateSaveValue(req); validationError != nil {
+	validationError, err := g.validateValue(ctx, req)
+	switch {
+	case err != nil:
+		return merrors.NotFound(g.id, err.Error())
+	case validationError != nil:
 		return merrors.BadRequest(g.id, validationError.Error())
 	}
-	r, err := g.manager.WriteValue(req.Value)
+	req.Value.UpdatedAt = timestamppb.Now()
+	r, err := g.store(ctx).WriteValue(req.Value)
 	if err != nil {
 		return merrors.BadRequest(g.id, err.Error())
 	}
+	g.writeValueHistoryEntry(ctx, r, req.Comment)
+	g.changes.publish(r, false)
 	valueWithIdentifier, err := g.getValueWithIdentifier(r)
 	if err != nil {
 		return merrors.NotFound(g.id, err.Error())
@@ -294,34 +811,177 @@ func (g Service) SaveValue(ctx context.Context, req *settingssvc.SaveValueReques
 	return nil
 }
 
+// ValidateValue implements the ValueServiceHandler interface
+func (g Service) ValidateValue(ctx context.Context, req *settingssvc.ValidateValueRequest, res *settingssvc.ValidateValueResponse) error {
+	if req.Value == nil {
+		return merrors.BadRequest(g.id, "value must not be empty")
+	}
+	validationError, err := g.validateProposedValue(ctx, req.Value)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	res.Valid = validationError == nil
+	res.Errors = toValidationErrors(validationError)
+	return nil
+}
+
+// BulkValidateValues implements the ValueServiceHandler interface
+// Values of unknown settings are reported as invalid instead of failing the request.
+func (g Service) BulkValidateValues(ctx context.Context, req *settingssvc.BulkValidateValuesRequest, res *settingssvc.BulkValidateValuesResponse) error {
+	if validationError := validateBulkValidateValues(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	for _, value := range req.Values {
+		result := &settingssvc.ValidateValueResponse{}
+		validationError, err := g.validateProposedValue(ctx, value)
+		if err != nil {
+			validationError = validation.Errors{"setting_id": err}
+		}
+		result.Valid = validationError == nil
+		result.Errors = toValidationErrors(validationError)
+		res.Results = append(res.Results, result)
+	}
+	return nil
+}
+
+// validateProposedValue checks a value like SaveValue without writing it. The returned error is set if the
+// setting of the value can't be read, the value is invalid if the validation error is set.
+func (g Service) validateProposedValue(ctx context.Context, value *settingsmsg.Value) (validationError error, err error) {
+	value.AccountUuid = getValidatedAccountUUID(ctx, value.AccountUuid)
+	cleanUpResource(ctx, value.Resource)
+	return g.validateValue(ctx, &settingssvc.SaveValueRequest{Value: value})
+}
+
+// validateValue validates the request, the value has to be writable and match its setting.
+func (g Service) validateValue(ctx context.Context, req *settingssvc.SaveValueRequest) (validationError error, err error) {
+	ctx, span := g.startSpan(ctx, "Settings.Validate", settingAttribute(req.GetValue().GetSettingId()))
+	defer func() { endSpan(span, err) }()
+
+	validationError = validateSaveValue(req)
+	if validationError == nil {
+		validationError = validateWritable(req.Value)
+	}
+	if validationError == nil {
+		setting, err := g.store(ctx).ReadSetting(req.Value.SettingId)
+		if err != nil {
+			return nil, err
+		}
+		g.resolveOptions(ctx, setting)
+		// values with a unit suffix like "5GB" are saved in the unit of the setting
+		if validationError = normalizeUnitValue(req.Value, setting); validationError == nil {
+			validationError = validateValueForSetting(req.Value, setting)
+		}
+	}
+	span.SetAttributes(attribute.Bool("settings.valid", validationError == nil))
+	return validationError, nil
+}
+
 // GetValue implements the ValueServiceHandler interface
 func (g Service) GetValue(ctx context.Context, req *settingssvc.GetValueRequest, res *settingssvc.GetValueResponse) error {
 	if validationError := validateGetValue(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
-	r, err := g.manager.ReadValue(req.Id)
+	value, err := g.getValue(ctx, req.Id)
 	if err != nil {
-		return merrors.NotFound(g.id, "%s", err)
+		return err
 	}
-	valueWithIdentifier, err := g.getValueWithIdentifier(r)
-	if err != nil {
-		return merrors.NotFound(g.id, "%s", err)
+	res.Value = value
+	return nil
+}
+
+// GetValues implements the ValueServiceHandler interface
+// Values that can't be found, or that belong to other accounts, are reported in the response instead of failing
+// the request.
+func (g Service) GetValues(ctx context.Context, req *settingssvc.GetValuesRequest, res *settingssvc.GetValuesResponse) error {
+	if validationError := validateGetValues(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	for _, id := range req.Ids {
+		value, err := g.getValue(ctx, id)
+		switch {
+		case err == nil:
+			res.Values = append(res.Values, value)
+		case merrors.FromError(err).Code == http.StatusNotFound:
+			res.NotFound = append(res.NotFound, id)
+		default:
+			return err
+		}
 	}
-	res.Value = valueWithIdentifier
 	return nil
 }
 
+// getValue reads the value with the given id, values of settings with a resolver are computed.
+// Values of other accounts are not found, so their ids can't be probed.
+func (g Service) getValue(ctx context.Context, id string) (*settingsmsg.ValueWithIdentifier, error) {
+	r, err := g.store(ctx).ReadValue(id)
+	if err != nil {
+		return nil, merrors.NotFound(g.id, "%s", err)
+	}
+	if !g.canReadValue(ctx, r.AccountUuid) {
+		return nil, merrors.NotFound(g.id, "value %s not found", id)
+	}
+	resolved, ok, err := g.resolveValue(ctx, r.AccountUuid, r.Resource, r.SettingId)
+	if err != nil {
+		return nil, merrors.InternalServerError(g.id, "%s", err)
+	}
+	if ok {
+		return resolved, nil
+	}
+	bundle, err := g.store(ctx).ReadBundle(r.BundleId)
+	if err != nil {
+		return nil, merrors.NotFound(g.id, "%s", err)
+	}
+	valueWithIdentifier, err := g.identifyValue(bundle, r)
+	if err != nil {
+		return nil, merrors.NotFound(g.id, "%s", err)
+	}
+	g.auditRead(ctx, "GetValue", bundle, r)
+	return valueWithIdentifier, nil
+}
+
 // GetValueByUniqueIdentifiers implements the ValueService interface
 func (g Service) GetValueByUniqueIdentifiers(ctx context.Context, req *settingssvc.GetValueByUniqueIdentifiersRequest, res *settingssvc.GetValueResponse) error {
 	req.AccountUuid = getValidatedAccountUUID(ctx, req.AccountUuid)
-	if !g.isCurrentUser(ctx, req.AccountUuid) {
+	// system and group values apply to everyone or the members, so everyone can read them
+	shared := req.AccountUuid == settings.SystemAccountUUID || settings.IsGroupAccount(req.AccountUuid)
+	if !shared && !g.isCurrentUser(ctx, req.AccountUuid) {
 		return merrors.Forbidden(g.id, "can't get value of another user")
 	}
 	if validationError := validateGetValueByUniqueIdentifiers(req); validationError != nil {
 		return merrors.BadRequest(g.id, validationError.Error())
 	}
-	v, err := g.manager.ReadValueByUniqueIdentifiers(req.AccountUuid, req.SettingId)
+	resolved, ok, err := g.resolveValue(ctx, req.AccountUuid, nil, req.SettingId)
 	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	if ok {
+		res.Value = resolved
+		return nil
+	}
+	// the value of the account takes precedence over the values of its groups, the system value and the defaults
+	v, err := g.store(ctx).ReadValueByUniqueIdentifiers(req.AccountUuid, req.SettingId)
+	if err != nil && !shared {
+		var groups []string
+		if groups, err = g.parentGroups(ctx, req.AccountUuid, req.ParentGroupId); err != nil {
+			return merrors.InternalServerError(g.id, "%s", err)
+		}
+		v, err = g.inheritedValue(groups, req.SettingId)
+	}
+	if err != nil && req.AccountUuid != settings.SystemAccountUUID {
+		v, err = g.store(ctx).ReadValueByUniqueIdentifiers(settings.SystemAccountUUID, req.SettingId)
+	}
+	if err != nil {
+		if overridden, ok := g.overriddenValue(req.AccountUuid, req.SettingId); ok {
+			res.Value = overridden
+			return nil
+		}
+		derived, ok, derr := g.expressionValue(ctx, req.AccountUuid, req.SettingId)
+		if derr != nil {
+			g.logger.Error().Err(derr).Str("setting", req.SettingId).Msg("could not evaluate the default expression")
+		} else if ok {
+			res.Value = derived
+			return nil
+		}
 		return merrors.NotFound(g.id, err.Error())
 	}
 
@@ -346,7 +1006,7 @@ func (g Service) ListValues(ctx context.Context, req *settingssvc.ListValuesRequ
 	if validationError := validateListValues(req); validationError != nil {
 		return merrors.BadRequest(g.id, validationError.Error())
 	}
-	values, err := g.manager.ListValues(req.BundleId, req.AccountUuid)
+	values, err := g.store(ctx).ListValues(req.BundleId, req.AccountUuid)
 	if err != nil {
 		return merrors.NotFound(g.id, err.Error())
 	}
@@ -357,7 +1017,166 @@ func (g Service) ListValues(ctx context.Context, req *settingssvc.ListValuesRequ
 			result = append(result, valueWithIdentifier)
 		}
 	}
-	res.Values = result
+	res.Values = g.appendOverriddenValues(result, req.BundleId, req.AccountUuid)
+	return nil
+}
+
+// ListValuesModifiedSince implements the ValueServiceHandler interface
+// It returns the values of the account, and the system values, that were updated after the given time.
+func (g Service) ListValuesModifiedSince(ctx context.Context, req *settingssvc.ListValuesModifiedSinceRequest, res *settingssvc.ListValuesModifiedSinceResponse) error {
+	req.AccountUuid = getValidatedAccountUUID(ctx, req.AccountUuid)
+	if !g.isCurrentUser(ctx, req.AccountUuid) {
+		return merrors.Forbidden(g.id, "can't list values of another user")
+	}
+
+	if validationError := validateListValuesModifiedSince(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	wait := time.Duration(req.WaitSeconds) * time.Second
+	if max := g.longPollTimeout(); wait > max {
+		wait = max
+	}
+	// subscribing before listing makes sure that changes in between aren't missed
+	changed, cancel := g.changes.subscribe(req.AccountUuid)
+	defer cancel()
+
+	values, err := g.listValuesModifiedSince(req)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if len(values) == 0 && wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-changed:
+			if values, err = g.listValuesModifiedSince(req); err != nil {
+				return merrors.NotFound(g.id, "%s", err)
+			}
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+	res.Values = values
+	return nil
+}
+
+// listValuesModifiedSince lists the values of the account modified after the requested time, ordered by
+// their updated_at time and id.
+func (g Service) listValuesModifiedSince(req *settingssvc.ListValuesModifiedSinceRequest) ([]*settingsmsg.ValueWithIdentifier, error) {
+	values, err := g.manager.ListValues("", req.AccountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	modified := make([]*settingsmsg.Value, 0, len(values))
+	for _, value := range values {
+		// values written before the updated_at timestamp was introduced are only part of a full sync
+		if req.Since == nil || (value.UpdatedAt != nil && value.UpdatedAt.AsTime().After(req.Since.AsTime())) {
+			modified = append(modified, value)
+		}
+	}
+	sort.Slice(modified, func(i, j int) bool {
+		ti, tj := modified[i].GetUpdatedAt().AsTime(), modified[j].GetUpdatedAt().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return modified[i].Id < modified[j].Id
+	})
+
+	result := make([]*settingsmsg.ValueWithIdentifier, 0, len(modified))
+	for _, value := range modified {
+		valueWithIdentifier, err := g.getValueWithIdentifier(value)
+		if err == nil {
+			result = append(result, valueWithIdentifier)
+		}
+	}
+	return result, nil
+}
+
+// longPollTimeout is the maximum time ListValuesModifiedSince waits for changes.
+func (g Service) longPollTimeout() time.Duration {
+	if g.config == nil {
+		return 0
+	}
+	return time.Duration(g.config.LongPollTimeout) * time.Second
+}
+
+// PurgeAccount implements the ValueServiceHandler interface
+// It removes all values and role assignments of an account, e.g. after the account was deleted.
+// Purging an account without any values or assignments left is not an error.
+func (g Service) PurgeAccount(ctx context.Context, req *settingssvc.PurgeAccountRequest, res *settingssvc.PurgeAccountResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
+	if !g.canManageRoles(ctx) || !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "user has no permission to purge accounts")
+	}
+	if validationError := validatePurgeAccount(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	values, err := g.store(ctx).ListValues("", req.AccountUuid)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "could not list values: %s", err)
+	}
+	for _, value := range values {
+		// system values are listed together with the values of the account
+		if value.AccountUuid != req.AccountUuid {
+			continue
+		}
+		if err := g.store(ctx).DeleteValue(value.Id); err != nil {
+			return merrors.InternalServerError(g.id, "could not delete value %s: %s", value.Id, err)
+		}
+		g.changes.publish(value, true)
+		res.RemovedValues++
+	}
+
+	assignments, err := g.store(ctx).ListRoleAssignments(req.AccountUuid)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "could not list role assignments: %s", err)
+	}
+	for _, assignment := range assignments {
+		if err := g.store(ctx).RemoveRoleAssignment(assignment.Id); err != nil {
+			return merrors.InternalServerError(g.id, "could not remove role assignment %s: %s", assignment.Id, err)
+		}
+		res.RemovedAssignments++
+	}
+
+	executant, _ := metadata.Get(ctx, middleware.AccountID)
+	g.logger.Info().
+		Str("executant", executant).
+		Str("account", req.AccountUuid).
+		Uint32("removed_values", res.RemovedValues).
+		Uint32("removed_assignments", res.RemovedAssignments).
+		Msg("purged account")
+	g.publish(ocisevents.AccountPurged{
+		Executant:          &user.UserId{OpaqueId: executant},
+		AccountUUID:        req.AccountUuid,
+		RemovedValues:      res.RemovedValues,
+		RemovedAssignments: res.RemovedAssignments,
+		Timestamp:          utils.TSNow(),
+	})
+	return nil
+}
+
+// ListValueHistory implements the ValueServiceHandler interface
+func (g Service) ListValueHistory(ctx context.Context, req *settingssvc.ListValueHistoryRequest, res *settingssvc.ListValueHistoryResponse) error {
+	if validationError := validateListValueHistory(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	v, err := g.store(ctx).ReadValue(req.ValueId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if !g.isCurrentUser(ctx, v.AccountUuid) && !g.hasStaticPermission(ctx, SettingsManagementPermissionID) {
+		return merrors.Forbidden(g.id, "can't list the history of another user's value")
+	}
+	entries, err := g.store(ctx).ListValueHistory(req.ValueId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	res.Entries = entries
 	return nil
 }
 
@@ -382,7 +1201,7 @@ func (g Service) ListRoleAssignments(ctx context.Context, req *settingssvc.ListR
 	if validationError := validateListRoleAssignments(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
-	r, err := g.manager.ListRoleAssignments(req.AccountUuid)
+	r, err := g.store(ctx).ListRoleAssignments(req.AccountUuid)
 	if err != nil {
 		return merrors.NotFound(g.id, "%s", err)
 	}
@@ -392,6 +1211,9 @@ func (g Service) ListRoleAssignments(ctx context.Context, req *settingssvc.ListR
 
 // AssignRoleToUser implements the RoleServiceHandler interface
 func (g Service) AssignRoleToUser(ctx context.Context, req *settingssvc.AssignRoleToUserRequest, res *settingssvc.AssignRoleToUserResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
 	if !g.canManageRoles(ctx) {
 		return merrors.Forbidden(g.id, "user has no role management permission")
 	}
@@ -411,7 +1233,9 @@ func (g Service) AssignRoleToUser(ctx context.Context, req *settingssvc.AssignRo
 		return merrors.Forbidden(g.id, "Changing own role assignment forbidden")
 	}
 
-	r, err := g.manager.WriteRoleAssignment(req.AccountUuid, req.RoleId)
+	unlock := lockAssignments(req.AccountUuid)
+	r, err := g.store(ctx).WriteRoleAssignment(req.AccountUuid, req.RoleId)
+	unlock()
 	if err != nil {
 		return merrors.BadRequest(g.id, err.Error())
 	}
@@ -419,8 +1243,51 @@ func (g Service) AssignRoleToUser(ctx context.Context, req *settingssvc.AssignRo
 	return nil
 }
 
+// PreviewRoleAssignment implements the RoleServiceHandler interface
+func (g Service) PreviewRoleAssignment(ctx context.Context, req *settingssvc.AssignRoleToUserRequest, res *settingssvc.PreviewRoleAssignmentResponse) error {
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+
+	req.AccountUuid = getValidatedAccountUUID(ctx, req.AccountUuid)
+	if validationError := validateAssignRoleToUser(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	role, err := g.store(ctx).ReadBundle(req.RoleId)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	if role.Type != settingsmsg.Bundle_TYPE_ROLE {
+		return merrors.BadRequest(g.id, "bundle %s is not a role", req.RoleId)
+	}
+
+	assignments, err := g.store(ctx).ListRoleAssignments(req.AccountUuid)
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	current := make([]*settingsmsg.Bundle, 0, len(assignments))
+	for _, a := range assignments {
+		r, err := g.store(ctx).ReadBundle(a.RoleId)
+		if err != nil {
+			// the permissions of a deleted role don't apply anymore
+			g.logger.Debug().Err(err).Str("role", a.RoleId).Msg("could not read an assigned role")
+			continue
+		}
+		current = append(current, r)
+		res.CurrentRoleIds = append(res.CurrentRoleIds, a.RoleId)
+	}
+
+	// an account has exactly one role, the assignment replaces the current ones
+	res.Added, res.Removed, res.Unchanged = diffGrants(effectiveGrants(current), effectiveGrants([]*settingsmsg.Bundle{role}))
+	return nil
+}
+
 // RemoveRoleFromUser implements the RoleServiceHandler interface
 func (g Service) RemoveRoleFromUser(ctx context.Context, req *settingssvc.RemoveRoleFromUserRequest, _ *emptypb.Empty) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
 	if !g.canManageRoles(ctx) {
 		return merrors.Forbidden(g.id, "user has no role management permission")
 	}
@@ -435,7 +1302,7 @@ func (g Service) RemoveRoleFromUser(ctx context.Context, req *settingssvc.Remove
 		return merrors.InternalServerError(g.id, "user not in context")
 	}
 
-	al, err := g.manager.ListRoleAssignments(ownAccountUUID)
+	al, err := g.store(ctx).ListRoleAssignments(ownAccountUUID)
 	if err != nil {
 		g.logger.Debug().Err(err).Str("id", g.id).Msg("ListRoleAssignments failed")
 		return merrors.InternalServerError(g.id, err.Error())
@@ -448,18 +1315,98 @@ func (g Service) RemoveRoleFromUser(ctx context.Context, req *settingssvc.Remove
 		}
 	}
 
-	if err := g.manager.RemoveRoleAssignment(req.Id); err != nil {
+	if err := g.store(ctx).RemoveRoleAssignment(req.Id); err != nil {
 		return merrors.BadRequest(g.id, err.Error())
 	}
 	return nil
 }
 
+// AnalyzeRolePermissions implements the RoleServiceHandler interface
+func (g Service) AnalyzeRolePermissions(ctx context.Context, req *settingssvc.AnalyzeRolePermissionsRequest, res *settingssvc.AnalyzeRolePermissionsResponse) error {
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	if validationError := validateAnalyzeRolePermissions(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	roles := make([]*settingsmsg.Bundle, 0, len(req.RoleIds))
+	seen := make(map[string]struct{}, len(req.RoleIds))
+	for _, id := range req.RoleIds {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		role, err := g.store(ctx).ReadBundle(id)
+		if err != nil {
+			return merrors.NotFound(g.id, "%s", err)
+		}
+		if role.Type != settingsmsg.Bundle_TYPE_ROLE {
+			return merrors.BadRequest(g.id, "bundle %s is not a role", id)
+		}
+		roles = append(roles, role)
+	}
+	res.Resources, res.Conflicts = analyzeRolePermissions(roles)
+	return nil
+}
+
+// ExportPermissionMatrix implements the RoleServiceHandler interface
+func (g Service) ExportPermissionMatrix(ctx context.Context, req *settingssvc.ExportPermissionMatrixRequest, res *settingssvc.ExportPermissionMatrixResponse) error {
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	if validationError := validateExportPermissionMatrix(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	roles, err := g.store(ctx).ListBundles(settingsmsg.Bundle_TYPE_ROLE, req.RoleIds)
+	if err != nil {
+		return merrors.NotFound(g.id, "%s", err)
+	}
+	found := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		found[role.Id] = struct{}{}
+	}
+	for _, id := range req.RoleIds {
+		if _, ok := found[id]; !ok {
+			return merrors.NotFound(g.id, "could not find role %s", id)
+		}
+	}
+	res.Entries = permissionMatrix(roles, req.Resource)
+	return nil
+}
+
+// RebuildAssignmentIndex implements the RoleServiceHandler interface
+func (g Service) RebuildAssignmentIndex(ctx context.Context, _ *settingssvc.RebuildAssignmentIndexRequest, res *settingssvc.RebuildAssignmentIndexResponse) error {
+	if err := g.checkWritable(); err != nil {
+		return err
+	}
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+
+	stats, err := g.store(ctx).RebuildAssignmentIndex()
+	if err != nil {
+		g.logger.Error().Err(err).Str("id", g.id).Msg("RebuildAssignmentIndex failed")
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	g.logger.Info().Int("accounts", stats.Accounts).Int("assignments", stats.Assignments).Int("added", stats.Added).
+		Int("removed", stats.Removed).Int("invalid", stats.Invalid).Msg("rebuilt the role assignment index")
+
+	res.Accounts = int32(stats.Accounts)
+	res.Assignments = int32(stats.Assignments)
+	res.Added = int32(stats.Added)
+	res.Removed = int32(stats.Removed)
+	res.Invalid = int32(stats.Invalid)
+	return nil
+}
+
 // ListPermissionsByResource implements the PermissionServiceHandler interface
 func (g Service) ListPermissionsByResource(ctx context.Context, req *settingssvc.ListPermissionsByResourceRequest, res *settingssvc.ListPermissionsByResourceResponse) error {
 	if validationError := validateListPermissionsByResource(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
-	permissions, err := g.manager.ListPermissionsByResource(req.Resource, g.getRoleIDs(ctx))
+	permissions, err := g.store(ctx).ListPermissionsByResource(req.Resource, g.getRoleIDs(ctx))
 	if err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
@@ -472,7 +1419,7 @@ func (g Service) GetPermissionByID(ctx context.Context, req *settingssvc.GetPerm
 	if validationError := validateGetPermissionByID(req); validationError != nil {
 		return merrors.BadRequest(g.id, "%s", validationError)
 	}
-	permission, err := g.manager.ReadPermissionByID(req.PermissionId, g.getRoleIDs(ctx))
+	permission, err := g.store(ctx).ReadPermissionByID(req.PermissionId, g.getRoleIDs(ctx))
 	if err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
@@ -511,7 +1458,7 @@ func (g Service) getRoleIDs(ctx context.Context) []string {
 		return ownRoleIDs
 	}
 	if accountID, ok := metadata.Get(ctx, middleware.AccountID); ok {
-		assignments, err := g.manager.ListRoleAssignments(accountID)
+		assignments, err := g.store(ctx).ListRoleAssignments(accountID)
 		if err != nil {
 			g.logger.Info().Err(err).Str("userid", accountID).Msg("failed to get roles for user")
 			return nil
@@ -532,6 +1479,11 @@ func (g Service) getValueWithIdentifier(value *settingsmsg.Value) (*settingsmsg.
 	if err != nil {
 		return nil, err
 	}
+	return g.identifyValue(bundle, value)
+}
+
+// identifyValue adds the identifier to a value of the given bundle.
+func (g Service) identifyValue(bundle *settingsmsg.Bundle, value *settingsmsg.Value) (*settingsmsg.ValueWithIdentifier, error) {
 	setting, err := g.manager.ReadSetting(value.SettingId)
 	if err != nil {
 		return nil, err
@@ -546,7 +1498,94 @@ func (g Service) getValueWithIdentifier(value *settingsmsg.Value) (*settingsmsg.
 	}, nil
 }
 
-func (g Service) hasStaticPermission(ctx context.Context, permissionID string) bool {
+// resolveOptions replaces the static options of a choice setting with the ones of its registered options provider.
+// The static options are kept if the provider is not registered or fails.
+func (g Service) resolveOptions(ctx context.Context, setting *settingsmsg.Setting) {
+	var (
+		name    string
+		options *[]*settingsmsg.ListOption
+	)
+	switch s := setting.GetValue().(type) {
+	case *settingsmsg.Setting_SingleChoiceValue:
+		name, options = s.SingleChoiceValue.GetOptionsProvider(), &s.SingleChoiceValue.Options
+	case *settingsmsg.Setting_MultiChoiceValue:
+		name, options = s.MultiChoiceValue.GetOptionsProvider(), &s.MultiChoiceValue.Options
+	default:
+		return
+	}
+	if name == "" {
+		return
+	}
+
+	provider, ok := settings.OptionsProviders[name]
+	if !ok {
+		g.logger.Debug().Str("setting", setting.GetId()).Str("provider", name).Msg("options provider not registered, using static options")
+		return
+	}
+	resolved, err := provider(ctx, setting)
+	if err != nil {
+		g.logger.Error().Err(err).Str("setting", setting.GetId()).Str("provider", name).Msg("could not resolve options, using static options")
+		return
+	}
+	*options = resolved
+}
+
+// writeValueHistoryEntry records the saved value together with the comment of the change.
+// Failing to write the history does not fail the request, as the value has already been written.
+func (g Service) writeValueHistoryEntry(ctx context.Context, value *settingsmsg.Value, comment string) {
+	changedBy, _ := metadata.Get(ctx, middleware.AccountID)
+	_, err := g.store(ctx).WriteValueHistoryEntry(&settingsmsg.ValueHistoryEntry{
+		Value:     value,
+		Comment:   comment,
+		ChangedBy: changedBy,
+		ChangedAt: timestamppb.Now(),
+	})
+	if err != nil {
+		g.logger.Error().Err(err).Str("value", value.GetId()).Msg("could not write value history entry")
+	}
+}
+
+// auditRead records a read of a bundle with read auditing enabled, or of one of its values.
+// Like the value history, failing to record the read doesn't fail the request.
+func (g Service) auditRead(ctx context.Context, operation string, bundle *settingsmsg.Bundle, value *settingsmsg.Value) {
+	if !bundle.GetAuditReads() {
+		return
+	}
+	// replicas don't write to the store, the reads are only audited by the writable instance
+	if g.readOnly() {
+		return
+	}
+	accountUUID, _ := metadata.Get(ctx, middleware.AccountID)
+	_, err := g.store(ctx).WriteReadAuditEntry(&settingsmsg.ReadAuditEntry{
+		BundleId:    bundle.Id,
+		ValueId:     value.GetId(),
+		Operation:   operation,
+		AccountUuid: accountUUID,
+		ReadAt:      timestamppb.Now(),
+	})
+	if err != nil {
+		g.logger.Error().Err(err).Str("bundle", bundle.Id).Str("operation", operation).Msg("could not write read audit entry")
+	}
+}
+
+// sanitizeComment drops invalid utf8, replaces control characters with spaces and trims the result.
+func sanitizeComment(comment string) string {
+	comment = strings.ToValidUTF8(comment, "")
+	comment = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, comment)
+	return strings.TrimSpace(comment)
+}
+
+func (g Service) hasStaticPermission(ctx context.Context, permissionID string) (granted bool) {
+	ctx, span := g.startSpan(ctx, "Settings.CheckPermission", attribute.String("settings.permission_id", permissionID))
+	defer func() {
+		span.SetAttributes(attribute.Bool("settings.granted", granted))
+		span.End()
+	}()
 	roleIDs, ok := roles.ReadRoleIDsFromContext(ctx)
 	if !ok {
 		// TODO add system role for internal requests.
@@ -558,7 +1597,7 @@ func (g Service) hasStaticPermission(ctx context.Context, permissionID string) b
 		if !ok {
 			return false
 		}
-		assignments, err := g.manager.ListRoleAssignments(accountID)
+		assignments, err := g.store(ctx).ListRoleAssignments(accountID)
 		if err != nil {
 			return false
 		}
@@ -568,12 +1607,12 @@ func (g Service) hasStaticPermission(ctx context.Context, permissionID string) b
 			roleIDs = append(roleIDs, a.GetRoleId())
 		}
 	}
-	p, err := g.manager.ReadPermissionByID(permissionID, roleIDs)
+	p, err := g.store(ctx).ReadPermissionByID(permissionID, roleIDs)
 	return err == nil && p != nil
 }
 
 func (g Service) checkStaticPermissionsByBundleID(ctx context.Context, bundleID string) error {
-	bundle, err := g.manager.ReadBundle(bundleID)
+	bundle, err := g.store(ctx).ReadBundle(bundleID)
 	if err != nil {
 		return merrors.NotFound(g.id, "bundle not found: %s", err)
 	}
@@ -601,6 +1640,15 @@ func (g Service) isCurrentUser(ctx context.Context, accountID string) bool {
 	return accountID == ownAccountID
 }
 
+// canReadValue checks if the values of the account can be read by the user. System and group values apply to
+// everyone or the members, the values of other users require the settings management permission.
+func (g Service) canReadValue(ctx context.Context, accountUUID string) bool {
+	if accountUUID == settings.SystemAccountUUID || settings.IsGroupAccount(accountUUID) || g.isCurrentUser(ctx, accountUUID) {
+		return true
+	}
+	return g.hasStaticPermission(ctx, SettingsManagementPermissionID)
+}
+
 func (g Service) canManageRoles(ctx context.Context) bool {
 	return g.hasStaticPermission(ctx, RoleManagementPermissionID)
 }
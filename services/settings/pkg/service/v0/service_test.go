@@ -2,15 +2,35 @@ package svc
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
+	ocisevents "github.com/owncloud/ocis/v2/ocis-pkg/events"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
 	v0 "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	settingschangessvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settingschanges/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/settings/mocks"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/store/defaults"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/test-go/testify/mock"
+	merrors "go-micro.dev/v4/errors"
+	mevents "go-micro.dev/v4/events"
 	"go-micro.dev/v4/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -143,3 +163,4347 @@ func TestRemoveOwnRoleAssignment(t *testing.T) {
 	err = svc.RemoveRoleFromUser(ctxWithUUID, &req, nil)
 	assert.Nil(t, err)
 }
+
+func TestSaveValueRecordsCommentInHistory(t *testing.T) {
+	var (
+		stored  *settingsmsg.Value
+		history []*settingsmsg.ValueHistoryEntry
+	)
+	manager := &mocks.Manager{}
+	manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value {
+		v.Id = "c7d1a18e-7e3b-4c52-9c8e-6e65fd4f5a83"
+		stored = v
+		return v
+	}, nil)
+	manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry {
+		history = append(history, e)
+		return e
+	}, nil)
+	manager.On("ReadValue", mock.Anything).Return(func(string) *settingsmsg.Value { return stored }, nil)
+	manager.On("ListValueHistory", mock.Anything).Return(func(string) []*settingsmsg.ValueHistoryEntry { return history }, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	svc := Service{
+		manager: manager,
+	}
+
+	err := svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{
+		Value: &settingsmsg.Value{
+			BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			AccountUuid: "me",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_StringValue{StringValue: "de"},
+		},
+		Comment: "  requested by\tthe user\n",
+	}, &v0.SaveValueResponse{})
+	assert.Nil(t, err)
+	assert.NotNil(t, stored.UpdatedAt)
+
+	hres := v0.ListValueHistoryResponse{}
+	err = svc.ListValueHistory(ctxWithUUID, &v0.ListValueHistoryRequest{ValueId: stored.Id}, &hres)
+	assert.Nil(t, err)
+	if assert.Len(t, hres.Entries, 1) {
+		assert.Equal(t, "requested by the user", hres.Entries[0].Comment)
+		assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7", hres.Entries[0].ChangedBy)
+		assert.NotNil(t, hres.Entries[0].ChangedAt)
+	}
+
+	gres := v0.GetValueResponse{}
+	err = svc.GetValue(ctxWithUUID, &v0.GetValueRequest{Id: stored.Id}, &gres)
+	assert.Nil(t, err)
+	b, err := protojson.Marshal(&gres)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "requested by")
+}
+
+func TestSaveValueRejectsLongComment(t *testing.T) {
+	manager := &mocks.Manager{}
+	svc := Service{
+		manager: manager,
+	}
+
+	err := svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{
+		Value: &settingsmsg.Value{
+			BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			AccountUuid: "me",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_StringValue{StringValue: "de"},
+		},
+		Comment: strings.Repeat("a", maxValueCommentLength+1),
+	}, &v0.SaveValueResponse{})
+	assert.NotNil(t, err)
+	manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+}
+
+func TestValidateValue(t *testing.T) {
+	manager := &mocks.Manager{}
+	manager.On("ReadSetting", mock.Anything).Return(intSetting, nil)
+	svc := Service{
+		manager: manager,
+	}
+	value := func(v int64) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			AccountUuid: "me",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_IntValue{IntValue: v},
+		}
+	}
+
+	res := v0.ValidateValueResponse{}
+	err := svc.ValidateValue(ctxWithUUID, &v0.ValidateValueRequest{Value: value(10)}, &res)
+	assert.Nil(t, err)
+	assert.True(t, res.Valid)
+	assert.Empty(t, res.Errors)
+
+	res = v0.ValidateValueResponse{}
+	err = svc.ValidateValue(ctxWithUUID, &v0.ValidateValueRequest{Value: value(1000)}, &res)
+	assert.Nil(t, err)
+	assert.False(t, res.Valid)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "int_value", res.Errors[0].Field)
+	}
+
+	// structural errors are reported per field as well
+	res = v0.ValidateValueResponse{}
+	invalid := value(10)
+	invalid.BundleId = "not-a-uuid"
+	err = svc.ValidateValue(ctxWithUUID, &v0.ValidateValueRequest{Value: invalid}, &res)
+	assert.Nil(t, err)
+	assert.False(t, res.Valid)
+	if assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "bundle_id", res.Errors[0].Field)
+	}
+
+	manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+}
+
+func TestBulkValidateValues(t *testing.T) {
+	const (
+		intSettingID    = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		choiceSettingID = "8ca11b5c-6b8a-4b08-a4f6-1a7f7b0ee1a6"
+		missingID       = "0a4b7f8e-21a6-4c1f-9a57-0d44e58e0c1f"
+	)
+	manager := &mocks.Manager{}
+	manager.On("ReadSetting", intSettingID).Return(intSetting, nil)
+	manager.On("ReadSetting", choiceSettingID).Return(singleChoiceSetting, nil)
+	manager.On("ReadSetting", missingID).Return(nil, settings.ErrSettingNotFound)
+	svc := Service{
+		manager: manager,
+	}
+	value := func(settingID string) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			SettingId:   settingID,
+			AccountUuid: "me",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+		}
+	}
+	intValue := func(v int64) *settingsmsg.Value {
+		val := value(intSettingID)
+		val.Value = &settingsmsg.Value_IntValue{IntValue: v}
+		return val
+	}
+	choiceValue := func(option string) *settingsmsg.Value {
+		val := value(choiceSettingID)
+		val.Value = &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: []*settingsmsg.ListOptionValue{
+			{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: option}},
+		}}}
+		return val
+	}
+	wrongType := value(intSettingID)
+	wrongType.Value = &settingsmsg.Value_BoolValue{BoolValue: true}
+	malformed := intValue(10)
+	malformed.BundleId = "not-a-uuid"
+	missing := intValue(10)
+	missing.SettingId = missingID
+
+	req := &v0.BulkValidateValuesRequest{Values: []*settingsmsg.Value{
+		intValue(10),
+		intValue(1000),
+		intValue(12),
+		wrongType,
+		choiceValue("de"),
+		choiceValue("fr"),
+		malformed,
+		missing,
+	}}
+	res := v0.BulkValidateValuesResponse{}
+	err := svc.BulkValidateValues(ctxWithUUID, req, &res)
+	require.NoError(t, err)
+	require.Len(t, res.Results, len(req.Values))
+
+	expected := []struct {
+		valid bool
+		field string
+	}{
+		{true, ""},
+		{false, "int_value"},
+		{false, "int_value"},
+		{false, "int_value"},
+		{true, ""},
+		{false, "list_value"},
+		{false, "bundle_id"},
+		{false, "setting_id"},
+	}
+	for i, e := range expected {
+		result := res.Results[i]
+		assert.Equal(t, e.valid, result.Valid, "value %d", i)
+		if e.valid {
+			assert.Empty(t, result.Errors, "value %d", i)
+		} else if assert.Len(t, result.Errors, 1, "value %d", i) {
+			assert.Equal(t, e.field, result.Errors[0].Field, "value %d", i)
+		}
+	}
+	manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+
+	err = svc.BulkValidateValues(ctxWithUUID, &v0.BulkValidateValuesRequest{}, &v0.BulkValidateValuesResponse{})
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+}
+
+func TestDynamicChoiceOptions(t *testing.T) {
+	option := func(v string) *settingsmsg.ListOption {
+		return &settingsmsg.ListOption{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: v}}}
+	}
+	newSetting := func(provider string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			Value: &settingsmsg.Setting_SingleChoiceValue{SingleChoiceValue: &settingsmsg.SingleChoiceList{
+				Options:         []*settingsmsg.ListOption{option("static")},
+				OptionsProvider: provider,
+			}},
+		}
+	}
+	settings.OptionsProviders["test-themes"] = func(context.Context, *settingsmsg.Setting) ([]*settingsmsg.ListOption, error) {
+		return []*settingsmsg.ListOption{option("dark"), option("light")}, nil
+	}
+	t.Cleanup(func() { delete(settings.OptionsProviders, "test-themes") })
+
+	scenarios := []struct {
+		name     string
+		provider string
+		options  []string
+	}{
+		{"registered provider", "test-themes", []string{"dark", "light"}},
+		{"unregistered provider", "unknown", []string{"static"}},
+		{"no provider", "", []string{"static"}},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle {
+				return &settingsmsg.Bundle{Settings: []*settingsmsg.Setting{newSetting(scenario.provider)}}
+			}, nil)
+			manager.On("ReadSetting", mock.Anything).Return(func(string) *settingsmsg.Setting {
+				return newSetting(scenario.provider)
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+			manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+				Operation:  settingsmsg.Permission_OPERATION_READ,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}}, nil)
+			svc := Service{
+				manager: manager,
+			}
+
+			res := v0.GetBundleResponse{}
+			err := svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}, &res)
+			assert.Nil(t, err)
+			var got []string
+			for _, o := range res.Bundle.Settings[0].GetSingleChoiceValue().Options {
+				got = append(got, o.Value.GetStringValue())
+			}
+			assert.Equal(t, scenario.options, got)
+
+			// only the resolved options are valid choices
+			for _, o := range []string{"static", "dark"} {
+				value := func() *settingsmsg.Value {
+					return &settingsmsg.Value{
+						BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+						SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+						AccountUuid: "me",
+						Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+						Value: &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: []*settingsmsg.ListOptionValue{
+							{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: o}},
+						}}},
+					}
+				}
+				vres := v0.ValidateValueResponse{}
+				err = svc.ValidateValue(ctxWithUUID, &v0.ValidateValueRequest{Value: value()}, &vres)
+				assert.Nil(t, err)
+				assert.Equal(t, contains(scenario.options, o), vres.Valid, o)
+
+				if !contains(scenario.options, o) {
+					err = svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value()}, &v0.SaveValueResponse{})
+					assert.NotNil(t, err, o)
+				}
+			}
+			manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+		})
+	}
+}
+
+func TestSettingVisibleToRoles(t *testing.T) {
+	adminRole := "71881883-1768-46bd-a24d-a356a2afdf7f"
+	userRole := "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"
+	newBundle := func() *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Settings: []*settingsmsg.Setting{
+				{Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", Name: "everyone"},
+				{Id: "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686", Name: "beta", VisibleToRoles: []string{adminRole}},
+			},
+		}
+	}
+
+	scenarios := []struct {
+		name     string
+		role     string
+		settings []string
+	}{
+		{"role matches", adminRole, []string{"everyone", "beta"}},
+		{"role doesn't match", userRole, []string{"everyone"}},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle {
+				return newBundle()
+			}, nil)
+			manager.On("ListBundles", mock.Anything, mock.Anything).Return(func(settingsmsg.Bundle_Type, []string) []*settingsmsg.Bundle {
+				return []*settingsmsg.Bundle{newBundle()}
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: scenario.role}}, nil)
+			// the whole bundle is readable, visibility is checked regardless
+			manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+				Operation:  settingsmsg.Permission_OPERATION_READ,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}}, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+			names := func(settings []*settingsmsg.Setting) []string {
+				var got []string
+				for _, s := range settings {
+					got = append(got, s.Name)
+				}
+				return got
+			}
+
+			res := v0.GetBundleResponse{}
+			err := svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}, &res)
+			assert.Nil(t, err)
+			assert.Equal(t, scenario.settings, names(res.Bundle.Settings))
+
+			lres := v0.ListBundlesResponse{}
+			err = svc.ListBundles(ctxWithUUID, &v0.ListBundlesRequest{}, &lres)
+			assert.Nil(t, err)
+			assert.Len(t, lres.Bundles, 1)
+			assert.Equal(t, scenario.settings, names(lres.Bundles[0].Settings))
+		})
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRunMigrations(t *testing.T) {
+	bundleID := "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+	// version 2 turns the boolean "dark mode" setting into a theme choice
+	settings.Migrations[bundleID] = []settings.Migration{
+		{
+			Version: 2,
+			Migrate: func(value *settingsmsg.Value, _ *settingsmsg.Bundle) (*settingsmsg.Value, error) {
+				theme := "light"
+				if value.GetBoolValue() {
+					theme = "dark"
+				}
+				value.Value = &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{
+					Values: []*settingsmsg.ListOptionValue{{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: theme}}},
+				}}
+				return value, nil
+			},
+		},
+		{
+			Version: 1,
+			Migrate: func(value *settingsmsg.Value, _ *settingsmsg.Bundle) (*settingsmsg.Value, error) {
+				return value, nil
+			},
+		},
+	}
+	t.Cleanup(func() { delete(settings.Migrations, bundleID) })
+
+	values := []*settingsmsg.Value{
+		{Id: "v1", BundleId: bundleID, Value: &settingsmsg.Value_BoolValue{BoolValue: true}},
+		{Id: "v2", BundleId: bundleID, Value: &settingsmsg.Value_BoolValue{BoolValue: false}},
+	}
+
+	manager := &mocks.Manager{}
+	manager.On("ListBundles", settingsmsg.Bundle_TYPE_DEFAULT, mock.Anything).Return([]*settingsmsg.Bundle{
+		{Id: bundleID, Version: 2},
+		{Id: "0b0a8d5e-6a4c-4f8e-9a22-65a9b1c0a9f5", Version: 7},
+	}, nil)
+	roleID := "7f1c5b4e-33d0-4c1b-8c3e-2a9d4f6e8b10"
+	settings.Migrations[roleID] = []settings.Migration{
+		{
+			Version: 3,
+			Migrate: func(value *settingsmsg.Value, _ *settingsmsg.Bundle) (*settingsmsg.Value, error) {
+				return value, nil
+			},
+		},
+	}
+	t.Cleanup(func() { delete(settings.Migrations, roleID) })
+	manager.On("ListBundles", settingsmsg.Bundle_TYPE_ROLE, mock.Anything).Return([]*settingsmsg.Bundle{
+		{Id: roleID, Type: settingsmsg.Bundle_TYPE_ROLE, Version: 3},
+	}, nil)
+	manager.On("ReadMigrationVersion", roleID).Return(uint32(3), nil)
+	manager.On("ReadMigrationVersion", bundleID).Return(uint32(0), nil).Once()
+	manager.On("ListBundleValues", bundleID).Return(values, nil).Once()
+	var written []*settingsmsg.Value
+	manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value {
+		written = append(written, v)
+		return v
+	}, nil)
+	var versions []uint32
+	manager.On("WriteMigrationVersion", bundleID, mock.Anything).Return(func(_ string, v uint32) error {
+		versions = append(versions, v)
+		return nil
+	})
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+
+	assert.Nil(t, svc.RunMigrations())
+	// role bundles are migrated too
+	manager.AssertCalled(t, "ReadMigrationVersion", roleID)
+	assert.Equal(t, []uint32{1, 2}, versions)
+	assert.Len(t, written, 2)
+	assert.Equal(t, "dark", written[0].GetListValue().GetValues()[0].GetStringValue())
+	assert.Equal(t, "light", written[1].GetListValue().GetValues()[0].GetStringValue())
+
+	// applied migrations are not run again
+	manager.On("ReadMigrationVersion", bundleID).Return(uint32(2), nil)
+	assert.Nil(t, svc.RunMigrations())
+	assert.Len(t, written, 2)
+	manager.AssertNumberOfCalls(t, "ListBundleValues", 1)
+}
+
+func TestSaveBundleInitializesMigrationVersion(t *testing.T) {
+	bundle := &settingsmsg.Bundle{
+		Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		Name:        "test",
+		Extension:   "test",
+		DisplayName: "Test",
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Version:     3,
+		Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+		Settings: []*settingsmsg.Setting{{
+			Id:          "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			Name:        "dark-mode",
+			DisplayName: "Dark mode",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Value:       &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+		}},
+	}
+
+	scenarios := []struct {
+		name   string
+		values []*settingsmsg.Value
+		init   bool
+	}{
+		{"bundle without values", nil, true},
+		{"bundle with values", []*settingsmsg.Value{{Id: "v1"}}, false},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+			manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}}, nil)
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ReadBundleByName", bundle.Extension, bundle.Name).Return(nil, settings.ErrBundleNotFound)
+			manager.On("WriteBundle", mock.Anything).Return(bundle, nil)
+			manager.On("ReadMigrationVersion", bundle.Id).Return(uint32(0), nil)
+			manager.On("ListBundleValues", bundle.Id).Return(scenario.values, nil)
+			manager.On("WriteMigrationVersion", bundle.Id, uint32(3)).Return(nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: bundle}, &v0.SaveBundleResponse{})
+			assert.Nil(t, err)
+			if scenario.init {
+				manager.AssertCalled(t, "WriteMigrationVersion", bundle.Id, uint32(3))
+			} else {
+				manager.AssertNotCalled(t, "WriteMigrationVersion", bundle.Id, uint32(3))
+			}
+		})
+	}
+}
+
+func TestSaveBundleNameCollision(t *testing.T) {
+	newBundle := func(id string) *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:          id,
+			Name:        "test",
+			Extension:   "test",
+			DisplayName: "Test",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Settings: []*settingsmsg.Setting{{
+				Id:          "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+				Name:        "dark-mode",
+				DisplayName: "Dark mode",
+				Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				Value:       &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+			}},
+		}
+	}
+	existing := newBundle("2f06addf-4fd2-49d5-8f71-00fbd3a3ec47")
+
+	scenarios := []struct {
+		name   string
+		bundle *settingsmsg.Bundle
+		code   int32
+	}{
+		{"update of the same bundle", newBundle(existing.Id), 0},
+		{"new bundle with the same name", newBundle("0b0a8d5e-6a4c-4f8e-9a22-65a9b1c0a9f5"), http.StatusConflict},
+		{"new bundle without id", newBundle(""), http.StatusConflict},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ReadBundleByName", "test", "test").Return(existing, nil)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: scenario.bundle}, &v0.SaveBundleResponse{})
+			if scenario.code == 0 {
+				assert.Nil(t, err)
+				manager.AssertCalled(t, "WriteBundle", scenario.bundle)
+				return
+			}
+			assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+			manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+		})
+	}
+}
+
+func TestEmbeddedDefaultBundles(t *testing.T) {
+	bundles, err := defaults.LoadBundles()
+	require.NoError(t, err)
+	require.NotEmpty(t, bundles)
+	assert.NoError(t, validateDefaultBundles(bundles))
+
+	ids := map[string]bool{}
+	for _, b := range bundles {
+		assert.False(t, ids[b.Id], "duplicate bundle %s", b.Id)
+		ids[b.Id] = true
+	}
+	for _, id := range []string{BundleUUIDRoleAdmin, BundleUUIDRoleSpaceAdmin, BundleUUIDRoleUser, BundleUUIDRoleGuest} {
+		assert.True(t, ids[id], "missing role %s", id)
+	}
+
+	// the filesystem store saves the defaults like SaveBundle
+	manager := &mocks.Manager{}
+	manager.On("ReadBundle", mock.Anything).Return(nil, settings.ErrBundleNotFound)
+	manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+	manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+	svc := Service{
+		config:  &config.Config{},
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+	svc.RegisterDefaultRoles()
+	manager.AssertNumberOfCalls(t, "WriteBundle", len(bundles))
+}
+
+func TestRegisterDefaultRolesKeepsExistingBundles(t *testing.T) {
+	svc := NewService(&config.Config{StoreType: "filesystem", DataPath: t.TempDir()}, log.NopLogger())
+
+	admin, err := svc.manager.ReadBundle(BundleUUIDRoleAdmin)
+	require.NoError(t, err)
+	require.NotEmpty(t, admin.Settings)
+	removed := admin.Settings[0].Id
+	admin.DisplayName = "Administrators"
+	admin.Settings = admin.Settings[1:]
+	_, err = svc.manager.WriteBundle(admin)
+	require.NoError(t, err)
+	user, err := svc.manager.ReadBundle(BundleUUIDRoleUser)
+	require.NoError(t, err)
+
+	// a restart adds the missing settings but keeps the changes to the bundle
+	svc.RegisterDefaultRoles()
+	admin, err = svc.manager.ReadBundle(BundleUUIDRoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, "Administrators", admin.DisplayName)
+	ids := make([]string, 0, len(admin.Settings))
+	for _, s := range admin.Settings {
+		ids = append(ids, s.Id)
+	}
+	assert.Contains(t, ids, removed)
+
+	// unchanged bundles are not written again
+	unchanged, err := svc.manager.ReadBundle(BundleUUIDRoleUser)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(user, unchanged))
+}
+
+func TestListValuesModifiedSince(t *testing.T) {
+	since := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	values := []*settingsmsg.Value{
+		{Id: "c", UpdatedAt: timestamppb.New(since.Add(time.Minute))},
+		{Id: "old", UpdatedAt: timestamppb.New(since.Add(-time.Minute))},
+		{Id: "legacy"},
+		{Id: "b", UpdatedAt: timestamppb.New(since.Add(time.Minute))},
+		{Id: "a", UpdatedAt: timestamppb.New(since.Add(2 * time.Minute))},
+		{Id: "exact", UpdatedAt: timestamppb.New(since)},
+	}
+	ids := func(res *v0.ListValuesModifiedSinceResponse) []string {
+		var out []string
+		for _, v := range res.Values {
+			out = append(out, v.Value.Id)
+		}
+		return out
+	}
+
+	manager := &mocks.Manager{}
+	manager.On("ListValues", "", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return(values, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	svc := Service{
+		manager: manager,
+	}
+
+	res := &v0.ListValuesModifiedSinceResponse{}
+	err := svc.ListValuesModifiedSince(ctxWithUUID, &v0.ListValuesModifiedSinceRequest{
+		AccountUuid: "me",
+		Since:       timestamppb.New(since),
+	}, res)
+	assert.Nil(t, err)
+	// ordered by update time, values updated at the same time by id
+	assert.Equal(t, []string{"b", "c", "a"}, ids(res))
+
+	// without a timestamp all values are returned
+	res = &v0.ListValuesModifiedSinceResponse{}
+	err = svc.ListValuesModifiedSince(ctxWithUUID, &v0.ListValuesModifiedSinceRequest{AccountUuid: "me"}, res)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"legacy", "old", "exact", "b", "c", "a"}, ids(res))
+
+	err = svc.ListValuesModifiedSince(ctxWithUUID, &v0.ListValuesModifiedSinceRequest{
+		AccountUuid: "e11f9769-416a-427d-9441-41a0e51391d7",
+	}, &v0.ListValuesModifiedSinceResponse{})
+	assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+}
+
+func TestLongPollValuesModifiedSince(t *testing.T) {
+	since := time.Now()
+	var (
+		l      sync.Mutex
+		values []*settingsmsg.Value
+	)
+	manager := &mocks.Manager{}
+	manager.On("ListValues", "", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return(func(string, string) []*settingsmsg.Value {
+		l.Lock()
+		defer l.Unlock()
+		return values
+	}, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	svc := Service{
+		manager: manager,
+		config:  &config.Config{LongPollTimeout: 1},
+		changes: newChangeHub(),
+	}
+	poll := func(wait uint32) (*v0.ListValuesModifiedSinceResponse, time.Duration) {
+		start := time.Now()
+		res := &v0.ListValuesModifiedSinceResponse{}
+		require.NoError(t, svc.ListValuesModifiedSince(ctxWithUUID, &v0.ListValuesModifiedSinceRequest{
+			AccountUuid: "me",
+			Since:       timestamppb.New(since),
+			WaitSeconds: wait,
+		}, res))
+		return res, time.Since(start)
+	}
+
+	// the wait is capped by the timeout
+	res, elapsed := poll(60)
+	assert.Empty(t, res.Values)
+	assert.GreaterOrEqual(t, elapsed, time.Second)
+	assert.Less(t, elapsed, 5*time.Second)
+
+	// without waiting the response isn't held back
+	res, elapsed = poll(0)
+	assert.Empty(t, res.Values)
+	assert.Less(t, elapsed, time.Second)
+
+	// a change returns right away
+	svc.config.LongPollTimeout = 30
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		l.Lock()
+		values = []*settingsmsg.Value{{Id: "changed", UpdatedAt: timestamppb.Now()}}
+		l.Unlock()
+		// changes of other accounts don't end a wait
+		svc.changes.notify("e11f9769-416a-427d-9441-41a0e51391d7")
+		svc.changes.notify("61445573-4dbe-4d56-88dc-88ab47aceba7")
+	}()
+	res, elapsed = poll(30)
+	require.Len(t, res.Values, 1)
+	assert.Equal(t, "changed", res.Values[0].Value.Id)
+	assert.Less(t, elapsed, 5*time.Second)
+
+	// modified values are returned without waiting
+	res, elapsed = poll(30)
+	assert.Len(t, res.Values, 1)
+	assert.Less(t, elapsed, time.Second)
+
+	// the subscriptions are removed
+	assert.Empty(t, svc.changes.waiters)
+}
+
+func TestSaveValueNotifiesLongPolls(t *testing.T) {
+	manager := &mocks.Manager{}
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{
+		Value: &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+	}, nil)
+	manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value { return v }, nil)
+	manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry { return e }, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+		changes: newChangeHub(),
+	}
+	changed, cancel := svc.changes.subscribe("61445573-4dbe-4d56-88dc-88ab47aceba7")
+	defer cancel()
+
+	require.NoError(t, svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: &settingsmsg.Value{
+		BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+		AccountUuid: "me",
+		Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+		Value:       &settingsmsg.Value_BoolValue{BoolValue: true},
+	}}, &v0.SaveValueResponse{}))
+	select {
+	case <-changed:
+	default:
+		t.Fatal("the long poll wasn't notified")
+	}
+}
+
+// valueChangesStream collects the sent changes, sending blocks until release is closed if it is set.
+type valueChangesStream struct {
+	settingschangessvc.ChangeService_SubscribeValueChangesStream
+	sent    chan *settingschangessvc.SubscribeValueChangesResponse
+	release chan struct{}
+}
+
+func (s *valueChangesStream) Send(res *settingschangessvc.SubscribeValueChangesResponse) error {
+	if s.release != nil {
+		<-s.release
+	}
+	s.sent <- res
+	return nil
+}
+
+func TestSubscribeValueChanges(t *testing.T) {
+	const (
+		account   = "61445573-4dbe-4d56-88dc-88ab47aceba7"
+		other     = "e11f9769-416a-427d-9441-41a0e51391d7"
+		watched   = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		unwatched = "f3b4a2c1-9d8e-4f7a-b6c5-d4e3f2a1b0c9"
+	)
+	manager := &mocks.Manager{}
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+	manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(nil, settings.ErrPermissionNotFound)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+		changes: newChangeHub(),
+	}
+	watchers := func() int {
+		svc.changes.mu.Lock()
+		defer svc.changes.mu.Unlock()
+		return len(svc.changes.watchers)
+	}
+	subscribe := func(stream *valueChangesStream) (context.CancelFunc, chan error) {
+		ctx, cancel := context.WithCancel(ctxWithUUID)
+		done := make(chan error, 1)
+		go func() {
+			done <- svc.SubscribeValueChanges(ctx, &settingschangessvc.SubscribeValueChangesRequest{
+				SettingIds:  []string{watched},
+				AccountUuid: "me",
+			}, stream)
+		}()
+		require.Eventually(t, func() bool { return watchers() == 1 }, time.Second, 10*time.Millisecond)
+		return cancel, done
+	}
+
+	stream := &valueChangesStream{sent: make(chan *settingschangessvc.SubscribeValueChangesResponse, 10)}
+	cancel, done := subscribe(stream)
+	svc.changes.publish(&settingsmsg.Value{Id: "unwatched", SettingId: unwatched, AccountUuid: account}, false)
+	svc.changes.publish(&settingsmsg.Value{Id: "other", SettingId: watched, AccountUuid: other}, false)
+	svc.changes.publish(&settingsmsg.Value{Id: "own", SettingId: watched, AccountUuid: account}, false)
+	svc.changes.publish(&settingsmsg.Value{Id: "system", SettingId: watched, AccountUuid: settings.SystemAccountUUID}, false)
+	svc.changes.publish(&settingsmsg.Value{Id: "own", SettingId: watched, AccountUuid: account}, true)
+
+	// only the changes of the watched setting of the account and the system are delivered
+	for _, expected := range []struct {
+		id      string
+		deleted bool
+	}{{"own", false}, {"system", false}, {"own", true}} {
+		select {
+		case res := <-stream.sent:
+			assert.Equal(t, expected.id, res.Value.Value.Id)
+			assert.Equal(t, "setting", res.Value.Identifier.Setting)
+			assert.Equal(t, expected.deleted, res.Deleted)
+		case <-time.After(time.Second):
+			t.Fatalf("the change of %s wasn't delivered", expected.id)
+		}
+	}
+	select {
+	case res := <-stream.sent:
+		t.Fatalf("unexpected change of %s", res.Value.Value.Id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// a disconnect ends the subscription
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Equal(t, 0, watchers())
+
+	// a subscriber that doesn't keep up is dropped
+	stream = &valueChangesStream{sent: make(chan *settingschangessvc.SubscribeValueChangesResponse, 2*valueChangesBuffer), release: make(chan struct{})}
+	cancel, done = subscribe(stream)
+	defer cancel()
+	for i := 0; i < valueChangesBuffer+2; i++ {
+		svc.changes.publish(&settingsmsg.Value{Id: "own", SettingId: watched, AccountUuid: account}, false)
+	}
+	assert.Equal(t, 0, watchers())
+	close(stream.release)
+	select {
+	case err := <-done:
+		assert.Equal(t, int32(http.StatusTooManyRequests), merrors.FromError(err).Code)
+	case <-time.After(time.Second):
+		t.Fatal("the slow subscriber wasn't disconnected")
+	}
+
+	// subscribing to other accounts requires the settings management permission
+	err := svc.SubscribeValueChanges(ctxWithUUID, &settingschangessvc.SubscribeValueChangesRequest{
+		SettingIds:  []string{watched},
+		AllAccounts: true,
+	}, stream)
+	assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+	err = svc.SubscribeValueChanges(ctxWithUUID, &settingschangessvc.SubscribeValueChangesRequest{
+		SettingIds:  []string{watched},
+		AccountUuid: other,
+	}, stream)
+	assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+
+	// the settings have to be given
+	err = svc.SubscribeValueChanges(ctxWithUUID, &settingschangessvc.SubscribeValueChangesRequest{AccountUuid: "me"}, stream)
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+}
+
+func TestPurgeAccount(t *testing.T) {
+	purged := "00000000-0000-0000-0000-000000000000"
+	values := map[string]*settingsmsg.Value{
+		"a1b6d6b2-3e36-4d8e-9f0b-2a0e5ad3e5a1": {Id: "a1b6d6b2-3e36-4d8e-9f0b-2a0e5ad3e5a1", AccountUuid: purged},
+		"b2c7e7c3-4f47-4e9f-8a1c-3b1f6be4f6b2": {Id: "b2c7e7c3-4f47-4e9f-8a1c-3b1f6be4f6b2", AccountUuid: purged},
+		"c3d8f8d4-5a58-4fa0-9b2d-4c2a7cf5a7c3": {Id: "c3d8f8d4-5a58-4fa0-9b2d-4c2a7cf5a7c3", AccountUuid: ""},
+	}
+	assignments := map[string]*settingsmsg.UserRoleAssignment{
+		"00000000-0000-0000-0000-000000000001": {Id: "00000000-0000-0000-0000-000000000001", AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7", RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"},
+		"00000000-0000-0000-0000-000000000002": {Id: "00000000-0000-0000-0000-000000000002", AccountUuid: purged, RoleId: "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"},
+	}
+
+	manager := &mocks.Manager{}
+	manager.On("ListValues", mock.Anything, mock.Anything).Return(func(_, accountUUID string) []*settingsmsg.Value {
+		var list []*settingsmsg.Value
+		for _, v := range values {
+			if v.AccountUuid == "" || v.AccountUuid == accountUUID {
+				list = append(list, v)
+			}
+		}
+		return list
+	}, nil)
+	manager.On("DeleteValue", mock.Anything).Return(func(valueID string) error {
+		delete(values, valueID)
+		return nil
+	})
+	manager.On("ListRoleAssignments", mock.Anything).Return(func(accountUUID string) []*settingsmsg.UserRoleAssignment {
+		var list []*settingsmsg.UserRoleAssignment
+		for _, a := range assignments {
+			if a.AccountUuid == accountUUID {
+				list = append(list, a)
+			}
+		}
+		return list
+	}, nil)
+	manager.On("RemoveRoleAssignment", mock.Anything).Return(func(assignmentID string) error {
+		delete(assignments, assignmentID)
+		return nil
+	})
+	manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}, nil)
+	publisher := &recordingPublisher{}
+	svc := Service{
+		manager:         manager,
+		logger:          log.NopLogger(),
+		eventsPublisher: publisher,
+	}
+
+	res := v0.PurgeAccountResponse{}
+	err := svc.PurgeAccount(ctxWithUUID, &v0.PurgeAccountRequest{AccountUuid: purged}, &res)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), res.RemovedValues)
+	assert.Equal(t, uint32(1), res.RemovedAssignments)
+
+	// the purge is emitted on the event bus for the audit log
+	require.Len(t, publisher.events, 1)
+	ev, ok := publisher.events[0].(ocisevents.AccountPurged)
+	require.True(t, ok)
+	assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7", ev.Executant.GetOpaqueId())
+	assert.Equal(t, purged, ev.AccountUUID)
+	assert.Equal(t, uint32(2), ev.RemovedValues)
+	assert.Equal(t, uint32(1), ev.RemovedAssignments)
+	assert.NotNil(t, ev.Timestamp)
+
+	// only the system value and the assignment of the other account are left
+	assert.Len(t, values, 1)
+	assert.Contains(t, values, "c3d8f8d4-5a58-4fa0-9b2d-4c2a7cf5a7c3")
+	assert.Len(t, assignments, 1)
+	assert.Contains(t, assignments, "00000000-0000-0000-0000-000000000001")
+
+	// purging again doesn't fail
+	res = v0.PurgeAccountResponse{}
+	err = svc.PurgeAccount(ctxWithUUID, &v0.PurgeAccountRequest{AccountUuid: purged}, &res)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), res.RemovedValues)
+	assert.Equal(t, uint32(0), res.RemovedAssignments)
+	assert.Len(t, publisher.events, 2)
+
+	// users without management permissions can't purge accounts
+	manager = &mocks.Manager{}
+	manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+	manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(nil, settings.ErrPermissionNotFound)
+	publisher = &recordingPublisher{}
+	svc = Service{
+		manager:         manager,
+		logger:          log.NopLogger(),
+		eventsPublisher: publisher,
+	}
+	err = svc.PurgeAccount(ctxWithUUID, &v0.PurgeAccountRequest{AccountUuid: purged}, &v0.PurgeAccountResponse{})
+	assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+	assert.Empty(t, publisher.events)
+}
+
+// recordingPublisher records the published events
+type recordingPublisher struct {
+	events []interface{}
+}
+
+func (p *recordingPublisher) Publish(_ string, ev interface{}, _ ...mevents.PublishOption) error {
+	p.events = append(p.events, ev)
+	return nil
+}
+
+func TestSaveBundleDependencies(t *testing.T) {
+	const (
+		bundleA = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+		bundleB = "0b0a8d5e-6a4c-4f8e-9a22-65a9b1c0a9f5"
+		bundleC = "9c1a2f4e-1b3d-4c5e-8f7a-0d9e8c7b6a51"
+		missing = "e4d3c2b1-a0f9-4e8d-b7c6-a5b4c3d2e1f0"
+	)
+	newBundle := func(id string, dependsOn ...string) *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:          id,
+			Name:        "test",
+			Extension:   "test",
+			DisplayName: "Test",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Settings: []*settingsmsg.Setting{{
+				Id:          "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+				Name:        "dark-mode",
+				DisplayName: "Dark mode",
+				Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				Value:       &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+			}},
+			DependsOn: dependsOn,
+		}
+	}
+	// A depends on B, which depends on nothing
+	stored := map[string]*settingsmsg.Bundle{
+		bundleA: newBundle(bundleA, bundleB),
+		bundleB: newBundle(bundleB),
+	}
+
+	scenarios := []struct {
+		name   string
+		bundle *settingsmsg.Bundle
+		code   int32
+	}{
+		{"existing dependencies", newBundle(bundleC, bundleA, bundleB), 0},
+		{"missing dependency", newBundle(bundleC, bundleA, missing), http.StatusBadRequest},
+		{"self dependency", newBundle(bundleC, bundleC), http.StatusBadRequest},
+		{"cycle", newBundle(bundleB, bundleA), http.StatusBadRequest},
+		{"invalid dependency", newBundle(bundleC, "not-a-uuid"), http.StatusBadRequest},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ReadBundleByName", "test", "test").Return(nil, settings.ErrBundleNotFound)
+			manager.On("ReadBundle", mock.Anything).Return(func(id string) *settingsmsg.Bundle {
+				return stored[id]
+			}, func(id string) error {
+				if _, ok := stored[id]; !ok {
+					return settings.ErrBundleNotFound
+				}
+				return nil
+			})
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			manager.On("ListBundleValues", mock.Anything).Return(nil, nil)
+			manager.On("WriteMigrationVersion", mock.Anything, mock.Anything).Return(nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: scenario.bundle}, &v0.SaveBundleResponse{})
+			if scenario.code == 0 {
+				assert.Nil(t, err)
+				manager.AssertCalled(t, "WriteBundle", scenario.bundle)
+				return
+			}
+			assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+			manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+		})
+	}
+}
+
+func TestGetBundleConditional(t *testing.T) {
+	updatedAt := time.Date(2022, 10, 14, 12, 0, 0, 500, time.UTC)
+	newBundle := func() *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Settings: []*settingsmsg.Setting{
+				{Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", Name: "everyone"},
+				{Id: "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686", Name: "beta", VisibleToRoles: []string{"71881883-1768-46bd-a24d-a356a2afdf7f"}},
+			},
+			UpdatedAt: timestamppb.New(updatedAt),
+		}
+	}
+	newService := func(roleID string) Service {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle {
+			return newBundle()
+		}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: roleID}}, nil)
+		manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+			Operation:  settingsmsg.Permission_OPERATION_READ,
+			Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+		}}, nil)
+		return Service{
+			manager: manager,
+			logger:  log.NopLogger(),
+		}
+	}
+	get := func(svc Service, req *v0.GetBundleRequest) *v0.GetBundleResponse {
+		req.BundleId = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+		res := &v0.GetBundleResponse{}
+		require.NoError(t, svc.GetBundle(ctxWithUUID, req, res))
+		return res
+	}
+
+	svc := newService("d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11")
+	res := get(svc, &v0.GetBundleRequest{})
+	require.NotNil(t, res.Bundle)
+	require.NotEmpty(t, res.Etag)
+	assert.False(t, res.NotModified)
+	assert.True(t, res.LastModified.AsTime().Equal(updatedAt))
+	etag := res.Etag
+
+	// the etag is stable
+	assert.Equal(t, etag, get(svc, &v0.GetBundleRequest{}).Etag)
+
+	scenarios := []struct {
+		name        string
+		req         *v0.GetBundleRequest
+		notModified bool
+	}{
+		{"matching etag", &v0.GetBundleRequest{IfNoneMatch: etag}, true},
+		{"matching weak etag in a list", &v0.GetBundleRequest{IfNoneMatch: `"other", W/` + etag}, true},
+		{"any etag", &v0.GetBundleRequest{IfNoneMatch: "*"}, true},
+		{"other etag", &v0.GetBundleRequest{IfNoneMatch: `"other"`}, false},
+		{"not modified since", &v0.GetBundleRequest{IfModifiedSince: timestamppb.New(updatedAt.Truncate(time.Second))}, true},
+		{"modified since", &v0.GetBundleRequest{IfModifiedSince: timestamppb.New(updatedAt.Add(-time.Minute))}, false},
+		{"etags take precedence", &v0.GetBundleRequest{IfNoneMatch: `"other"`, IfModifiedSince: timestamppb.New(updatedAt.Add(time.Minute))}, false},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			res := get(svc, scenario.req)
+			assert.Equal(t, scenario.notModified, res.NotModified)
+			assert.Equal(t, etag, res.Etag)
+			if scenario.notModified {
+				assert.Nil(t, res.Bundle)
+			} else {
+				assert.NotNil(t, res.Bundle)
+			}
+		})
+	}
+
+	// users seeing other settings of the bundle get another etag
+	assert.NotEqual(t, etag, get(newService("71881883-1768-46bd-a24d-a356a2afdf7f"), &v0.GetBundleRequest{}).Etag)
+}
+
+func TestGetSetting(t *testing.T) {
+	const (
+		bundleID = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+		everyone = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		beta     = "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686"
+		unknown  = "b6b2cc46-2bb0-4af4-a9e2-6e0a4c8b4a3d"
+		broken   = "9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e"
+	)
+	manager := &mocks.Manager{}
+	manager.On("ReadBundleBySetting", mock.Anything).Return(func(id string) *settingsmsg.Bundle {
+		if id != everyone && id != beta {
+			return nil
+		}
+		return &settingsmsg.Bundle{
+			Id: bundleID,
+			Settings: []*settingsmsg.Setting{
+				{Id: everyone, Name: "everyone"},
+				{Id: beta, Name: "beta", VisibleToRoles: []string{"71881883-1768-46bd-a24d-a356a2afdf7f"}},
+			},
+		}
+	}, func(id string) error {
+		switch id {
+		case everyone, beta:
+			return nil
+		case broken:
+			return errors.New("metadata service unavailable")
+		}
+		return settings.ErrSettingNotFound
+	})
+	manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"}}, nil)
+	manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+		Operation:  settingsmsg.Permission_OPERATION_READ,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}}, nil)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+
+	scenarios := []struct {
+		name      string
+		settingID string
+		code      int32
+	}{
+		{"found", everyone, 0},
+		{"not visible to the role of the user", beta, http.StatusNotFound},
+		{"unknown setting", unknown, http.StatusNotFound},
+		{"store error", broken, http.StatusInternalServerError},
+		{"invalid id", "everyone", http.StatusBadRequest},
+		{"no id", "", http.StatusBadRequest},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			res := v0.GetSettingResponse{}
+			err := svc.GetSetting(ctxWithUUID, &v0.GetSettingRequest{SettingId: scenario.settingID}, &res)
+			if scenario.code != 0 {
+				require.Error(t, err)
+				assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+				assert.Nil(t, res.Setting)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, scenario.settingID, res.Setting.Id)
+			assert.Equal(t, "everyone", res.Setting.Name)
+			assert.Equal(t, bundleID, res.BundleId)
+		})
+	}
+}
+
+func TestExplainPermission(t *testing.T) {
+	adminRole := "71881883-1768-46bd-a24d-a356a2afdf7f"
+	userRole := "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"
+	bundle := &settingsmsg.Bundle{
+		Id: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		Settings: []*settingsmsg.Setting{
+			{Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", Name: "everyone"},
+			{Id: "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686", Name: "beta", VisibleToRoles: []string{adminRole}},
+		},
+	}
+
+	scenarios := []struct {
+		name        string
+		setting     string
+		roles       []string
+		permissions []*settingsmsg.Permission
+		operation   settingsmsg.Permission_Operation
+		denied      bool
+	}{
+		{
+			name:    "read write granted",
+			setting: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			roles:   []string{userRole},
+			permissions: []*settingsmsg.Permission{{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
+			}},
+			operation: settingsmsg.Permission_OPERATION_READWRITE,
+		},
+		{
+			name:    "read granted",
+			setting: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			roles:   []string{userRole},
+			permissions: []*settingsmsg.Permission{{
+				Operation:  settingsmsg.Permission_OPERATION_READ,
+				Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
+			}},
+			operation: settingsmsg.Permission_OPERATION_READ,
+		},
+		{
+			name:    "hidden from role",
+			setting: "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686",
+			roles:   []string{userRole},
+			permissions: []*settingsmsg.Permission{{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
+			}},
+			denied: true,
+		},
+		{
+			name:    "no permission",
+			setting: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			roles:   []string{userRole},
+			denied:  true,
+		},
+		{
+			name:    "no roles",
+			setting: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			denied:  true,
+		},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			var assignments []*settingsmsg.UserRoleAssignment
+			for _, r := range scenario.roles {
+				assignments = append(assignments, &settingsmsg.UserRoleAssignment{RoleId: r})
+			}
+			manager := &mocks.Manager{}
+			manager.On("ListBundles", mock.Anything, mock.Anything).Return([]*settingsmsg.Bundle{bundle}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return(assignments, nil)
+			manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Id: userRole, DisplayName: "User"}, nil)
+			manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return(scenario.permissions, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			e, err := svc.explainPermission("61445573-4dbe-4d56-88dc-88ab47aceba7", scenario.setting)
+			require.NoError(t, err)
+			assert.Equal(t, scenario.operation, e.Operation)
+			assert.Equal(t, scenario.denied, e.Reason != "")
+			assert.Equal(t, scenario.setting, e.Setting.Id)
+			if len(scenario.roles) > 0 && len(scenario.permissions) > 0 {
+				// once for the bundle and once for the setting
+				assert.Len(t, e.Grants, 2)
+				assert.Equal(t, "User", e.Grants[0].Role.DisplayName)
+			}
+		})
+	}
+
+	t.Run("unknown setting", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ListBundles", mock.Anything, mock.Anything).Return([]*settingsmsg.Bundle{bundle}, nil)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+		_, err := svc.explainPermission("61445573-4dbe-4d56-88dc-88ab47aceba7", "4e319981-a2ca-4a2f-82cf-e4b9ed8db3e0")
+		assert.Error(t, err)
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ListBundles", mock.Anything, mock.Anything).Return([]*settingsmsg.Bundle{bundle}, nil)
+		manager.On("ListRoleAssignments", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return([]*settingsmsg.UserRoleAssignment{{RoleId: userRole}}, nil)
+		manager.On("ListRoleAssignments", "other").Return([]*settingsmsg.UserRoleAssignment{{RoleId: userRole}}, nil)
+		manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Id: userRole, Name: "user", DisplayName: "User"}, nil)
+		manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+			Operation:  settingsmsg.Permission_OPERATION_READ,
+			Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
+		}}, nil)
+		// the user role has no settings management permission
+		manager.On("ReadPermissionByID", SettingsManagementPermissionID, mock.Anything).Return(nil, nil)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+
+		res := &v0.ExplainPermissionResponse{}
+		err := svc.ExplainPermission(ctxWithUUID, &v0.ExplainPermissionRequest{
+			AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+		}, res)
+		require.NoError(t, err)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READ, res.Operation)
+		require.Len(t, res.Grants, 2)
+		assert.Equal(t, userRole, res.Grants[0].RoleId)
+		assert.Equal(t, "User", res.Grants[0].RoleDisplayName)
+
+		err = svc.ExplainPermission(ctxWithUUID, &v0.ExplainPermissionRequest{
+			AccountUuid: "other",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+		}, &v0.ExplainPermissionResponse{})
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+
+		err = svc.ExplainPermission(ctxWithUUID, &v0.ExplainPermissionRequest{
+			AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+			SettingId:   "4e319981-a2ca-4a2f-82cf-e4b9ed8db3e0",
+		}, &v0.ExplainPermissionResponse{})
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+}
+
+func TestSaveValueRateLimit(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	manager := &mocks.Manager{}
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value { return v }, nil)
+	manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry { return e }, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	svc := Service{
+		manager:      manager,
+		logger:       log.NopLogger(),
+		writeLimiter: limiter,
+	}
+	save := func() error {
+		return svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{
+			Value: &settingsmsg.Value{
+				BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+				SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+				AccountUuid: "me",
+				Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:       &settingsmsg.Value_StringValue{StringValue: "de"},
+			},
+		}, &v0.SaveValueResponse{})
+	}
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, save())
+	}
+	err := save()
+	require.Error(t, err)
+	assert.Equal(t, int32(http.StatusTooManyRequests), merrors.FromError(err).Code)
+	assert.Contains(t, err.Error(), "retry in 60 seconds")
+
+	// other accounts are not affected
+	_, ok := limiter.allow("914b7b6f-e1e1-4b56-8fa5-5d6a4e6d1d0b")
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, save())
+	manager.AssertNumberOfCalls(t, "WriteValue", 3)
+}
+
+func TestComputedValues(t *testing.T) {
+	settingID := "1b57c1c9-1aab-4d8f-a02f-6e8c8f2e2b39"
+	var resolvedFor string
+	settings.ValueResolvers[settingID] = func(ctx context.Context, accountUUID string, resource *settingsmsg.Resource, setting *settingsmsg.Setting) (*settingsmsg.Value, error) {
+		resolvedFor = accountUUID + "/" + resource.Type.String()
+		return &settingsmsg.Value{Value: &settingsmsg.Value_IntValue{IntValue: 42}}, nil
+	}
+	t.Cleanup(func() { delete(settings.ValueResolvers, settingID) })
+
+	manager := &mocks.Manager{}
+	manager.On("ListBundles", mock.Anything, mock.Anything).Return([]*settingsmsg.Bundle{{
+		Id:        "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		Name:      "profile",
+		Extension: "ocis-accounts",
+		Settings: []*settingsmsg.Setting{{
+			Id:       settingID,
+			Name:     "storage-used",
+			Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+		}},
+	}}, nil)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+
+	res := v0.GetValueResponse{}
+	err := svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+		AccountUuid: "me",
+		SettingId:   settingID,
+	}, &res)
+	require.NoError(t, err)
+	assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7/TYPE_USER", resolvedFor)
+	assert.Equal(t, int64(42), res.Value.Value.GetIntValue())
+	assert.True(t, res.Value.Value.ReadOnly)
+	assert.Equal(t, "storage-used", res.Value.Identifier.Setting)
+	// the stored values are not consulted
+	manager.AssertNotCalled(t, "ReadValueByUniqueIdentifiers", mock.Anything, mock.Anything)
+
+	value := &settingsmsg.Value{
+		BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		SettingId:   settingID,
+		AccountUuid: "me",
+		Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+		Value:       &settingsmsg.Value_IntValue{IntValue: 1},
+	}
+	err = svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value}, &v0.SaveValueResponse{})
+	require.Error(t, err)
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+
+	vres := v0.ValidateValueResponse{}
+	require.NoError(t, svc.ValidateValue(ctxWithUUID, &v0.ValidateValueRequest{Value: value}, &vres))
+	assert.False(t, vres.Valid)
+	require.Len(t, vres.Errors, 1)
+	assert.Equal(t, "setting_id", vres.Errors[0].Field)
+}
+
+func TestGetValues(t *testing.T) {
+	existing := []string{"c7d1a18e-7e3b-4c52-9c8e-6e65fd4f5a83", "5b1dd1a3-e1a1-4f75-9f5b-8ad1e7a8d0a3"}
+	system := "0f3ad5a2-1b9e-4b8d-9c3e-7d2f1a6b5c4e"
+	foreign := "e2b6f1c4-8a3d-4e7f-b5c9-1d0a2f3e4b5c"
+	missing := "9a8e5dbd-5b0e-4cf6-b2b4-5f2b3f3e4a0e"
+	owners := map[string]string{
+		existing[0]: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+		existing[1]: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+		system:      settings.SystemAccountUUID,
+		foreign:     "00000000-0000-0000-0000-000000000000",
+	}
+
+	newService := func(permission *settingsmsg.Permission) Service {
+		manager := &mocks.Manager{}
+		for id, owner := range owners {
+			manager.On("ReadValue", id).Return(&settingsmsg.Value{
+				Id:          id,
+				AccountUuid: owner,
+				BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+				SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+				Value:       &settingsmsg.Value_StringValue{StringValue: "de"},
+			}, nil)
+		}
+		manager.On("ReadValue", missing).Return(nil, errors.New("value not found"))
+		manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+		manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+		if permission != nil {
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(permission, nil)
+		} else {
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(nil, settings.ErrPermissionNotFound)
+		}
+		return Service{
+			manager: manager,
+			logger:  log.NopLogger(),
+		}
+	}
+	svc := newService(nil)
+
+	res := v0.GetValuesResponse{}
+	err := svc.GetValues(ctxWithUUID, &v0.GetValuesRequest{Ids: []string{existing[1], missing, existing[0]}}, &res)
+	require.NoError(t, err)
+	require.Len(t, res.Values, 2)
+	assert.Equal(t, existing[1], res.Values[0].Value.Id)
+	assert.Equal(t, existing[0], res.Values[1].Value.Id)
+	assert.Equal(t, "setting", res.Values[0].Identifier.Setting)
+	assert.Equal(t, []string{missing}, res.NotFound)
+
+	// the values of other users are not found, system values can be read by everyone
+	res = v0.GetValuesResponse{}
+	err = svc.GetValues(ctxWithUUID, &v0.GetValuesRequest{Ids: []string{foreign, system}}, &res)
+	require.NoError(t, err)
+	require.Len(t, res.Values, 1)
+	assert.Equal(t, system, res.Values[0].Value.Id)
+	assert.Equal(t, []string{foreign}, res.NotFound)
+	err = svc.GetValue(ctxWithUUID, &v0.GetValueRequest{Id: foreign}, &v0.GetValueResponse{})
+	assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+
+	// users with the settings management permission can read the values of all accounts
+	svc = newService(&settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	})
+	res = v0.GetValuesResponse{}
+	err = svc.GetValues(ctxWithUUID, &v0.GetValuesRequest{Ids: []string{foreign}}, &res)
+	require.NoError(t, err)
+	require.Len(t, res.Values, 1)
+	assert.Equal(t, foreign, res.Values[0].Value.Id)
+	assert.Empty(t, res.NotFound)
+
+	for _, ids := range [][]string{nil, {"not-a-uuid"}} {
+		err = svc.GetValues(ctxWithUUID, &v0.GetValuesRequest{Ids: ids}, &v0.GetValuesResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	}
+}
+
+func TestSaveBundleGroups(t *testing.T) {
+	newBundle := func(groups []*settingsmsg.SettingGroup, groupID string) *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Name:        "bundle",
+			Extension:   "extension",
+			DisplayName: "Bundle",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Groups:      groups,
+			Settings: []*settingsmsg.Setting{{
+				Id:       "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+				Name:     "theme",
+				GroupId:  groupID,
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			}},
+		}
+	}
+	appearance := &settingsmsg.SettingGroup{Id: "appearance", DisplayName: "Appearance"}
+
+	scenarios := []struct {
+		name   string
+		bundle *settingsmsg.Bundle
+		valid  bool
+	}{
+		{"grouped setting", newBundle([]*settingsmsg.SettingGroup{appearance}, "appearance"), true},
+		{"ungrouped setting", newBundle([]*settingsmsg.SettingGroup{appearance}, ""), true},
+		{"unknown group", newBundle([]*settingsmsg.SettingGroup{appearance}, "notifications"), false},
+		{"duplicate group", newBundle([]*settingsmsg.SettingGroup{appearance, appearance}, "appearance"), false},
+		{"group without display name", newBundle([]*settingsmsg.SettingGroup{{Id: "appearance"}}, ""), false},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+			manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			res := v0.SaveBundleResponse{}
+			err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: scenario.bundle}, &res)
+			if scenario.valid {
+				require.NoError(t, err)
+				assert.Equal(t, scenario.bundle.Groups, res.Bundle.Groups)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+			manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+		})
+	}
+}
+
+func TestSaveChoiceValue(t *testing.T) {
+	settings.OptionsProviders["test-languages"] = func(context.Context, *settingsmsg.Setting) ([]*settingsmsg.ListOption, error) {
+		return choiceOptions, nil
+	}
+	t.Cleanup(func() { delete(settings.OptionsProviders, "test-languages") })
+	providedChoiceSetting := func() *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Value: &settingsmsg.Setting_SingleChoiceValue{SingleChoiceValue: &settingsmsg.SingleChoiceList{OptionsProvider: "test-languages"}},
+		}
+	}
+
+	scenarios := []struct {
+		name    string
+		setting *settingsmsg.Setting
+		value   *settingsmsg.Value
+		code    int32
+	}{
+		{"single choice with a declared option", singleChoiceSetting, listValue("en"), 0},
+		{"single choice with an unknown option", singleChoiceSetting, listValue("fr"), http.StatusBadRequest},
+		{"single choice with two options", singleChoiceSetting, listValue("de", "en"), http.StatusBadRequest},
+		{"single choice without an option", singleChoiceSetting, listValue(), http.StatusBadRequest},
+		{"multi choice with declared options", multiChoiceSetting, listValue("de", "en"), 0},
+		{"multi choice with an unknown option", multiChoiceSetting, listValue("de", "fr"), http.StatusBadRequest},
+		{"multi choice with a duplicate option", multiChoiceSetting, listValue("en", "en"), http.StatusBadRequest},
+		{"provided option", providedChoiceSetting(), listValue("de"), 0},
+		{"option unknown to the provider", providedChoiceSetting(), listValue("fr"), http.StatusBadRequest},
+		{"choice setting with a string value", singleChoiceSetting, stringValue("de"), http.StatusBadRequest},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadSetting", mock.Anything).Return(scenario.setting, nil)
+			manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value { return v }, nil)
+			manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry { return e }, nil)
+			manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			value := scenario.value
+			value.BundleId = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+			value.SettingId = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+			value.AccountUuid = "me"
+			value.Resource = &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER}
+			err := svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value}, &v0.SaveValueResponse{})
+			if scenario.code == 0 {
+				assert.NoError(t, err)
+				manager.AssertNumberOfCalls(t, "WriteValue", 1)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+			manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+		})
+	}
+}
+
+func TestSaveJSONValue(t *testing.T) {
+	setting := &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_JsonValue{JsonValue: &settingsmsg.Json{
+			Schema: `{"type": "object", "required": ["columns"], "properties": {"columns": {"type": "array", "items": {"type": "string"}}}}`,
+		}},
+	}
+	var stored *settingsmsg.Value
+	manager := &mocks.Manager{}
+	manager.On("ReadSetting", mock.Anything).Return(setting, nil)
+	manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value {
+		stored = proto.Clone(v).(*settingsmsg.Value)
+		return v
+	}, nil)
+	manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry { return e }, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+	manager.On("ReadValue", mock.Anything).Return(func(string) *settingsmsg.Value { return stored }, nil)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+	value := func(doc string) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			AccountUuid: "me",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_JsonValue{JsonValue: doc},
+		}
+	}
+
+	// the document is stored as it was sent, including the order of the keys, the whitespace and the number formats
+	doc := "{\n  \"columns\": [\"size\", \"name\"],\n  \"width\": 1.50e2\n}"
+	res := v0.SaveValueResponse{}
+	require.NoError(t, svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value(doc)}, &res))
+	assert.Equal(t, doc, res.GetValue().GetValue().GetJsonValue())
+	assert.Equal(t, doc, stored.GetJsonValue())
+
+	gres := v0.GetValueResponse{}
+	require.NoError(t, svc.GetValue(ctxWithUUID, &v0.GetValueRequest{Id: stored.Id}, &gres))
+	assert.Equal(t, doc, gres.GetValue().GetValue().GetJsonValue())
+
+	err := svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value(`{"columns": "size"}`)}, &v0.SaveValueResponse{})
+	require.Error(t, err)
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	assert.Contains(t, err.Error(), "/columns")
+
+	large := `{"columns": ["` + strings.Repeat("a", maxJSONValueSize-17) + `"]}`
+	require.Len(t, large, maxJSONValueSize)
+	require.NoError(t, svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value(large)}, &v0.SaveValueResponse{}))
+	assert.Equal(t, large, stored.GetJsonValue())
+
+	err = svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: value(large + " ")}, &v0.SaveValueResponse{})
+	require.Error(t, err)
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	manager.AssertNumberOfCalls(t, "WriteValue", 2)
+}
+
+func TestUpdateBundle(t *testing.T) {
+	newBundle := func() *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Name:        "bundle",
+			Extension:   "extension",
+			DisplayName: "Bundle",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Settings: []*settingsmsg.Setting{
+				{
+					Id:          "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+					Name:        "theme",
+					DisplayName: "Theme",
+					Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value:       &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{Default: "light"}},
+				},
+				{
+					Id:       "3f3bc8e5-0b1b-4d5e-9bb4-6a2e4b729a6c",
+					Name:     "language",
+					Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				},
+			},
+		}
+	}
+
+	scenarios := []struct {
+		name   string
+		patch  string
+		code   int32
+		expect func(*settingsmsg.Bundle)
+	}{
+		{"field update", `{"displayName": "Appearance"}`, 0, func(b *settingsmsg.Bundle) {
+			b.DisplayName = "Appearance"
+		}},
+		{"setting update", `{"settings": {"c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a": {"displayName": "Color theme", "stringValue": {"default": "dark"}}}}`, 0, func(b *settingsmsg.Bundle) {
+			b.Settings[0].DisplayName = "Color theme"
+			b.Settings[0].GetStringValue().Default = "dark"
+		}},
+		{"removed field", `{"settings": {"c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a": {"displayName": null}}}`, 0, func(b *settingsmsg.Bundle) {
+			b.Settings[0].DisplayName = ""
+		}},
+		{"added and removed settings", `{"settings": {
+			"3f3bc8e5-0b1b-4d5e-9bb4-6a2e4b729a6c": null,
+			"9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e": {"name": "timezone", "resource": {"type": "TYPE_SYSTEM"}}
+		}}`, 0, func(b *settingsmsg.Bundle) {
+			b.Settings[1] = &settingsmsg.Setting{
+				Id:       "9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e",
+				Name:     "timezone",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			}
+		}},
+		{"replaced settings", `{"settings": [{"id": "3f3bc8e5-0b1b-4d5e-9bb4-6a2e4b729a6c", "name": "language", "resource": {"type": "TYPE_SYSTEM"}}]}`, 0, func(b *settingsmsg.Bundle) {
+			b.Settings = b.Settings[1:]
+		}},
+		{"changed bundle id", `{"id": "9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e"}`, http.StatusBadRequest, nil},
+		{"changed setting id", `{"settings": {"c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a": {"id": "9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e"}}}`, http.StatusBadRequest, nil},
+		{"invalid result", `{"displayName": null}`, http.StatusBadRequest, nil},
+		{"unknown field", `{"color": "red"}`, http.StatusBadRequest, nil},
+		{"no object", `["displayName"]`, http.StatusBadRequest, nil},
+		{"missing patch", ``, http.StatusBadRequest, nil},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle { return newBundle() }, nil)
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+			manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(func(string, string) *settingsmsg.Bundle { return newBundle() }, nil)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			res := v0.UpdateBundleResponse{}
+			err := svc.UpdateBundle(ctxWithUUID, &v0.UpdateBundleRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", Patch: scenario.patch}, &res)
+			if scenario.code != 0 {
+				require.Error(t, err)
+				assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+				manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			expected := newBundle()
+			scenario.expect(expected)
+			assert.True(t, proto.Equal(expected, res.Bundle), "expected %v, got %v", expected, res.Bundle)
+			manager.AssertNumberOfCalls(t, "WriteBundle", 1)
+		})
+	}
+
+	t.Run("no-op patches", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle { return newBundle() }, nil)
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+		svc := Service{
+			manager: manager,
+			logger:  log.NopLogger(),
+		}
+		for _, patch := range []string{`{}`, `{"displayName": "Bundle"}`, `{"settings": {"c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a": {}}}`} {
+			res := v0.UpdateBundleResponse{}
+			require.NoError(t, svc.UpdateBundle(ctxWithUUID, &v0.UpdateBundleRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", Patch: patch}, &res), patch)
+			assert.True(t, proto.Equal(newBundle(), res.Bundle), patch)
+		}
+		manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+	})
+}
+
+func TestReorderSettings(t *testing.T) {
+	const (
+		theme    = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		language = "3f3bc8e5-0b1b-4d5e-9bb4-6a2e4b729a6c"
+		timezone = "9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e"
+		unknown  = "b6b2cc46-2bb0-4af4-a9e2-6e0a4c8b4a3d"
+	)
+	newBundle := func() *settingsmsg.Bundle {
+		b := &settingsmsg.Bundle{
+			Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Name:        "bundle",
+			Extension:   "extension",
+			DisplayName: "Bundle",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+		}
+		for _, id := range []string{theme, language, timezone} {
+			b.Settings = append(b.Settings, &settingsmsg.Setting{
+				Id:       id,
+				Name:     "setting-" + id[:8],
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			})
+		}
+		return b
+	}
+
+	scenarios := []struct {
+		name string
+		ids  []string
+		code int32
+	}{
+		{"reversed", []string{timezone, language, theme}, 0},
+		{"unchanged", []string{theme, language, timezone}, 0},
+		{"missing id", []string{timezone, theme}, http.StatusBadRequest},
+		{"extra id", []string{timezone, language, theme, unknown}, http.StatusBadRequest},
+		{"unknown id", []string{timezone, language, unknown}, http.StatusBadRequest},
+		{"repeated id", []string{timezone, language, theme, theme}, http.StatusBadRequest},
+		{"invalid id", []string{timezone, language, "theme"}, http.StatusBadRequest},
+		{"no ids", nil, http.StatusBadRequest},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle { return newBundle() }, nil)
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+			manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(func(string, string) *settingsmsg.Bundle { return newBundle() }, nil)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			res := v0.ReorderSettingsResponse{}
+			err := svc.ReorderSettings(ctxWithUUID, &v0.ReorderSettingsRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", SettingIds: scenario.ids}, &res)
+			if scenario.code != 0 {
+				require.Error(t, err)
+				assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+				manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			written := manager.Calls[len(manager.Calls)-1].Arguments.Get(0).(*settingsmsg.Bundle)
+			for i, id := range scenario.ids {
+				assert.Equal(t, id, res.Bundle.Settings[i].Id)
+				assert.Equal(t, id, written.Settings[i].Id)
+			}
+			assert.Len(t, written.Settings, len(scenario.ids))
+			manager.AssertNumberOfCalls(t, "WriteBundle", 1)
+		})
+	}
+
+	t.Run("without permission", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(newBundle(), nil)
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{}, nil)
+		svc := Service{
+			manager: manager,
+			logger:  log.NopLogger(),
+		}
+
+		err := svc.ReorderSettings(ctxWithUUID, &v0.ReorderSettingsRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", SettingIds: []string{timezone, language, theme}}, &v0.ReorderSettingsResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+	})
+}
+
+func TestCloneBundle(t *testing.T) {
+	var admin *settingsmsg.Bundle
+	for _, b := range defaults.GenerateBundlesDefaultRoles() {
+		if b.Id == defaults.BundleUUIDRoleAdmin {
+			admin = b
+		}
+	}
+	require.NotNil(t, admin)
+	profile := &settingsmsg.Bundle{
+		Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		Name:        "profile",
+		Extension:   "ocis-accounts",
+		DisplayName: "Profile",
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+		Settings: []*settingsmsg.Setting{{
+			Id:       "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			Name:     "language",
+			Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:    &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{}},
+		}},
+	}
+	newManager := func(canManage bool) *mocks.Manager {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(func(id string) *settingsmsg.Bundle {
+			if id == admin.Id {
+				return admin
+			}
+			return profile
+		}, nil)
+		var permission *settingsmsg.Permission
+		if canManage {
+			permission = &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+		}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(permission, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: admin.Id}}, nil)
+		manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+		return manager
+	}
+
+	t.Run("admin role", func(t *testing.T) {
+		manager := newManager(true)
+		manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+
+		res := v0.CloneBundleResponse{}
+		err := svc.CloneBundle(ctxWithUUID, &v0.CloneBundleRequest{
+			BundleId:    admin.Id,
+			Name:        "helpdesk",
+			Extension:   "ocis-roles",
+			DisplayName: "Helpdesk",
+		}, &res)
+		require.NoError(t, err)
+		assert.NotEqual(t, admin.Id, res.Bundle.Id)
+		assert.Equal(t, "helpdesk", res.Bundle.Name)
+		assert.Equal(t, "ocis-roles", res.Bundle.Extension)
+		assert.Equal(t, "Helpdesk", res.Bundle.DisplayName)
+		assert.Equal(t, settingsmsg.Bundle_TYPE_ROLE, res.Bundle.Type)
+		// the permissions are copied with their ids, e.g. the role management permission
+		require.Len(t, res.Bundle.Settings, len(admin.Settings))
+		for i, s := range res.Bundle.Settings {
+			assert.Equal(t, admin.Settings[i].Id, s.Id)
+			assert.True(t, proto.Equal(admin.Settings[i].GetPermissionValue(), s.GetPermissionValue()))
+			assert.NotSame(t, admin.Settings[i], s)
+		}
+		assert.Equal(t, "admin", admin.Name)
+		manager.AssertNumberOfCalls(t, "WriteBundle", 1)
+	})
+
+	t.Run("default bundle", func(t *testing.T) {
+		manager := newManager(true)
+		manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+
+		res := v0.CloneBundleResponse{}
+		err := svc.CloneBundle(ctxWithUUID, &v0.CloneBundleRequest{BundleId: profile.Id, Name: "profile-copy", Extension: "ocis-accounts", DisplayName: "Profile copy"}, &res)
+		require.NoError(t, err)
+		require.Len(t, res.Bundle.Settings, 1)
+		// other settings get new ids, setting ids are unique across bundles
+		assert.NotEqual(t, profile.Settings[0].Id, res.Bundle.Settings[0].Id)
+		assert.Equal(t, "language", res.Bundle.Settings[0].Name)
+		assert.Equal(t, "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", profile.Settings[0].Id)
+	})
+
+	t.Run("existing name", func(t *testing.T) {
+		manager := newManager(true)
+		manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(profile, nil)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+
+		err := svc.CloneBundle(ctxWithUUID, &v0.CloneBundleRequest{BundleId: admin.Id, Name: "profile", Extension: "ocis-accounts", DisplayName: "Profile"}, &v0.CloneBundleResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusConflict), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		manager := newManager(false)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+
+		err := svc.CloneBundle(ctxWithUUID, &v0.CloneBundleRequest{BundleId: admin.Id, Name: "helpdesk", Extension: "ocis-roles", DisplayName: "Helpdesk"}, &v0.CloneBundleResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+		err = svc.CloneBundle(ctxWithUUID, &v0.CloneBundleRequest{BundleId: admin.Id, Name: "help desk", Extension: "ocis-roles", DisplayName: "Helpdesk"}, &v0.CloneBundleResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+	})
+}
+
+func TestDefaultOverrides(t *testing.T) {
+	bundles := []*settingsmsg.Bundle{{
+		Id:        "2a506de7-99bd-4f0d-994e-c38e72c28fd9",
+		Name:      "profile",
+		Extension: "ocis-accounts",
+		Type:      settingsmsg.Bundle_TYPE_DEFAULT,
+		Settings: []*settingsmsg.Setting{
+			{
+				Id:       "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f",
+				Name:     "language",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value: &settingsmsg.Setting_SingleChoiceValue{SingleChoiceValue: &settingsmsg.SingleChoiceList{
+					Options: []*settingsmsg.ListOption{
+						{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "de"}}},
+						{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "en"}}, Default: true},
+					},
+				}},
+			},
+			{
+				Id:       "4b6a3c6e-9b7e-4a53-9c55-0b7d0aa6c8f1",
+				Name:     "page-size",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:    &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: 50, Min: 10, Max: 100}},
+			},
+		},
+	}, {
+		Id:   "71881883-1768-46bd-a24d-a356a2afdf7f",
+		Name: "admin",
+		Type: settingsmsg.Bundle_TYPE_ROLE,
+		Settings: []*settingsmsg.Setting{{
+			Id:    "a53e601e-571f-4f86-8fec-d4576ef49c62",
+			Name:  "role-management",
+			Value: &settingsmsg.Setting_PermissionValue{PermissionValue: &settingsmsg.Permission{}},
+		}},
+	}}
+
+	t.Run("validation", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			overrides []string
+			err       string
+		}{
+			{name: "valid", overrides: []string{"aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f=de", "4b6a3c6e-9b7e-4a53-9c55-0b7d0aa6c8f1= 20"}},
+			{name: "no value", overrides: []string{"aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f"}, err: "expected 'setting id=value'"},
+			{name: "unknown setting", overrides: []string{"d3b9c2a8-6a53-4a7b-9a84-8f0f3b1c9a11=de"}, err: "unknown setting"},
+			{name: "role setting", overrides: []string{"a53e601e-571f-4f86-8fec-d4576ef49c62=true"}, err: "unknown setting"},
+			{name: "twice", overrides: []string{"aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f=de", "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f=en"}, err: "more than once"},
+			{name: "invalid option", overrides: []string{"aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f=fr"}, err: `"fr" is not a valid option`},
+			{name: "not an int", overrides: []string{"4b6a3c6e-9b7e-4a53-9c55-0b7d0aa6c8f1=many"}, err: "is not an int"},
+			{name: "out of range", overrides: []string{"4b6a3c6e-9b7e-4a53-9c55-0b7d0aa6c8f1=500"}, err: "int_value"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := parseDefaultOverrides(tc.overrides, bundles)
+				if tc.err == "" {
+					assert.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+			})
+		}
+	})
+
+	overrides, err := parseDefaultOverrides([]string{"aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f=de"}, bundles)
+	require.NoError(t, err)
+	stored := &settingsmsg.Value{
+		Id:          "b7bb0d4c-7c64-4cc4-9f85-5b1e2b0f8ec6",
+		BundleId:    "2a506de7-99bd-4f0d-994e-c38e72c28fd9",
+		SettingId:   "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f",
+		AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+		Value: &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: []*settingsmsg.ListOptionValue{
+			{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "en"}},
+		}}},
+	}
+	language := func(v *settingsmsg.ValueWithIdentifier) string {
+		return v.GetValue().GetListValue().GetValues()[0].GetStringValue()
+	}
+
+	t.Run("without a stored value", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ReadValueByUniqueIdentifiers", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+		manager.On("ListValues", mock.Anything, mock.Anything).Return([]*settingsmsg.Value{}, nil)
+		manager.On("ReadBundleBySetting", mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		svc := Service{manager: manager, logger: log.NopLogger(), defaultOverrides: overrides}
+
+		res := v0.GetValueResponse{}
+		require.NoError(t, svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: "me",
+			SettingId:   "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f",
+		}, &res))
+		assert.Equal(t, "de", language(res.Value))
+		assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7", res.Value.Value.AccountUuid)
+		assert.Equal(t, "language", res.Value.Identifier.Setting)
+
+		lres := v0.ListValuesResponse{}
+		require.NoError(t, svc.ListValues(ctxWithUUID, &v0.ListValuesRequest{AccountUuid: "me"}, &lres))
+		require.Len(t, lres.Values, 1)
+		assert.Equal(t, "de", language(lres.Values[0]))
+
+		// settings without an override are still not found
+		err := svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: "me",
+			SettingId:   "4b6a3c6e-9b7e-4a53-9c55-0b7d0aa6c8f1",
+		}, &v0.GetValueResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+
+	t.Run("stored values take precedence", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ReadValueByUniqueIdentifiers", mock.Anything, mock.Anything).Return(stored, nil)
+		manager.On("ListValues", mock.Anything, mock.Anything).Return([]*settingsmsg.Value{stored}, nil)
+		manager.On("ReadBundle", mock.Anything).Return(bundles[0], nil)
+		manager.On("ReadSetting", mock.Anything).Return(bundles[0].Settings[0], nil)
+		svc := Service{manager: manager, logger: log.NopLogger(), defaultOverrides: overrides}
+
+		res := v0.GetValueResponse{}
+		require.NoError(t, svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: "me",
+			SettingId:   "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f",
+		}, &res))
+		assert.Equal(t, "en", language(res.Value))
+
+		lres := v0.ListValuesResponse{}
+		require.NoError(t, svc.ListValues(ctxWithUUID, &v0.ListValuesRequest{AccountUuid: "me"}, &lres))
+		require.Len(t, lres.Values, 1)
+		assert.Equal(t, "en", language(lres.Values[0]))
+	})
+
+	t.Run("other bundles", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ListValues", mock.Anything, mock.Anything).Return([]*settingsmsg.Value{}, nil)
+		svc := Service{manager: manager, logger: log.NopLogger(), defaultOverrides: overrides}
+
+		lres := v0.ListValuesResponse{}
+		require.NoError(t, svc.ListValues(ctxWithUUID, &v0.ListValuesRequest{
+			AccountUuid: "me",
+			BundleId:    "f8a3e2d1-5b4c-4a3b-9c2d-1e0f9a8b7c6d",
+		}, &lres))
+		assert.Empty(t, lres.Values)
+	})
+
+	t.Run("built-in settings", func(t *testing.T) {
+		_, err := parseDefaultOverrides([]string{"aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f=de"}, defaults.GenerateBundlesDefaultRoles())
+		assert.NoError(t, err)
+	})
+}
+
+func TestSystemValues(t *testing.T) {
+	const (
+		accountID = "61445573-4dbe-4d56-88dc-88ab47aceba7"
+		settingID = "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f"
+	)
+	bundles := defaults.GenerateBundlesDefaultRoles()
+	overrides, err := parseDefaultOverrides([]string{settingID + "=de"}, bundles)
+	require.NoError(t, err)
+	value := func(account, language string) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			Id:          uuid.Must(uuid.NewV4()).String(),
+			BundleId:    "2a506de7-99bd-4f0d-994e-c38e72c28fd9",
+			SettingId:   settingID,
+			AccountUuid: account,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value: &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: []*settingsmsg.ListOptionValue{
+				{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: language}},
+			}}},
+		}
+	}
+	newService := func(account, system *settingsmsg.Value) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		for id, v := range map[string]*settingsmsg.Value{accountID: account, settings.SystemAccountUUID: system} {
+			if v != nil {
+				manager.On("ReadValueByUniqueIdentifiers", id, settingID).Return(v, nil)
+			} else {
+				manager.On("ReadValueByUniqueIdentifiers", id, settingID).Return(nil, errors.New("not found"))
+			}
+		}
+		for _, b := range bundles {
+			manager.On("ReadBundle", b.Id).Return(b, nil)
+			for _, s := range b.Settings {
+				manager.On("ReadSetting", s.Id).Return(s, nil)
+			}
+		}
+		return Service{manager: manager, logger: log.NopLogger(), defaultOverrides: overrides}, manager
+	}
+	get := func(svc Service, account string) (string, error) {
+		res := v0.GetValueResponse{}
+		err := svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: account,
+			SettingId:   settingID,
+		}, &res)
+		return res.GetValue().GetValue().GetListValue().GetValues()[0].GetStringValue(), err
+	}
+
+	t.Run("precedence", func(t *testing.T) {
+		for _, tc := range []struct {
+			name            string
+			account, system *settingsmsg.Value
+			expected        string
+		}{
+			{"account value", value(accountID, "fr"), value(settings.SystemAccountUUID, "es"), "fr"},
+			{"system value", nil, value(settings.SystemAccountUUID, "es"), "es"},
+			{"default", nil, nil, "de"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				svc, _ := newService(tc.account, tc.system)
+				language, err := get(svc, "me")
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, language)
+			})
+		}
+	})
+
+	t.Run("everyone can read the system value", func(t *testing.T) {
+		svc, manager := newService(value(accountID, "fr"), value(settings.SystemAccountUUID, "es"))
+		language, err := get(svc, settings.SystemAccountUUID)
+		require.NoError(t, err)
+		assert.Equal(t, "es", language)
+		manager.AssertNotCalled(t, "ReadValueByUniqueIdentifiers", accountID, settingID)
+	})
+
+	t.Run("saving requires the settings management permission", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			allowed bool
+		}{
+			{"without permission", false},
+			{"with permission", true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				svc, manager := newService(nil, nil)
+				manager.On("ListRoleAssignments", accountID).Return([]*settingsmsg.UserRoleAssignment{{AccountUuid: accountID, RoleId: BundleUUIDRoleAdmin}}, nil)
+				if tc.allowed {
+					manager.On("ReadPermissionByID", SettingsManagementPermissionID, []string{BundleUUIDRoleAdmin}).Return(&settingsmsg.Permission{
+						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+					}, nil)
+				} else {
+					manager.On("ReadPermissionByID", SettingsManagementPermissionID, []string{BundleUUIDRoleAdmin}).Return(nil, nil)
+				}
+				manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value { return v }, nil)
+				manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry { return e }, nil)
+
+				system := value(settings.SystemAccountUUID, "es")
+				system.Id = ""
+				err := svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: system}, &v0.SaveValueResponse{})
+				if tc.allowed {
+					require.NoError(t, err)
+					manager.AssertCalled(t, "WriteValue", mock.Anything)
+				} else {
+					require.Error(t, err)
+					assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+					manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+				}
+			})
+		}
+	})
+}
+
+func TestValueInheritance(t *testing.T) {
+	const (
+		accountID = "61445573-4dbe-4d56-88dc-88ab47aceba7"
+		settingID = "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f"
+	)
+	bundles := defaults.GenerateBundlesDefaultRoles()
+	overrides, err := parseDefaultOverrides([]string{settingID + "=de"}, bundles)
+	require.NoError(t, err)
+	value := func(account, language string) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			Id:          uuid.Must(uuid.NewV4()).String(),
+			BundleId:    "2a506de7-99bd-4f0d-994e-c38e72c28fd9",
+			SettingId:   settingID,
+			AccountUuid: account,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value: &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: []*settingsmsg.ListOptionValue{
+				{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: language}},
+			}}},
+		}
+	}
+	var parents []string
+	settings.ParentProviders["test"] = func(_ context.Context, account string) ([]string, error) {
+		if account != accountID {
+			return nil, errors.New("unknown account")
+		}
+		return parents, nil
+	}
+	t.Cleanup(func() { delete(settings.ParentProviders, "test") })
+
+	newService := func(provider string, values ...*settingsmsg.Value) Service {
+		manager := &mocks.Manager{}
+		for _, v := range values {
+			manager.On("ReadValueByUniqueIdentifiers", v.AccountUuid, settingID).Return(v, nil)
+		}
+		manager.On("ReadValueByUniqueIdentifiers", mock.Anything, settingID).Return(nil, errors.New("not found"))
+		for _, b := range bundles {
+			manager.On("ReadBundle", b.Id).Return(b, nil)
+			for _, s := range b.Settings {
+				manager.On("ReadSetting", s.Id).Return(s, nil)
+			}
+		}
+		return Service{
+			manager:          manager,
+			logger:           log.NopLogger(),
+			config:           &config.Config{ParentProvider: provider},
+			defaultOverrides: overrides,
+		}
+	}
+	get := func(svc Service, parent string) (*settingsmsg.Value, error) {
+		res := v0.GetValueResponse{}
+		err := svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid:   "me",
+			SettingId:     settingID,
+			ParentGroupId: parent,
+		}, &res)
+		return res.GetValue().GetValue(), err
+	}
+	language := func(v *settingsmsg.Value) string {
+		return v.GetListValue().GetValues()[0].GetStringValue()
+	}
+	account := value(accountID, "fr")
+	admins := value(settings.GroupAccountUUID("admins"), "it")
+	users := value(settings.GroupAccountUUID("users"), "nl")
+	system := value(settings.SystemAccountUUID, "es")
+
+	for _, tc := range []struct {
+		name     string
+		provider string
+		groups   []string
+		parent   string
+		values   []*settingsmsg.Value
+		expected string
+		from     string
+	}{
+		{"account value", "", nil, "admins", []*settingsmsg.Value{account, admins, system}, "fr", accountID},
+		{"group of the request", "", nil, "admins", []*settingsmsg.Value{admins, users, system}, "it", settings.GroupAccountUUID("admins")},
+		{"group of the provider", "test", []string{"users"}, "", []*settingsmsg.Value{admins, users, system}, "nl", settings.GroupAccountUUID("users")},
+		{"first group with a value", "test", []string{"guests", "users", "admins"}, "", []*settingsmsg.Value{admins, users}, "nl", settings.GroupAccountUUID("users")},
+		{"request over provider", "test", []string{"users"}, "admins", []*settingsmsg.Value{admins, users}, "it", settings.GroupAccountUUID("admins")},
+		{"system value", "test", []string{"guests"}, "", []*settingsmsg.Value{admins, system}, "es", settings.SystemAccountUUID},
+		{"default", "", nil, "guests", []*settingsmsg.Value{admins}, "de", accountID},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parents = tc.groups
+			v, err := get(newService(tc.provider, tc.values...), tc.parent)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, language(v))
+			assert.Equal(t, tc.from, v.AccountUuid)
+		})
+	}
+
+	t.Run("failing provider", func(t *testing.T) {
+		svc := newService("test")
+		settings.ParentProviders["failing"] = func(context.Context, string) ([]string, error) {
+			return nil, errors.New("directory unavailable")
+		}
+		t.Cleanup(func() { delete(settings.ParentProviders, "failing") })
+		svc.config.ParentProvider = "failing"
+		_, err := get(svc, "")
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusInternalServerError), merrors.FromError(err).Code)
+	})
+
+	t.Run("everyone can read group values", func(t *testing.T) {
+		res := v0.GetValueResponse{}
+		err := newService("", admins).GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: settings.GroupAccountUUID("admins"),
+			SettingId:   settingID,
+		}, &res)
+		require.NoError(t, err)
+		assert.Equal(t, "it", language(res.Value.Value))
+	})
+
+	t.Run("saving requires the settings management permission", func(t *testing.T) {
+		svc := newService("")
+		manager := svc.manager.(*mocks.Manager)
+		manager.On("ListRoleAssignments", accountID).Return([]*settingsmsg.UserRoleAssignment{{AccountUuid: accountID, RoleId: BundleUUIDRoleUser}}, nil)
+		manager.On("ReadPermissionByID", SettingsManagementPermissionID, []string{BundleUUIDRoleUser}).Return(nil, nil)
+
+		group := value(settings.GroupAccountUUID("admins"), "it")
+		group.Id = ""
+		err := svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: group}, &v0.SaveValueResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "WriteValue", mock.Anything)
+	})
+}
+
+func TestListBundlesWithValues(t *testing.T) {
+	newBundle := func(id, name, settingID string) *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:        id,
+			Name:      name,
+			Extension: "ocis-accounts",
+			Type:      settingsmsg.Bundle_TYPE_DEFAULT,
+			Settings: []*settingsmsg.Setting{{
+				Id:       settingID,
+				Name:     "setting",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:    &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+			}},
+		}
+	}
+	manager := &mocks.Manager{}
+	manager.On("ListBundles", mock.Anything, mock.Anything).Return(func(settingsmsg.Bundle_Type, []string) []*settingsmsg.Bundle {
+		return []*settingsmsg.Bundle{
+			newBundle("2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", "profile", "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"),
+			newBundle("2d745744-749c-4286-8e92-74a24d8331c5", "notifications", "3fd9a3d9-20b7-40d4-9294-b22bb5868c10"),
+		}
+	}, nil)
+	manager.On("ListBundleIDsWithValues", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return([]string{"2d745744-749c-4286-8e92-74a24d8331c5"}, nil)
+	manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{}, nil)
+	manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+		Operation:  settingsmsg.Permission_OPERATION_READ,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}}, nil)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+
+	res := v0.ListBundlesResponse{}
+	require.NoError(t, svc.ListBundles(ctxWithUUID, &v0.ListBundlesRequest{HasValues: true}, &res))
+	require.Len(t, res.Bundles, 1)
+	assert.Equal(t, "notifications", res.Bundles[0].Name)
+
+	res = v0.ListBundlesResponse{}
+	require.NoError(t, svc.ListBundles(ctxWithUUID, &v0.ListBundlesRequest{}, &res))
+	assert.Len(t, res.Bundles, 2)
+
+	// without an account there are no stored values
+	res = v0.ListBundlesResponse{}
+	require.NoError(t, svc.ListBundles(emptyCtx, &v0.ListBundlesRequest{HasValues: true}, &res))
+	assert.Empty(t, res.Bundles)
+	manager.AssertNumberOfCalls(t, "ListBundleIDsWithValues", 1)
+}
+
+func TestListBundlesByTags(t *testing.T) {
+	newBundle := func(id, name string, tags ...string) *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:        id,
+			Name:      name,
+			Extension: "ocis-accounts",
+			Type:      settingsmsg.Bundle_TYPE_DEFAULT,
+			Tags:      tags,
+			Settings: []*settingsmsg.Setting{{
+				Id:       id,
+				Name:     "setting",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:    &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+			}},
+		}
+	}
+	manager := &mocks.Manager{}
+	manager.On("ListBundles", mock.Anything, mock.Anything).Return(func(settingsmsg.Bundle_Type, []string) []*settingsmsg.Bundle {
+		return []*settingsmsg.Bundle{
+			newBundle("2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", "profile"),
+			newBundle("2d745744-749c-4286-8e92-74a24d8331c5", "security", "security"),
+			newBundle("c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", "preview", "security", "experimental"),
+			newBundle("3fd9a3d9-20b7-40d4-9294-b22bb5868c10", "labs", "experimental"),
+		}
+	}, nil)
+	manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{}, nil)
+	manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+		Operation:  settingsmsg.Permission_OPERATION_READ,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}}, nil)
+	svc := Service{
+		manager: manager,
+		logger:  log.NopLogger(),
+	}
+
+	scenarios := []struct {
+		name     string
+		tags     []string
+		matchAll bool
+		expected []string
+	}{
+		{"no tags", nil, false, []string{"profile", "security", "preview", "labs"}},
+		{"single tag", []string{"security"}, false, []string{"security", "preview"}},
+		{"any tag", []string{"security", "experimental"}, false, []string{"security", "preview", "labs"}},
+		{"all tags", []string{"security", "experimental"}, true, []string{"preview"}},
+		{"unknown tag", []string{"unknown"}, false, []string{}},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			res := v0.ListBundlesResponse{}
+			require.NoError(t, svc.ListBundles(ctxWithUUID, &v0.ListBundlesRequest{Tags: scenario.tags, MatchAllTags: scenario.matchAll}, &res))
+			names := []string{}
+			for _, b := range res.Bundles {
+				names = append(names, b.Name)
+			}
+			assert.Equal(t, scenario.expected, names)
+		})
+	}
+
+	err := svc.ListBundles(ctxWithUUID, &v0.ListBundlesRequest{Tags: []string{"Not A Slug"}}, &v0.ListBundlesResponse{})
+	require.Error(t, err)
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+}
+
+func TestSaveBundleTags(t *testing.T) {
+	scenarios := []struct {
+		name  string
+		tags  []string
+		valid bool
+	}{
+		{"slugs", []string{"security", "end-to-end"}, true},
+		{"upper case", []string{"Security"}, false},
+		{"whitespace", []string{"end to end"}, false},
+		{"leading dash", []string{"-security"}, false},
+		{"empty tag", []string{""}, false},
+		{"too long", []string{strings.Repeat("a", 65)}, false},
+		{"duplicate tag", []string{"security", "security"}, false},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+			manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			svc := Service{
+				manager: manager,
+				logger:  log.NopLogger(),
+			}
+
+			res := v0.SaveBundleResponse{}
+			err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: &settingsmsg.Bundle{
+				Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+				Name:        "bundle",
+				Extension:   "extension",
+				DisplayName: "Bundle",
+				Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+				Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				Tags:        scenario.tags,
+				Settings: []*settingsmsg.Setting{{
+					Id:       "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+					Name:     "theme",
+					Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				}},
+			}}, &res)
+			if scenario.valid {
+				require.NoError(t, err)
+				assert.Equal(t, scenario.tags, res.Bundle.Tags)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+			manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+		})
+	}
+}
+
+func TestAnalyzeRolePermissions(t *testing.T) {
+	language := &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SETTING, Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"}
+	profile := &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_BUNDLE, Id: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}
+	permission := func(id string, resource *settingsmsg.Resource, op settingsmsg.Permission_Operation, c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:       id,
+			Name:     id,
+			Resource: resource,
+			Value: &settingsmsg.Setting_PermissionValue{PermissionValue: &settingsmsg.Permission{
+				Operation:  op,
+				Constraint: c,
+			}},
+		}
+	}
+	roles := map[string]*settingsmsg.Bundle{
+		"3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1": {
+			Id:   "3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1",
+			Type: settingsmsg.Bundle_TYPE_ROLE,
+			Settings: []*settingsmsg.Setting{
+				permission("language-readwrite-own", language, settingsmsg.Permission_OPERATION_READWRITE, settingsmsg.Permission_CONSTRAINT_OWN),
+				permission("profile-read-all", profile, settingsmsg.Permission_OPERATION_READ, settingsmsg.Permission_CONSTRAINT_ALL),
+			},
+		},
+		"9d2f3a44-58c7-4c8e-9a3e-b0d1c7e2f6a0": {
+			Id:   "9d2f3a44-58c7-4c8e-9a3e-b0d1c7e2f6a0",
+			Type: settingsmsg.Bundle_TYPE_ROLE,
+			Settings: []*settingsmsg.Setting{
+				// deliberately conflicts with the readwrite permission of the other role
+				permission("language-none", language, settingsmsg.Permission_OPERATION_UNKNOWN, settingsmsg.Permission_CONSTRAINT_OWN),
+				permission("profile-read-own", profile, settingsmsg.Permission_OPERATION_READ, settingsmsg.Permission_CONSTRAINT_OWN),
+				permission("profile-readwrite-own", profile, settingsmsg.Permission_OPERATION_READWRITE, settingsmsg.Permission_CONSTRAINT_OWN),
+			},
+		},
+		"a1b2c3d4-0000-4000-8000-000000000001": {
+			Id:   "a1b2c3d4-0000-4000-8000-000000000001",
+			Type: settingsmsg.Bundle_TYPE_DEFAULT,
+		},
+	}
+	newService := func(canManage bool) Service {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(func(id string) *settingsmsg.Bundle {
+			return roles[id]
+		}, func(id string) error {
+			if roles[id] == nil {
+				return settings.ErrBundleNotFound
+			}
+			return nil
+		})
+		var p *settingsmsg.Permission
+		if canManage {
+			p = &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+		}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(p, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1"}}, nil)
+		return Service{manager: manager, logger: log.NopLogger()}
+	}
+
+	t.Run("conflicting and overlapping permissions", func(t *testing.T) {
+		res := v0.AnalyzeRolePermissionsResponse{}
+		err := newService(true).AnalyzeRolePermissions(ctxWithUUID, &v0.AnalyzeRolePermissionsRequest{
+			RoleIds: []string{"3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1", "9d2f3a44-58c7-4c8e-9a3e-b0d1c7e2f6a0"},
+		}, &res)
+		require.NoError(t, err)
+
+		require.Len(t, res.Resources, 2)
+		assert.Equal(t, language.Id, res.Resources[0].Resource.Id)
+		assert.Len(t, res.Resources[0].Sources, 2)
+		require.Len(t, res.Resources[0].Effective, 1)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READWRITE, res.Resources[0].Effective[0].Operation)
+
+		// read all and readwrite own are both needed, neither covers the other
+		assert.Equal(t, profile.Id, res.Resources[1].Resource.Id)
+		require.Len(t, res.Resources[1].Effective, 2)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READ, res.Resources[1].Effective[0].Operation)
+		assert.Equal(t, settingsmsg.Permission_CONSTRAINT_ALL, res.Resources[1].Effective[0].Constraint)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READWRITE, res.Resources[1].Effective[1].Operation)
+		assert.Equal(t, settingsmsg.Permission_CONSTRAINT_OWN, res.Resources[1].Effective[1].Constraint)
+
+		require.Len(t, res.Conflicts, 3)
+		assert.Equal(t, v0.PermissionConflict_KIND_CONFLICT, res.Conflicts[0].Kind)
+		assert.Equal(t, language.Id, res.Conflicts[0].Resource.Id)
+		assert.Equal(t, "language-readwrite-own", res.Conflicts[0].First.SettingName)
+		assert.Equal(t, "language-none", res.Conflicts[0].Second.SettingName)
+		assert.Contains(t, res.Conflicts[0].Reason, "permission language-none of role 9d2f3a44-58c7-4c8e-9a3e-b0d1c7e2f6a0 grants nothing")
+		// profile-read-all and profile-read-own
+		assert.Equal(t, v0.PermissionConflict_KIND_OVERLAP, res.Conflicts[1].Kind)
+		assert.Equal(t, "profile-read-all", res.Conflicts[1].First.SettingName)
+		assert.Equal(t, "profile-read-own", res.Conflicts[1].Second.SettingName)
+		// profile-read-own and profile-readwrite-own within the same role
+		assert.Equal(t, v0.PermissionConflict_KIND_OVERLAP, res.Conflicts[2].Kind)
+		assert.Equal(t, "profile-read-own", res.Conflicts[2].First.SettingName)
+		assert.Equal(t, "profile-readwrite-own", res.Conflicts[2].Second.SettingName)
+		assert.Contains(t, res.Conflicts[2].Reason, "permission profile-readwrite-own of role 9d2f3a44-58c7-4c8e-9a3e-b0d1c7e2f6a0 grants OPERATION_READWRITE with CONSTRAINT_OWN, which covers")
+	})
+
+	t.Run("single role", func(t *testing.T) {
+		res := v0.AnalyzeRolePermissionsResponse{}
+		err := newService(true).AnalyzeRolePermissions(ctxWithUUID, &v0.AnalyzeRolePermissionsRequest{
+			RoleIds: []string{"3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1", "3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1"},
+		}, &res)
+		require.NoError(t, err)
+		assert.Len(t, res.Resources, 2)
+		assert.Empty(t, res.Conflicts)
+	})
+
+	scenarios := []struct {
+		name      string
+		canManage bool
+		roleIDs   []string
+		code      int32
+	}{
+		{"no role management permission", false, []string{"3f5b0da2-4d3b-4b17-a1f3-8f8c2f4e9bb1"}, http.StatusForbidden},
+		{"no roles", true, nil, http.StatusBadRequest},
+		{"invalid role id", true, []string{"helpdesk"}, http.StatusBadRequest},
+		{"unknown role", true, []string{"0b0a2f58-2c5e-4bc8-9a64-7c3cb8f2d1e9"}, http.StatusNotFound},
+		{"not a role", true, []string{"a1b2c3d4-0000-4000-8000-000000000001"}, http.StatusBadRequest},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			err := newService(scenario.canManage).AnalyzeRolePermissions(ctxWithUUID, &v0.AnalyzeRolePermissionsRequest{RoleIds: scenario.roleIDs}, &v0.AnalyzeRolePermissionsResponse{})
+			require.Error(t, err)
+			assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+		})
+	}
+}
+
+func TestPreviewRoleAssignment(t *testing.T) {
+	const (
+		admin   = "71881883-1768-46bd-a24d-a356a2afdf7f"
+		user    = "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"
+		manager = "a6d0b3a9-32bb-4e34-bd4c-2c9e6b0f1c5a"
+		account = "9d2f3a44-58c7-4c8e-9a3e-b0d1c7e2f6a0"
+	)
+	language := &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SETTING, Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"}
+	profile := &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_BUNDLE, Id: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}
+	users := &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM}
+	permission := func(id string, resource *settingsmsg.Resource, op settingsmsg.Permission_Operation, c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:       id,
+			Name:     id,
+			Resource: resource,
+			Value: &settingsmsg.Setting_PermissionValue{PermissionValue: &settingsmsg.Permission{
+				Operation:  op,
+				Constraint: c,
+			}},
+		}
+	}
+	roles := map[string]*settingsmsg.Bundle{
+		user: {
+			Id:   user,
+			Type: settingsmsg.Bundle_TYPE_ROLE,
+			Settings: []*settingsmsg.Setting{
+				permission("language-readwrite-own", language, settingsmsg.Permission_OPERATION_READWRITE, settingsmsg.Permission_CONSTRAINT_OWN),
+				permission("profile-read-own", profile, settingsmsg.Permission_OPERATION_READ, settingsmsg.Permission_CONSTRAINT_OWN),
+			},
+		},
+		manager: {
+			Id:   manager,
+			Type: settingsmsg.Bundle_TYPE_ROLE,
+			Settings: []*settingsmsg.Setting{
+				permission("language-readwrite-own", language, settingsmsg.Permission_OPERATION_READWRITE, settingsmsg.Permission_CONSTRAINT_OWN),
+				permission("profile-read-all", profile, settingsmsg.Permission_OPERATION_READ, settingsmsg.Permission_CONSTRAINT_ALL),
+				permission("users-read-all", users, settingsmsg.Permission_OPERATION_READ, settingsmsg.Permission_CONSTRAINT_ALL),
+			},
+		},
+		"a1b2c3d4-0000-4000-8000-000000000001": {
+			Id:   "a1b2c3d4-0000-4000-8000-000000000001",
+			Type: settingsmsg.Bundle_TYPE_DEFAULT,
+		},
+	}
+	newService := func(assigned []*settingsmsg.UserRoleAssignment, canManage bool) (Service, *mocks.Manager) {
+		m := &mocks.Manager{}
+		m.On("ReadBundle", mock.Anything).Return(func(id string) *settingsmsg.Bundle {
+			return roles[id]
+		}, func(id string) error {
+			if roles[id] == nil {
+				return settings.ErrBundleNotFound
+			}
+			return nil
+		})
+		var p *settingsmsg.Permission
+		if canManage {
+			p = &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+		}
+		m.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(p, nil)
+		m.On("ListRoleAssignments", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return([]*settingsmsg.UserRoleAssignment{{RoleId: admin}}, nil)
+		m.On("ListRoleAssignments", account).Return(assigned, nil)
+		return Service{manager: m, logger: log.NopLogger()}, m
+	}
+	grants := func(gs []*v0.PermissionGrant) []string {
+		var s []string
+		for _, g := range gs {
+			s = append(s, g.Resource.Type.String()+"/"+g.Resource.Id+" "+g.Permission.Operation.String()+" "+g.Permission.Constraint.String())
+		}
+		return s
+	}
+
+	t.Run("replacing a role", func(t *testing.T) {
+		svc, m := newService([]*settingsmsg.UserRoleAssignment{{AccountUuid: account, RoleId: user}}, true)
+		res := v0.PreviewRoleAssignmentResponse{}
+		err := svc.PreviewRoleAssignment(ctxWithUUID, &v0.AssignRoleToUserRequest{AccountUuid: account, RoleId: manager}, &res)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{user}, res.CurrentRoleIds)
+		assert.Equal(t, []string{
+			"TYPE_BUNDLE/" + profile.Id + " OPERATION_READ CONSTRAINT_ALL",
+			"TYPE_SYSTEM/ OPERATION_READ CONSTRAINT_ALL",
+		}, grants(res.Added))
+		assert.Equal(t, []string{"TYPE_BUNDLE/" + profile.Id + " OPERATION_READ CONSTRAINT_OWN"}, grants(res.Removed))
+		assert.Equal(t, []string{"TYPE_SETTING/" + language.Id + " OPERATION_READWRITE CONSTRAINT_OWN"}, grants(res.Unchanged))
+		m.AssertNotCalled(t, "WriteRoleAssignment", mock.Anything, mock.Anything)
+
+		// previewing the reverse assignment swaps the added and removed grants
+		svc, _ = newService([]*settingsmsg.UserRoleAssignment{{AccountUuid: account, RoleId: manager}}, true)
+		reverse := v0.PreviewRoleAssignmentResponse{}
+		require.NoError(t, svc.PreviewRoleAssignment(ctxWithUUID, &v0.AssignRoleToUserRequest{AccountUuid: account, RoleId: user}, &reverse))
+		assert.Equal(t, grants(res.Added), grants(reverse.Removed))
+		assert.Equal(t, grants(res.Removed), grants(reverse.Added))
+		assert.Equal(t, grants(res.Unchanged), grants(reverse.Unchanged))
+	})
+
+	t.Run("account without a role", func(t *testing.T) {
+		svc, _ := newService(nil, true)
+		res := v0.PreviewRoleAssignmentResponse{}
+		err := svc.PreviewRoleAssignment(ctxWithUUID, &v0.AssignRoleToUserRequest{AccountUuid: account, RoleId: user}, &res)
+		require.NoError(t, err)
+		assert.Empty(t, res.CurrentRoleIds)
+		assert.Len(t, res.Added, 2)
+		assert.Empty(t, res.Removed)
+		assert.Empty(t, res.Unchanged)
+	})
+
+	t.Run("same role", func(t *testing.T) {
+		svc, _ := newService([]*settingsmsg.UserRoleAssignment{{AccountUuid: account, RoleId: user}}, true)
+		res := v0.PreviewRoleAssignmentResponse{}
+		err := svc.PreviewRoleAssignment(ctxWithUUID, &v0.AssignRoleToUserRequest{AccountUuid: account, RoleId: user}, &res)
+		require.NoError(t, err)
+		assert.Empty(t, res.Added)
+		assert.Empty(t, res.Removed)
+		assert.Len(t, res.Unchanged, 2)
+	})
+
+	scenarios := []struct {
+		name      string
+		roleID    string
+		canManage bool
+		code      int32
+	}{
+		{"unknown role", "5e8b2a64-65b4-4fd0-8a3b-7d0d9c1f4e21", true, http.StatusNotFound},
+		{"no role", "a1b2c3d4-0000-4000-8000-000000000001", true, http.StatusBadRequest},
+		{"invalid id", "manager", true, http.StatusBadRequest},
+		{"without permission", manager, false, http.StatusForbidden},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			svc, _ := newService(nil, scenario.canManage)
+			err := svc.PreviewRoleAssignment(ctxWithUUID, &v0.AssignRoleToUserRequest{AccountUuid: account, RoleId: scenario.roleID}, &v0.PreviewRoleAssignmentResponse{})
+			require.Error(t, err)
+			assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+		})
+	}
+}
+
+func TestGetBundleRoleGrants(t *testing.T) {
+	bundle := &settingsmsg.Bundle{
+		Id: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		Settings: []*settingsmsg.Setting{
+			{Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", Name: "language"},
+			{Id: "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686", Name: "timezone"},
+		},
+	}
+	permission := func(resource *settingsmsg.Resource, op settingsmsg.Permission_Operation) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Resource: resource,
+			Value: &settingsmsg.Setting_PermissionValue{PermissionValue: &settingsmsg.Permission{
+				Operation:  op,
+				Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
+			}},
+		}
+	}
+	roles := []*settingsmsg.Bundle{
+		{
+			Id:          "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11",
+			Name:        "user",
+			DisplayName: "User",
+			Type:        settingsmsg.Bundle_TYPE_ROLE,
+			Settings: []*settingsmsg.Setting{
+				permission(&settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SETTING, Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"}, settingsmsg.Permission_OPERATION_READWRITE),
+				permission(&settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SETTING, Id: "0a32c4b3-9b4e-4d8e-a6a8-0f3c3a6a9f21"}, settingsmsg.Permission_OPERATION_READWRITE),
+			},
+		},
+		{
+			Id:   "71881883-1768-46bd-a24d-a356a2afdf7f",
+			Name: "guest",
+			Type: settingsmsg.Bundle_TYPE_ROLE,
+			Settings: []*settingsmsg.Setting{
+				permission(&settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_BUNDLE, Id: bundle.Id}, settingsmsg.Permission_OPERATION_READ),
+			},
+		},
+	}
+	newService := func(canManage bool) Service {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(bundle, nil)
+		manager.On("ListBundles", settingsmsg.Bundle_TYPE_ROLE, mock.Anything).Return(roles, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"}}, nil)
+		manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+			Operation:  settingsmsg.Permission_OPERATION_READ,
+			Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+		}}, nil)
+		var p *settingsmsg.Permission
+		if canManage {
+			p = &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+		}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(p, nil)
+		return Service{manager: manager, logger: log.NopLogger()}
+	}
+
+	t.Run("annotations", func(t *testing.T) {
+		res := &v0.GetBundleResponse{}
+		require.NoError(t, newService(true).GetBundle(ctxWithUUID, &v0.GetBundleRequest{
+			BundleId:          bundle.Id,
+			IncludeRoleGrants: true,
+			// ignored, the roles aren't covered by the etag
+			IfNoneMatch: "*",
+		}, res))
+		require.NotNil(t, res.Bundle)
+		assert.False(t, res.NotModified)
+		require.Len(t, res.RoleGrants, 2)
+
+		language := res.RoleGrants["c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"].Grants
+		require.Len(t, language, 2)
+		assert.Equal(t, "user", language[0].RoleName)
+		assert.Equal(t, "User", language[0].RoleDisplayName)
+		assert.Equal(t, settingsmsg.Resource_TYPE_SETTING, language[0].Resource.Type)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READWRITE, language[0].Permission.Operation)
+		assert.Equal(t, "guest", language[1].RoleName)
+		assert.Equal(t, settingsmsg.Resource_TYPE_BUNDLE, language[1].Resource.Type)
+
+		// only the permission on the bundle applies to the timezone
+		timezone := res.RoleGrants["aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686"].Grants
+		require.Len(t, timezone, 1)
+		assert.Equal(t, "71881883-1768-46bd-a24d-a356a2afdf7f", timezone[0].RoleId)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READ, timezone[0].Permission.Operation)
+	})
+
+	t.Run("not requested", func(t *testing.T) {
+		res := &v0.GetBundleResponse{}
+		require.NoError(t, newService(false).GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: bundle.Id}, res))
+		assert.Empty(t, res.RoleGrants)
+	})
+
+	t.Run("no role management permission", func(t *testing.T) {
+		err := newService(false).GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: bundle.Id, IncludeRoleGrants: true}, &v0.GetBundleResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+	})
+}
+
+func TestVisibleWhen(t *testing.T) {
+	const (
+		sharing = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		expiry  = "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686"
+		notify  = "0a32c4b3-9b4e-4d8e-a6a8-0f3c3a6a9f21"
+		channel = "3f3bc8e5-0b1b-4d5e-9bb4-6a2e4b729a6c"
+		email   = "9a6f2c16-6e35-4b36-8b5f-2a8d6f1b5b0e"
+	)
+	newBundle := func() *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Name:        "bundle",
+			Extension:   "extension",
+			DisplayName: "Bundle",
+			Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Settings: []*settingsmsg.Setting{
+				{
+					Id: sharing, Name: "sharing", Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value: &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{Default: false}},
+				},
+				{
+					Id: expiry, Name: "expiry", Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value:       &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: 7}},
+					VisibleWhen: &settingsmsg.VisibleWhen{SettingId: sharing, Value: &settingsmsg.VisibleWhen_BoolValue{BoolValue: true}},
+				},
+				{
+					Id: notify, Name: "notify", Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value:       &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{Default: true}},
+					VisibleWhen: &settingsmsg.VisibleWhen{SettingId: expiry, Value: &settingsmsg.VisibleWhen_IntValue{IntValue: 7}},
+				},
+				{
+					Id: channel, Name: "channel", Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value: &settingsmsg.Setting_SingleChoiceValue{SingleChoiceValue: &settingsmsg.SingleChoiceList{Options: []*settingsmsg.ListOption{
+						{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "push"}}, Default: true},
+						{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "email"}}},
+					}}},
+				},
+				{
+					Id: email, Name: "email", Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value:       &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{}},
+					VisibleWhen: &settingsmsg.VisibleWhen{SettingId: channel, Value: &settingsmsg.VisibleWhen_StringValue{StringValue: "email"}},
+				},
+			},
+		}
+	}
+
+	t.Run("evaluating the conditions", func(t *testing.T) {
+		var values []*settingsmsg.Value
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle { return newBundle() }, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "d7beeea8-8ff4-406b-8fb6-ab2dd81e6b11"}}, nil)
+		manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+			Operation:  settingsmsg.Permission_OPERATION_READ,
+			Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
+		}}, nil)
+		manager.On("ListValues", "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return(func(string, string) []*settingsmsg.Value { return values }, nil)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+		get := func() *v0.GetBundleResponse {
+			res := &v0.GetBundleResponse{}
+			require.NoError(t, svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}, res))
+			// hidden settings are marked, not removed
+			assert.Len(t, res.Bundle.Settings, 5)
+			return res
+		}
+
+		// the defaults hide the expiry and the settings depending on it
+		res := get()
+		assert.Equal(t, []string{expiry, notify, email}, res.HiddenSettingIds)
+		hiddenETag := res.Etag
+
+		values = []*settingsmsg.Value{{SettingId: sharing, Value: &settingsmsg.Value_BoolValue{BoolValue: true}}}
+		res = get()
+		assert.Equal(t, []string{email}, res.HiddenSettingIds)
+		assert.NotEqual(t, hiddenETag, res.Etag)
+
+		values = append(values,
+			&settingsmsg.Value{SettingId: expiry, Value: &settingsmsg.Value_IntValue{IntValue: 30}},
+			&settingsmsg.Value{SettingId: channel, Value: &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: []*settingsmsg.ListOptionValue{
+				{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "email"}},
+			}}}},
+		)
+		res = get()
+		assert.Equal(t, []string{notify}, res.HiddenSettingIds)
+
+		// toggling the controlling setting back hides the dependent settings again
+		values[0] = &settingsmsg.Value{SettingId: sharing, Value: &settingsmsg.Value_BoolValue{BoolValue: false}}
+		res = get()
+		assert.Equal(t, []string{expiry, notify}, res.HiddenSettingIds)
+	})
+
+	scenarios := []struct {
+		name   string
+		modify func(b *settingsmsg.Bundle)
+		valid  bool
+	}{
+		{"valid conditions", func(b *settingsmsg.Bundle) {}, true},
+		{"unknown setting", func(b *settingsmsg.Bundle) {
+			b.Settings[1].VisibleWhen.SettingId = "b6b2cc46-2bb0-4af4-a9e2-6e0a4c8b4a3d"
+		}, false},
+		{"missing value", func(b *settingsmsg.Bundle) {
+			b.Settings[1].VisibleWhen.Value = nil
+		}, false},
+		{"self reference", func(b *settingsmsg.Bundle) {
+			b.Settings[1].VisibleWhen.SettingId = expiry
+		}, false},
+		{"cycle", func(b *settingsmsg.Bundle) {
+			b.Settings[0].VisibleWhen = &settingsmsg.VisibleWhen{SettingId: notify, Value: &settingsmsg.VisibleWhen_BoolValue{BoolValue: true}}
+		}, false},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+			manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			svc := Service{manager: manager, logger: log.NopLogger()}
+
+			bundle := newBundle()
+			scenario.modify(bundle)
+			err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: bundle}, &v0.SaveBundleResponse{})
+			if scenario.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+			manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+		})
+	}
+
+	t.Run("adding a setting with an unknown reference", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ReadBundle", mock.Anything).Return(func(string) *settingsmsg.Bundle { return newBundle() }, nil)
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+			Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+			Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+		}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+		manager.On("AddSettingToBundle", mock.Anything, mock.Anything).Return(func(_ string, s *settingsmsg.Setting) *settingsmsg.Setting { return s }, nil)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+		add := func(ref string) error {
+			return svc.AddSettingToBundle(ctxWithUUID, &v0.AddSettingToBundleRequest{
+				BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+				Setting: &settingsmsg.Setting{
+					Name:        "reminder",
+					Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					VisibleWhen: &settingsmsg.VisibleWhen{SettingId: ref, Value: &settingsmsg.VisibleWhen_BoolValue{BoolValue: true}},
+				},
+			}, &v0.AddSettingToBundleResponse{})
+		}
+
+		err := add("b6b2cc46-2bb0-4af4-a9e2-6e0a4c8b4a3d")
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "AddSettingToBundle", mock.Anything, mock.Anything)
+
+		require.NoError(t, add(sharing))
+	})
+}
+
+func TestReadAudit(t *testing.T) {
+	bundles := map[string]*settingsmsg.Bundle{
+		"2f06addf-4fd2-49d5-8f71-00fbd3a3ec47": {
+			Id:         "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			Name:       "secrets",
+			AuditReads: true,
+			Settings:   []*settingsmsg.Setting{{Id: "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", Name: "token"}},
+		},
+		"5e3b6c1a-0d2f-4b8e-9a7c-1f2e3d4c5b6a": {
+			Id:       "5e3b6c1a-0d2f-4b8e-9a7c-1f2e3d4c5b6a",
+			Name:     "profile",
+			Settings: []*settingsmsg.Setting{{Id: "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686", Name: "language"}},
+		},
+	}
+	values := map[string]*settingsmsg.Value{
+		"0fa5e6b1-7f0a-4b8e-9d5c-3e2a1b0c9d8e": {
+			Id:          "0fa5e6b1-7f0a-4b8e-9d5c-3e2a1b0c9d8e",
+			BundleId:    "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+			SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+			AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+		},
+		"9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d": {
+			Id:          "9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+			BundleId:    "5e3b6c1a-0d2f-4b8e-9a7c-1f2e3d4c5b6a",
+			SettingId:   "aa8ac4e3-1bfa-4d36-a81c-0046d7d8d686",
+			AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+		},
+	}
+	var entries []*settingsmsg.ReadAuditEntry
+	manager := &mocks.Manager{}
+	manager.On("ReadBundle", mock.Anything).Return(func(id string) *settingsmsg.Bundle { return bundles[id] }, nil)
+	manager.On("ReadValue", mock.Anything).Return(func(id string) *settingsmsg.Value { return values[id] }, nil)
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+	manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+		Operation:  settingsmsg.Permission_OPERATION_READ,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}}, nil)
+	manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}, nil)
+	manager.On("WriteReadAuditEntry", mock.Anything).Return(func(e *settingsmsg.ReadAuditEntry) *settingsmsg.ReadAuditEntry {
+		entries = append(entries, e)
+		return e
+	}, nil)
+	manager.On("ListReadAuditEntries", mock.Anything).Return(func(string) []*settingsmsg.ReadAuditEntry { return entries }, nil)
+	svc := Service{manager: manager, logger: log.NopLogger()}
+
+	// reads of the bundle without read auditing aren't recorded
+	require.NoError(t, svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: "5e3b6c1a-0d2f-4b8e-9a7c-1f2e3d4c5b6a"}, &v0.GetBundleResponse{}))
+	require.NoError(t, svc.GetValue(ctxWithUUID, &v0.GetValueRequest{Id: "9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"}, &v0.GetValueResponse{}))
+	assert.Empty(t, entries)
+
+	require.NoError(t, svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}, &v0.GetBundleResponse{}))
+	require.NoError(t, svc.GetValue(ctxWithUUID, &v0.GetValueRequest{Id: "0fa5e6b1-7f0a-4b8e-9d5c-3e2a1b0c9d8e"}, &v0.GetValueResponse{}))
+
+	res := &v0.ListAuditResponse{}
+	require.NoError(t, svc.ListAudit(ctxWithUUID, &v0.ListAuditRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}, res))
+	require.Len(t, res.Entries, 2)
+	assert.Equal(t, "GetBundle", res.Entries[0].Operation)
+	assert.Empty(t, res.Entries[0].ValueId)
+	assert.Equal(t, "GetValue", res.Entries[1].Operation)
+	assert.Equal(t, "0fa5e6b1-7f0a-4b8e-9d5c-3e2a1b0c9d8e", res.Entries[1].ValueId)
+	for _, e := range res.Entries {
+		assert.Equal(t, "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", e.BundleId)
+		assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7", e.AccountUuid)
+		assert.NotNil(t, e.ReadAt)
+	}
+
+	t.Run("listing requires the settings management permission", func(t *testing.T) {
+		manager := &mocks.Manager{}
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{}, nil)
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(nil, nil)
+		svc := Service{manager: manager, logger: log.NopLogger()}
+		err := svc.ListAudit(ctxWithUUID, &v0.ListAuditRequest{BundleId: "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"}, &v0.ListAuditResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "ListReadAuditEntries", mock.Anything)
+	})
+}
+
+func TestExportPermissionMatrix(t *testing.T) {
+	newService := func(canManage bool) Service {
+		manager := &mocks.Manager{}
+		manager.On("ListBundles", settingsmsg.Bundle_TYPE_ROLE, mock.Anything).Return(func(_ settingsmsg.Bundle_Type, ids []string) []*settingsmsg.Bundle {
+			var roles []*settingsmsg.Bundle
+			for _, b := range defaults.GenerateBundlesDefaultRoles() {
+				if b.Type != settingsmsg.Bundle_TYPE_ROLE {
+					continue
+				}
+				for _, id := range ids {
+					if id == b.Id {
+						roles = append(roles, b)
+					}
+				}
+				if len(ids) == 0 {
+					roles = append(roles, b)
+				}
+			}
+			return roles
+		}, nil)
+		var p *settingsmsg.Permission
+		if canManage {
+			p = &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+		}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(p, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: defaults.BundleUUIDRoleAdmin}}, nil)
+		return Service{manager: manager, logger: log.NopLogger()}
+	}
+	roleNames := func(entries []*v0.PermissionMatrixEntry) []string {
+		var names []string
+		for _, e := range entries {
+			if len(names) == 0 || names[len(names)-1] != e.RoleName {
+				names = append(names, e.RoleName)
+			}
+		}
+		return names
+	}
+
+	t.Run("all default roles", func(t *testing.T) {
+		res := v0.ExportPermissionMatrixResponse{}
+		err := newService(true).ExportPermissionMatrix(ctxWithUUID, &v0.ExportPermissionMatrixRequest{}, &res)
+		require.NoError(t, err)
+		assert.Len(t, res.Entries, 20)
+		assert.Equal(t, []string{"admin", "guest", "spaceadmin", "user"}, roleNames(res.Entries))
+
+		first := res.Entries[0]
+		assert.Equal(t, defaults.BundleUUIDRoleAdmin, first.RoleId)
+		assert.Equal(t, "role-management", first.SettingName)
+		assert.Equal(t, settingsmsg.Resource_TYPE_USER, first.ResourceType)
+		assert.Equal(t, "all", first.ResourceId)
+		assert.Equal(t, settingsmsg.Permission_OPERATION_READWRITE, first.Operation)
+		assert.Equal(t, settingsmsg.Permission_CONSTRAINT_ALL, first.Constraint)
+	})
+
+	t.Run("filtered by role", func(t *testing.T) {
+		res := v0.ExportPermissionMatrixResponse{}
+		err := newService(true).ExportPermissionMatrix(ctxWithUUID, &v0.ExportPermissionMatrixRequest{
+			RoleIds: []string{defaults.BundleUUIDRoleUser, defaults.BundleUUIDRoleGuest},
+		}, &res)
+		require.NoError(t, err)
+		assert.Len(t, res.Entries, 4)
+		assert.Equal(t, []string{"guest", "user"}, roleNames(res.Entries))
+	})
+
+	t.Run("filtered by resource", func(t *testing.T) {
+		res := v0.ExportPermissionMatrixResponse{}
+		err := newService(true).ExportPermissionMatrix(ctxWithUUID, &v0.ExportPermissionMatrixRequest{
+			Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SETTING, Id: "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f"},
+		}, &res)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"admin", "guest", "spaceadmin", "user"}, roleNames(res.Entries))
+		for _, e := range res.Entries {
+			assert.Equal(t, "language-readwrite", e.SettingName)
+		}
+
+		// without an id all resources of the type match
+		res = v0.ExportPermissionMatrixResponse{}
+		err = newService(true).ExportPermissionMatrix(ctxWithUUID, &v0.ExportPermissionMatrixRequest{
+			RoleIds:  []string{defaults.BundleUUIDRoleAdmin},
+			Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+		}, &res)
+		require.NoError(t, err)
+		assert.Len(t, res.Entries, 3)
+	})
+
+	scenarios := []struct {
+		name      string
+		canManage bool
+		req       *v0.ExportPermissionMatrixRequest
+		code      int32
+	}{
+		{"no role management permission", false, &v0.ExportPermissionMatrixRequest{}, http.StatusForbidden},
+		{"invalid role id", true, &v0.ExportPermissionMatrixRequest{RoleIds: []string{"admin"}}, http.StatusBadRequest},
+		{"resource without type", true, &v0.ExportPermissionMatrixRequest{Resource: &settingsmsg.Resource{Id: "all"}}, http.StatusBadRequest},
+		{"unknown role", true, &v0.ExportPermissionMatrixRequest{RoleIds: []string{"0b0a2f58-2c5e-4bc8-9a64-7c3cb8f2d1e9"}}, http.StatusNotFound},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			err := newService(scenario.canManage).ExportPermissionMatrix(ctxWithUUID, scenario.req, &v0.ExportPermissionMatrixResponse{})
+			require.Error(t, err)
+			assert.Equal(t, scenario.code, merrors.FromError(err).Code)
+		})
+	}
+}
+
+func TestRebuildAssignmentIndex(t *testing.T) {
+	newService := func(canManage bool) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		var p *settingsmsg.Permission
+		if canManage {
+			p = &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+		}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(p, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: defaults.BundleUUIDRoleAdmin}}, nil)
+		manager.On("RebuildAssignmentIndex").Return(&settings.AssignmentIndexStats{Accounts: 3, Assignments: 4, Added: 1, Removed: 2, Invalid: 1}, nil)
+		return Service{manager: manager, logger: log.NopLogger()}, manager
+	}
+
+	t.Run("counts", func(t *testing.T) {
+		svc, _ := newService(true)
+		res := v0.RebuildAssignmentIndexResponse{}
+		require.NoError(t, svc.RebuildAssignmentIndex(ctxWithUUID, &v0.RebuildAssignmentIndexRequest{}, &res))
+		assert.Equal(t, int32(3), res.Accounts)
+		assert.Equal(t, int32(4), res.Assignments)
+		assert.Equal(t, int32(1), res.Added)
+		assert.Equal(t, int32(2), res.Removed)
+		assert.Equal(t, int32(1), res.Invalid)
+	})
+
+	t.Run("without role management permission", func(t *testing.T) {
+		svc, manager := newService(false)
+		err := svc.RebuildAssignmentIndex(ctxWithUUID, &v0.RebuildAssignmentIndexRequest{}, &v0.RebuildAssignmentIndexResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "RebuildAssignmentIndex")
+	})
+}
+
+func TestListChangedBundles(t *testing.T) {
+	now := time.Now()
+	bundles := []*settingsmsg.Bundle{
+		{Id: "b", Type: settingsmsg.Bundle_TYPE_DEFAULT, UpdatedAt: timestamppb.New(now.Add(2 * time.Second))},
+		{Id: "role", Type: settingsmsg.Bundle_TYPE_ROLE, UpdatedAt: timestamppb.New(now.Add(time.Second))},
+		{Id: "c", Type: settingsmsg.Bundle_TYPE_DEFAULT, UpdatedAt: timestamppb.New(now.Add(time.Second))},
+		{Id: "a", Type: settingsmsg.Bundle_TYPE_DEFAULT, UpdatedAt: timestamppb.New(now.Add(time.Second))},
+	}
+	newService := func(permissions ...string) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(func(id string, _ []string) *settingsmsg.Permission {
+			for _, p := range permissions {
+				if p == id {
+					return &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+				}
+			}
+			return nil
+		}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: BundleUUIDRoleAdmin}}, nil)
+		manager.On("ListChangedBundles", mock.Anything).Return(func(since time.Time) []*settingsmsg.Bundle {
+			var changed []*settingsmsg.Bundle
+			for _, b := range bundles {
+				if b.UpdatedAt.AsTime().After(since) {
+					changed = append(changed, b)
+				}
+			}
+			return changed
+		}, nil)
+		manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+		return Service{manager: manager, logger: log.NopLogger()}, manager
+	}
+	ids := func(bundles []*settingsmsg.Bundle) []string {
+		var ids []string
+		for _, b := range bundles {
+			ids = append(ids, b.Id)
+		}
+		return ids
+	}
+
+	t.Run("ordered by updated_at and id", func(t *testing.T) {
+		svc, _ := newService(SettingsManagementPermissionID, RoleManagementPermissionID)
+		res := v0.ListChangedBundlesResponse{}
+		require.NoError(t, svc.ListChangedBundles(ctxWithUUID, &v0.ListChangedBundlesRequest{}, &res))
+		assert.Equal(t, []string{"a", "c", "role", "b"}, ids(res.Bundles))
+
+		res = v0.ListChangedBundlesResponse{}
+		require.NoError(t, svc.ListChangedBundles(ctxWithUUID, &v0.ListChangedBundlesRequest{Since: timestamppb.New(now.Add(time.Second))}, &res))
+		assert.Equal(t, []string{"b"}, ids(res.Bundles))
+	})
+
+	t.Run("roles require the role management permission", func(t *testing.T) {
+		svc, _ := newService(SettingsManagementPermissionID)
+		res := v0.ListChangedBundlesResponse{}
+		require.NoError(t, svc.ListChangedBundles(ctxWithUUID, &v0.ListChangedBundlesRequest{}, &res))
+		assert.Equal(t, []string{"a", "c", "b"}, ids(res.Bundles))
+	})
+
+	t.Run("forbidden without the settings management permission", func(t *testing.T) {
+		svc, manager := newService(RoleManagementPermissionID)
+		err := svc.ListChangedBundles(ctxWithUUID, &v0.ListChangedBundlesRequest{}, &v0.ListChangedBundlesResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+		manager.AssertNotCalled(t, "ListChangedBundles", mock.Anything)
+	})
+
+	t.Run("the account saving a bundle is only recorded with auditing", func(t *testing.T) {
+		svc, _ := newService(SettingsManagementPermissionID)
+		for _, audit := range []bool{false, true} {
+			res := v0.SaveBundleResponse{}
+			require.NoError(t, svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: &settingsmsg.Bundle{
+				Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+				Name:        "bundle",
+				Extension:   "extension",
+				DisplayName: "Bundle",
+				Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+				Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				AuditReads:  audit,
+				UpdatedBy:   "forged",
+				Settings: []*settingsmsg.Setting{{
+					Id:       "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+					Name:     "theme",
+					Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+				}},
+			}}, &res))
+			if audit {
+				assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7", res.Bundle.UpdatedBy)
+			} else {
+				assert.Empty(t, res.Bundle.UpdatedBy)
+			}
+		}
+	})
+}
+
+func TestGetBundleVersion(t *testing.T) {
+	bundleID := "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+	saved := time.Now()
+	newService := func(permission *settingsmsg.Permission) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: BundleUUIDRoleAdmin}}, nil)
+		manager.On("ListPermissionsByResource", mock.Anything, mock.Anything).Return([]*settingsmsg.Permission{{
+			Operation:  settingsmsg.Permission_OPERATION_READ,
+			Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+		}}, nil)
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(permission, nil)
+		manager.On("ReadBundleVersion", bundleID, mock.Anything).Return(func(_ string, at time.Time) *settingsmsg.Bundle {
+			if at.Before(saved) {
+				return nil
+			}
+			return &settingsmsg.Bundle{Id: bundleID, DisplayName: "old", Settings: []*settingsmsg.Setting{{Id: "setting"}}}
+		}, func(_ string, at time.Time) error {
+			if at.Before(saved) {
+				return settings.ErrBundleVersionNotFound
+			}
+			return nil
+		})
+		return Service{manager: manager, logger: log.NopLogger()}, manager
+	}
+
+	t.Run("returns the version", func(t *testing.T) {
+		svc, manager := newService(&settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL})
+		res := v0.GetBundleResponse{}
+		require.NoError(t, svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: bundleID, At: timestamppb.New(saved)}, &res))
+		assert.Equal(t, "old", res.Bundle.DisplayName)
+		manager.AssertNotCalled(t, "ReadBundle", mock.Anything)
+	})
+
+	t.Run("not found before the first version", func(t *testing.T) {
+		svc, _ := newService(&settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL})
+		err := svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: bundleID, At: timestamppb.New(saved.Add(-time.Second))}, &v0.GetBundleResponse{})
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).GetCode())
+	})
+
+	t.Run("forbidden without the settings management permission", func(t *testing.T) {
+		svc, manager := newService(nil)
+		err := svc.GetBundle(ctxWithUUID, &v0.GetBundleRequest{BundleId: bundleID, At: timestamppb.New(saved)}, &v0.GetBundleResponse{})
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).GetCode())
+		manager.AssertNotCalled(t, "ReadBundleVersion", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListPublicBundles(t *testing.T) {
+	newSetting := func(id string, roles ...string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:             id,
+			Name:           id,
+			DisplayName:    id,
+			Resource:       &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Value:          &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+			VisibleToRoles: roles,
+		}
+	}
+	manager := &mocks.Manager{}
+	manager.On("ListBundles", settingsmsg.Bundle_TYPE_DEFAULT, mock.Anything).Return([]*settingsmsg.Bundle{
+		{Id: "public", Name: "public", Extension: "test", Public: true, Settings: []*settingsmsg.Setting{
+			newSetting("self-registration"),
+			newSetting("admins-only", BundleUUIDRoleAdmin),
+			newSetting("unset"),
+		}},
+		{Id: "private", Name: "private", Extension: "test", Settings: []*settingsmsg.Setting{newSetting("private-setting")}},
+		{Id: "audited", Name: "audited", Extension: "test", Public: true, AuditReads: true, Settings: []*settingsmsg.Setting{newSetting("audited-setting")}},
+	}, nil)
+	// only system values are read, a call for any other account fails the test
+	manager.On("ReadValueByUniqueIdentifiers", settings.SystemAccountUUID, mock.Anything).Return(func(_, settingID string) *settingsmsg.Value {
+		return &settingsmsg.Value{SettingId: settingID, AccountUuid: settings.SystemAccountUUID, Value: &settingsmsg.Value_BoolValue{BoolValue: true}}
+	}, func(_, settingID string) error {
+		if settingID == "unset" {
+			return errors.New("value not found")
+		}
+		return nil
+	})
+	svc := Service{manager: manager, logger: log.NopLogger()}
+
+	res := v0.ListPublicBundlesResponse{}
+	require.NoError(t, svc.ListPublicBundles(context.Background(), &v0.ListPublicBundlesRequest{}, &res))
+	require.Len(t, res.Bundles, 1)
+	assert.Equal(t, "public", res.Bundles[0].Id)
+	var settingIDs []string
+	for _, s := range res.Bundles[0].Settings {
+		settingIDs = append(settingIDs, s.Id)
+	}
+	assert.Equal(t, []string{"self-registration", "unset"}, settingIDs)
+	require.Len(t, res.Values, 1)
+	assert.Equal(t, "self-registration", res.Values[0].Value.SettingId)
+	assert.Equal(t, "self-registration", res.Values[0].Identifier.Setting)
+	manager.AssertNotCalled(t, "ReadValueByUniqueIdentifiers", settings.SystemAccountUUID, "private-setting")
+	manager.AssertNotCalled(t, "ReadValueByUniqueIdentifiers", settings.SystemAccountUUID, "audited-setting")
+	manager.AssertNotCalled(t, "ReadValueByUniqueIdentifiers", settings.SystemAccountUUID, "admins-only")
+
+	t.Run("only default bundles without read auditing can be public", func(t *testing.T) {
+		bundle := &settingsmsg.Bundle{
+			Name:        "test",
+			Extension:   "test",
+			DisplayName: "Test",
+			Type:        settingsmsg.Bundle_TYPE_ROLE,
+			Public:      true,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Settings:    []*settingsmsg.Setting{newSetting("c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a")},
+		}
+		assert.Error(t, validateSaveBundle(&v0.SaveBundleRequest{Bundle: bundle}))
+		bundle.Type, bundle.AuditReads = settingsmsg.Bundle_TYPE_DEFAULT, true
+		assert.Error(t, validateSaveBundle(&v0.SaveBundleRequest{Bundle: bundle}))
+		bundle.AuditReads = false
+		assert.NoError(t, validateSaveBundle(&v0.SaveBundleRequest{Bundle: bundle}))
+	})
+}
+
+func TestDiffBundles(t *testing.T) {
+	intSetting := func(id, name string, def int64) *settingsmsg.Setting {
+		return &settingsmsg.Setting{Id: id, Name: name, Value: &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: def}}}
+	}
+	base := &settingsmsg.Bundle{
+		Id:          "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47",
+		Name:        "profile",
+		DisplayName: "Profile",
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		UpdatedAt:   timestamppb.Now(),
+		Settings: []*settingsmsg.Setting{
+			intSetting("c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", "unchanged", 1),
+			intSetting("0bc2e1b0-6c07-4e6a-9c99-8d5af5c3c1a1", "modified", 1),
+			intSetting("43c1f1a1-30b0-4baa-a2e0-3d6bfb9b41c8", "removed", 1),
+			intSetting("6f1d7d1a-b0e1-4ea4-a1a6-48b4d5c5a1e2", "renamed-id", 1),
+		},
+	}
+	target := &settingsmsg.Bundle{
+		Id:          "5e0c9d1f-98a4-4b5b-8f2f-8a5e60d8a7ce",
+		Name:        "profile",
+		DisplayName: "User profile",
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Settings: []*settingsmsg.Setting{
+			intSetting("a2b6b73c-9a64-4e21-8d02-b6c4b8f6e3d4", "added", 2),
+			intSetting("0bc2e1b0-6c07-4e6a-9c99-8d5af5c3c1a1", "modified", 2),
+			intSetting("c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", "unchanged", 1),
+			// the setting was created independently in the other environment
+			intSetting("e8b5e0ef-7a7f-4f4c-8c61-8b7e6b1f1c55", "renamed-id", 1),
+		},
+	}
+	newService := func(permissions ...string) Service {
+		manager := &mocks.Manager{}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(func(id string, _ []string) *settingsmsg.Permission {
+			for _, p := range permissions {
+				if p == id {
+					return &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+				}
+			}
+			return nil
+		}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: BundleUUIDRoleAdmin}}, nil)
+		manager.On("ReadBundle", base.Id).Return(base, nil)
+		manager.On("ReadBundle", target.Id).Return(target, nil)
+		manager.On("ReadBundle", mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		return Service{manager: manager, logger: log.NopLogger()}
+	}
+
+	t.Run("added, removed and modified settings", func(t *testing.T) {
+		svc := newService(SettingsManagementPermissionID)
+		res := &v0.DiffBundlesResponse{}
+		require.NoError(t, svc.DiffBundles(ctxWithUUID, &v0.DiffBundlesRequest{BaseBundleId: base.Id, TargetBundleId: target.Id}, res))
+
+		require.Len(t, res.BundleChanges, 1)
+		assert.Equal(t, "display_name", res.BundleChanges[0].Field)
+		assert.Equal(t, `"Profile"`, res.BundleChanges[0].OldValue)
+		assert.Equal(t, `"User profile"`, res.BundleChanges[0].NewValue)
+
+		require.Len(t, res.SettingChanges, 4)
+		added, modified, removed, renamed := res.SettingChanges[0], res.SettingChanges[1], res.SettingChanges[2], res.SettingChanges[3]
+		assert.Equal(t, v0.SettingChange_TYPE_ADDED, added.Type)
+		assert.Equal(t, "added", added.Name)
+		assert.Nil(t, added.Base)
+		assert.True(t, proto.Equal(target.Settings[0], added.Target))
+
+		assert.Equal(t, v0.SettingChange_TYPE_MODIFIED, modified.Type)
+		assert.Equal(t, "modified", modified.Name)
+		require.Len(t, modified.Fields, 1)
+		assert.Equal(t, &v0.FieldChange{Field: "int_value", OldValue: `{"default":"1"}`, NewValue: `{"default":"2"}`}, modified.Fields[0])
+
+		assert.Equal(t, v0.SettingChange_TYPE_REMOVED, removed.Type)
+		assert.Equal(t, "43c1f1a1-30b0-4baa-a2e0-3d6bfb9b41c8", removed.SettingId)
+		assert.Nil(t, removed.Target)
+
+		// settings without a match by id are matched by name
+		assert.Equal(t, v0.SettingChange_TYPE_MODIFIED, renamed.Type)
+		assert.Equal(t, "e8b5e0ef-7a7f-4f4c-8c61-8b7e6b1f1c55", renamed.SettingId)
+		require.Len(t, renamed.Fields, 1)
+		assert.Equal(t, "id", renamed.Fields[0].Field)
+	})
+
+	t.Run("supplied target bundle", func(t *testing.T) {
+		svc := newService(SettingsManagementPermissionID)
+		supplied := proto.Clone(base).(*settingsmsg.Bundle)
+		supplied.Settings = supplied.Settings[:1]
+		res := &v0.DiffBundlesResponse{}
+		require.NoError(t, svc.DiffBundles(ctxWithUUID, &v0.DiffBundlesRequest{BaseBundleId: base.Id, TargetBundle: supplied}, res))
+		assert.Empty(t, res.BundleChanges)
+		require.Len(t, res.SettingChanges, 3)
+		for _, c := range res.SettingChanges {
+			assert.Equal(t, v0.SettingChange_TYPE_REMOVED, c.Type)
+		}
+		// the diff is deterministic
+		again := &v0.DiffBundlesResponse{}
+		require.NoError(t, svc.DiffBundles(ctxWithUUID, &v0.DiffBundlesRequest{BaseBundleId: base.Id, TargetBundle: supplied}, again))
+		assert.True(t, proto.Equal(res, again))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc := newService(SettingsManagementPermissionID)
+		for _, req := range []*v0.DiffBundlesRequest{
+			{BaseBundleId: base.Id},
+			{BaseBundleId: base.Id, TargetBundleId: target.Id, TargetBundle: target},
+			{BaseBundleId: "base", TargetBundleId: target.Id},
+		} {
+			err := svc.DiffBundles(ctxWithUUID, req, &v0.DiffBundlesResponse{})
+			require.Error(t, err)
+			assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+		}
+		err := svc.DiffBundles(ctxWithUUID, &v0.DiffBundlesRequest{BaseBundleId: base.Id, TargetBundleId: "d7a0c0f4-3c51-4bd0-9e1e-1c3c7f5b9b90"}, &v0.DiffBundlesResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+
+	t.Run("requires the settings management permission", func(t *testing.T) {
+		err := newService().DiffBundles(ctxWithUUID, &v0.DiffBundlesRequest{BaseBundleId: base.Id, TargetBundleId: target.Id}, &v0.DiffBundlesResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+	})
+}
+
+func TestDefaultExpressions(t *testing.T) {
+	const (
+		bundleID      = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+		systemQuotaID = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		userQuotaID   = "9a3e1f0e-7c4b-4f6e-8a55-2f7d6c1b9e20"
+		envQuotaID    = "5d2f7b1a-3e8c-4a9d-b6f0-1c4e8a2d7b35"
+		greetingID    = "e8b4c2d6-1f3a-4b7e-9c05-6a2d8f1e3b47"
+	)
+	t.Setenv("OCIS_TEST_DEFAULT_QUOTA", "2000")
+	t.Setenv("OCIS_TEST_SECRET", "secret")
+
+	quota := func(id, name, expression string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:                id,
+			Name:              name,
+			Resource:          &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:             &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: 100, Max: 5000}},
+			DefaultExpression: expression,
+		}
+	}
+	bundle := &settingsmsg.Bundle{
+		Id:          bundleID,
+		Name:        "quotas",
+		Extension:   "storage",
+		DisplayName: "Quotas",
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+		Settings: []*settingsmsg.Setting{
+			quota(systemQuotaID, "system-quota", ""),
+			quota(userQuotaID, "user-quota", "${setting:"+systemQuotaID+"}"),
+			quota(envQuotaID, "env-quota", "${env:OCIS_TEST_DEFAULT_QUOTA}"),
+			{
+				Id:                greetingID,
+				Name:              "greeting",
+				Resource:          &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:             &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{MaxLength: 50}},
+				DefaultExpression: "$$${setting:" + userQuotaID + "} of ${setting:" + envQuotaID + "}",
+			},
+		},
+	}
+	newService := func(systemQuota *settingsmsg.Value) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		if systemQuota != nil {
+			manager.On("ReadValueByUniqueIdentifiers", settings.SystemAccountUUID, systemQuotaID).Return(systemQuota, nil)
+		}
+		manager.On("ReadValueByUniqueIdentifiers", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+		manager.On("ReadBundleBySetting", mock.Anything).Return(bundle, nil)
+		for _, s := range bundle.Settings {
+			manager.On("ReadSetting", s.Id).Return(s, nil)
+		}
+		manager.On("ReadSetting", mock.Anything).Return(nil, settings.ErrSettingNotFound)
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{
+			Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+			Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+		}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: "71881883-1768-46bd-a24d-a356a2afdf7f"}}, nil)
+		manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+		return Service{
+			manager: manager,
+			logger:  log.NopLogger(),
+			config:  &config.Config{DefaultExpressionEnv: []string{"OCIS_TEST_DEFAULT_QUOTA"}},
+		}, manager
+	}
+	get := func(svc Service, settingID string) (*settingsmsg.Value, error) {
+		res := v0.GetValueResponse{}
+		err := svc.GetValueByUniqueIdentifiers(ctxWithUUID, &v0.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: "me",
+			SettingId:   settingID,
+		}, &res)
+		return res.GetValue().GetValue(), err
+	}
+
+	t.Run("environment variable", func(t *testing.T) {
+		svc, _ := newService(nil)
+		v, err := get(svc, envQuotaID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2000), v.GetIntValue())
+		assert.Equal(t, envQuotaID, v.SettingId)
+		assert.Equal(t, bundleID, v.BundleId)
+		assert.Equal(t, "61445573-4dbe-4d56-88dc-88ab47aceba7", v.AccountUuid)
+	})
+
+	t.Run("other setting", func(t *testing.T) {
+		svc, _ := newService(&settingsmsg.Value{Value: &settingsmsg.Value_IntValue{IntValue: 3000}})
+		v, err := get(svc, userQuotaID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3000), v.GetIntValue())
+
+		// without a system value the default of the referenced setting is used
+		svc, _ = newService(nil)
+		v, err = get(svc, userQuotaID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), v.GetIntValue())
+
+		v, err = get(svc, greetingID)
+		require.NoError(t, err)
+		assert.Equal(t, "$100 of 2000", v.GetStringValue())
+	})
+
+	t.Run("settings without an expression are not found", func(t *testing.T) {
+		svc, _ := newService(nil)
+		_, err := get(svc, systemQuotaID)
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+
+	t.Run("invalid results are not used", func(t *testing.T) {
+		svc, _ := newService(&settingsmsg.Value{Value: &settingsmsg.Value_IntValue{IntValue: 3000}})
+		svc.config.DefaultExpressionEnv = nil
+		_, err := get(svc, envQuotaID)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+
+	t.Run("stored cycles end", func(t *testing.T) {
+		svc, manager := newService(nil)
+		first := quota("0c8e6b1d-2a4f-4e7c-9b3d-5f1a8e6c2d40", "first", "${setting:1f9d7c2e-3b5a-4f8d-8c4e-6a2b9f7d3e51}")
+		second := quota("1f9d7c2e-3b5a-4f8d-8c4e-6a2b9f7d3e51", "second", "${setting:0c8e6b1d-2a4f-4e7c-9b3d-5f1a8e6c2d40}")
+		manager.ExpectedCalls = nil
+		manager.On("ReadValueByUniqueIdentifiers", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+		manager.On("ReadBundleBySetting", mock.Anything).Return(&settingsmsg.Bundle{Id: bundleID, Settings: []*settingsmsg.Setting{first, second}}, nil)
+		_, err := get(svc, first.Id)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		for _, tc := range []struct {
+			name       string
+			expression string
+			valid      bool
+		}{
+			{name: "literal", expression: "1000", valid: true},
+			{name: "allowed variable", expression: "${env:OCIS_TEST_DEFAULT_QUOTA}", valid: true},
+			{name: "other setting", expression: "${setting:" + systemQuotaID + "}", valid: true},
+			{name: "other variable", expression: "${env:OCIS_TEST_SECRET}"},
+			{name: "unknown setting", expression: "${setting:0e5d6f1a-8b2c-4d3e-9f7a-1b6c5d4e3f20}"},
+			{name: "self reference", expression: "${setting:" + userQuotaID + "}0"},
+			{name: "unterminated", expression: "${env:OCIS_TEST_DEFAULT_QUOTA"},
+			{name: "unknown reference", expression: "${exec:id}"},
+			{name: "unescaped dollar", expression: "$5"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				svc, manager := newService(nil)
+				b := proto.Clone(bundle).(*settingsmsg.Bundle)
+				b.Settings[1].DefaultExpression = tc.expression
+				err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: b}, &v0.SaveBundleResponse{})
+				if tc.valid {
+					assert.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+				manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+			})
+		}
+
+		svc, _ := newService(nil)
+		b := proto.Clone(bundle).(*settingsmsg.Bundle)
+		// the system quota would reference the user quota referencing the system quota
+		b.Settings[0].DefaultExpression = "${setting:" + userQuotaID + "}"
+		err := svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: b}, &v0.SaveBundleResponse{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle")
+	})
+}
+
+// assignmentStore keeps role assignments in memory for the Manager mock.
+type assignmentStore struct {
+	mu          sync.Mutex
+	assignments map[string][]*settingsmsg.UserRoleAssignment
+}
+
+func (s *assignmentStore) mock(manager *mocks.Manager) {
+	manager.On("ListRoleAssignments", mock.Anything).Return(func(accountUUID string) []*settingsmsg.UserRoleAssignment {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return append([]*settingsmsg.UserRoleAssignment{}, s.assignments[accountUUID]...)
+	}, nil)
+	manager.On("WriteRoleAssignment", mock.Anything, mock.Anything).Return(func(accountUUID, roleID string) *settingsmsg.UserRoleAssignment {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		a := &settingsmsg.UserRoleAssignment{Id: uuid.Must(uuid.NewV4()).String(), AccountUuid: accountUUID, RoleId: roleID}
+		s.assignments[accountUUID] = []*settingsmsg.UserRoleAssignment{a}
+		return a
+	}, nil)
+	manager.On("RemoveRoleAssignment", mock.Anything).Return(func(id string) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for account, assignments := range s.assignments {
+			for i, a := range assignments {
+				if a.Id == id {
+					s.assignments[account] = append(assignments[:i], assignments[i+1:]...)
+					return nil
+				}
+			}
+		}
+		return errors.New("assignment not found")
+	})
+}
+
+func TestBulkRoleAssignment(t *testing.T) {
+	const ownAccountUUID = "61445573-4dbe-4d56-88dc-88ab47aceba7"
+	newService := func() (Service, *assignmentStore) {
+		store := &assignmentStore{assignments: map[string][]*settingsmsg.UserRoleAssignment{
+			ownAccountUUID: {{Id: uuid.Must(uuid.NewV4()).String(), AccountUuid: ownAccountUUID, RoleId: defaults.BundleUUIDRoleAdmin}},
+		}}
+		manager := &mocks.Manager{}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(&settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}, nil)
+		manager.On("ReadBundle", mock.Anything).Return(func(id string) *settingsmsg.Bundle {
+			for _, b := range defaults.GenerateBundlesDefaultRoles() {
+				if b.Id == id {
+					return b
+				}
+			}
+			return nil
+		}, func(id string) error {
+			for _, b := range defaults.GenerateBundlesDefaultRoles() {
+				if b.Id == id {
+					return nil
+				}
+			}
+			return settings.ErrBundleNotFound
+		})
+		store.mock(manager)
+		return Service{manager: manager, logger: log.NopLogger()}, store
+	}
+	accounts := make([]string, 100)
+	for i := range accounts {
+		accounts[i] = uuid.Must(uuid.NewV4()).String()
+	}
+	listRoles := func(t *testing.T, svc Service, accountUUID string) []string {
+		res := v0.ListRoleAssignmentsResponse{}
+		require.NoError(t, svc.ListRoleAssignments(ctxWithUUID, &v0.ListRoleAssignmentsRequest{AccountUuid: accountUUID}, &res))
+		roles := []string{}
+		for _, a := range res.Assignments {
+			roles = append(roles, a.RoleId)
+		}
+		return roles
+	}
+
+	t.Run("assign and remove", func(t *testing.T) {
+		svc, _ := newService()
+		res := v0.BulkRoleAssignmentResponse{}
+		require.NoError(t, svc.BulkAssignRole(ctxWithUUID, &v0.BulkRoleAssignmentRequest{RoleId: defaults.BundleUUIDRoleUser, AccountUuids: accounts}, &res))
+		require.Len(t, res.Results, len(accounts))
+		for i, r := range res.Results {
+			assert.Equal(t, accounts[i], r.AccountUuid)
+			assert.Empty(t, r.Error)
+			assert.Equal(t, defaults.BundleUUIDRoleUser, r.Assignment.GetRoleId())
+		}
+		for _, account := range accounts {
+			assert.Equal(t, []string{defaults.BundleUUIDRoleUser}, listRoles(t, svc, account))
+		}
+
+		// removing another role leaves the assignments alone
+		res = v0.BulkRoleAssignmentResponse{}
+		require.NoError(t, svc.BulkRemoveRole(ctxWithUUID, &v0.BulkRoleAssignmentRequest{RoleId: defaults.BundleUUIDRoleGuest, AccountUuids: accounts[:10]}, &res))
+		for _, r := range res.Results {
+			assert.Empty(t, r.Error)
+			assert.Nil(t, r.Assignment)
+		}
+
+		res = v0.BulkRoleAssignmentResponse{}
+		require.NoError(t, svc.BulkRemoveRole(ctxWithUUID, &v0.BulkRoleAssignmentRequest{RoleId: defaults.BundleUUIDRoleUser, AccountUuids: accounts[:50]}, &res))
+		require.Len(t, res.Results, 50)
+		for _, r := range res.Results {
+			assert.Empty(t, r.Error)
+			assert.Equal(t, defaults.BundleUUIDRoleUser, r.Assignment.GetRoleId())
+		}
+		for i, account := range accounts {
+			if i < 50 {
+				assert.Empty(t, listRoles(t, svc, account))
+			} else {
+				assert.Equal(t, []string{defaults.BundleUUIDRoleUser}, listRoles(t, svc, account))
+			}
+		}
+	})
+
+	t.Run("own account", func(t *testing.T) {
+		svc, _ := newService()
+		res := v0.BulkRoleAssignmentResponse{}
+		require.NoError(t, svc.BulkAssignRole(ctxWithUUID, &v0.BulkRoleAssignmentRequest{RoleId: defaults.BundleUUIDRoleUser, AccountUuids: []string{accounts[0], ownAccountUUID}}, &res))
+		require.Len(t, res.Results, 2)
+		assert.Empty(t, res.Results[0].Error)
+		assert.NotEmpty(t, res.Results[1].Error)
+		assert.Equal(t, []string{defaults.BundleUUIDRoleAdmin}, listRoles(t, svc, ownAccountUUID))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc, store := newService()
+		for name, req := range map[string]*v0.BulkRoleAssignmentRequest{
+			"unknown role":       {RoleId: "f3ec3f1c-4a36-4b39-8c8b-2d8fbd5bd6f1", AccountUuids: accounts},
+			"no accounts":        {RoleId: defaults.BundleUUIDRoleUser},
+			"duplicate accounts": {RoleId: defaults.BundleUUIDRoleUser, AccountUuids: []string{accounts[0], accounts[1], accounts[0]}},
+			"invalid account":    {RoleId: defaults.BundleUUIDRoleUser, AccountUuids: []string{accounts[0], "not an account"}},
+		} {
+			err := svc.BulkAssignRole(ctxWithUUID, req, &v0.BulkRoleAssignmentResponse{})
+			require.Error(t, err, name)
+			if name == "unknown role" {
+				assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code, name)
+			} else {
+				assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code, name)
+			}
+		}
+		// nothing was assigned
+		assert.Len(t, store.assignments, 1)
+	})
+}
+
+func TestPlanImport(t *testing.T) {
+	bundle := func(id, name, displayName string, bundleType settingsmsg.Bundle_Type, dependsOn ...string) *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Id:          id,
+			Name:        name,
+			Extension:   "ocis-test",
+			DisplayName: displayName,
+			Type:        bundleType,
+			DependsOn:   dependsOn,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Settings: []*settingsmsg.Setting{
+				{
+					Id:       "7a9f5c8e-58a5-4a4c-9c1a-1a4d5a7c6c2e",
+					Name:     "limit",
+					Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+					Value:    &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: 1}},
+				},
+			},
+		}
+	}
+	saved := bundle("2f06addf-4fd2-49d5-8f71-00fbd3a3ec47", "profile", "Profile", settingsmsg.Bundle_TYPE_DEFAULT)
+	saved.UpdatedAt = timestamppb.Now()
+	unchanged := bundle("9d3c1e47-3b0a-4f5e-a0c4-6e9f2d1b8a73", "privacy", "Privacy", settingsmsg.Bundle_TYPE_DEFAULT)
+	newService := func(permissions ...string) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(func(id string, _ []string) *settingsmsg.Permission {
+			for _, p := range permissions {
+				if p == id {
+					return &settingsmsg.Permission{Operation: settingsmsg.Permission_OPERATION_READWRITE, Constraint: settingsmsg.Permission_CONSTRAINT_ALL}
+				}
+			}
+			return nil
+		}, nil)
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: BundleUUIDRoleAdmin}}, nil)
+		manager.On("ReadBundle", saved.Id).Return(saved, nil)
+		manager.On("ReadBundle", unchanged.Id).Return(unchanged, nil)
+		manager.On("ReadBundle", mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		manager.On("ReadBundleByName", "ocis-test", "profile").Return(saved, nil)
+		manager.On("ReadBundleByName", "ocis-test", "privacy").Return(unchanged, nil)
+		manager.On("ReadBundleByName", mock.Anything, mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		return Service{manager: manager, logger: log.NopLogger()}, manager
+	}
+
+	t.Run("create, update, skip and reject", func(t *testing.T) {
+		svc, manager := newService(SettingsManagementPermissionID)
+		updated := bundle(saved.Id, "profile", "User profile", settingsmsg.Bundle_TYPE_DEFAULT)
+		updated.Settings[0].Value = &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: 2}}
+		created := bundle("5e0c9d1f-98a4-4b5b-8f2f-8a5e60d8a7ce", "notifications", "Notifications", settingsmsg.Bundle_TYPE_DEFAULT)
+		req := &v0.PlanImportRequest{Bundles: []*settingsmsg.Bundle{
+			created,
+			updated,
+			bundle(unchanged.Id, "privacy", "Privacy", settingsmsg.Bundle_TYPE_DEFAULT),
+			// depends on a bundle created before it
+			bundle("a2b6b73c-9a64-4e21-8d02-b6c4b8f6e3d4", "digest", "Digest", settingsmsg.Bundle_TYPE_DEFAULT, created.Id),
+			bundle("e8b5e0ef-7a7f-4f4c-8c61-8b7e6b1f1c55", "missing-display-name", "", settingsmsg.Bundle_TYPE_DEFAULT),
+			bundle("0bc2e1b0-6c07-4e6a-9c99-8d5af5c3c1a1", "profile", "Profile", settingsmsg.Bundle_TYPE_DEFAULT),
+			bundle("43c1f1a1-30b0-4baa-a2e0-3d6bfb9b41c8", "dangling", "Dangling", settingsmsg.Bundle_TYPE_DEFAULT, "6f1d7d1a-b0e1-4ea4-a1a6-48b4d5c5a1e2"),
+			bundle("c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a", "role", "Role", settingsmsg.Bundle_TYPE_ROLE),
+			created,
+		}}
+		res := &v0.PlanImportResponse{}
+		require.NoError(t, svc.PlanImport(ctxWithUUID, req, res))
+
+		actions := make([]v0.ImportPlanEntry_Action, 0, len(res.Entries))
+		for _, e := range res.Entries {
+			actions = append(actions, e.Action)
+		}
+		assert.Equal(t, []v0.ImportPlanEntry_Action{
+			v0.ImportPlanEntry_ACTION_CREATE,
+			v0.ImportPlanEntry_ACTION_UPDATE,
+			v0.ImportPlanEntry_ACTION_SKIP,
+			v0.ImportPlanEntry_ACTION_CREATE,
+			v0.ImportPlanEntry_ACTION_REJECT,
+			v0.ImportPlanEntry_ACTION_REJECT,
+			v0.ImportPlanEntry_ACTION_REJECT,
+			v0.ImportPlanEntry_ACTION_REJECT,
+			v0.ImportPlanEntry_ACTION_REJECT,
+		}, actions)
+
+		update := res.Entries[1]
+		require.Len(t, update.BundleChanges, 1)
+		assert.Equal(t, &v0.FieldChange{Field: "display_name", OldValue: `"Profile"`, NewValue: `"User profile"`}, update.BundleChanges[0])
+		require.Len(t, update.SettingChanges, 1)
+		assert.Equal(t, v0.SettingChange_TYPE_MODIFIED, update.SettingChanges[0].Type)
+		assert.Empty(t, res.Entries[0].BundleChanges)
+		assert.Empty(t, res.Entries[2].BundleChanges)
+		assert.Empty(t, res.Entries[2].SettingChanges)
+
+		assert.Contains(t, res.Entries[4].Error, "display_name")
+		assert.Contains(t, res.Entries[5].Error, "already exists")
+		assert.Contains(t, res.Entries[6].Error, "depends on missing bundle")
+		assert.Equal(t, "user has no role management permission", res.Entries[7].Error)
+		assert.Contains(t, res.Entries[8].Error, "more than once")
+		for _, e := range res.Entries[:4] {
+			assert.Empty(t, e.Error)
+		}
+		manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+	})
+
+	t.Run("role bundles with the role management permission", func(t *testing.T) {
+		svc, _ := newService(SettingsManagementPermissionID, RoleManagementPermissionID)
+		res := &v0.PlanImportResponse{}
+		require.NoError(t, svc.PlanImport(ctxWithUUID, &v0.PlanImportRequest{Bundles: []*settingsmsg.Bundle{
+			bundle("", "role", "Role", settingsmsg.Bundle_TYPE_ROLE),
+		}}, res))
+		require.Len(t, res.Entries, 1)
+		assert.Equal(t, v0.ImportPlanEntry_ACTION_CREATE, res.Entries[0].Action)
+	})
+
+	t.Run("without bundles", func(t *testing.T) {
+		svc, _ := newService(SettingsManagementPermissionID)
+		err := svc.PlanImport(ctxWithUUID, &v0.PlanImportRequest{}, &v0.PlanImportResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	})
+
+	t.Run("without the settings management permission", func(t *testing.T) {
+		svc, _ := newService()
+		err := svc.PlanImport(ctxWithUUID, &v0.PlanImportRequest{Bundles: []*settingsmsg.Bundle{saved}}, &v0.PlanImportResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+	})
+}
+
+func TestBatchGetEffectiveValues(t *testing.T) {
+	const (
+		bundleID  = "5d7a4a61-4e1e-4b4b-9a3f-2d8c1f0e6b52"
+		limitID   = "0b5a3c9e-1f7d-4c2a-8e6b-9d4f2a7c1e38"
+		enabledID = "c3e8f1a2-6b4d-4f9e-a7c5-1d2b3e4f5a69"
+		alice     = "alice"
+		bob       = "bob"
+		carol     = "carol"
+	)
+	bundle := &settingsmsg.Bundle{
+		Id:        bundleID,
+		Name:      "limits",
+		Extension: "ocis-test",
+		Type:      settingsmsg.Bundle_TYPE_DEFAULT,
+		Resource:  &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+		Settings: []*settingsmsg.Setting{
+			{
+				Id:       limitID,
+				Name:     "limit",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:    &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Default: 5}},
+			},
+			{
+				Id:       enabledID,
+				Name:     "enabled",
+				Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+				Value:    &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{Default: false}},
+			},
+		},
+	}
+	intValue := func(account string, v int64) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			Id:          uuid.Must(uuid.NewV4()).String(),
+			BundleId:    bundleID,
+			SettingId:   limitID,
+			AccountUuid: account,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_IntValue{IntValue: v},
+		}
+	}
+	settings.ParentProviders["test"] = func(_ context.Context, account string) ([]string, error) {
+		if account == bob || account == carol {
+			return []string{"staff"}, nil
+		}
+		return nil, nil
+	}
+	t.Cleanup(func() { delete(settings.ParentProviders, "test") })
+
+	newService := func(permission bool, values ...*settingsmsg.Value) (Service, *mocks.Manager) {
+		manager := &mocks.Manager{}
+		manager.On("ListRoleAssignments", mock.Anything).Return([]*settingsmsg.UserRoleAssignment{{RoleId: BundleUUIDRoleAdmin}}, nil)
+		if permission {
+			manager.On("ReadPermissionByID", SettingsManagementPermissionID, mock.Anything).Return(&settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+			}, nil)
+		} else {
+			manager.On("ReadPermissionByID", SettingsManagementPermissionID, mock.Anything).Return(nil, nil)
+		}
+		manager.On("ReadBundle", bundleID).Return(bundle, nil)
+		manager.On("ReadBundleBySetting", limitID).Return(bundle, nil)
+		manager.On("ReadBundleBySetting", mock.Anything).Return(nil, settings.ErrBundleNotFound)
+		for _, v := range values {
+			manager.On("ReadValueByUniqueIdentifiers", v.AccountUuid, v.SettingId).Return(v, nil)
+		}
+		manager.On("ReadValueByUniqueIdentifiers", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+		return Service{
+			manager: manager,
+			logger:  log.NopLogger(),
+			config:  &config.Config{ParentProvider: "test"},
+		}, manager
+	}
+	type result struct {
+		account string
+		setting string
+		value   string
+		origin  v0.EffectiveValue_Origin
+	}
+	results := func(res *v0.BatchGetEffectiveValuesResponse) []result {
+		r := make([]result, 0, len(res.Values))
+		for _, v := range res.Values {
+			r = append(r, result{v.AccountUuid, v.SettingId, formatValue(v.GetValue().GetValue()), v.Origin})
+		}
+		return r
+	}
+
+	t.Run("stored, inherited and default values", func(t *testing.T) {
+		svc, manager := newService(true, intValue(alice, 10), intValue(settings.GroupAccountUUID("staff"), 20))
+		res := &v0.BatchGetEffectiveValuesResponse{}
+		err := svc.BatchGetEffectiveValues(ctxWithUUID, &v0.BatchGetEffectiveValuesRequest{
+			SettingId:    limitID,
+			AccountUuids: []string{alice, bob, carol, "dave"},
+		}, res)
+		require.NoError(t, err)
+		assert.Equal(t, []result{
+			{alice, limitID, "10", v0.EffectiveValue_ORIGIN_STORED},
+			{bob, limitID, "20", v0.EffectiveValue_ORIGIN_GROUP},
+			{carol, limitID, "20", v0.EffectiveValue_ORIGIN_GROUP},
+			{"dave", limitID, "5", v0.EffectiveValue_ORIGIN_DEFAULT},
+		}, results(res))
+		assert.Equal(t, "dave", res.Values[3].Value.Value.AccountUuid)
+		assert.Equal(t, "limit", res.Values[3].Value.Identifier.Setting)
+
+		// the bundle and the value of the group are read once for the whole batch
+		manager.AssertNumberOfCalls(t, "ReadBundleBySetting", 1)
+		manager.AssertNotCalled(t, "ReadSetting", mock.Anything)
+		groupReads := 0
+		for _, c := range manager.Calls {
+			if c.Method == "ReadValueByUniqueIdentifiers" && c.Arguments.String(0) == settings.GroupAccountUUID("staff") {
+				groupReads++
+			}
+		}
+		assert.Equal(t, 1, groupReads)
+	})
+
+	t.Run("system values and the settings of a bundle", func(t *testing.T) {
+		system := intValue(settings.SystemAccountUUID, 30)
+		svc, _ := newService(true, intValue(alice, 10), system)
+		svc.defaultOverrides = map[string]defaultOverride{
+			enabledID: {bundle: bundle, setting: bundle.Settings[1], value: &settingsmsg.Value{Value: &settingsmsg.Value_BoolValue{BoolValue: true}}},
+		}
+		res := &v0.BatchGetEffectiveValuesResponse{}
+		err := svc.BatchGetEffectiveValues(ctxWithUUID, &v0.BatchGetEffectiveValuesRequest{
+			BundleId:     bundleID,
+			AccountUuids: []string{alice, "dave"},
+		}, res)
+		require.NoError(t, err)
+		assert.Equal(t, []result{
+			{alice, limitID, "10", v0.EffectiveValue_ORIGIN_STORED},
+			{alice, enabledID, "true", v0.EffectiveValue_ORIGIN_DEFAULT},
+			{"dave", limitID, "30", v0.EffectiveValue_ORIGIN_SYSTEM},
+			{"dave", enabledID, "true", v0.EffectiveValue_ORIGIN_DEFAULT},
+		}, results(res))
+		assert.Equal(t, settings.SystemAccountUUID, system.AccountUuid)
+	})
+
+	t.Run("requires the settings management permission", func(t *testing.T) {
+		svc, _ := newService(false)
+		err := svc.BatchGetEffectiveValues(ctxWithUUID, &v0.BatchGetEffectiveValuesRequest{
+			SettingId:    limitID,
+			AccountUuids: []string{alice},
+		}, &v0.BatchGetEffectiveValuesResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusForbidden), merrors.FromError(err).Code)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		svc, _ := newService(true)
+		for _, req := range []*v0.BatchGetEffectiveValuesRequest{
+			{AccountUuids: []string{alice}},
+			{SettingId: limitID, BundleId: bundleID, AccountUuids: []string{alice}},
+			{SettingId: limitID},
+			{SettingId: "limit", AccountUuids: []string{alice}},
+		} {
+			err := svc.BatchGetEffectiveValues(ctxWithUUID, req, &v0.BatchGetEffectiveValuesResponse{})
+			require.Error(t, err)
+			assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+		}
+
+		err := svc.BatchGetEffectiveValues(ctxWithUUID, &v0.BatchGetEffectiveValuesRequest{
+			SettingId:    "e2b9c4d1-7a3f-4e6b-8c5d-0f1a2b3c4d5e",
+			AccountUuids: []string{alice},
+		}, &v0.BatchGetEffectiveValuesResponse{})
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+}
+
+func TestReadOnly(t *testing.T) {
+	const bundleID = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+	// the manager has no expectations for writes, calling them fails the test
+	manager := &mocks.Manager{}
+	manager.On("ReadValue", "c7d1a18e-7e3b-4c52-9c8e-6e65fd4f5a83").Return(&settingsmsg.Value{
+		Id:          "c7d1a18e-7e3b-4c52-9c8e-6e65fd4f5a83",
+		AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7",
+		BundleId:    bundleID,
+		SettingId:   "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a",
+		Value:       &settingsmsg.Value_StringValue{StringValue: "de"},
+	}, nil)
+	// reads of audited bundles are not recorded in the store of a replica
+	manager.On("ReadBundle", bundleID).Return(&settingsmsg.Bundle{Id: bundleID, Name: "bundle", Extension: "extension", AuditReads: true}, nil)
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Name: "setting"}, nil)
+	manager.On("ListRoleAssignments", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return([]*settingsmsg.UserRoleAssignment{
+		{AccountUuid: "61445573-4dbe-4d56-88dc-88ab47aceba7", RoleId: BundleUUIDRoleAdmin},
+	}, nil)
+	svc := Service{
+		id:      "ocis-settings",
+		manager: manager,
+		logger:  log.NopLogger(),
+		config:  &config.Config{ReadOnly: true},
+	}
+
+	t.Run("writes are rejected", func(t *testing.T) {
+		bundle := &settingsmsg.Bundle{Id: bundleID, Type: settingsmsg.Bundle_TYPE_DEFAULT}
+		for name, write := range map[string]func() error{
+			"SaveBundle": func() error {
+				return svc.SaveBundle(ctxWithUUID, &v0.SaveBundleRequest{Bundle: bundle}, &v0.SaveBundleResponse{})
+			},
+			"UpdateBundle": func() error {
+				return svc.UpdateBundle(ctxWithUUID, &v0.UpdateBundleRequest{BundleId: bundleID, Patch: "{}"}, &v0.UpdateBundleResponse{})
+			},
+			"CloneBundle": func() error {
+				return svc.CloneBundle(ctxWithUUID, &v0.CloneBundleRequest{BundleId: bundleID}, &v0.CloneBundleResponse{})
+			},
+			"AddSettingToBundle": func() error {
+				return svc.AddSettingToBundle(ctxWithUUID, &v0.AddSettingToBundleRequest{BundleId: bundleID, Setting: &settingsmsg.Setting{}}, &v0.AddSettingToBundleResponse{})
+			},
+			"RemoveSettingFromBundle": func() error {
+				return svc.RemoveSettingFromBundle(ctxWithUUID, &v0.RemoveSettingFromBundleRequest{BundleId: bundleID}, nil)
+			},
+			"ReorderSettings": func() error {
+				return svc.ReorderSettings(ctxWithUUID, &v0.ReorderSettingsRequest{BundleId: bundleID}, &v0.ReorderSettingsResponse{})
+			},
+			"SaveValue": func() error {
+				return svc.SaveValue(ctxWithUUID, &v0.SaveValueRequest{Value: &settingsmsg.Value{BundleId: bundleID}}, &v0.SaveValueResponse{})
+			},
+			"PurgeAccount": func() error {
+				return svc.PurgeAccount(ctxWithUUID, &v0.PurgeAccountRequest{AccountUuid: "me"}, &v0.PurgeAccountResponse{})
+			},
+			"AssignRoleToUser": func() error {
+				return svc.AssignRoleToUser(ctxWithUUID, &v0.AssignRoleToUserRequest{AccountUuid: "me", RoleId: BundleUUIDRoleAdmin}, &v0.AssignRoleToUserResponse{})
+			},
+			"RemoveRoleFromUser": func() error {
+				return svc.RemoveRoleFromUser(ctxWithUUID, &v0.RemoveRoleFromUserRequest{Id: "assignment"}, nil)
+			},
+			"BulkAssignRole": func() error {
+				return svc.BulkAssignRole(ctxWithUUID, &v0.BulkRoleAssignmentRequest{RoleId: BundleUUIDRoleAdmin, AccountUuids: []string{"einstein"}}, &v0.BulkRoleAssignmentResponse{})
+			},
+			"BulkRemoveRole": func() error {
+				return svc.BulkRemoveRole(ctxWithUUID, &v0.BulkRoleAssignmentRequest{RoleId: BundleUUIDRoleAdmin, AccountUuids: []string{"einstein"}}, &v0.BulkRoleAssignmentResponse{})
+			},
+			"RebuildAssignmentIndex": func() error {
+				return svc.RebuildAssignmentIndex(ctxWithUUID, &v0.RebuildAssignmentIndexRequest{}, &v0.RebuildAssignmentIndexResponse{})
+			},
+		} {
+			err := write()
+			require.Error(t, err, name)
+			assert.Equal(t, int32(http.StatusMethodNotAllowed), merrors.FromError(err).Code, name)
+			assert.Equal(t, "service is read-only", merrors.FromError(err).Detail, name)
+		}
+	})
+
+	t.Run("reads succeed", func(t *testing.T) {
+		res := &v0.GetValueResponse{}
+		require.NoError(t, svc.GetValue(ctxWithUUID, &v0.GetValueRequest{Id: "c7d1a18e-7e3b-4c52-9c8e-6e65fd4f5a83"}, res))
+		assert.Equal(t, "de", res.Value.Value.GetStringValue())
+
+		assignments := &v0.ListRoleAssignmentsResponse{}
+		require.NoError(t, svc.ListRoleAssignments(ctxWithUUID, &v0.ListRoleAssignmentsRequest{AccountUuid: "me"}, assignments))
+		require.Len(t, assignments.Assignments, 1)
+	})
+
+	t.Run("the default roles are not registered", func(t *testing.T) {
+		cfg := &config.Config{StoreType: "filesystem", DataPath: t.TempDir()}
+		NewService(cfg, log.NopLogger())
+		entries, err := os.ReadDir(cfg.DataPath)
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+
+		cfg = &config.Config{StoreType: "filesystem", DataPath: filepath.Join(t.TempDir(), "replica"), BundleCache: true, ReadOnly: true}
+		NewService(cfg, log.NopLogger())
+		entries, err = os.ReadDir(cfg.DataPath)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
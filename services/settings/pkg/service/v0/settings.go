@@ -2,7 +2,6 @@ package svc
 
 import (
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
-	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
 )
 
 const (
@@ -43,8 +42,6 @@ const (
 	// CreateSpacePermissionName is the hardcoded setting name for the create space permission
 	CreateSpacePermissionName string = "create-space"
 
-	settingUUIDProfileLanguage = "aa8cfbe5-95d4-4f7e-a032-c3c01f5f062f"
-
 	// AccountManagementPermissionID is the hardcoded setting UUID for the account management permission
 	AccountManagementPermissionID string = "8e587774-d929-4215-910b-a317b1e80f73"
 	// AccountManagementPermissionName is the hardcoded setting name for the account management permission
@@ -59,479 +56,6 @@ const (
 	SelfManagementPermissionName string = "self-management"
 )
 
-// generateBundlesDefaultRoles bootstraps the default roles.
-func generateBundlesDefaultRoles() []*settingsmsg.Bundle {
-	return []*settingsmsg.Bundle{
-		generateBundleAdminRole(),
-		generateBundleSpaceAdminRole(),
-		generateBundleUserRole(),
-		generateBundleGuestRole(),
-		generateBundleProfileRequest(),
-	}
-}
-
-func generateBundleAdminRole() *settingsmsg.Bundle {
-	return &settingsmsg.Bundle{
-		Id:          BundleUUIDRoleAdmin,
-		Name:        "admin",
-		Type:        settingsmsg.Bundle_TYPE_ROLE,
-		Extension:   "ocis-roles",
-		DisplayName: "Admin",
-		Resource: &settingsmsg.Resource{
-			Type: settingsmsg.Resource_TYPE_SYSTEM,
-		},
-		Settings: []*settingsmsg.Setting{},
-	}
-}
-
-func generateBundleSpaceAdminRole() *settingsmsg.Bundle {
-	return &settingsmsg.Bundle{
-		Id:          BundleUUIDRoleSpaceAdmin,
-		Name:        "spaceadmin",
-		Type:        settingsmsg.Bundle_TYPE_ROLE,
-		Extension:   "ocis-roles",
-		DisplayName: "Space Admin",
-		Resource: &settingsmsg.Resource{
-			Type: settingsmsg.Resource_TYPE_SYSTEM,
-		},
-		Settings: []*settingsmsg.Setting{},
-	}
-}
-
-func generateBundleUserRole() *settingsmsg.Bundle {
-	return &settingsmsg.Bundle{
-		Id:          BundleUUIDRoleUser,
-		Name:        "user",
-		Type:        settingsmsg.Bundle_TYPE_ROLE,
-		Extension:   "ocis-roles",
-		DisplayName: "User",
-		Resource: &settingsmsg.Resource{
-			Type: settingsmsg.Resource_TYPE_SYSTEM,
-		},
-		Settings: []*settingsmsg.Setting{},
-	}
-}
-
-func generateBundleGuestRole() *settingsmsg.Bundle {
-	return &settingsmsg.Bundle{
-		Id:          BundleUUIDRoleGuest,
-		Name:        "guest",
-		Type:        settingsmsg.Bundle_TYPE_ROLE,
-		Extension:   "ocis-roles",
-		DisplayName: "Guest",
-		Resource: &settingsmsg.Resource{
-			Type: settingsmsg.Resource_TYPE_SYSTEM,
-		},
-		Settings: []*settingsmsg.Setting{},
-	}
-}
-
-var languageSetting = settingsmsg.Setting_SingleChoiceValue{
-	SingleChoiceValue: &settingsmsg.SingleChoiceList{
-		Options: []*settingsmsg.ListOption{
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "cs",
-					},
-				},
-				DisplayValue: "Czech",
-			},
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "de",
-					},
-				},
-				DisplayValue: "Deutsch",
-			},
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "en",
-					},
-				},
-				DisplayValue: "English",
-				Default:      true,
-			},
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "es",
-					},
-				},
-				DisplayValue: "Español",
-			},
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "fr",
-					},
-				},
-				DisplayValue: "Français",
-			},
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "gl",
-					},
-				},
-				DisplayValue: "Galego",
-			},
-			{
-				Value: &settingsmsg.ListOptionValue{
-					Option: &settingsmsg.ListOptionValue_StringValue{
-						StringValue: "it",
-					},
-				},
-				DisplayValue: "Italiano",
-			},
-		},
-	},
-}
-
-func generateBundleProfileRequest() *settingsmsg.Bundle {
-	return &settingsmsg.Bundle{
-		Id:        "2a506de7-99bd-4f0d-994e-c38e72c28fd9",
-		Name:      "profile",
-		Extension: "ocis-accounts",
-		Type:      settingsmsg.Bundle_TYPE_DEFAULT,
-		Resource: &settingsmsg.Resource{
-			Type: settingsmsg.Resource_TYPE_SYSTEM,
-		},
-		DisplayName: "Profile",
-		Settings: []*settingsmsg.Setting{
-			{
-				Id:          settingUUIDProfileLanguage,
-				Name:        "language",
-				DisplayName: "Language",
-				Description: "User language",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_USER,
-				},
-				Value: &languageSetting,
-			},
-		},
-	}
-}
-
-func generatePermissionRequests() []*settingssvc.AddSettingToBundleRequest {
-	return []*settingssvc.AddSettingToBundleRequest{
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          RoleManagementPermissionID,
-				Name:        RoleManagementPermissionName,
-				DisplayName: "Role Management",
-				Description: "This permission gives full access to everything that is related to role management.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_USER,
-					Id:   "all",
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          SettingsManagementPermissionID,
-				Name:        SettingsManagementPermissionName,
-				DisplayName: "Settings Management",
-				Description: "This permission gives full access to everything that is related to settings management.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_USER,
-					Id:   "all",
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          "7d81f103-0488-4853-bce5-98dcce36d649",
-				Name:        "language-readwrite",
-				DisplayName: "Permission to read and set the language (anyone)",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SETTING,
-					Id:   settingUUIDProfileLanguage,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleUser,
-			Setting: &settingsmsg.Setting{
-				Id:          "640e00d2-4df8-41bd-b1c2-9f30a01e0e99",
-				Name:        "language-readwrite",
-				DisplayName: "Permission to read and set the language (self)",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SETTING,
-					Id:   settingUUIDProfileLanguage,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleGuest,
-			Setting: &settingsmsg.Setting{
-				Id:          "ca878636-8b1a-4fae-8282-8617a4c13597",
-				Name:        "language-readwrite",
-				DisplayName: "Permission to read and set the language (self)",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SETTING,
-					Id:   settingUUIDProfileLanguage,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          AccountManagementPermissionID,
-				Name:        AccountManagementPermissionName,
-				DisplayName: "Account Management",
-				Description: "This permission gives full access to everything that is related to account management.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_USER,
-					Id:   "all",
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          GroupManagementPermissionID,
-				Name:        GroupManagementPermissionName,
-				DisplayName: "Group Management",
-				Description: "This permission gives full access to everything that is related to group management.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_GROUP,
-					Id:   "all",
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleUser,
-			Setting: &settingsmsg.Setting{
-				Id:          SelfManagementPermissionID,
-				Name:        SelfManagementPermissionName,
-				DisplayName: "Self Management",
-				Description: "This permission gives access to self management.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_USER,
-					Id:   "me",
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          SetSpaceQuotaPermissionID,
-				Name:        SetSpaceQuotaPermissionName,
-				DisplayName: "Set Space Quota",
-				Description: "This permission allows to manage space quotas.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleUser,
-			Setting: &settingsmsg.Setting{
-				Id:          CreateSpacePermissionID,
-				Name:        CreateSpacePermissionName,
-				DisplayName: "Create own Space",
-				Description: "This permission allows to create a space owned by the current user.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM, // TODO resource type space? self? me? own?
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_CREATE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          CreateSpacePermissionID,
-				Name:        CreateSpacePermissionName,
-				DisplayName: "Create Space",
-				Description: "This permission allows to create new spaces.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          ListAllSpacesPermissionID,
-				Name:        ListAllSpacesPermissionName,
-				DisplayName: "List All Spaces",
-				Description: "This permission allows list all spaces.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READ,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleSpaceAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          CreateSpacePermissionID,
-				Name:        CreateSpacePermissionName,
-				DisplayName: "Create Space",
-				Description: "This permission allows to create new spaces.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleSpaceAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          SetSpaceQuotaPermissionID,
-				Name:        SetSpaceQuotaPermissionName,
-				DisplayName: "Set Space Quota",
-				Description: "This permission allows to manage space quotas.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleSpaceAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          ListAllSpacesPermissionID,
-				Name:        ListAllSpacesPermissionName,
-				DisplayName: "List All Spaces",
-				Description: "This permission allows list all spaces.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SYSTEM,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READ,
-						Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleSpaceAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          "640e00d2-4df8-41bd-b1c2-9f30a01e0e99",
-				Name:        "language-readwrite",
-				DisplayName: "Permission to read and set the language (self)",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_SETTING,
-					Id:   settingUUIDProfileLanguage,
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
-					},
-				},
-			},
-		},
-		{
-			BundleId: BundleUUIDRoleSpaceAdmin,
-			Setting: &settingsmsg.Setting{
-				Id:          SelfManagementPermissionID,
-				Name:        SelfManagementPermissionName,
-				DisplayName: "Self Management",
-				Description: "This permission gives access to self management.",
-				Resource: &settingsmsg.Resource{
-					Type: settingsmsg.Resource_TYPE_USER,
-					Id:   "me",
-				},
-				Value: &settingsmsg.Setting_PermissionValue{
-					PermissionValue: &settingsmsg.Permission{
-						Operation:  settingsmsg.Permission_OPERATION_READWRITE,
-						Constraint: settingsmsg.Permission_CONSTRAINT_OWN,
-					},
-				},
-			},
-		},
-	}
-}
-
 func (g Service) defaultRoleAssignments() []*settingsmsg.UserRoleAssignment {
 	return []*settingsmsg.UserRoleAssignment{
 		// default admin users
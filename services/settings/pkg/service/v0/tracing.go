@@ -1,5 +1,21 @@
 package svc
 
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/tracing"
+	"go-micro.dev/v4/metadata"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
 // NewTracing returns a service that instruments traces.
 func NewTracing(next Service) Service {
 	return Service{
@@ -7,3 +23,260 @@ func NewTracing(next Service) Service {
 		config:  next.config,
 	}
 }
+
+// startSpan starts a span of the service. Requests without a span in their context continue the trace of the
+// W3C trace context headers in their metadata, if there are any.
+func (g Service) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = propagation.TraceContext{}.Extract(ctx, metadataCarrier{ctx: ctx})
+	}
+	return tracing.TraceProvider.Tracer("settings").Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error, if there is one, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// accountAttribute returns the account of a span, it is replaced by a hash if account uuids are redacted.
+func (g Service) accountAttribute(accountUUID string) attribute.KeyValue {
+	if g.config != nil && g.config.Tracing != nil && g.config.Tracing.RedactAccountUUIDs && accountUUID != "" {
+		sum := sha256.Sum256([]byte(accountUUID))
+		accountUUID = "sha256:" + hex.EncodeToString(sum[:8])
+	}
+	return attribute.String("settings.account_uuid", accountUUID)
+}
+
+// metadataCarrier reads the trace context from the metadata of a request.
+type metadataCarrier struct {
+	ctx context.Context
+}
+
+func (c metadataCarrier) Get(key string) string {
+	v, _ := metadata.Get(c.ctx, key)
+	return v
+}
+
+func (c metadataCarrier) Set(string, string) {}
+
+func (c metadataCarrier) Keys() []string {
+	md, _ := metadata.FromContext(c.ctx)
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// store returns the manager of the service, which traces the operations as part of the request.
+func (g Service) store(ctx context.Context) settings.Manager {
+	return tracedManager{next: g.manager, ctx: ctx, svc: g}
+}
+
+// tracedManager starts a span for every store operation.
+type tracedManager struct {
+	next settings.Manager
+	ctx  context.Context
+	svc  Service
+}
+
+func (m tracedManager) span(operation string, attrs ...attribute.KeyValue) trace.Span {
+	_, span := m.svc.startSpan(m.ctx, "SettingsStore."+operation, append(attrs, attribute.String("settings.operation", operation))...)
+	return span
+}
+
+func bundleAttribute(bundleID string) attribute.KeyValue {
+	return attribute.String("settings.bundle_id", bundleID)
+}
+
+func settingAttribute(settingID string) attribute.KeyValue {
+	return attribute.String("settings.setting_id", settingID)
+}
+
+func valueAttribute(valueID string) attribute.KeyValue {
+	return attribute.String("settings.value_id", valueID)
+}
+
+func (m tracedManager) ListBundles(bundleType settingsmsg.Bundle_Type, bundleIDs []string) (b []*settingsmsg.Bundle, err error) {
+	span := m.span("ListBundles", attribute.String("settings.bundle_type", bundleType.String()), attribute.StringSlice("settings.bundle_ids", bundleIDs))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListBundles(bundleType, bundleIDs)
+}
+
+func (m tracedManager) ListChangedBundles(since time.Time) (b []*settingsmsg.Bundle, err error) {
+	span := m.span("ListChangedBundles", attribute.String("settings.since", since.Format(time.RFC3339)))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListChangedBundles(since)
+}
+
+func (m tracedManager) ReadBundle(bundleID string) (b *settingsmsg.Bundle, err error) {
+	span := m.span("ReadBundle", bundleAttribute(bundleID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadBundle(bundleID)
+}
+
+func (m tracedManager) ReadBundleByName(extension, name string) (b *settingsmsg.Bundle, err error) {
+	span := m.span("ReadBundleByName", attribute.String("settings.extension", extension), attribute.String("settings.bundle_name", name))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadBundleByName(extension, name)
+}
+
+func (m tracedManager) WriteBundle(bundle *settingsmsg.Bundle) (b *settingsmsg.Bundle, err error) {
+	span := m.span("WriteBundle", bundleAttribute(bundle.GetId()))
+	defer func() { endSpan(span, err) }()
+	return m.next.WriteBundle(bundle)
+}
+
+func (m tracedManager) ReadSetting(settingID string) (s *settingsmsg.Setting, err error) {
+	span := m.span("ReadSetting", settingAttribute(settingID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadSetting(settingID)
+}
+
+func (m tracedManager) ReadBundleBySetting(settingID string) (b *settingsmsg.Bundle, err error) {
+	span := m.span("ReadBundleBySetting", settingAttribute(settingID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadBundleBySetting(settingID)
+}
+
+func (m tracedManager) AddSettingToBundle(bundleID string, setting *settingsmsg.Setting) (s *settingsmsg.Setting, err error) {
+	span := m.span("AddSettingToBundle", bundleAttribute(bundleID), settingAttribute(setting.GetId()))
+	defer func() { endSpan(span, err) }()
+	return m.next.AddSettingToBundle(bundleID, setting)
+}
+
+func (m tracedManager) RemoveSettingFromBundle(bundleID, settingID string) (err error) {
+	span := m.span("RemoveSettingFromBundle", bundleAttribute(bundleID), settingAttribute(settingID))
+	defer func() { endSpan(span, err) }()
+	return m.next.RemoveSettingFromBundle(bundleID, settingID)
+}
+
+func (m tracedManager) ReadBundleVersion(bundleID string, at time.Time) (b *settingsmsg.Bundle, err error) {
+	span := m.span("ReadBundleVersion", bundleAttribute(bundleID), attribute.String("settings.at", at.Format(time.RFC3339)))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadBundleVersion(bundleID, at)
+}
+
+func (m tracedManager) ListValues(bundleID, accountUUID string) (v []*settingsmsg.Value, err error) {
+	span := m.span("ListValues", bundleAttribute(bundleID), m.svc.accountAttribute(accountUUID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListValues(bundleID, accountUUID)
+}
+
+func (m tracedManager) ReadValue(valueID string) (v *settingsmsg.Value, err error) {
+	span := m.span("ReadValue", valueAttribute(valueID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadValue(valueID)
+}
+
+func (m tracedManager) ReadValueByUniqueIdentifiers(accountUUID, settingID string) (v *settingsmsg.Value, err error) {
+	span := m.span("ReadValueByUniqueIdentifiers", m.svc.accountAttribute(accountUUID), settingAttribute(settingID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadValueByUniqueIdentifiers(accountUUID, settingID)
+}
+
+func (m tracedManager) WriteValue(value *settingsmsg.Value) (v *settingsmsg.Value, err error) {
+	span := m.span("WriteValue", bundleAttribute(value.GetBundleId()), settingAttribute(value.GetSettingId()), m.svc.accountAttribute(value.GetAccountUuid()))
+	defer func() { endSpan(span, err) }()
+	return m.next.WriteValue(value)
+}
+
+func (m tracedManager) DeleteValue(valueID string) (err error) {
+	span := m.span("DeleteValue", valueAttribute(valueID))
+	defer func() { endSpan(span, err) }()
+	return m.next.DeleteValue(valueID)
+}
+
+func (m tracedManager) ListBundleIDsWithValues(accountUUID string) (ids []string, err error) {
+	span := m.span("ListBundleIDsWithValues", m.svc.accountAttribute(accountUUID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListBundleIDsWithValues(accountUUID)
+}
+
+func (m tracedManager) ListValueHistory(valueID string) (e []*settingsmsg.ValueHistoryEntry, err error) {
+	span := m.span("ListValueHistory", valueAttribute(valueID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListValueHistory(valueID)
+}
+
+func (m tracedManager) WriteValueHistoryEntry(entry *settingsmsg.ValueHistoryEntry) (e *settingsmsg.ValueHistoryEntry, err error) {
+	span := m.span("WriteValueHistoryEntry", valueAttribute(entry.GetValue().GetId()))
+	defer func() { endSpan(span, err) }()
+	return m.next.WriteValueHistoryEntry(entry)
+}
+
+func (m tracedManager) ListReadAuditEntries(bundleID string) (e []*settingsmsg.ReadAuditEntry, err error) {
+	span := m.span("ListReadAuditEntries", bundleAttribute(bundleID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListReadAuditEntries(bundleID)
+}
+
+func (m tracedManager) WriteReadAuditEntry(entry *settingsmsg.ReadAuditEntry) (e *settingsmsg.ReadAuditEntry, err error) {
+	span := m.span("WriteReadAuditEntry", bundleAttribute(entry.GetBundleId()), m.svc.accountAttribute(entry.GetAccountUuid()))
+	defer func() { endSpan(span, err) }()
+	return m.next.WriteReadAuditEntry(entry)
+}
+
+func (m tracedManager) ListRoleAssignments(accountUUID string) (a []*settingsmsg.UserRoleAssignment, err error) {
+	span := m.span("ListRoleAssignments", m.svc.accountAttribute(accountUUID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListRoleAssignments(accountUUID)
+}
+
+func (m tracedManager) WriteRoleAssignment(accountUUID, roleID string) (a *settingsmsg.UserRoleAssignment, err error) {
+	span := m.span("WriteRoleAssignment", m.svc.accountAttribute(accountUUID), attribute.String("settings.role_id", roleID))
+	defer func() { endSpan(span, err) }()
+	return m.next.WriteRoleAssignment(accountUUID, roleID)
+}
+
+func (m tracedManager) RemoveRoleAssignment(assignmentID string) (err error) {
+	span := m.span("RemoveRoleAssignment", attribute.String("settings.assignment_id", assignmentID))
+	defer func() { endSpan(span, err) }()
+	return m.next.RemoveRoleAssignment(assignmentID)
+}
+
+func (m tracedManager) RebuildAssignmentIndex() (stats *settings.AssignmentIndexStats, err error) {
+	span := m.span("RebuildAssignmentIndex")
+	defer func() { endSpan(span, err) }()
+	return m.next.RebuildAssignmentIndex()
+}
+
+func (m tracedManager) ListPermissionsByResource(resource *settingsmsg.Resource, roleIDs []string) (p []*settingsmsg.Permission, err error) {
+	span := m.span("ListPermissionsByResource", attribute.String("settings.resource_type", resource.GetType().String()), attribute.StringSlice("settings.role_ids", roleIDs))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListPermissionsByResource(resource, roleIDs)
+}
+
+func (m tracedManager) ReadPermissionByID(permissionID string, roleIDs []string) (p *settingsmsg.Permission, err error) {
+	span := m.span("ReadPermissionByID", attribute.String("settings.permission_id", permissionID), attribute.StringSlice("settings.role_ids", roleIDs))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadPermissionByID(permissionID, roleIDs)
+}
+
+func (m tracedManager) ReadPermissionByName(name string, roleIDs []string) (p *settingsmsg.Permission, err error) {
+	span := m.span("ReadPermissionByName", attribute.String("settings.permission_name", name), attribute.StringSlice("settings.role_ids", roleIDs))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadPermissionByName(name, roleIDs)
+}
+
+func (m tracedManager) ListBundleValues(bundleID string) (v []*settingsmsg.Value, err error) {
+	span := m.span("ListBundleValues", bundleAttribute(bundleID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ListBundleValues(bundleID)
+}
+
+func (m tracedManager) ReadMigrationVersion(bundleID string) (version uint32, err error) {
+	span := m.span("ReadMigrationVersion", bundleAttribute(bundleID))
+	defer func() { endSpan(span, err) }()
+	return m.next.ReadMigrationVersion(bundleID)
+}
+
+func (m tracedManager) WriteMigrationVersion(bundleID string, version uint32) (err error) {
+	span := m.span("WriteMigrationVersion", bundleAttribute(bundleID))
+	defer func() { endSpan(span, err) }()
+	return m.next.WriteMigrationVersion(bundleID, version)
+}
@@ -0,0 +1,92 @@
+package svc
+
+import (
+	"testing"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	v0 "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings/mocks"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/test-go/testify/mock"
+	"go-micro.dev/v4/metadata"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := tracing.TraceProvider
+	tracing.TraceProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { tracing.TraceProvider = provider })
+	return recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, a := range span.Attributes() {
+		attrs[a.Key] = a.Value
+	}
+	return attrs
+}
+
+func TestSaveValueSpans(t *testing.T) {
+	const (
+		accountUUID = "61445573-4dbe-4d56-88dc-88ab47aceba7"
+		bundleID    = "2f06addf-4fd2-49d5-8f71-00fbd3a3ec47"
+		settingID   = "c7ebbc8b-d15a-4f2e-9d7d-d6a4cf858d1a"
+		traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	)
+	manager := &mocks.Manager{}
+	manager.On("ReadSetting", mock.Anything).Return(&settingsmsg.Setting{Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{MaxLength: 10}}}, nil)
+	manager.On("WriteValue", mock.Anything).Return(func(v *settingsmsg.Value) *settingsmsg.Value { return v }, nil)
+	manager.On("WriteValueHistoryEntry", mock.Anything).Return(func(e *settingsmsg.ValueHistoryEntry) *settingsmsg.ValueHistoryEntry { return e }, nil)
+	manager.On("ReadBundle", mock.Anything).Return(&settingsmsg.Bundle{Name: "bundle", Extension: "extension"}, nil)
+
+	for _, redact := range []bool{false, true} {
+		recorder := recordSpans(t)
+		svc := Service{
+			manager: manager,
+			config:  &config.Config{Tracing: &config.Tracing{RedactAccountUUIDs: redact}},
+			logger:  log.NopLogger(),
+		}
+		ctx := metadata.Set(ctxWithUUID, "traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+		req := &v0.SaveValueRequest{Value: &settingsmsg.Value{
+			BundleId:    bundleID,
+			SettingId:   settingID,
+			AccountUuid: "me",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_StringValue{StringValue: "value"},
+		}}
+		require.NoError(t, svc.SaveValue(ctx, req, &v0.SaveValueResponse{}))
+
+		spans := map[string]sdktrace.ReadOnlySpan{}
+		for _, s := range recorder.Ended() {
+			spans[s.Name()] = s
+		}
+		for _, name := range []string{"Settings.SaveValue", "Settings.Validate", "SettingsStore.ReadSetting", "SettingsStore.WriteValue", "SettingsStore.WriteValueHistoryEntry"} {
+			require.Contains(t, spans, name)
+			// the spans continue the trace of the request
+			assert.Equal(t, traceID, spans[name].SpanContext().TraceID().String(), name)
+		}
+		root := spans["Settings.SaveValue"]
+		assert.Equal(t, root.SpanContext().SpanID(), spans["Settings.Validate"].Parent().SpanID())
+		assert.Equal(t, spans["Settings.Validate"].SpanContext().SpanID(), spans["SettingsStore.ReadSetting"].Parent().SpanID())
+		assert.Equal(t, root.SpanContext().SpanID(), spans["SettingsStore.WriteValue"].Parent().SpanID())
+
+		attrs := spanAttributes(spans["SettingsStore.WriteValue"])
+		assert.Equal(t, "WriteValue", attrs["settings.operation"].AsString())
+		assert.Equal(t, bundleID, attrs["settings.bundle_id"].AsString())
+		assert.Equal(t, settingID, attrs["settings.setting_id"].AsString())
+		if redact {
+			assert.NotContains(t, attrs["settings.account_uuid"].AsString(), accountUUID)
+			assert.Equal(t, attrs["settings.account_uuid"], spanAttributes(root)["settings.account_uuid"])
+		} else {
+			assert.Equal(t, accountUUID, attrs["settings.account_uuid"].AsString())
+		}
+	}
+}
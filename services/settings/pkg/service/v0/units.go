@@ -0,0 +1,97 @@
+package svc
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+const (
+	// UnitBytes is the unit of int settings holding a size in bytes.
+	UnitBytes = "bytes"
+	// UnitSeconds is the unit of int settings holding a duration in seconds.
+	UnitSeconds = "seconds"
+	// UnitPercent is the unit of int settings holding a percentage.
+	UnitPercent = "percent"
+
+	// maxFormatLength limits the length of the format hint of int settings.
+	maxFormatLength = 64
+)
+
+// unitSuffixes are the factors of the suffixes accepted for the values of each unit, keyed by the lower case suffix.
+var unitSuffixes = map[string]map[string]int64{
+	UnitBytes: {
+		"": 1, "b": 1,
+		"kb": 1000, "mb": 1000 * 1000, "gb": 1000 * 1000 * 1000, "tb": 1000 * 1000 * 1000 * 1000,
+		"kib": 1 << 10, "mib": 1 << 20, "gib": 1 << 30, "tib": 1 << 40,
+	},
+	UnitSeconds: {
+		"": 1, "s": 1, "m": 60, "h": 60 * 60, "d": 24 * 60 * 60,
+	},
+	UnitPercent: {
+		"": 1, "%": 1,
+	},
+}
+
+// unitValuePattern splits a value like "1.5 GiB" into the number and the suffix.
+var unitValuePattern = regexp.MustCompile(`^([+-]?[0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z%]*)$`)
+
+// parseUnitValue parses a value with an optional unit suffix, e.g. "5GB" or "90m", into the unit of the setting.
+// Decimal numbers are accepted if the result is a whole number, e.g. "1.5h".
+func parseUnitValue(s, unit string) (int64, error) {
+	suffixes, ok := unitSuffixes[unit]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %s", unit)
+	}
+	m := unitValuePattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, fmt.Errorf("'%s' is not a number of %s", s, unit)
+	}
+	factor, ok := suffixes[strings.ToLower(m[2])]
+	if !ok {
+		return 0, fmt.Errorf("unknown suffix '%s' for %s", m[2], unit)
+	}
+	n, ok := new(big.Rat).SetString(m[1])
+	if !ok {
+		return 0, fmt.Errorf("'%s' is not a number of %s", s, unit)
+	}
+	n.Mul(n, new(big.Rat).SetInt64(factor))
+	if !n.IsInt() {
+		return 0, fmt.Errorf("'%s' is not a whole number of %s", s, unit)
+	}
+	if !n.Num().IsInt64() {
+		return 0, fmt.Errorf("'%s' is too large", s)
+	}
+	return n.Num().Int64(), nil
+}
+
+// normalizeUnitValue converts a string value of an int setting with a unit, e.g. "5GB", into the int value in the
+// unit of the setting. Other values are left as they are.
+func normalizeUnitValue(value *settingsmsg.Value, setting *settingsmsg.Setting) error {
+	unit := setting.GetIntValue().GetUnit()
+	v, ok := value.GetValue().(*settingsmsg.Value_StringValue)
+	if unit == "" || !ok {
+		return nil
+	}
+	n, err := parseUnitValue(v.StringValue, unit)
+	if err != nil {
+		return validation.Errors{"string_value": err}
+	}
+	value.Value = &settingsmsg.Value_IntValue{IntValue: n}
+	return nil
+}
+
+// validateUnit checks the unit and the format hint of an int setting.
+func validateUnit(s *settingsmsg.Int) error {
+	if _, ok := unitSuffixes[s.GetUnit()]; s.GetUnit() != "" && !ok {
+		return validation.Errors{"unit": fmt.Errorf("must be one of %s, %s or %s", UnitBytes, UnitSeconds, UnitPercent)}
+	}
+	if len(s.GetFormat()) > maxFormatLength {
+		return validation.Errors{"format": fmt.Errorf("must not be longer than %d characters", maxFormatLength)}
+	}
+	return nil
+}
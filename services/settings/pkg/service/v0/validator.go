@@ -1,12 +1,28 @@
 package svc
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
+	"sort"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
 	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	settingschangessvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settingschanges/v0"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxValueCommentLength is the maximum number of characters allowed for the comment of a value change
+	maxValueCommentLength = 512
+	// maxBatchSize is the maximum number of values that can be requested at once
+	maxBatchSize = 1000
+	// maxTagLength is the maximum number of characters of a bundle tag
+	maxTagLength = 64
+	// maxJSONValueSize is the maximum size in bytes of the document of a json value
+	maxJSONValueSize = 64 * 1024
 )
 
 var (
@@ -16,6 +32,12 @@ var (
 		validation.Required,
 		validation.Match(regexForAccountUUID),
 	}
+	regexForTags = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	requireTag   = []validation.Rule{
+		validation.Required,
+		validation.Length(1, maxTagLength),
+		validation.Match(regexForTags),
+	}
 	regexForKeys        = regexp.MustCompile(`^[A-Za-z0-9\-_]*$`)
 	requireAlphanumeric = []validation.Rule{
 		validation.Required,
@@ -32,9 +54,14 @@ func validateSaveBundle(req *settingssvc.SaveBundleRequest) error {
 		validation.Field(&req.Bundle.Extension, requireAlphanumeric...),
 		validation.Field(&req.Bundle.DisplayName, validation.Required),
 		validation.Field(&req.Bundle.Settings, validation.Required),
+		validation.Field(&req.Bundle.DependsOn, validation.Each(is.UUID)),
+		validation.Field(&req.Bundle.Tags, validation.Each(requireTag...)),
 	); err != nil {
 		return err
 	}
+	if req.Bundle.Public && (req.Bundle.Type != settingsmsg.Bundle_TYPE_DEFAULT || req.Bundle.AuditReads) {
+		return validation.Errors{"public": errors.New("only default bundles without read auditing can be public")}
+	}
 	if err := validateResource(req.Bundle.Resource); err != nil {
 		return err
 	}
@@ -43,15 +70,114 @@ func validateSaveBundle(req *settingssvc.SaveBundleRequest) error {
 			return err
 		}
 	}
+	if err := validateTags(req.Bundle.Tags); err != nil {
+		return err
+	}
+	if err := validateVisibleWhen(req.Bundle.Settings); err != nil {
+		return err
+	}
+	return validateSettingGroups(req.Bundle)
+}
+
+// validateDefaultBundles checks the default bundles like SaveBundle checks saved bundles.
+func validateDefaultBundles(bundles []*settingsmsg.Bundle) error {
+	for _, b := range bundles {
+		if err := validateSaveBundle(&settingssvc.SaveBundleRequest{Bundle: b}); err != nil {
+			return fmt.Errorf("default bundle %s of extension %s is invalid: %w", b.Name, b.Extension, err)
+		}
+	}
+	return nil
+}
+
+// validateTags checks that a bundle doesn't carry the same tag more than once.
+func validateTags(tags []string) error {
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if _, ok := seen[tag]; ok {
+			return fmt.Errorf("duplicate tag %s", tag)
+		}
+		seen[tag] = struct{}{}
+	}
+	return nil
+}
+
+// validateSettingGroups checks that the group ids of a bundle are unique and that the settings only reference existing groups.
+func validateSettingGroups(bundle *settingsmsg.Bundle) error {
+	groups := make(map[string]struct{}, len(bundle.Groups))
+	for _, group := range bundle.Groups {
+		if err := validation.ValidateStruct(
+			group,
+			validation.Field(&group.Id, requireAlphanumeric...),
+			validation.Field(&group.DisplayName, validation.Required),
+		); err != nil {
+			return err
+		}
+		if _, ok := groups[group.Id]; ok {
+			return fmt.Errorf("duplicate group id %s", group.Id)
+		}
+		groups[group.Id] = struct{}{}
+	}
+	for _, setting := range bundle.Settings {
+		if _, ok := groups[setting.GroupId]; setting.GroupId != "" && !ok {
+			return fmt.Errorf("group %s of setting %s does not exist", setting.GroupId, setting.Name)
+		}
+	}
+	return nil
+}
+
+func validateUpdateBundle(req *settingssvc.UpdateBundleRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.BundleId, validation.Required, is.UUID),
+		validation.Field(&req.Patch, validation.Required),
+	)
+}
+
+func validateCloneBundle(req *settingssvc.CloneBundleRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.BundleId, validation.Required, is.UUID),
+		validation.Field(&req.Name, requireAlphanumeric...),
+		validation.Field(&req.Extension, requireAlphanumeric...),
+		validation.Field(&req.DisplayName, validation.Required),
+	)
+}
+
+func validateDiffBundles(req *settingssvc.DiffBundlesRequest) error {
+	if err := validation.ValidateStruct(
+		req,
+		validation.Field(&req.BaseBundleId, validation.Required, is.UUID),
+		validation.Field(&req.TargetBundleId, is.UUID),
+	); err != nil {
+		return err
+	}
+	if (req.TargetBundleId == "") == (req.TargetBundle == nil) {
+		return validation.Errors{"target_bundle_id": errors.New("either a target bundle id or a target bundle is required")}
+	}
 	return nil
 }
 
+func validatePlanImport(req *settingssvc.PlanImportRequest) error {
+	return validation.Validate(req.Bundles, validation.Required, validation.Length(1, maxPlanBundles), validation.Each(validation.NotNil))
+}
+
+func validateListAudit(req *settingssvc.ListAuditRequest) error {
+	return validation.Validate(&req.BundleId, validation.Required, is.UUID)
+}
+
 func validateGetBundle(req *settingssvc.GetBundleRequest) error {
 	return validation.Validate(&req.BundleId, is.UUID)
 }
 
+func validateGetSetting(req *settingssvc.GetSettingRequest) error {
+	return validation.Validate(&req.SettingId, validation.Required, is.UUID)
+}
+
 func validateListBundles(req *settingssvc.ListBundlesRequest) error {
-	return nil
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.Tags, validation.Each(requireTag...)),
+	)
 }
 
 func validateAddSettingToBundle(req *settingssvc.AddSettingToBundleRequest) error {
@@ -65,7 +191,15 @@ func validateRemoveSettingFromBundle(req *settingssvc.RemoveSettingFromBundleReq
 	return validation.ValidateStruct(
 		req,
 		validation.Field(&req.BundleId, is.UUID),
-		validation.Field(&req.SettingId, is.UUID),
+		validation.Field(&req.SettingId, requireAlphanumeric...),
+	)
+}
+
+func validateReorderSettings(req *settingssvc.ReorderSettingsRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.BundleId, validation.Required, is.UUID),
+		validation.Field(&req.SettingIds, validation.Required, validation.Each(is.UUID)),
 	)
 }
 
@@ -84,19 +218,203 @@ func validateSaveValue(req *settingssvc.SaveValueRequest) error {
 		return err
 	}
 
-	// TODO: validate values against the respective setting. need to check if constraints of the setting are fulfilled.
+	if err := validation.Validate(req.Comment, validation.RuneLength(0, maxValueCommentLength)); err != nil {
+		return fmt.Errorf("comment: %w", err)
+	}
+
 	return nil
 }
 
+// validateValueForSetting checks that the value matches the type of the given setting and fulfills its constraints.
+func validateValueForSetting(value *settingsmsg.Value, setting *settingsmsg.Setting) error {
+	switch s := setting.GetValue().(type) {
+	case *settingsmsg.Setting_IntValue:
+		v, ok := value.GetValue().(*settingsmsg.Value_IntValue)
+		if !ok {
+			return validation.Errors{"int_value": errors.New("must be set for an int setting")}
+		}
+		return validation.Errors{"int_value": validateIntValue(v.IntValue, s.IntValue)}.Filter()
+	case *settingsmsg.Setting_StringValue:
+		v, ok := value.GetValue().(*settingsmsg.Value_StringValue)
+		if !ok {
+			return validation.Errors{"string_value": errors.New("must be set for a string setting")}
+		}
+		return validation.Errors{"string_value": validateStringValue(v.StringValue, s.StringValue)}.Filter()
+	case *settingsmsg.Setting_BoolValue:
+		if _, ok := value.GetValue().(*settingsmsg.Value_BoolValue); !ok {
+			return validation.Errors{"bool_value": errors.New("must be set for a bool setting")}
+		}
+	case *settingsmsg.Setting_SingleChoiceValue:
+		v, ok := value.GetValue().(*settingsmsg.Value_ListValue)
+		if !ok {
+			return validation.Errors{"list_value": errors.New("must be set for a single choice setting")}
+		}
+		if len(v.ListValue.GetValues()) != 1 {
+			return validation.Errors{"list_value": errors.New("must contain exactly one option")}
+		}
+		return validation.Errors{"list_value": validateListOptions(v.ListValue, s.SingleChoiceValue.GetOptions())}.Filter()
+	case *settingsmsg.Setting_MultiChoiceValue:
+		v, ok := value.GetValue().(*settingsmsg.Value_ListValue)
+		if !ok {
+			return validation.Errors{"list_value": errors.New("must be set for a multi choice setting")}
+		}
+		return validation.Errors{"list_value": validateListOptions(v.ListValue, s.MultiChoiceValue.GetOptions())}.Filter()
+	case *settingsmsg.Setting_JsonValue:
+		v, ok := value.GetValue().(*settingsmsg.Value_JsonValue)
+		if !ok {
+			return validation.Errors{"json_value": errors.New("must be set for a json setting")}
+		}
+		return validation.Errors{"json_value": validateJSONValue(v.JsonValue, s.JsonValue)}.Filter()
+	}
+	return nil
+}
+
+// validateJSONValue checks that the document is valid json and matches the schema of the setting, if it has one.
+func validateJSONValue(v string, s *settingsmsg.Json) error {
+	if len(v) > maxJSONValueSize {
+		return fmt.Errorf("must not be larger than %d bytes", maxJSONValueSize)
+	}
+	doc, err := decodeJSON(v)
+	if err != nil {
+		return fmt.Errorf("must be a json document: %w", err)
+	}
+	if s.GetSchema() == "" {
+		return nil
+	}
+	schema, err := compileJSONSchema(s.GetSchema())
+	if err != nil {
+		return err
+	}
+	return schema.validate(doc, "")
+}
+
+func validateIntValue(v int64, s *settingsmsg.Int) error {
+	// a range is only enforced if one is defined
+	// validation.Min and validation.Max treat 0 as empty and skip it, so the range is checked by hand
+	if s.GetMax() > s.GetMin() && (v < s.GetMin() || v > s.GetMax()) {
+		return fmt.Errorf("must be between %d and %d", s.GetMin(), s.GetMax())
+	}
+	if s.GetStep() > 0 && (v-s.GetMin())%s.GetStep() != 0 {
+		return fmt.Errorf("must be a multiple of %d starting from %d", s.GetStep(), s.GetMin())
+	}
+	return nil
+}
+
+func validateStringValue(v string, s *settingsmsg.String) error {
+	rules := []validation.Rule{
+		validation.When(s.GetRequired(), validation.Required),
+		validation.RuneLength(int(s.GetMinLength()), int(s.GetMaxLength())),
+	}
+	if s.GetPattern() != "" {
+		re, err := compilePattern(s.GetPattern())
+		if err != nil {
+			return err
+		}
+		rules = append(rules, validation.Match(re).Error(fmt.Sprintf("must match the pattern %s", s.GetPattern())))
+	}
+	return validation.Validate(v, rules...)
+}
+
+// compilePattern compiles the pattern of a string setting, which has to match the whole value.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+	}
+	return regexp.MustCompile("^(?:" + pattern + ")$"), nil
+}
+
+func validateListOptions(v *settingsmsg.ListValue, options []*settingsmsg.ListOption) error {
+	seen := make([]*settingsmsg.ListOptionValue, 0, len(v.GetValues()))
+	for _, lov := range v.GetValues() {
+		if !containsListOptionValue(lov, options) {
+			return fmt.Errorf("%s is not a valid option", listOptionValueString(lov))
+		}
+		for _, s := range seen {
+			if proto.Equal(s, lov) {
+				return fmt.Errorf("%s is selected more than once", listOptionValueString(lov))
+			}
+		}
+		seen = append(seen, lov)
+	}
+	return nil
+}
+
+func containsListOptionValue(lov *settingsmsg.ListOptionValue, options []*settingsmsg.ListOption) bool {
+	for _, o := range options {
+		if proto.Equal(o.GetValue(), lov) {
+			return true
+		}
+	}
+	return false
+}
+
+func listOptionValueString(lov *settingsmsg.ListOptionValue) string {
+	switch o := lov.GetOption().(type) {
+	case *settingsmsg.ListOptionValue_StringValue:
+		return fmt.Sprintf("%q", o.StringValue)
+	case *settingsmsg.ListOptionValue_IntValue:
+		return fmt.Sprintf("%d", o.IntValue)
+	}
+	return "empty option"
+}
+
+// toValidationErrors flattens the given validation error into a list of field errors, sorted by field.
+func toValidationErrors(err error) []*settingssvc.ValidationError {
+	if err == nil {
+		return nil
+	}
+	var out []*settingssvc.ValidationError
+	var flatten func(prefix string, err error)
+	flatten = func(prefix string, err error) {
+		errs, ok := err.(validation.Errors)
+		if !ok {
+			out = append(out, &settingssvc.ValidationError{Field: prefix, Message: err.Error()})
+			return
+		}
+		for field, e := range errs {
+			if prefix != "" {
+				field = prefix + "." + field
+			}
+			flatten(field, e)
+		}
+	}
+	flatten("", err)
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Field < out[j].Field
+	})
+	return out
+}
+
+func validateBulkValidateValues(req *settingssvc.BulkValidateValuesRequest) error {
+	return validation.Validate(req.Values, validation.Required, validation.Length(1, maxBatchSize), validation.Each(validation.NotNil))
+}
+
 func validateGetValue(req *settingssvc.GetValueRequest) error {
 	return validation.Validate(req.Id, is.UUID)
 }
 
+func validateGetValues(req *settingssvc.GetValuesRequest) error {
+	return validation.Validate(req.Ids, validation.Required, validation.Length(1, maxBatchSize), validation.Each(is.UUID))
+}
+
+func validateBatchGetEffectiveValues(req *settingssvc.BatchGetEffectiveValuesRequest) error {
+	if (req.SettingId == "") == (req.BundleId == "") {
+		return validation.Errors{"setting_id": errors.New("either the setting id or the bundle id must be set")}
+	}
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.SettingId, validation.When(req.SettingId != "", is.UUID)),
+		validation.Field(&req.BundleId, validation.When(req.BundleId != "", is.UUID)),
+		validation.Field(&req.AccountUuids, validation.Required, validation.Length(1, maxBatchSize), validation.Each(requireAccountID...)),
+	)
+}
+
 func validateGetValueByUniqueIdentifiers(req *settingssvc.GetValueByUniqueIdentifiersRequest) error {
 	return validation.ValidateStruct(
 		req,
-		validation.Field(&req.SettingId, is.UUID),
+		validation.Field(&req.SettingId, requireAlphanumeric...),
 		validation.Field(&req.AccountUuid, requireAccountID...),
+		validation.Field(&req.ParentGroupId, validation.When(req.ParentGroupId != "", validation.Match(regexForAccountUUID))),
 	)
 }
 
@@ -108,6 +426,29 @@ func validateListValues(req *settingssvc.ListValuesRequest) error {
 	)
 }
 
+func validateListValuesModifiedSince(req *settingssvc.ListValuesModifiedSinceRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.AccountUuid, validation.When(req.AccountUuid != "", validation.Match(regexForAccountUUID))),
+	)
+}
+
+func validateSubscribeValueChanges(req *settingschangessvc.SubscribeValueChangesRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.SettingIds, validation.Required, validation.Length(1, maxBatchSize), validation.Each(is.UUID)),
+		validation.Field(&req.AccountUuid, validation.When(!req.AllAccounts, requireAccountID...)),
+	)
+}
+
+func validatePurgeAccount(req *settingssvc.PurgeAccountRequest) error {
+	return validation.Validate(req.AccountUuid, requireAccountID...)
+}
+
+func validateListValueHistory(req *settingssvc.ListValueHistoryRequest) error {
+	return validation.Validate(req.ValueId, is.UUID)
+}
+
 func validateListRoles(req *settingssvc.ListBundlesRequest) error {
 	return nil
 }
@@ -131,6 +472,48 @@ func validateRemoveRoleFromUser(req *settingssvc.RemoveRoleFromUserRequest) erro
 	)
 }
 
+func validateBulkRoleAssignment(req *settingssvc.BulkRoleAssignmentRequest) error {
+	if err := validation.ValidateStruct(
+		req,
+		validation.Field(&req.RoleId, validation.Required, is.UUID),
+		validation.Field(&req.AccountUuids, validation.Required, validation.Length(1, maxBulkAccounts), validation.Each(requireAccountID...)),
+	); err != nil {
+		return err
+	}
+	seen := make(map[string]struct{}, len(req.AccountUuids))
+	for _, accountUUID := range req.AccountUuids {
+		if _, ok := seen[accountUUID]; ok {
+			return validation.Errors{"account_uuids": fmt.Errorf("the account %s is listed more than once", accountUUID)}
+		}
+		seen[accountUUID] = struct{}{}
+	}
+	return nil
+}
+
+func validateAnalyzeRolePermissions(req *settingssvc.AnalyzeRolePermissionsRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.RoleIds, validation.Required, validation.Each(is.UUID)),
+	)
+}
+
+func validateExportPermissionMatrix(req *settingssvc.ExportPermissionMatrixRequest) error {
+	if err := validation.ValidateStruct(
+		req,
+		validation.Field(&req.RoleIds, validation.Each(is.UUID)),
+	); err != nil {
+		return err
+	}
+	// the resource filter is optional, but it needs a type
+	if req.Resource != nil {
+		return validation.ValidateStruct(
+			req.Resource,
+			validation.Field(&req.Resource.Type, validation.Required),
+		)
+	}
+	return nil
+}
+
 func validateListPermissionsByResource(req *settingssvc.ListPermissionsByResourceRequest) error {
 	return validateResource(req.Resource)
 }
@@ -142,6 +525,14 @@ func validateGetPermissionByID(req *settingssvc.GetPermissionByIDRequest) error
 	)
 }
 
+func validateExplainPermission(req *settingssvc.ExplainPermissionRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.AccountUuid, requireAccountID...),
+		validation.Field(&req.SettingId, requireAlphanumeric...),
+	)
+}
+
 // validateResource is an internal helper for validating the content of a resource.
 func validateResource(resource *settingsmsg.Resource) error {
 	if err := validation.Validate(&resource, validation.Required); err != nil {
@@ -157,8 +548,34 @@ func validateSetting(setting *settingsmsg.Setting) error {
 		setting,
 		validation.Field(&setting.Id, validation.When(setting.Id != "", is.UUID)),
 		validation.Field(&setting.Name, requireAlphanumeric...),
+		validation.Field(&setting.VisibleToRoles, validation.Each(is.UUID)),
 	); err != nil {
 		return err
 	}
+	if s, ok := setting.Value.(*settingsmsg.Setting_StringValue); ok && s.StringValue.GetPattern() != "" {
+		if _, err := compilePattern(s.StringValue.GetPattern()); err != nil {
+			return validation.Errors{"pattern": err}
+		}
+	}
+	if s, ok := setting.Value.(*settingsmsg.Setting_JsonValue); ok {
+		if s.JsonValue.GetSchema() != "" {
+			if _, err := compileJSONSchema(s.JsonValue.GetSchema()); err != nil {
+				return validation.Errors{"schema": err}
+			}
+		}
+		if s.JsonValue.GetDefault() != "" {
+			if err := validateJSONValue(s.JsonValue.GetDefault(), s.JsonValue); err != nil {
+				return validation.Errors{"default": err}
+			}
+		}
+	}
+	if s, ok := setting.Value.(*settingsmsg.Setting_IntValue); ok {
+		if err := validateUnit(s.IntValue); err != nil {
+			return err
+		}
+	}
+	if err := validateDefaultExpression(setting); err != nil {
+		return err
+	}
 	return validateResource(setting.Resource)
 }
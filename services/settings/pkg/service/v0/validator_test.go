@@ -0,0 +1,288 @@
+package svc
+
+import (
+	"strings"
+	"testing"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	intSetting = &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Min: 0, Max: 100, Step: 5}},
+	}
+	stringSetting = &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{Required: true, MinLength: 2, MaxLength: 4}},
+	}
+	patternSetting = &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{MaxLength: 10, Pattern: "[a-z]+|[0-9]+"}},
+	}
+	boolSetting = &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_BoolValue{BoolValue: &settingsmsg.Bool{}},
+	}
+	choiceOptions = []*settingsmsg.ListOption{
+		{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "de"}}},
+		{Value: &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: "en"}}},
+	}
+	singleChoiceSetting = &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_SingleChoiceValue{SingleChoiceValue: &settingsmsg.SingleChoiceList{Options: choiceOptions}},
+	}
+	multiChoiceSetting = &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_MultiChoiceValue{MultiChoiceValue: &settingsmsg.MultiChoiceList{Options: choiceOptions}},
+	}
+)
+
+func intValue(v int64) *settingsmsg.Value {
+	return &settingsmsg.Value{Value: &settingsmsg.Value_IntValue{IntValue: v}}
+}
+
+func stringValue(v string) *settingsmsg.Value {
+	return &settingsmsg.Value{Value: &settingsmsg.Value_StringValue{StringValue: v}}
+}
+
+func listValue(options ...string) *settingsmsg.Value {
+	values := make([]*settingsmsg.ListOptionValue, 0, len(options))
+	for _, o := range options {
+		values = append(values, &settingsmsg.ListOptionValue{Option: &settingsmsg.ListOptionValue_StringValue{StringValue: o}})
+	}
+	return &settingsmsg.Value{Value: &settingsmsg.Value_ListValue{ListValue: &settingsmsg.ListValue{Values: values}}}
+}
+
+func TestValidateValueForSetting(t *testing.T) {
+	scenarios := []struct {
+		name    string
+		value   *settingsmsg.Value
+		setting *settingsmsg.Setting
+		valid   bool
+	}{
+		{"int in range", intValue(50), intSetting, true},
+		{"int at the lower bound", intValue(0), intSetting, true},
+		{"int above the range", intValue(105), intSetting, false},
+		{"int below the range", intValue(-5), intSetting, false},
+		{"int not matching the step", intValue(12), intSetting, false},
+		{"int setting with a string value", stringValue("50"), intSetting, false},
+		{"string within length", stringValue("abc"), stringSetting, true},
+		{"string too short", stringValue("a"), stringSetting, false},
+		{"string too long", stringValue("abcde"), stringSetting, false},
+		{"required string missing", stringValue(""), stringSetting, false},
+		{"string matching the pattern", stringValue("abc"), patternSetting, true},
+		{"string matching another alternative of the pattern", stringValue("123"), patternSetting, true},
+		{"string only partially matching the pattern", stringValue("abc123"), patternSetting, false},
+		{"string not matching the pattern", stringValue("ABC"), patternSetting, false},
+		{"bool", &settingsmsg.Value{Value: &settingsmsg.Value_BoolValue{BoolValue: true}}, boolSetting, true},
+		{"bool setting with an int value", intValue(1), boolSetting, false},
+		{"single choice with a valid option", listValue("de"), singleChoiceSetting, true},
+		{"single choice with an unknown option", listValue("fr"), singleChoiceSetting, false},
+		{"single choice with two options", listValue("de", "en"), singleChoiceSetting, false},
+		{"multi choice with valid options", listValue("de", "en"), multiChoiceSetting, true},
+		{"multi choice with duplicate options", listValue("de", "de"), multiChoiceSetting, false},
+		{"multi choice with an unknown option", listValue("de", "fr"), multiChoiceSetting, false},
+		{"setting without definition", intValue(1), &settingsmsg.Setting{}, true},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			err := validateValueForSetting(scenario.value, scenario.setting)
+			if scenario.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSettingPattern(t *testing.T) {
+	setting := func(pattern string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:          "a8cdcf3e-4fbd-4cbd-9ce4-b3e6c5bc1ec2",
+			Name:        "pattern",
+			DisplayName: "Pattern",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Value:       &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{MaxLength: 10, Pattern: pattern}},
+		}
+	}
+	assert.NoError(t, validateSetting(setting("^[a-z]+$")))
+	assert.Error(t, validateSetting(setting("[a-z")))
+
+	err := validateValueForSetting(stringValue("ABC"), setting("[a-z]+"))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "[a-z]+")
+	}
+}
+
+func TestValidateJSONValue(t *testing.T) {
+	jsonSetting := func(schema string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Value: &settingsmsg.Setting_JsonValue{JsonValue: &settingsmsg.Json{Schema: schema}},
+		}
+	}
+	jsonValue := func(v string) *settingsmsg.Value {
+		return &settingsmsg.Value{Value: &settingsmsg.Value_JsonValue{JsonValue: v}}
+	}
+	schema := `{
+		"type": "object",
+		"required": ["columns"],
+		"additionalProperties": false,
+		"properties": {
+			"columns": {"type": "array", "minItems": 1, "items": {"type": "string", "enum": ["name", "size", "mtime"]}},
+			"pageSize": {"type": "integer", "minimum": 10, "maximum": 100},
+			"title": {"type": ["string", "null"], "maxLength": 3, "pattern": "^[a-z]"}
+		}
+	}`
+	scenarios := []struct {
+		name    string
+		value   *settingsmsg.Value
+		setting *settingsmsg.Setting
+		valid   bool
+	}{
+		{"document without schema", jsonValue(`{"any": [1, "thing"]}`), jsonSetting(""), true},
+		{"scalar document without schema", jsonValue(`42`), jsonSetting(""), true},
+		{"invalid document", jsonValue(`{"any": `), jsonSetting(""), false},
+		{"two documents", jsonValue(`{} {}`), jsonSetting(""), false},
+		{"document matching the schema", jsonValue(`{"columns": ["name", "size"], "pageSize": 20, "title": null}`), jsonSetting(schema), true},
+		{"missing required property", jsonValue(`{"pageSize": 20}`), jsonSetting(schema), false},
+		{"additional property", jsonValue(`{"columns": ["name"], "sort": "name"}`), jsonSetting(schema), false},
+		{"wrong type", jsonValue(`{"columns": "name"}`), jsonSetting(schema), false},
+		{"too few items", jsonValue(`{"columns": []}`), jsonSetting(schema), false},
+		{"item not in enum", jsonValue(`{"columns": ["owner"]}`), jsonSetting(schema), false},
+		{"not an integer", jsonValue(`{"columns": ["name"], "pageSize": 20.5}`), jsonSetting(schema), false},
+		{"integer written as float", jsonValue(`{"columns": ["name"], "pageSize": 20.0}`), jsonSetting(schema), true},
+		{"number above the maximum", jsonValue(`{"columns": ["name"], "pageSize": 200}`), jsonSetting(schema), false},
+		{"string too long", jsonValue(`{"columns": ["name"], "title": "abcd"}`), jsonSetting(schema), false},
+		{"string not matching the pattern", jsonValue(`{"columns": ["name"], "title": "Abc"}`), jsonSetting(schema), false},
+		{"json setting with a string value", stringValue(`{}`), jsonSetting(""), false},
+		{"document near the size limit", jsonValue(`"` + strings.Repeat("a", maxJSONValueSize-2) + `"`), jsonSetting(`{"type": "string"}`), true},
+		{"document above the size limit", jsonValue(`"` + strings.Repeat("a", maxJSONValueSize-1) + `"`), jsonSetting(`{"type": "string"}`), false},
+	}
+	for _, s := range scenarios {
+		scenario := s
+		t.Run(scenario.name, func(t *testing.T) {
+			err := validateValueForSetting(scenario.value, scenario.setting)
+			if scenario.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	err := validateValueForSetting(jsonValue(`{"columns": ["name"], "pageSize": 5}`), jsonSetting(schema))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "/pageSize")
+	}
+}
+
+func TestValidateSettingJSONSchema(t *testing.T) {
+	setting := func(def, schema string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:          "a8cdcf3e-4fbd-4cbd-9ce4-b3e6c5bc1ec2",
+			Name:        "json",
+			DisplayName: "JSON",
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Value:       &settingsmsg.Setting_JsonValue{JsonValue: &settingsmsg.Json{Default: def, Schema: schema}},
+		}
+	}
+	assert.NoError(t, validateSetting(setting("", "")))
+	assert.NoError(t, validateSetting(setting(`{"a": 1}`, `{"$schema": "https://json-schema.org/draft/2020-12/schema", "title": "A", "type": "object"}`)))
+	assert.Error(t, validateSetting(setting("", `{"type": "object"`)))
+	assert.Error(t, validateSetting(setting("", `{"type": "thing"}`)))
+	assert.Error(t, validateSetting(setting("", `{"oneOf": [{"type": "string"}]}`)))
+	assert.Error(t, validateSetting(setting("", `{"properties": {"a": {"pattern": "[a-"}}}`)))
+	assert.Error(t, validateSetting(setting("", `{"minItems": -1}`)))
+	assert.Error(t, validateSetting(setting(`"a"`, `{"type": "object"}`)))
+	assert.Error(t, validateSetting(setting(`{`, "")))
+}
+
+func TestParseUnitValue(t *testing.T) {
+	scenarios := []struct {
+		value    string
+		unit     string
+		expected int64
+	}{
+		{"1024", UnitBytes, 1024},
+		{"5GB", UnitBytes, 5 * 1000 * 1000 * 1000},
+		{"5 gb", UnitBytes, 5 * 1000 * 1000 * 1000},
+		{"1.5GiB", UnitBytes, 3 << 29},
+		{"100B", UnitBytes, 100},
+		{"90", UnitSeconds, 90},
+		{"30s", UnitSeconds, 30},
+		{"5m", UnitSeconds, 300},
+		{"1.5h", UnitSeconds, 5400},
+		{"7d", UnitSeconds, 7 * 24 * 60 * 60},
+		{"50%", UnitPercent, 50},
+		{" 75 ", UnitPercent, 75},
+		{"-10", UnitPercent, -10},
+	}
+	for _, s := range scenarios {
+		n, err := parseUnitValue(s.value, s.unit)
+		if assert.NoError(t, err, s.value) {
+			assert.Equal(t, s.expected, n, s.value)
+		}
+	}
+}
+
+func TestParseUnitValueMalformed(t *testing.T) {
+	scenarios := []struct {
+		value string
+		unit  string
+	}{
+		{"", UnitBytes},
+		{"GB", UnitBytes},
+		{"5XB", UnitBytes},
+		{"5 G B", UnitBytes},
+		{"1e3GB", UnitBytes},
+		{"1/2GB", UnitBytes},
+		{"0.5B", UnitBytes},
+		{"5.GB", UnitBytes},
+		{"9999999999TB", UnitBytes},
+		{"5GB", UnitSeconds},
+		{"1.5s", UnitSeconds},
+		{"50%%", UnitPercent},
+		{"50", "minutes"},
+	}
+	for _, s := range scenarios {
+		_, err := parseUnitValue(s.value, s.unit)
+		assert.Error(t, err, s.value)
+	}
+}
+
+func TestNormalizeUnitValue(t *testing.T) {
+	quota := &settingsmsg.Setting{
+		Value: &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Unit: UnitBytes}},
+	}
+	value := stringValue("5GB")
+	assert.NoError(t, normalizeUnitValue(value, quota))
+	assert.Equal(t, int64(5*1000*1000*1000), value.GetIntValue())
+
+	// int values and settings without a unit are left as they are
+	value = intValue(5)
+	assert.NoError(t, normalizeUnitValue(value, quota))
+	assert.Equal(t, int64(5), value.GetIntValue())
+	value = stringValue("5GB")
+	assert.NoError(t, normalizeUnitValue(value, intSetting))
+	assert.Equal(t, "5GB", value.GetStringValue())
+
+	value = stringValue("5 gigabytes")
+	err := normalizeUnitValue(value, quota)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "string_value")
+	}
+	assert.Equal(t, "5 gigabytes", value.GetStringValue())
+}
+
+func TestValidateSettingUnit(t *testing.T) {
+	setting := func(unit, format string) *settingsmsg.Setting {
+		return &settingsmsg.Setting{
+			Id:       "a8cdcf3e-4fbd-4cbd-9ce4-b3e6c5bc1ec2",
+			Name:     "quota",
+			Resource: &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_SYSTEM},
+			Value:    &settingsmsg.Setting_IntValue{IntValue: &settingsmsg.Int{Unit: unit, Format: format}},
+		}
+	}
+	assert.NoError(t, validateSetting(setting("", "")))
+	assert.NoError(t, validateSetting(setting(UnitBytes, "binary")))
+	assert.Error(t, validateSetting(setting("minutes", "")))
+	assert.Error(t, validateSetting(setting(UnitSeconds, strings.Repeat("x", 65))))
+}
@@ -0,0 +1,141 @@
+package svc
+
+import (
+	"fmt"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+// hiddenSettings returns the ids of the settings whose visible_when condition isn't met by the given values.
+// Settings depending on a hidden setting are hidden as well.
+func hiddenSettings(settings []*settingsmsg.Setting, values []*settingsmsg.Value) []string {
+	byID := make(map[string]*settingsmsg.Setting, len(settings))
+	for _, s := range settings {
+		byID[s.Id] = s
+	}
+	valuesByID := make(map[string]*settingsmsg.Value, len(values))
+	for _, v := range values {
+		valuesByID[v.SettingId] = v
+	}
+
+	hidden := map[string]bool{}
+	var isHidden func(s *settingsmsg.Setting, depth int) bool
+	isHidden = func(s *settingsmsg.Setting, depth int) bool {
+		if h, ok := hidden[s.Id]; ok {
+			return h
+		}
+		cond := s.VisibleWhen
+		if cond == nil {
+			return false
+		}
+		controlling, ok := byID[cond.SettingId]
+		// cycles are rejected when saving the bundle, they can only be left in bundles saved before
+		h := !ok || depth > len(settings) || isHidden(controlling, depth+1) || !conditionMet(controlling, valuesByID[controlling.Id], cond)
+		hidden[s.Id] = h
+		return h
+	}
+
+	var ids []string
+	for _, s := range settings {
+		if isHidden(s, 0) {
+			ids = append(ids, s.Id)
+		}
+	}
+	return ids
+}
+
+// conditionMet compares the value of the controlling setting with the expected value of the condition.
+// The default of the setting is compared if there is no value.
+func conditionMet(s *settingsmsg.Setting, v *settingsmsg.Value, cond *settingsmsg.VisibleWhen) bool {
+	if options, ok := selectedOptions(s, v); ok {
+		for _, o := range options {
+			switch expected := cond.Value.(type) {
+			case *settingsmsg.VisibleWhen_StringValue:
+				if sv, ok := o.Option.(*settingsmsg.ListOptionValue_StringValue); ok && sv.StringValue == expected.StringValue {
+					return true
+				}
+			case *settingsmsg.VisibleWhen_IntValue:
+				if iv, ok := o.Option.(*settingsmsg.ListOptionValue_IntValue); ok && iv.IntValue == expected.IntValue {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	switch expected := cond.Value.(type) {
+	case *settingsmsg.VisibleWhen_BoolValue:
+		if bv, ok := v.GetValue().(*settingsmsg.Value_BoolValue); ok {
+			return bv.BoolValue == expected.BoolValue
+		}
+		return s.GetBoolValue().GetDefault() == expected.BoolValue
+	case *settingsmsg.VisibleWhen_IntValue:
+		if iv, ok := v.GetValue().(*settingsmsg.Value_IntValue); ok {
+			return iv.IntValue == expected.IntValue
+		}
+		return s.GetIntValue() != nil && s.GetIntValue().Default == expected.IntValue
+	case *settingsmsg.VisibleWhen_StringValue:
+		if sv, ok := v.GetValue().(*settingsmsg.Value_StringValue); ok {
+			return sv.StringValue == expected.StringValue
+		}
+		return s.GetStringValue() != nil && s.GetStringValue().Default == expected.StringValue
+	}
+	return false
+}
+
+// selectedOptions returns the selected options of a choice list setting, the default options if there is
+// no value. It returns false if the setting is no choice list.
+func selectedOptions(s *settingsmsg.Setting, v *settingsmsg.Value) ([]*settingsmsg.ListOptionValue, bool) {
+	var options []*settingsmsg.ListOption
+	switch list := s.Value.(type) {
+	case *settingsmsg.Setting_SingleChoiceValue:
+		options = list.SingleChoiceValue.GetOptions()
+	case *settingsmsg.Setting_MultiChoiceValue:
+		options = list.MultiChoiceValue.GetOptions()
+	default:
+		return nil, false
+	}
+	if lv, ok := v.GetValue().(*settingsmsg.Value_ListValue); ok {
+		return lv.ListValue.GetValues(), true
+	}
+	var selected []*settingsmsg.ListOptionValue
+	for _, o := range options {
+		if o.Default {
+			selected = append(selected, o.Value)
+		}
+	}
+	return selected, true
+}
+
+// validateVisibleWhen checks that the conditions of the settings reference other settings of the bundle
+// and that the settings don't depend on each other in a cycle.
+func validateVisibleWhen(settings []*settingsmsg.Setting) error {
+	byID := make(map[string]*settingsmsg.Setting, len(settings))
+	for _, s := range settings {
+		if s.Id != "" {
+			byID[s.Id] = s
+		}
+	}
+	for _, s := range settings {
+		cond := s.VisibleWhen
+		if cond == nil {
+			continue
+		}
+		if cond.Value == nil {
+			return fmt.Errorf("the visibility condition of setting %s has no value", s.Name)
+		}
+		controlling, ok := byID[cond.SettingId]
+		if !ok {
+			return fmt.Errorf("setting %s referenced by the visibility condition of setting %s does not exist in the bundle", cond.SettingId, s.Name)
+		}
+		for steps := 0; controlling.VisibleWhen != nil; steps++ {
+			if controlling == s || steps > len(settings) {
+				return fmt.Errorf("the visibility conditions of setting %s form a cycle", s.Name)
+			}
+			if controlling, ok = byID[controlling.VisibleWhen.SettingId]; !ok {
+				break
+			}
+		}
+	}
+	return nil
+}
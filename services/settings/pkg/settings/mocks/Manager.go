@@ -5,6 +5,10 @@ package mocks
 import (
 	mock "github.com/stretchr/testify/mock"
 
+	settings "github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+
+	time "time"
+
 	v0 "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
 )
 
@@ -36,6 +40,66 @@ func (_m *Manager) AddSettingToBundle(bundleID string, setting *v0.Setting) (*v0
 	return r0, r1
 }
 
+// DeleteValue provides a mock function with given fields: valueID
+func (_m *Manager) DeleteValue(valueID string) error {
+	ret := _m.Called(valueID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(valueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListBundleIDsWithValues provides a mock function with given fields: accountUUID
+func (_m *Manager) ListBundleIDsWithValues(accountUUID string) ([]string, error) {
+	ret := _m.Called(accountUUID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(accountUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accountUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBundleValues provides a mock function with given fields: bundleID
+func (_m *Manager) ListBundleValues(bundleID string) ([]*v0.Value, error) {
+	ret := _m.Called(bundleID)
+
+	var r0 []*v0.Value
+	if rf, ok := ret.Get(0).(func(string) []*v0.Value); ok {
+		r0 = rf(bundleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v0.Value)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(bundleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBundles provides a mock function with given fields: bundleType, bundleIDs
 func (_m *Manager) ListBundles(bundleType v0.Bundle_Type, bundleIDs []string) ([]*v0.Bundle, error) {
 	ret := _m.Called(bundleType, bundleIDs)
@@ -59,6 +123,29 @@ func (_m *Manager) ListBundles(bundleType v0.Bundle_Type, bundleIDs []string) ([
 	return r0, r1
 }
 
+// ListChangedBundles provides a mock function with given fields: since
+func (_m *Manager) ListChangedBundles(since time.Time) ([]*v0.Bundle, error) {
+	ret := _m.Called(since)
+
+	var r0 []*v0.Bundle
+	if rf, ok := ret.Get(0).(func(time.Time) []*v0.Bundle); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v0.Bundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListPermissionsByResource provides a mock function with given fields: resource, roleIDs
 func (_m *Manager) ListPermissionsByResource(resource *v0.Resource, roleIDs []string) ([]*v0.Permission, error) {
 	ret := _m.Called(resource, roleIDs)
@@ -82,6 +169,29 @@ func (_m *Manager) ListPermissionsByResource(resource *v0.Resource, roleIDs []st
 	return r0, r1
 }
 
+// ListReadAuditEntries provides a mock function with given fields: bundleID
+func (_m *Manager) ListReadAuditEntries(bundleID string) ([]*v0.ReadAuditEntry, error) {
+	ret := _m.Called(bundleID)
+
+	var r0 []*v0.ReadAuditEntry
+	if rf, ok := ret.Get(0).(func(string) []*v0.ReadAuditEntry); ok {
+		r0 = rf(bundleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v0.ReadAuditEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(bundleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListRoleAssignments provides a mock function with given fields: accountUUID
 func (_m *Manager) ListRoleAssignments(accountUUID string) ([]*v0.UserRoleAssignment, error) {
 	ret := _m.Called(accountUUID)
@@ -105,6 +215,29 @@ func (_m *Manager) ListRoleAssignments(accountUUID string) ([]*v0.UserRoleAssign
 	return r0, r1
 }
 
+// ListValueHistory provides a mock function with given fields: valueID
+func (_m *Manager) ListValueHistory(valueID string) ([]*v0.ValueHistoryEntry, error) {
+	ret := _m.Called(valueID)
+
+	var r0 []*v0.ValueHistoryEntry
+	if rf, ok := ret.Get(0).(func(string) []*v0.ValueHistoryEntry); ok {
+		r0 = rf(valueID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v0.ValueHistoryEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(valueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListValues provides a mock function with given fields: bundleID, accountUUID
 func (_m *Manager) ListValues(bundleID string, accountUUID string) ([]*v0.Value, error) {
 	ret := _m.Called(bundleID, accountUUID)
@@ -151,6 +284,96 @@ func (_m *Manager) ReadBundle(bundleID string) (*v0.Bundle, error) {
 	return r0, r1
 }
 
+// ReadBundleByName provides a mock function with given fields: extension, name
+func (_m *Manager) ReadBundleByName(extension string, name string) (*v0.Bundle, error) {
+	ret := _m.Called(extension, name)
+
+	var r0 *v0.Bundle
+	if rf, ok := ret.Get(0).(func(string, string) *v0.Bundle); ok {
+		r0 = rf(extension, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.Bundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(extension, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadBundleBySetting provides a mock function with given fields: settingID
+func (_m *Manager) ReadBundleBySetting(settingID string) (*v0.Bundle, error) {
+	ret := _m.Called(settingID)
+
+	var r0 *v0.Bundle
+	if rf, ok := ret.Get(0).(func(string) *v0.Bundle); ok {
+		r0 = rf(settingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.Bundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(settingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadBundleVersion provides a mock function with given fields: bundleID, at
+func (_m *Manager) ReadBundleVersion(bundleID string, at time.Time) (*v0.Bundle, error) {
+	ret := _m.Called(bundleID, at)
+
+	var r0 *v0.Bundle
+	if rf, ok := ret.Get(0).(func(string, time.Time) *v0.Bundle); ok {
+		r0 = rf(bundleID, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.Bundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(bundleID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadMigrationVersion provides a mock function with given fields: bundleID
+func (_m *Manager) ReadMigrationVersion(bundleID string) (uint32, error) {
+	ret := _m.Called(bundleID)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(bundleID)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(bundleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadPermissionByID provides a mock function with given fields: permissionID, roleIDs
 func (_m *Manager) ReadPermissionByID(permissionID string, roleIDs []string) (*v0.Permission, error) {
 	ret := _m.Called(permissionID, roleIDs)
@@ -266,6 +489,29 @@ func (_m *Manager) ReadValueByUniqueIdentifiers(accountUUID string, settingID st
 	return r0, r1
 }
 
+// RebuildAssignmentIndex provides a mock function with given fields:
+func (_m *Manager) RebuildAssignmentIndex() (*settings.AssignmentIndexStats, error) {
+	ret := _m.Called()
+
+	var r0 *settings.AssignmentIndexStats
+	if rf, ok := ret.Get(0).(func() *settings.AssignmentIndexStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settings.AssignmentIndexStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveRoleAssignment provides a mock function with given fields: assignmentID
 func (_m *Manager) RemoveRoleAssignment(assignmentID string) error {
 	ret := _m.Called(assignmentID)
@@ -317,6 +563,43 @@ func (_m *Manager) WriteBundle(bundle *v0.Bundle) (*v0.Bundle, error) {
 	return r0, r1
 }
 
+// WriteMigrationVersion provides a mock function with given fields: bundleID, version
+func (_m *Manager) WriteMigrationVersion(bundleID string, version uint32) error {
+	ret := _m.Called(bundleID, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint32) error); ok {
+		r0 = rf(bundleID, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WriteReadAuditEntry provides a mock function with given fields: entry
+func (_m *Manager) WriteReadAuditEntry(entry *v0.ReadAuditEntry) (*v0.ReadAuditEntry, error) {
+	ret := _m.Called(entry)
+
+	var r0 *v0.ReadAuditEntry
+	if rf, ok := ret.Get(0).(func(*v0.ReadAuditEntry) *v0.ReadAuditEntry); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.ReadAuditEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v0.ReadAuditEntry) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WriteRoleAssignment provides a mock function with given fields: accountUUID, roleID
 func (_m *Manager) WriteRoleAssignment(accountUUID string, roleID string) (*v0.UserRoleAssignment, error) {
 	ret := _m.Called(accountUUID, roleID)
@@ -362,3 +645,26 @@ func (_m *Manager) WriteValue(value *v0.Value) (*v0.Value, error) {
 
 	return r0, r1
 }
+
+// WriteValueHistoryEntry provides a mock function with given fields: entry
+func (_m *Manager) WriteValueHistoryEntry(entry *v0.ValueHistoryEntry) (*v0.ValueHistoryEntry, error) {
+	ret := _m.Called(entry)
+
+	var r0 *v0.ValueHistoryEntry
+	if rf, ok := ret.Get(0).(func(*v0.ValueHistoryEntry) *v0.ValueHistoryEntry); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.ValueHistoryEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v0.ValueHistoryEntry) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -1,49 +1,135 @@
 package settings
 
 import (
+	"context"
 	"errors"
+	"strings"
+	"time"
 
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/config"
 )
 
+// SystemAccountUUID is the account of system values. A system value applies to all accounts without a value of their own.
+const SystemAccountUUID = "system"
+
+// GroupAccountPrefix is the prefix of the accounts of group values. A group value applies to the members of the
+// group without a value of their own.
+const GroupAccountPrefix = "group:"
+
+// GroupAccountUUID returns the account of the values of the group.
+func GroupAccountUUID(groupID string) string {
+	return GroupAccountPrefix + groupID
+}
+
+// IsGroupAccount checks if the account is the account of group values.
+func IsGroupAccount(accountUUID string) bool {
+	return strings.HasPrefix(accountUUID, GroupAccountPrefix)
+}
+
 var (
 	// Registry uses the strategy pattern as a registry
 	Registry = map[string]RegisterFunc{}
 
 	// ErrPermissionNotFound defines a new error for when a permission was not found
 	ErrPermissionNotFound = errors.New("permission not found")
+
+	// ErrBundleNotFound defines a new error for when a bundle was not found
+	ErrBundleNotFound = errors.New("bundle not found")
+
+	// ErrSettingNotFound defines a new error for when a setting was not found
+	ErrSettingNotFound = errors.New("setting not found")
+
+	// ErrBundleVersionNotFound defines a new error for when a bundle has no version saved at the requested time
+	ErrBundleVersionNotFound = errors.New("bundle version not found")
+
+	// OptionsProviders holds the providers for dynamic choice options, keyed by the name used as options_provider in the setting
+	OptionsProviders = map[string]OptionsProviderFunc{}
+
+	// ValueResolvers holds the resolvers of computed values, keyed by setting id
+	ValueResolvers = map[string]ValueResolverFunc{}
+
+	// Migrations holds the value migrations of bundles, keyed by bundle id
+	Migrations = map[string][]Migration{}
+
+	// ParentProviders holds the providers of the groups accounts inherit values from, keyed by the name configured as parent_provider
+	ParentProviders = map[string]ParentProviderFunc{}
 )
 
 // RegisterFunc stores store constructors
 type RegisterFunc func(*config.Config) Manager
 
+// OptionsProviderFunc resolves the options of a single or multi choice setting at runtime
+type OptionsProviderFunc func(ctx context.Context, setting *settingsmsg.Setting) ([]*settingsmsg.ListOption, error)
+
+// ValueResolverFunc computes the value of a setting for an account and resource at runtime
+type ValueResolverFunc func(ctx context.Context, accountUUID string, resource *settingsmsg.Resource, setting *settingsmsg.Setting) (*settingsmsg.Value, error)
+
+// ParentProviderFunc returns the groups an account inherits values from, in the order of precedence
+type ParentProviderFunc func(ctx context.Context, accountUUID string) ([]string, error)
+
+// MigrationFunc transforms a stored value to the given version of its bundle
+type MigrationFunc func(value *settingsmsg.Value, bundle *settingsmsg.Bundle) (*settingsmsg.Value, error)
+
+// Migration migrates the values of a bundle to the bundle version Version
+type Migration struct {
+	Version uint32
+	Migrate MigrationFunc
+}
+
 //go:generate mockery --name=Manager
 
 // Manager combines service interfaces for abstraction of storage implementations
 type Manager interface {
 	BundleManager
+	BundleHistoryManager
 	ValueManager
+	ValueHistoryManager
+	ReadAuditManager
 	RoleAssignmentManager
 	PermissionManager
+	MigrationManager
 }
 
 // BundleManager is a bundle service interface for abstraction of storage implementations
 type BundleManager interface {
 	ListBundles(bundleType settingsmsg.Bundle_Type, bundleIDs []string) ([]*settingsmsg.Bundle, error)
+	ListChangedBundles(since time.Time) ([]*settingsmsg.Bundle, error)
 	ReadBundle(bundleID string) (*settingsmsg.Bundle, error)
+	ReadBundleByName(extension, name string) (*settingsmsg.Bundle, error)
 	WriteBundle(bundle *settingsmsg.Bundle) (*settingsmsg.Bundle, error)
 	ReadSetting(settingID string) (*settingsmsg.Setting, error)
+	ReadBundleBySetting(settingID string) (*settingsmsg.Bundle, error)
 	AddSettingToBundle(bundleID string, setting *settingsmsg.Setting) (*settingsmsg.Setting, error)
 	RemoveSettingFromBundle(bundleID, settingID string) error
 }
 
+// BundleHistoryManager is a bundle history service interface for abstraction of storage implementations.
+// Every written bundle is kept in the history of its bundle.
+type BundleHistoryManager interface {
+	ReadBundleVersion(bundleID string, at time.Time) (*settingsmsg.Bundle, error)
+}
+
 // ValueManager is a value service interface for abstraction of storage implementations
 type ValueManager interface {
 	ListValues(bundleID, accountUUID string) ([]*settingsmsg.Value, error)
 	ReadValue(valueID string) (*settingsmsg.Value, error)
 	ReadValueByUniqueIdentifiers(accountUUID, settingID string) (*settingsmsg.Value, error)
 	WriteValue(value *settingsmsg.Value) (*settingsmsg.Value, error)
+	DeleteValue(valueID string) error
+	ListBundleIDsWithValues(accountUUID string) ([]string, error)
+}
+
+// ValueHistoryManager is a value history service interface for abstraction of storage implementations
+type ValueHistoryManager interface {
+	ListValueHistory(valueID string) ([]*settingsmsg.ValueHistoryEntry, error)
+	WriteValueHistoryEntry(entry *settingsmsg.ValueHistoryEntry) (*settingsmsg.ValueHistoryEntry, error)
+}
+
+// ReadAuditManager is a read audit service interface for abstraction of storage implementations
+type ReadAuditManager interface {
+	ListReadAuditEntries(bundleID string) ([]*settingsmsg.ReadAuditEntry, error)
+	WriteReadAuditEntry(entry *settingsmsg.ReadAuditEntry) (*settingsmsg.ReadAuditEntry, error)
 }
 
 // RoleAssignmentManager is a role assignment service interface for abstraction of storage implementations
@@ -51,6 +137,21 @@ type RoleAssignmentManager interface {
 	ListRoleAssignments(accountUUID string) ([]*settingsmsg.UserRoleAssignment, error)
 	WriteRoleAssignment(accountUUID, roleID string) (*settingsmsg.UserRoleAssignment, error)
 	RemoveRoleAssignment(assignmentID string) error
+	RebuildAssignmentIndex() (*AssignmentIndexStats, error)
+}
+
+// AssignmentIndexStats are the counts of a rebuild of the role assignment index
+type AssignmentIndexStats struct {
+	// Accounts is the number of accounts with role assignments
+	Accounts int
+	// Assignments is the number of role assignments in the rebuilt index
+	Assignments int
+	// Added is the number of assignments missing from the previous index
+	Added int
+	// Removed is the number of assignments in the previous index without a stored assignment
+	Removed int
+	// Invalid is the number of stored assignments that can't be read or are stored at the wrong location
+	Invalid int
 }
 
 // PermissionManager is a permissions service interface for abstraction of storage implementations
@@ -59,3 +160,10 @@ type PermissionManager interface {
 	ReadPermissionByID(permissionID string, roleIDs []string) (*settingsmsg.Permission, error)
 	ReadPermissionByName(name string, roleIDs []string) (*settingsmsg.Permission, error)
 }
+
+// MigrationManager is a migration service interface for abstraction of storage implementations
+type MigrationManager interface {
+	ListBundleValues(bundleID string) ([]*settingsmsg.Value, error)
+	ReadMigrationVersion(bundleID string) (uint32, error)
+	WriteMigrationVersion(bundleID string, version uint32) error
+}
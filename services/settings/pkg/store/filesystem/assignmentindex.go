@@ -0,0 +1,138 @@
+package store
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+)
+
+var (
+	// the index needs to be global as the `Service` (and therefore the `Store`) is instantiated twice (for grpc and http)
+	assignmentIndexes   = map[string]*assignmentIndex{}
+	assignmentIndexesMu sync.Mutex
+)
+
+// assignmentIndex maps the accounts to the ids of their role assignments, so listing the assignments of an account
+// doesn't need to parse every file in the assignments folder.
+type assignmentIndex struct {
+	mu        sync.RWMutex
+	built     bool
+	byAccount map[string]map[string]struct{}
+	accounts  map[string]string
+}
+
+// assignmentIndex returns the index of the role assignments in the data path of the store, it is built on first use.
+func (s Store) assignmentIndex() *assignmentIndex {
+	assignmentIndexesMu.Lock()
+	idx, ok := assignmentIndexes[s.dataPath]
+	if !ok {
+		idx = &assignmentIndex{}
+		assignmentIndexes[s.dataPath] = idx
+	}
+	assignmentIndexesMu.Unlock()
+
+	idx.mu.RLock()
+	built := idx.built
+	idx.mu.RUnlock()
+	if !built {
+		idx.mu.Lock()
+		if !idx.built {
+			s.scanRoleAssignments(idx)
+		}
+		idx.mu.Unlock()
+	}
+	return idx
+}
+
+// scanRoleAssignments replaces the content of the index with the assignment files. It returns the number of files
+// not containing a valid assignment. The caller must hold the write lock of the index.
+func (s Store) scanRoleAssignments(idx *assignmentIndex) int {
+	idx.byAccount = map[string]map[string]struct{}{}
+	idx.accounts = map[string]string{}
+	idx.built = true
+
+	folder := s.buildFolderPathForRoleAssignments(false)
+	files, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return 0
+	}
+	invalid := 0
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		record := settingsmsg.UserRoleAssignment{}
+		if err := s.parseRecordFromFile(&record, filepath.Join(folder, f.Name())); err != nil || record.AccountUuid == "" || record.Id+".json" != f.Name() {
+			s.Logger.Warn().Err(err).Str("file", f.Name()).Msg("ignoring invalid role assignment file")
+			invalid++
+			continue
+		}
+		idx.add(record.AccountUuid, record.Id)
+	}
+	return invalid
+}
+
+func (idx *assignmentIndex) add(accountUUID, assignmentID string) {
+	idx.remove(assignmentID)
+	ids, ok := idx.byAccount[accountUUID]
+	if !ok {
+		ids = map[string]struct{}{}
+		idx.byAccount[accountUUID] = ids
+	}
+	ids[assignmentID] = struct{}{}
+	idx.accounts[assignmentID] = accountUUID
+}
+
+func (idx *assignmentIndex) remove(assignmentID string) {
+	accountUUID, ok := idx.accounts[assignmentID]
+	if !ok {
+		return
+	}
+	delete(idx.accounts, assignmentID)
+	delete(idx.byAccount[accountUUID], assignmentID)
+	if len(idx.byAccount[accountUUID]) == 0 {
+		delete(idx.byAccount, accountUUID)
+	}
+}
+
+// assignmentIDs returns the ids of the assignments of the account.
+func (idx *assignmentIndex) assignmentIDs(accountUUID string) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	ids := make([]string, 0, len(idx.byAccount[accountUUID]))
+	for id := range idx.byAccount[accountUUID] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// RebuildAssignmentIndex scans the assignment files and replaces the index with their content. The writes of role
+// assignments update the index after their file was changed and wait for a running rebuild, so it is safe to rebuild
+// the index while the service is in use.
+func (s Store) RebuildAssignmentIndex() (*settings.AssignmentIndexStats, error) {
+	idx := s.assignmentIndex()
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	previous := idx.accounts
+	stats := &settings.AssignmentIndexStats{
+		Invalid: s.scanRoleAssignments(idx),
+	}
+	for id, account := range idx.accounts {
+		if previous[id] != account {
+			stats.Added++
+		}
+	}
+	for id, account := range previous {
+		if idx.accounts[id] != account {
+			stats.Removed++
+		}
+	}
+	stats.Accounts = len(idx.byAccount)
+	stats.Assignments = len(idx.accounts)
+	return stats, nil
+}
@@ -2,9 +2,8 @@
 package store
 
 import (
-	"io/ioutil"
 	"os"
-	"path/filepath"
+	"sort"
 
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
@@ -13,15 +12,13 @@ import (
 // ListRoleAssignments loads and returns all role assignments matching the given assignment identifier.
 func (s Store) ListRoleAssignments(accountUUID string) ([]*settingsmsg.UserRoleAssignment, error) {
 	var records []*settingsmsg.UserRoleAssignment
-	assignmentsFolder := s.buildFolderPathForRoleAssignments(false)
-	assignmentFiles, err := ioutil.ReadDir(assignmentsFolder)
-	if err != nil {
-		return records, nil
-	}
+	ids := s.assignmentIndex().assignmentIDs(accountUUID)
+	sort.Strings(ids)
 
-	for _, assignmentFile := range assignmentFiles {
+	for _, id := range ids {
 		record := settingsmsg.UserRoleAssignment{}
-		err = s.parseRecordFromFile(&record, filepath.Join(assignmentsFolder, assignmentFile.Name()))
+		// files removed behind the back of the store stay in the index until it is rebuilt
+		err := s.parseRecordFromFile(&record, s.buildFilePathForRoleAssignment(id, false))
 		if err == nil {
 			if record.AccountUuid == accountUUID {
 				records = append(records, &record)
@@ -44,6 +41,7 @@ func (s Store) WriteRoleAssignment(accountUUID, roleID string) (*settingsmsg.Use
 		if err := os.Remove(filePath); err != nil {
 			return nil, err
 		}
+		s.removeFromAssignmentIndex(list[0].Id)
 	}
 
 	assignment := &settingsmsg.UserRoleAssignment{
@@ -56,6 +54,11 @@ func (s Store) WriteRoleAssignment(accountUUID, roleID string) (*settingsmsg.Use
 		return nil, err
 	}
 
+	idx := s.assignmentIndex()
+	idx.mu.Lock()
+	idx.add(assignment.AccountUuid, assignment.Id)
+	idx.mu.Unlock()
+
 	s.Logger.Debug().Msgf("request contents written to file: %v", filePath)
 	return assignment, nil
 }
@@ -63,5 +66,16 @@ func (s Store) WriteRoleAssignment(accountUUID, roleID string) (*settingsmsg.Use
 // RemoveRoleAssignment deletes the given role assignment from the existing assignments of the respective account.
 func (s Store) RemoveRoleAssignment(assignmentID string) error {
 	filePath := s.buildFilePathForRoleAssignment(assignmentID, false)
-	return os.Remove(filePath)
+	if err := os.Remove(filePath); err != nil {
+		return err
+	}
+	s.removeFromAssignmentIndex(assignmentID)
+	return nil
+}
+
+func (s Store) removeFromAssignmentIndex(assignmentID string) {
+	idx := s.assignmentIndex()
+	idx.mu.Lock()
+	idx.remove(assignmentID)
+	idx.mu.Unlock()
 }
@@ -9,7 +9,9 @@ import (
 
 	olog "github.com/owncloud/ocis/v2/ocis-pkg/log"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -176,3 +178,50 @@ func TestDeleteAssignment(t *testing.T) {
 	}
 	burnRoot()
 }
+
+func TestRebuildAssignmentIndex(t *testing.T) {
+	st := Store{
+		dataPath: t.TempDir(),
+		Logger:   logger,
+	}
+	var (
+		marie   = "3c054db3-eec1-4ca4-b985-bc56dcf560cb"
+		richard = "932b4540-8d16-481e-8ef4-588e4b6b151c"
+		role    = "f36db5e6-a03c-40df-8413-711c67e40b47"
+	)
+
+	einsteinAssignment, err := st.WriteRoleAssignment(einstein, role)
+	require.NoError(t, err)
+	_, err = st.WriteRoleAssignment(marie, role)
+	require.NoError(t, err)
+
+	// change the assignments behind the back of the store
+	require.NoError(t, os.Remove(st.buildFilePathForRoleAssignment(einsteinAssignment.Id, false)))
+	richardAssignment := &settingsmsg.UserRoleAssignment{Id: "b8c4f3a1-0b5e-4d6f-9a1c-3e2d7f8a9b0c", AccountUuid: richard, RoleId: role}
+	require.NoError(t, st.writeRecordToFile(richardAssignment, st.buildFilePathForRoleAssignment(richardAssignment.Id, true)))
+	require.NoError(t, os.WriteFile(filepath.Join(st.buildFolderPathForRoleAssignments(false), "broken.json"), []byte("{"), 0600))
+
+	list, err := st.ListRoleAssignments(richard)
+	require.NoError(t, err)
+	assert.Len(t, list, 0)
+
+	stats, err := st.RebuildAssignmentIndex()
+	require.NoError(t, err)
+	assert.Equal(t, &settings.AssignmentIndexStats{Accounts: 2, Assignments: 2, Added: 1, Removed: 1, Invalid: 1}, stats)
+
+	list, err = st.ListRoleAssignments(einstein)
+	require.NoError(t, err)
+	assert.Len(t, list, 0)
+	list, err = st.ListRoleAssignments(marie)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+	list, err = st.ListRoleAssignments(richard)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, richardAssignment.Id, list[0].Id)
+
+	// nothing changed since the last rebuild
+	stats, err = st.RebuildAssignmentIndex()
+	require.NoError(t, err)
+	assert.Equal(t, &settings.AssignmentIndexStats{Accounts: 2, Assignments: 2, Invalid: 1}, stats)
+}
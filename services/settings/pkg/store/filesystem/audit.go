@@ -0,0 +1,53 @@
+// Package store implements the go-micro store interface
+package store
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+// ListReadAuditEntries returns all read audit entries of the given bundle, oldest first.
+func (s Store) ListReadAuditEntries(bundleID string) ([]*settingsmsg.ReadAuditEntry, error) {
+	auditFolder := s.buildFolderPathForReadAudit(bundleID, false)
+	entryFiles, err := ioutil.ReadDir(auditFolder)
+	if err != nil {
+		return []*settingsmsg.ReadAuditEntry{}, nil
+	}
+
+	records := make([]*settingsmsg.ReadAuditEntry, 0, len(entryFiles))
+	for _, entryFile := range entryFiles {
+		record := settingsmsg.ReadAuditEntry{}
+		err := s.parseRecordFromFile(&record, filepath.Join(auditFolder, entryFile.Name()))
+		if err != nil {
+			s.Logger.Warn().Msgf("error reading %v", entryFile)
+			continue
+		}
+		records = append(records, &record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].GetReadAt().AsTime().Before(records[j].GetReadAt().AsTime())
+	})
+	return records, nil
+}
+
+// WriteReadAuditEntry appends the given entry to the read audit of its bundle.
+func (s Store) WriteReadAuditEntry(entry *settingsmsg.ReadAuditEntry) (*settingsmsg.ReadAuditEntry, error) {
+	if entry.GetBundleId() == "" {
+		return nil, fmt.Errorf("audit entry without bundle id")
+	}
+	if entry.Id == "" {
+		entry.Id = uuid.Must(uuid.NewV4()).String()
+	}
+
+	filePath := s.buildFilePathForReadAuditEntry(entry.BundleId, entry.Id, true)
+	if err := s.writeRecordToFile(entry, filePath); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,234 @@
+package store
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	olog "github.com/owncloud/ocis/v2/ocis-pkg/log"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"google.golang.org/protobuf/proto"
+)
+
+// bundleCacheFileName is the name of the bundle cache file in the data path
+const bundleCacheFileName = "bundles.cache"
+
+// bundleCacheVersion is increased whenever the format of the cache file changes, older files are ignored
+const bundleCacheVersion = 1
+
+// bundleCacheFile is the gob encoded content of the cache file
+type bundleCacheFile struct {
+	Version int
+	Entries []bundleCacheEntry
+}
+
+// bundleCacheEntry is a bundle in the protobuf wire format together with the modification time and size of its file
+type bundleCacheEntry struct {
+	Name    string
+	ModTime int64
+	Size    int64
+	Bundle  []byte
+}
+
+// bundleCache keeps the parsed bundles in a binary file, so all bundles are loaded with one read on startup.
+// The cached bundles are only used while the modification time and size of their files didn't change. The file is
+// built on startup and rewritten when bundles are written, reading bundles never writes it.
+type bundleCache struct {
+	path   string
+	logger olog.Logger
+	// readOnly keeps the cache in memory only, the cache file is still loaded
+	readOnly bool
+
+	mu      sync.Mutex
+	loaded  bool
+	entries map[string]bundleCacheEntry
+}
+
+func newBundleCache(dataPath string, logger olog.Logger) *bundleCache {
+	return &bundleCache{
+		path:    filepath.Join(dataPath, bundleCacheFileName),
+		logger:  logger,
+		entries: map[string]bundleCacheEntry{},
+	}
+}
+
+// load reads the cache file, a missing, unreadable or outdated file leaves the cache empty.
+func (c *bundleCache) load() {
+	c.loaded = true
+	b, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			c.logger.Warn().Err(err).Str("path", c.path).Msg("could not read the bundle cache, parsing all bundles")
+		}
+		return
+	}
+	f := bundleCacheFile{}
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&f); err != nil || f.Version != bundleCacheVersion {
+		c.logger.Warn().Err(err).Str("path", c.path).Msg("ignoring the invalid bundle cache, parsing all bundles")
+		return
+	}
+	for _, e := range f.Entries {
+		c.entries[e.Name] = e
+	}
+}
+
+// save atomically replaces the cache file with the cached bundles.
+func (c *bundleCache) save() error {
+	f := bundleCacheFile{Version: bundleCacheVersion, Entries: make([]bundleCacheEntry, 0, len(c.entries))}
+	for _, e := range c.entries {
+		f.Entries = append(f.Entries, e)
+	}
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(f); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(c.path), bundleCacheFileName+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(buf.Bytes()); err == nil {
+		err = tmp.Close()
+	} else {
+		tmp.Close()
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path)
+}
+
+// init loads the cache file on startup and brings it up to date with the given bundle files, the cache file is
+// rewritten if bundles had to be parsed.
+func (c *bundleCache) init(files []os.FileInfo, parse func(name string) (*settingsmsg.Bundle, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	if _, changed := c.refresh(files, true, parse); changed {
+		c.persist()
+	}
+}
+
+// written updates the cached bundle of a file after the bundle was written and rewrites the cache file.
+func (c *bundleCache) written(fi os.FileInfo, bundle *settingsmsg.Bundle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.loaded {
+		c.load()
+	}
+	b, err := proto.Marshal(bundle)
+	if err != nil {
+		delete(c.entries, fi.Name())
+	} else {
+		c.entries[fi.Name()] = bundleCacheEntry{Name: fi.Name(), ModTime: fi.ModTime().UnixNano(), Size: fi.Size(), Bundle: b}
+	}
+	c.persist()
+}
+
+// persist writes the cache file unless the store is read-only, failures are logged, the bundles are parsed from
+// their files then.
+func (c *bundleCache) persist() {
+	if c.readOnly {
+		return
+	}
+	if err := c.save(); err != nil {
+		c.logger.Warn().Err(err).Str("path", c.path).Msg("could not write the bundle cache")
+	}
+}
+
+// bundles returns the bundles of the given files. Files which are not cached or changed since they were cached
+// are parsed with parse and only cached in memory, the cache file is not written. Files which can't be parsed
+// are skipped. If complete is set the files are all bundle files, the entries of other files are removed from
+// the cache.
+func (c *bundleCache) bundles(files []os.FileInfo, complete bool, parse func(name string) (*settingsmsg.Bundle, error)) []*settingsmsg.Bundle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.loaded {
+		c.load()
+	}
+	bundles, _ := c.refresh(files, complete, parse)
+	return bundles
+}
+
+// refresh returns the bundles of the given files like bundles and reports if the cached entries changed.
+func (c *bundleCache) refresh(files []os.FileInfo, complete bool, parse func(name string) (*settingsmsg.Bundle, error)) ([]*settingsmsg.Bundle, bool) {
+	changed := false
+	bundles := make([]*settingsmsg.Bundle, 0, len(files))
+	for _, fi := range files {
+		if e, ok := c.entries[fi.Name()]; ok && e.ModTime == fi.ModTime().UnixNano() && e.Size == fi.Size() {
+			bundle := &settingsmsg.Bundle{}
+			if err := proto.Unmarshal(e.Bundle, bundle); err == nil {
+				bundles = append(bundles, bundle)
+				continue
+			}
+		}
+
+		changed = true
+		bundle, err := parse(fi.Name())
+		if err != nil {
+			c.logger.Warn().Msgf("error reading %v", fi)
+			delete(c.entries, fi.Name())
+			continue
+		}
+		bundles = append(bundles, bundle)
+		b, err := proto.Marshal(bundle)
+		if err != nil {
+			delete(c.entries, fi.Name())
+			continue
+		}
+		c.entries[fi.Name()] = bundleCacheEntry{Name: fi.Name(), ModTime: fi.ModTime().UnixNano(), Size: fi.Size(), Bundle: b}
+	}
+
+	if complete && len(c.entries) > len(files) {
+		listed := make(map[string]struct{}, len(files))
+		for _, fi := range files {
+			listed[fi.Name()] = struct{}{}
+		}
+		for name := range c.entries {
+			if _, ok := listed[name]; !ok {
+				delete(c.entries, name)
+				changed = true
+			}
+		}
+	}
+
+	return bundles, changed
+}
+
+// initBundleCache loads the bundle cache and caches the bundles which are missing in it on startup.
+func (s Store) initBundleCache() {
+	files, err := ioutil.ReadDir(s.buildFolderPathForBundles(false))
+	if err != nil && !os.IsNotExist(err) {
+		s.Logger.Warn().Err(err).Msg("could not list the bundles for the bundle cache")
+		return
+	}
+	s.bundleCache.init(files, s.parseBundleFile)
+}
+
+// parseBundleFile parses the bundle of the given file in the bundle folder.
+func (s Store) parseBundleFile(name string) (*settingsmsg.Bundle, error) {
+	record := &settingsmsg.Bundle{}
+	return record, s.parseRecordFromFile(record, filepath.Join(s.buildFolderPathForBundles(false), name))
+}
+
+// readBundleFiles returns the bundles of the given files in the bundle folder, using the bundle cache if it is enabled.
+func (s Store) readBundleFiles(files []os.FileInfo, complete bool) []*settingsmsg.Bundle {
+	if s.bundleCache != nil {
+		return s.bundleCache.bundles(files, complete, s.parseBundleFile)
+	}
+
+	bundles := make([]*settingsmsg.Bundle, 0, len(files))
+	for _, fi := range files {
+		record, err := s.parseBundleFile(fi.Name())
+		if err != nil {
+			s.Logger.Warn().Msgf("error reading %v", fi)
+			continue
+		}
+		bundles = append(bundles, record)
+	}
+	return bundles
+}
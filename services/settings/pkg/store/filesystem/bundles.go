@@ -4,12 +4,15 @@ package store
 import (
 	"fmt"
 	"io/ioutil"
-	"path/filepath"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/store/errortypes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var m = &sync.RWMutex{}
@@ -27,25 +30,48 @@ func (s Store) ListBundles(bundleType settingsmsg.Bundle_Type, bundleIDs []strin
 	}
 
 	records := make([]*settingsmsg.Bundle, 0, len(bundleFiles))
-	for _, bundleFile := range bundleFiles {
-		record := settingsmsg.Bundle{}
-		err = s.parseRecordFromFile(&record, filepath.Join(bundlesFolder, bundleFile.Name()))
-		if err != nil {
-			s.Logger.Warn().Msgf("error reading %v", bundleFile)
-			continue
-		}
+	for _, record := range s.readBundleFiles(bundleFiles, true) {
 		if record.Type != bundleType {
 			continue
 		}
 		if len(bundleIDs) > 0 && !containsStr(record.Id, bundleIDs) {
 			continue
 		}
-		records = append(records, &record)
+		records = append(records, record)
 	}
 
 	return records, nil
 }
 
+// ListChangedBundles returns the bundles saved after the given time, all bundles are returned for the zero time.
+// The filesystem store doesn't maintain an index, the files written after the time are read.
+func (s Store) ListChangedBundles(since time.Time) ([]*settingsmsg.Bundle, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	bundlesFolder := s.buildFolderPathForBundles(false)
+	bundleFiles, err := ioutil.ReadDir(bundlesFolder)
+	if err != nil {
+		return []*settingsmsg.Bundle{}, nil
+	}
+
+	changedFiles := bundleFiles[:0]
+	for _, bundleFile := range bundleFiles {
+		// a file is written after the updated_at time of its bundle was set
+		if since.IsZero() || bundleFile.ModTime().After(since) {
+			changedFiles = append(changedFiles, bundleFile)
+		}
+	}
+
+	records := make([]*settingsmsg.Bundle, 0, len(changedFiles))
+	for _, record := range s.readBundleFiles(changedFiles, since.IsZero()) {
+		if since.IsZero() || (record.UpdatedAt != nil && record.UpdatedAt.AsTime().After(since)) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
 // containsStr checks if the strs slice contains str
 func containsStr(str string, strs []string) bool {
 	for _, s := range strs {
@@ -71,6 +97,23 @@ func (s Store) ReadBundle(bundleID string) (*settingsmsg.Bundle, error) {
 	return &record, nil
 }
 
+// ReadBundleByName tries to find a bundle by the given extension and name within the dataPath.
+// The filesystem store doesn't maintain an index, all bundles are scanned.
+func (s Store) ReadBundleByName(extension, name string) (*settingsmsg.Bundle, error) {
+	for _, t := range []settingsmsg.Bundle_Type{settingsmsg.Bundle_TYPE_DEFAULT, settingsmsg.Bundle_TYPE_ROLE} {
+		bundles, err := s.ListBundles(t, []string{})
+		if err != nil {
+			return nil, err
+		}
+		for _, bundle := range bundles {
+			if bundle.Extension == extension && bundle.Name == name {
+				return bundle, nil
+			}
+		}
+	}
+	return nil, settings.ErrBundleNotFound
+}
+
 // ReadSetting tries to find a setting by the given id within the dataPath.
 func (s Store) ReadSetting(settingID string) (*settingsmsg.Setting, error) {
 	m.RLock()
@@ -90,6 +133,23 @@ func (s Store) ReadSetting(settingID string) (*settingsmsg.Setting, error) {
 	return nil, fmt.Errorf("could not read setting: %v", settingID)
 }
 
+// ReadBundleBySetting tries to find the bundle containing the setting with the given id within the dataPath.
+// The filesystem store doesn't maintain an index, all bundles are scanned.
+func (s Store) ReadBundleBySetting(settingID string) (*settingsmsg.Bundle, error) {
+	for _, t := range []settingsmsg.Bundle_Type{settingsmsg.Bundle_TYPE_DEFAULT, settingsmsg.Bundle_TYPE_ROLE} {
+		bundles, err := s.ListBundles(t, []string{})
+		if err != nil {
+			return nil, err
+		}
+		for _, bundle := range bundles {
+			if indexOfSetting(bundle, settingID) != -1 {
+				return bundle, nil
+			}
+		}
+	}
+	return nil, settings.ErrSettingNotFound
+}
+
 // WriteBundle writes the given record into a file within the dataPath.
 func (s Store) WriteBundle(record *settingsmsg.Bundle) (*settingsmsg.Bundle, error) {
 	// FIXME: locking should happen on the file here, not globally.
@@ -98,10 +158,19 @@ func (s Store) WriteBundle(record *settingsmsg.Bundle) (*settingsmsg.Bundle, err
 	if record.Id == "" {
 		record.Id = uuid.Must(uuid.NewV4()).String()
 	}
+	record.UpdatedAt = timestamppb.Now()
 	filePath := s.buildFilePathForBundle(record.Id, true)
 	if err := s.writeRecordToFile(record, filePath); err != nil {
 		return nil, err
 	}
+	if err := s.writeRecordToFile(record, s.buildFilePathForBundleVersion(record.Id, record.UpdatedAt.AsTime(), true)); err != nil {
+		return nil, err
+	}
+	if s.bundleCache != nil {
+		if fi, err := os.Stat(filePath); err == nil {
+			s.bundleCache.written(fi, record)
+		}
+	}
 
 	s.Logger.Debug().Msgf("request contents written to file: %v", filePath)
 	return record, nil
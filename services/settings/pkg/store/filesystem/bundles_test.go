@@ -1,11 +1,17 @@
 package store
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	olog "github.com/owncloud/ocis/v2/ocis-pkg/log"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var bundleScenarios = []struct {
@@ -153,3 +159,141 @@ func TestBundles(t *testing.T) {
 
 	burnRoot()
 }
+
+func TestListChangedBundles(t *testing.T) {
+	s := Store{dataPath: t.TempDir(), Logger: logger}
+	first, err := s.WriteBundle(&settingsmsg.Bundle{Type: settingsmsg.Bundle_TYPE_DEFAULT, DisplayName: "first"})
+	require.NoError(t, err)
+	second, err := s.WriteBundle(&settingsmsg.Bundle{Type: settingsmsg.Bundle_TYPE_ROLE, DisplayName: "second"})
+	require.NoError(t, err)
+
+	all, err := s.ListChangedBundles(time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	changed, err := s.ListChangedBundles(first.UpdatedAt.AsTime())
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	assert.Equal(t, second.Id, changed[0].Id)
+
+	changed, err = s.ListChangedBundles(second.UpdatedAt.AsTime())
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+}
+
+func TestBundleCache(t *testing.T) {
+	dir := t.TempDir()
+	cachedStore := func() Store {
+		return Store{dataPath: dir, Logger: logger, bundleCache: newBundleCache(dir, logger)}
+	}
+	listNames := func(s Store) []string {
+		bundles, err := s.ListBundles(settingsmsg.Bundle_TYPE_DEFAULT, []string{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, b := range bundles {
+			names = append(names, b.DisplayName)
+		}
+		return names
+	}
+	cacheFile := filepath.Join(dir, bundleCacheFileName)
+
+	s := cachedStore()
+	bundle, err := s.WriteBundle(&settingsmsg.Bundle{Type: settingsmsg.Bundle_TYPE_DEFAULT, DisplayName: "cached"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cached"}, listNames(s))
+	assert.FileExists(t, cacheFile)
+
+	t.Run("cache hit", func(t *testing.T) {
+		// the bundle file is broken without changing its size and modification time, only the cache can provide it
+		bundleFile := s.buildFilePathForBundle(bundle.Id, false)
+		fi, err := os.Stat(bundleFile)
+		require.NoError(t, err)
+		original, err := os.ReadFile(bundleFile)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(bundleFile, bytes.Repeat([]byte("x"), len(original)), 0600))
+		require.NoError(t, os.Chtimes(bundleFile, fi.ModTime(), fi.ModTime()))
+
+		assert.Equal(t, []string{"cached"}, listNames(cachedStore()))
+
+		require.NoError(t, os.WriteFile(bundleFile, original, 0600))
+		require.NoError(t, os.Chtimes(bundleFile, fi.ModTime(), fi.ModTime()))
+	})
+
+	t.Run("stale cache", func(t *testing.T) {
+		bundle.DisplayName = "changed"
+		_, err := s.WriteBundle(bundle)
+		require.NoError(t, err)
+		// make sure the modification time differs on filesystems with a coarse resolution
+		bundleFile := s.buildFilePathForBundle(bundle.Id, false)
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(bundleFile, later, later))
+
+		assert.Equal(t, []string{"changed"}, listNames(cachedStore()))
+	})
+
+	t.Run("missing cache", func(t *testing.T) {
+		require.NoError(t, os.Remove(cacheFile))
+		// reading the bundles doesn't write the cache, it is built on startup
+		assert.Equal(t, []string{"changed"}, listNames(cachedStore()))
+		assert.NoFileExists(t, cacheFile)
+
+		started := cachedStore()
+		started.initBundleCache()
+		assert.FileExists(t, cacheFile)
+		assert.Equal(t, []string{"changed"}, listNames(started))
+	})
+
+	t.Run("invalid cache", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(cacheFile, []byte("garbage"), 0600))
+		assert.Equal(t, []string{"changed"}, listNames(cachedStore()))
+		data, err := os.ReadFile(cacheFile)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("garbage"), data)
+
+		started := cachedStore()
+		started.initBundleCache()
+		data, err = os.ReadFile(cacheFile)
+		require.NoError(t, err)
+		assert.NotEqual(t, []byte("garbage"), data)
+		assert.Equal(t, []string{"changed"}, listNames(started))
+	})
+
+	t.Run("written bundles", func(t *testing.T) {
+		before, err := os.ReadFile(cacheFile)
+		require.NoError(t, err)
+		bundle.DisplayName = "written"
+		_, err = s.WriteBundle(bundle)
+		require.NoError(t, err)
+		after, err := os.ReadFile(cacheFile)
+		require.NoError(t, err)
+		assert.NotEqual(t, before, after)
+		assert.Equal(t, []string{"written"}, listNames(cachedStore()))
+	})
+}
+
+func TestReadBundleVersion(t *testing.T) {
+	s := Store{dataPath: t.TempDir(), Logger: logger}
+	bundle, err := s.WriteBundle(&settingsmsg.Bundle{Type: settingsmsg.Bundle_TYPE_DEFAULT, DisplayName: "first"})
+	require.NoError(t, err)
+	firstSaved := bundle.UpdatedAt.AsTime()
+	bundle.DisplayName = "second"
+	bundle, err = s.WriteBundle(bundle)
+	require.NoError(t, err)
+	secondSaved := bundle.UpdatedAt.AsTime()
+
+	for at, name := range map[time.Time]string{
+		firstSaved:                        "first",
+		secondSaved.Add(-time.Nanosecond): "first",
+		secondSaved:                       "second",
+		secondSaved.Add(time.Hour):        "second",
+	} {
+		version, err := s.ReadBundleVersion(bundle.Id, at)
+		require.NoError(t, err)
+		assert.Equal(t, name, version.DisplayName)
+	}
+
+	_, err = s.ReadBundleVersion(bundle.Id, firstSaved.Add(-time.Nanosecond))
+	assert.ErrorIs(t, err, settings.ErrBundleVersionNotFound)
+	_, err = s.ReadBundleVersion("unknown", secondSaved)
+	assert.ErrorIs(t, err, settings.ErrBundleVersionNotFound)
+}
@@ -0,0 +1,91 @@
+// Package store implements the go-micro store interface
+package store
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+)
+
+// ListValueHistory returns all history entries of the given value, oldest first.
+func (s Store) ListValueHistory(valueID string) ([]*settingsmsg.ValueHistoryEntry, error) {
+	historyFolder := s.buildFolderPathForValueHistory(valueID, false)
+	entryFiles, err := ioutil.ReadDir(historyFolder)
+	if err != nil {
+		return []*settingsmsg.ValueHistoryEntry{}, nil
+	}
+
+	records := make([]*settingsmsg.ValueHistoryEntry, 0, len(entryFiles))
+	for _, entryFile := range entryFiles {
+		record := settingsmsg.ValueHistoryEntry{}
+		err := s.parseRecordFromFile(&record, filepath.Join(historyFolder, entryFile.Name()))
+		if err != nil {
+			s.Logger.Warn().Msgf("error reading %v", entryFile)
+			continue
+		}
+		records = append(records, &record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].GetChangedAt().AsTime().Before(records[j].GetChangedAt().AsTime())
+	})
+	return records, nil
+}
+
+// WriteValueHistoryEntry appends the given entry to the history of its value.
+func (s Store) WriteValueHistoryEntry(entry *settingsmsg.ValueHistoryEntry) (*settingsmsg.ValueHistoryEntry, error) {
+	valueID := entry.GetValue().GetId()
+	if valueID == "" {
+		return nil, fmt.Errorf("history entry without value id")
+	}
+	if entry.Id == "" {
+		entry.Id = uuid.Must(uuid.NewV4()).String()
+	}
+
+	filePath := s.buildFilePathForValueHistoryEntry(valueID, entry.Id, true)
+	if err := s.writeRecordToFile(entry, filePath); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ReadBundleVersion returns the bundle as it was saved at the given time, i.e. the last version saved before or at it.
+func (s Store) ReadBundleVersion(bundleID string, at time.Time) (*settingsmsg.Bundle, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	historyFolder := s.buildFolderPathForBundleHistory(bundleID, false)
+	versionFiles, err := ioutil.ReadDir(historyFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s at %s", settings.ErrBundleVersionNotFound, bundleID, at)
+	}
+
+	// the file names are the zero padded save times in nanoseconds
+	version := ""
+	for _, versionFile := range versionFiles {
+		savedAt, err := strconv.ParseInt(strings.TrimSuffix(versionFile.Name(), ".json"), 10, 64)
+		if err != nil || savedAt > at.UnixNano() {
+			continue
+		}
+		if versionFile.Name() > version {
+			version = versionFile.Name()
+		}
+	}
+	if version == "" {
+		return nil, fmt.Errorf("%w: %s at %s", settings.ErrBundleVersionNotFound, bundleID, at)
+	}
+
+	record := settingsmsg.Bundle{}
+	if err := s.parseRecordFromFile(&record, filepath.Join(historyFolder, version)); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
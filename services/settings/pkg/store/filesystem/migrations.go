@@ -0,0 +1,69 @@
+// Package store implements the go-micro store interface
+package store
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+// migrationState records the bundle version the values of a bundle have been migrated to
+type migrationState struct {
+	BundleID string `json:"bundle_id"`
+	Version  uint32 `json:"version"`
+}
+
+// ListBundleValues reads all values of the given bundle, regardless of the account they belong to.
+func (s Store) ListBundleValues(bundleID string) ([]*settingsmsg.Value, error) {
+	valuesFolder := s.buildFolderPathForValues(false)
+	valueFiles, err := ioutil.ReadDir(valuesFolder)
+	if err != nil {
+		return []*settingsmsg.Value{}, nil
+	}
+
+	records := make([]*settingsmsg.Value, 0, len(valueFiles))
+	for _, valueFile := range valueFiles {
+		record := settingsmsg.Value{}
+		err := s.parseRecordFromFile(&record, filepath.Join(valuesFolder, valueFile.Name()))
+		if err != nil {
+			s.Logger.Warn().Msgf("error reading %v", valueFile)
+			continue
+		}
+		if record.BundleId != bundleID {
+			continue
+		}
+		records = append(records, &record)
+	}
+
+	return records, nil
+}
+
+// ReadMigrationVersion returns the bundle version the values of the given bundle have been migrated to.
+// It returns 0 if no migration has been applied yet.
+func (s Store) ReadMigrationVersion(bundleID string) (uint32, error) {
+	b, err := ioutil.ReadFile(s.buildFilePathForMigration(bundleID, false))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	state := migrationState{}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return 0, err
+	}
+	return state.Version, nil
+}
+
+// WriteMigrationVersion records the bundle version the values of the given bundle have been migrated to.
+func (s Store) WriteMigrationVersion(bundleID string, version uint32) error {
+	b, err := json.Marshal(migrationState{BundleID: bundleID, Version: version})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(s.buildFilePathForMigration(bundleID, true), b, 0600)
+}
@@ -1,13 +1,19 @@
 package store
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 const folderNameBundles = "bundles"
 const folderNameValues = "values"
 const folderNameAssignments = "assignments"
+const folderNameHistory = "history"
+const folderNameBundleHistory = "bundlehistory"
+const folderNameAudit = "audit"
+const folderNameMigrations = "migrations"
 
 // buildFolderPathForBundles builds the folder path for storing settings bundles. If mkdir is true, folders in the path will be created if necessary.
 func (s Store) buildFolderPathForBundles(mkdir bool) string {
@@ -54,6 +60,60 @@ func (s Store) buildFilePathForRoleAssignment(assignmentID string, mkdir bool) s
 	return filepath.Join(roleAssignmentsFolder, assignmentID+".json")
 }
 
+// buildFolderPathForValueHistory builds the folder path for storing the history of a settings value. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFolderPathForValueHistory(valueID string, mkdir bool) string {
+	folderPath := filepath.Join(s.dataPath, folderNameHistory, valueID)
+	if mkdir {
+		s.ensureFolderExists(folderPath)
+	}
+	return folderPath
+}
+
+// buildFilePathForValueHistoryEntry builds a unique file name from the given params. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFilePathForValueHistoryEntry(valueID, entryID string, mkdir bool) string {
+	historyFolder := s.buildFolderPathForValueHistory(valueID, mkdir)
+	return filepath.Join(historyFolder, entryID+".json")
+}
+
+// buildFolderPathForBundleHistory builds the folder path for storing the saved versions of a settings bundle. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFolderPathForBundleHistory(bundleID string, mkdir bool) string {
+	folderPath := filepath.Join(s.dataPath, folderNameBundleHistory, bundleID)
+	if mkdir {
+		s.ensureFolderExists(folderPath)
+	}
+	return folderPath
+}
+
+// buildFilePathForBundleVersion builds the file name of the bundle version saved at the given time. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFilePathForBundleVersion(bundleID string, savedAt time.Time, mkdir bool) string {
+	historyFolder := s.buildFolderPathForBundleHistory(bundleID, mkdir)
+	return filepath.Join(historyFolder, fmt.Sprintf("%020d.json", savedAt.UnixNano()))
+}
+
+// buildFolderPathForReadAudit builds the folder path for storing the read audit entries of a bundle. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFolderPathForReadAudit(bundleID string, mkdir bool) string {
+	folderPath := filepath.Join(s.dataPath, folderNameAudit, bundleID)
+	if mkdir {
+		s.ensureFolderExists(folderPath)
+	}
+	return folderPath
+}
+
+// buildFilePathForReadAuditEntry builds a unique file name from the given params. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFilePathForReadAuditEntry(bundleID, entryID string, mkdir bool) string {
+	auditFolder := s.buildFolderPathForReadAudit(bundleID, mkdir)
+	return filepath.Join(auditFolder, entryID+".json")
+}
+
+// buildFilePathForMigration builds the file path for storing the migration state of a bundle. If mkdir is true, folders in the path will be created if necessary.
+func (s Store) buildFilePathForMigration(bundleID string, mkdir bool) string {
+	folderPath := filepath.Join(s.dataPath, folderNameMigrations)
+	if mkdir {
+		s.ensureFolderExists(folderPath)
+	}
+	return filepath.Join(folderPath, bundleID+".json")
+}
+
 // ensureFolderExists checks if the given path is an existing folder and creates one if not existing
 func (s Store) ensureFolderExists(path string) {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
@@ -19,6 +19,9 @@ var (
 type Store struct {
 	dataPath string
 	Logger   olog.Logger
+
+	// bundleCache is nil if the bundle cache is disabled
+	bundleCache *bundleCache
 }
 
 // New creates a new store
@@ -42,6 +45,11 @@ func New(cfg *config.Config) settings.Manager {
 	}
 
 	s.dataPath = cfg.DataPath
+	if cfg.BundleCache {
+		s.bundleCache = newBundleCache(cfg.DataPath, s.Logger)
+		s.bundleCache.readOnly = cfg.ReadOnly
+		s.initBundleCache()
+	}
 	return &s
 }
 
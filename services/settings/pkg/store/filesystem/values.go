@@ -4,7 +4,9 @@ package store
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
@@ -46,6 +48,26 @@ func (s Store) ListValues(bundleID, accountUUID string) ([]*settingsmsg.Value, e
 	return records, nil
 }
 
+// ListBundleIDsWithValues returns the ids of the bundles the account has stored values in.
+// The filesystem store has no index, all values are read.
+func (s Store) ListBundleIDsWithValues(accountUUID string) ([]string, error) {
+	values, err := s.ListValues("", accountUUID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(values))
+	bundleIDs := make([]string, 0, len(values))
+	for _, v := range values {
+		if v.AccountUuid != accountUUID || seen[v.BundleId] {
+			continue
+		}
+		seen[v.BundleId] = true
+		bundleIDs = append(bundleIDs, v.BundleId)
+	}
+	sort.Strings(bundleIDs)
+	return bundleIDs, nil
+}
+
 // ReadValue tries to find a value by the given valueId within the dataPath
 func (s Store) ReadValue(valueID string) (*settingsmsg.Value, error) {
 	filePath := s.buildFilePathForValue(valueID, false)
@@ -107,3 +129,11 @@ func (s Store) WriteValue(value *settingsmsg.Value) (*settingsmsg.Value, error)
 	}
 	return value, nil
 }
+
+// DeleteValue deletes the value with the given valueId together with its history
+func (s Store) DeleteValue(valueID string) error {
+	if err := os.RemoveAll(s.buildFolderPathForValueHistory(valueID, false)); err != nil {
+		return err
+	}
+	return os.Remove(s.buildFilePathForValue(valueID, false))
+}
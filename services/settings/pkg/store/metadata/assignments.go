@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/cs3org/reva/v2/pkg/errtypes"
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 )
 
 // ListRoleAssignments loads and returns all role assignments matching the given assignment identifier.
@@ -16,6 +18,9 @@ func (s *Store) ListRoleAssignments(accountUUID string) ([]*settingsmsg.UserRole
 	ctx := context.TODO()
 	assIDs, err := s.mdc.ReadDir(ctx, accountPath(accountUUID))
 	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return []*settingsmsg.UserRoleAssignment{}, nil
+		}
 		return nil, err
 	}
 
@@ -88,6 +93,88 @@ func (s *Store) RemoveRoleAssignment(assignmentID string) error {
 	return fmt.Errorf("assignmentID '%s' not found", assignmentID)
 }
 
+// RebuildAssignmentIndex drops the cached listings and assignments of the accounts folder and reads them again from
+// the metadata storage. The counts compare the listings cached before with the stored assignments.
+func (s *Store) RebuildAssignmentIndex() (*settings.AssignmentIndexStats, error) {
+	s.Init()
+	ctx := context.TODO()
+	previous, err := s.listAssignmentIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := s.mdc.(*CachedMDC); ok {
+		_ = removePrefix(c.dirs, accountsFolderLocation)
+		_ = removePrefix(c.files, accountsFolderLocation)
+	}
+	current, err := s.listAssignmentIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &settings.AssignmentIndexStats{}
+	for accID, assIDs := range current {
+		for _, assID := range assIDs {
+			b, err := s.mdc.SimpleDownload(ctx, assignmentPath(accID, assID))
+			if err != nil {
+				return nil, err
+			}
+			a := &settingsmsg.UserRoleAssignment{}
+			if err := json.Unmarshal(b, a); err != nil || a.Id != assID || a.AccountUuid != accID {
+				s.Logger.Warn().Err(err).Str("account", accID).Str("assignment", assID).Msg("invalid role assignment")
+				stats.Invalid++
+				continue
+			}
+			stats.Assignments++
+			if !contains(previous[accID], assID) {
+				stats.Added++
+			}
+		}
+		if len(assIDs) > 0 {
+			stats.Accounts++
+		}
+	}
+	for accID, assIDs := range previous {
+		for _, assID := range assIDs {
+			if !contains(current[accID], assID) {
+				stats.Removed++
+			}
+		}
+	}
+	return stats, nil
+}
+
+// listAssignmentIDs returns the ids of the assignments by account.
+func (s *Store) listAssignmentIDs(ctx context.Context) (map[string][]string, error) {
+	accounts, err := s.mdc.ReadDir(ctx, accountsFolderLocation)
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return map[string][]string{}, nil
+		}
+		return nil, err
+	}
+	ids := make(map[string][]string, len(accounts))
+	for _, accID := range accounts {
+		assIDs, err := s.mdc.ReadDir(ctx, accountPath(accID))
+		if err != nil {
+			if _, ok := err.(errtypes.NotFound); ok {
+				continue
+			}
+			return nil, err
+		}
+		ids[accID] = assIDs
+	}
+	return ids, nil
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
 func accountPath(accountUUID string) string {
 	return fmt.Sprintf("%s/%s", accountsFolderLocation, accountUUID)
 }
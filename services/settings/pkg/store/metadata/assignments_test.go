@@ -1,6 +1,8 @@
 package store
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"sync"
 	"testing"
@@ -10,6 +12,7 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/stretchr/testify/require"
 )
 
@@ -193,3 +196,51 @@ func TestDeleteAssignment(t *testing.T) {
 		})
 	}
 }
+
+func TestRebuildAssignmentIndex(t *testing.T) {
+	var (
+		marie   = "3c054db3-eec1-4ca4-b985-bc56dcf560cb"
+		feynman = "9d9a4d4b-c4d7-4ce4-a1b6-7e5bd45a0b1f"
+		role    = "f36db5e6-a03c-40df-8413-711c67e40b47"
+	)
+	cfg := *s.cfg
+	cfg.AdminUserID = uuid.Must(uuid.NewV4()).String()
+	mdc := &MockedMetadataClient{data: make(map[string][]byte)}
+	st := &Store{
+		Logger: logger,
+		cfg:    &cfg,
+		l:      &sync.Mutex{},
+	}
+	require.NoError(t, st.initMetadataClient(&CachedMDC{next: mdc}))
+
+	einsteinAssignment, err := st.WriteRoleAssignment(einstein, role)
+	require.NoError(t, err)
+	_, err = st.WriteRoleAssignment(marie, role)
+	require.NoError(t, err)
+	stats, err := st.RebuildAssignmentIndex()
+	require.NoError(t, err)
+	// the default assignments of six accounts are written on initialization
+	require.Equal(t, &settings.AssignmentIndexStats{Accounts: 8, Assignments: 8}, stats)
+
+	// change the assignments behind the back of the cache
+	require.NoError(t, mdc.Delete(context.Background(), assignmentPath(einstein, einsteinAssignment.Id)))
+	b, err := json.Marshal(&settingsmsg.UserRoleAssignment{Id: "b8c4f3a1-0b5e-4d6f-9a1c-3e2d7f8a9b0c", AccountUuid: feynman, RoleId: role})
+	require.NoError(t, err)
+	require.NoError(t, mdc.SimpleUpload(context.Background(), assignmentPath(feynman, "b8c4f3a1-0b5e-4d6f-9a1c-3e2d7f8a9b0c"), b))
+	require.NoError(t, mdc.SimpleUpload(context.Background(), assignmentPath(feynman, "broken"), []byte("{")))
+
+	list, err := st.ListRoleAssignments(einstein)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	stats, err = st.RebuildAssignmentIndex()
+	require.NoError(t, err)
+	require.Equal(t, &settings.AssignmentIndexStats{Accounts: 8, Assignments: 8, Added: 1, Removed: 1, Invalid: 1}, stats)
+
+	list, err = st.ListRoleAssignments(einstein)
+	require.NoError(t, err)
+	require.Len(t, list, 0)
+	list, err = st.ListRoleAssignments(marie)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
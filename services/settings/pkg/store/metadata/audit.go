@@ -0,0 +1,80 @@
+// Package store implements the go-micro store interface
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/gofrs/uuid"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+// ListReadAuditEntries returns all read audit entries of the given bundle, oldest first.
+func (s *Store) ListReadAuditEntries(bundleID string) ([]*settingsmsg.ReadAuditEntry, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	eIDs, err := s.mdc.ReadDir(ctx, auditPath(bundleID))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return []*settingsmsg.ReadAuditEntry{}, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]*settingsmsg.ReadAuditEntry, 0, len(eIDs))
+	for _, eID := range eIDs {
+		b, err := s.mdc.SimpleDownload(ctx, auditEntryPath(bundleID, eID))
+		if err != nil {
+			return nil, err
+		}
+
+		e := &settingsmsg.ReadAuditEntry{}
+		err = json.Unmarshal(b, e)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].GetReadAt().AsTime().Before(entries[j].GetReadAt().AsTime())
+	})
+	return entries, nil
+}
+
+// WriteReadAuditEntry appends the given entry to the read audit of its bundle.
+func (s *Store) WriteReadAuditEntry(entry *settingsmsg.ReadAuditEntry) (*settingsmsg.ReadAuditEntry, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	bundleID := entry.GetBundleId()
+	if bundleID == "" {
+		return nil, fmt.Errorf("audit entry without bundle id")
+	}
+
+	err := s.mdc.MakeDirIfNotExist(ctx, auditPath(bundleID))
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Id == "" {
+		entry.Id = uuid.Must(uuid.NewV4()).String()
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, s.mdc.SimpleUpload(ctx, auditEntryPath(bundleID, entry.Id), b)
+}
+
+func auditPath(bundleID string) string {
+	return fmt.Sprintf("%s/%s", auditFolderLocation, bundleID)
+}
+
+func auditEntryPath(bundleID, entryID string) string {
+	return fmt.Sprintf("%s/%s/%s", auditFolderLocation, bundleID, entryID)
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReadAudit(t *testing.T) {
+	bundleID := "b3f6c6a4-4a1e-4c4b-9a8e-6c1f3c2e5d7a"
+	now := time.Now()
+
+	// write the newer entry first to make sure entries are sorted by read time
+	_, err := s.WriteReadAuditEntry(&settingsmsg.ReadAuditEntry{
+		BundleId:    bundleID,
+		ValueId:     "0fa5e6b1-7f0a-4b8e-9d5c-3e2a1b0c9d8e",
+		Operation:   "GetValue",
+		AccountUuid: accountUUID1,
+		ReadAt:      timestamppb.New(now),
+	})
+	require.NoError(t, err)
+	e, err := s.WriteReadAuditEntry(&settingsmsg.ReadAuditEntry{
+		BundleId:    bundleID,
+		Operation:   "GetBundle",
+		AccountUuid: accountUUID1,
+		ReadAt:      timestamppb.New(now.Add(-time.Minute)),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, e.Id)
+
+	entries, err := s.ListReadAuditEntries(bundleID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "GetBundle", entries[0].Operation)
+	require.Equal(t, "GetValue", entries[1].Operation)
+	require.Equal(t, accountUUID1, entries[0].AccountUuid)
+
+	entries, err = s.ListReadAuditEntries("6d1c0b4e-2f7a-4e3b-8c9d-5a4b3c2d1e0f")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	_, err = s.WriteReadAuditEntry(&settingsmsg.ReadAuditEntry{Operation: "GetBundle"})
+	require.Error(t, err)
+}
@@ -6,10 +6,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/cs3org/reva/v2/pkg/errtypes"
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/owncloud/ocis/v2/services/settings/pkg/store/defaults"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ListBundles returns all bundles in the dataPath folder that match the given type.
@@ -66,6 +72,36 @@ func (s *Store) ReadBundle(bundleID string) (*settingsmsg.Bundle, error) {
 	return bundle, json.Unmarshal(b, bundle)
 }
 
+// ReadBundleByName tries to find a bundle by the given extension and name using the name index
+func (s *Store) ReadBundleByName(extension, name string) (*settingsmsg.Bundle, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	id, err := s.mdc.SimpleDownload(ctx, bundleNamePath(extension, name))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return nil, settings.ErrBundleNotFound
+		}
+		return nil, err
+	}
+	if len(id) == 0 {
+		return nil, settings.ErrBundleNotFound
+	}
+
+	bundle, err := s.ReadBundle(string(id))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return nil, settings.ErrBundleNotFound
+		}
+		return nil, err
+	}
+	// the bundle might have been renamed since it was indexed
+	if bundle.Extension != extension || bundle.Name != name {
+		return nil, settings.ErrBundleNotFound
+	}
+	return bundle, nil
+}
+
 // ReadSetting tries to find a setting by the given id from the metadata service
 func (s *Store) ReadSetting(settingID string) (*settingsmsg.Setting, error) {
 	s.Init()
@@ -93,16 +129,110 @@ func (s *Store) ReadSetting(settingID string) (*settingsmsg.Setting, error) {
 	return nil, fmt.Errorf("setting '%s' not found", settingID)
 }
 
+// ReadBundleBySetting tries to find the bundle containing the setting with the given id using the setting index
+func (s *Store) ReadBundleBySetting(settingID string) (*settingsmsg.Bundle, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	id, err := s.mdc.SimpleDownload(ctx, settingBundlePath(settingID))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return nil, settings.ErrSettingNotFound
+		}
+		return nil, err
+	}
+	if len(id) == 0 {
+		return nil, settings.ErrSettingNotFound
+	}
+
+	bundle, err := s.ReadBundle(string(id))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return nil, settings.ErrSettingNotFound
+		}
+		return nil, err
+	}
+	// the setting might have been removed from the bundle since it was indexed
+	for _, setting := range bundle.Settings {
+		if setting.Id == settingID {
+			return bundle, nil
+		}
+	}
+	return nil, settings.ErrSettingNotFound
+}
+
 // WriteBundle sends the givens record to the metadataclient. returns `record` for legacy reasons
 func (s *Store) WriteBundle(record *settingsmsg.Bundle) (*settingsmsg.Bundle, error) {
 	s.Init()
 	ctx := context.TODO()
 
+	record.UpdatedAt = timestamppb.Now()
 	b, err := json.Marshal(record)
 	if err != nil {
 		return nil, err
 	}
-	return record, s.mdc.SimpleUpload(ctx, bundlePath(record.Id), b)
+	if err := s.mdc.SimpleUpload(ctx, bundlePath(record.Id), b); err != nil {
+		return nil, err
+	}
+	if err := writeBundleName(ctx, s.mdc, record); err != nil {
+		return nil, err
+	}
+	if err := writeSettingBundles(ctx, s.mdc, record); err != nil {
+		return nil, err
+	}
+	if err := writeBundleVersion(ctx, s.mdc, record, b); err != nil {
+		return nil, err
+	}
+	return record, writeBundleChange(ctx, s.mdc, record)
+}
+
+// ListChangedBundles returns the bundles saved after the given time using the change index. All bundles are
+// returned for the zero time, bundles written before the change index was introduced are only part of those.
+func (s *Store) ListChangedBundles(since time.Time) ([]*settingsmsg.Bundle, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	var bundleIDs []string
+	if since.IsZero() {
+		ids, err := s.mdc.ReadDir(ctx, bundleFolderLocation)
+		if err != nil {
+			return nil, err
+		}
+		bundleIDs = ids
+	} else {
+		changes, err := s.mdc.ReadDir(ctx, bundleChangesFolderLocation)
+		if err != nil {
+			if _, ok := err.(errtypes.NotFound); ok {
+				return []*settingsmsg.Bundle{}, nil
+			}
+			return nil, err
+		}
+		// the change time is part of the entry name, only the changed bundles have to be read
+		for _, change := range changes {
+			changedAt, id, ok := parseBundleChange(change)
+			if ok && changedAt > since.UnixNano() {
+				bundleIDs = append(bundleIDs, id)
+			}
+		}
+	}
+
+	bundles := make([]*settingsmsg.Bundle, 0, len(bundleIDs))
+	seen := make(map[string]struct{}, len(bundleIDs))
+	for _, id := range bundleIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		bundle, err := s.ReadBundle(id)
+		if err != nil {
+			if _, ok := err.(errtypes.NotFound); ok {
+				continue
+			}
+			return nil, err
+		}
+		bundles = append(bundles, bundle)
+	}
+	return bundles, nil
 }
 
 // AddSettingToBundle adds the given setting to the bundle with the given bundleID.
@@ -131,10 +261,80 @@ func (s *Store) RemoveSettingFromBundle(bundleID string, settingID string) error
 	return errors.New("not implemented")
 }
 
+// writeBundleName points the name index entry of the bundle to its id
+func writeBundleName(ctx context.Context, mdc MetadataClient, bundle *settingsmsg.Bundle) error {
+	if bundle.Extension == "" || bundle.Name == "" {
+		return nil
+	}
+	return mdc.SimpleUpload(ctx, bundleNamePath(bundle.Extension, bundle.Name), []byte(bundle.Id))
+}
+
+// writeBundleChange replaces the change index entry of the bundle with one for its current updated_at time
+func writeBundleChange(ctx context.Context, mdc MetadataClient, bundle *settingsmsg.Bundle) error {
+	current := bundleChangeName(bundle)
+	changes, err := mdc.ReadDir(ctx, bundleChangesFolderLocation)
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); !ok {
+			return err
+		}
+	}
+	if err := mdc.SimpleUpload(ctx, bundleChangesFolderLocation+"/"+current, []byte(bundle.Id)); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if _, id, ok := parseBundleChange(change); ok && id == bundle.Id && change != current {
+			if err := mdc.Delete(ctx, bundleChangesFolderLocation+"/"+change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bundleChangeName returns the name of the change index entry of the bundle, the zero padded updated_at time
+// in nanoseconds followed by the bundle id.
+func bundleChangeName(bundle *settingsmsg.Bundle) string {
+	return fmt.Sprintf("%020d.%s", bundle.GetUpdatedAt().AsTime().UnixNano(), bundle.Id)
+}
+
+func parseBundleChange(name string) (int64, string, bool) {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 {
+		return 0, "", false
+	}
+	changedAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return changedAt, parts[1], true
+}
+
+// writeSettingBundles points the setting index entries of the settings of the bundle to its id
+func writeSettingBundles(ctx context.Context, mdc MetadataClient, bundle *settingsmsg.Bundle) error {
+	for _, setting := range bundle.Settings {
+		if setting.Id == "" {
+			continue
+		}
+		if err := mdc.SimpleUpload(ctx, settingBundlePath(setting.Id), []byte(bundle.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func bundlePath(id string) string {
 	return fmt.Sprintf("%s/%s", bundleFolderLocation, id)
 }
 
+// bundleNamePath returns the path of the name index entry. Extensions and names can't contain dots.
+func bundleNamePath(extension, name string) string {
+	return fmt.Sprintf("%s/%s.%s", bundleNamesFolderLocation, extension, name)
+}
+
+func settingBundlePath(settingID string) string {
+	return fmt.Sprintf("%s/%s", settingBundlesFolderLocation, settingID)
+}
+
 func defaultBundle(bundleType settingsmsg.Bundle_Type, bundleID string) []*settingsmsg.Bundle {
 	var bundles []*settingsmsg.Bundle
 	for _, b := range defaults.GenerateBundlesDefaultRoles() {
@@ -1,10 +1,14 @@
 package store
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
 	"github.com/stretchr/testify/require"
 )
 
@@ -206,3 +210,205 @@ func TestAppendSetting(t *testing.T) {
 	require.Len(t, b.Settings, 2)
 
 }
+
+func TestReadBundleByName(t *testing.T) {
+	b := &settingsmsg.Bundle{
+		Id:          bundle3,
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Extension:   extension1,
+		Name:        "named",
+		DisplayName: "named bundle",
+	}
+	_, err := s.WriteBundle(b)
+	require.NoError(t, err)
+
+	found, err := s.ReadBundleByName(extension1, "named")
+	require.NoError(t, err)
+	require.Equal(t, bundle3, found.Id)
+
+	_, err = s.ReadBundleByName(extension2, "named")
+	require.ErrorIs(t, err, settings.ErrBundleNotFound)
+
+	// a renamed bundle is no longer found by its old name
+	b.Name = "renamed"
+	_, err = s.WriteBundle(b)
+	require.NoError(t, err)
+	_, err = s.ReadBundleByName(extension1, "named")
+	require.ErrorIs(t, err, settings.ErrBundleNotFound)
+	found, err = s.ReadBundleByName(extension1, "renamed")
+	require.NoError(t, err)
+	require.Equal(t, bundle3, found.Id)
+}
+
+func TestReadBundleBySetting(t *testing.T) {
+	settingID := uuid.Must(uuid.NewV4()).String()
+	b := &settingsmsg.Bundle{
+		Id:          uuid.Must(uuid.NewV4()).String(),
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Extension:   extension1,
+		DisplayName: "indexed",
+		Settings:    []*settingsmsg.Setting{{Id: settingID, Name: "indexed"}},
+	}
+	_, err := s.WriteBundle(b)
+	require.NoError(t, err)
+
+	found, err := s.ReadBundleBySetting(settingID)
+	require.NoError(t, err)
+	require.Equal(t, b.Id, found.Id)
+
+	_, err = s.ReadBundleBySetting(uuid.Must(uuid.NewV4()).String())
+	require.ErrorIs(t, err, settings.ErrSettingNotFound)
+
+	// a setting moved to another bundle is found in that bundle
+	other := &settingsmsg.Bundle{
+		Id:          uuid.Must(uuid.NewV4()).String(),
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Extension:   extension1,
+		DisplayName: "other",
+		Settings:    b.Settings,
+	}
+	b.Settings = nil
+	_, err = s.WriteBundle(b)
+	require.NoError(t, err)
+	_, err = s.ReadBundleBySetting(settingID)
+	require.ErrorIs(t, err, settings.ErrSettingNotFound)
+	_, err = s.WriteBundle(other)
+	require.NoError(t, err)
+	found, err = s.ReadBundleBySetting(settingID)
+	require.NoError(t, err)
+	require.Equal(t, other.Id, found.Id)
+
+	// bundles written by older versions are indexed on startup
+	legacyID, legacySettingID := uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String()
+	legacy, err := json.Marshal(&settingsmsg.Bundle{
+		Id:       legacyID,
+		Type:     settingsmsg.Bundle_TYPE_DEFAULT,
+		Settings: []*settingsmsg.Setting{{Id: legacySettingID}},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, s.mdc.SimpleUpload(ctx, bundlePath(legacyID), legacy))
+	_, err = s.ReadBundleBySetting(legacySettingID)
+	require.ErrorIs(t, err, settings.ErrSettingNotFound)
+	require.NoError(t, indexBundles(ctx, s.mdc))
+	found, err = s.ReadBundleBySetting(legacySettingID)
+	require.NoError(t, err)
+	require.Equal(t, legacyID, found.Id)
+}
+
+func TestBundleGroups(t *testing.T) {
+	b := &settingsmsg.Bundle{
+		Id:          uuid.Must(uuid.NewV4()).String(),
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Extension:   extension1,
+		Name:        "grouped",
+		DisplayName: "grouped",
+		Groups: []*settingsmsg.SettingGroup{
+			{Id: "appearance", DisplayName: "Appearance", Description: "How things look", Order: 2},
+			{Id: "notifications", DisplayName: "Notifications", Order: 1},
+		},
+		Settings: []*settingsmsg.Setting{
+			{Id: uuid.Must(uuid.NewV4()).String(), Name: "theme", GroupId: "appearance"},
+			{Id: uuid.Must(uuid.NewV4()).String(), Name: "ungrouped"},
+		},
+	}
+	_, err := s.WriteBundle(b)
+	require.NoError(t, err)
+
+	bundle, err := s.ReadBundle(b.Id)
+	require.NoError(t, err)
+	require.Equal(t, b.Groups, bundle.Groups)
+	require.Equal(t, "appearance", bundle.Settings[0].GroupId)
+	require.Empty(t, bundle.Settings[1].GroupId)
+}
+
+func TestBundleTags(t *testing.T) {
+	b := &settingsmsg.Bundle{
+		Id:          uuid.Must(uuid.NewV4()).String(),
+		Type:        settingsmsg.Bundle_TYPE_DEFAULT,
+		Extension:   extension1,
+		Name:        "tagged",
+		DisplayName: "tagged",
+		Tags:        []string{"security", "experimental"},
+		Settings: []*settingsmsg.Setting{
+			{Id: uuid.Must(uuid.NewV4()).String(), Name: "setting"},
+		},
+	}
+	_, err := s.WriteBundle(b)
+	require.NoError(t, err)
+
+	bundle, err := s.ReadBundle(b.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"security", "experimental"}, bundle.Tags)
+
+	bundles, err := s.ListBundles(settingsmsg.Bundle_TYPE_DEFAULT, []string{b.Id})
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	require.Equal(t, b.Tags, bundles[0].Tags)
+}
+
+func TestListChangedBundles(t *testing.T) {
+	first, err := s.WriteBundle(&settingsmsg.Bundle{Id: uuid.Must(uuid.NewV4()).String(), Type: settingsmsg.Bundle_TYPE_DEFAULT, DisplayName: "first"})
+	require.NoError(t, err)
+	second, err := s.WriteBundle(&settingsmsg.Bundle{Id: uuid.Must(uuid.NewV4()).String(), Type: settingsmsg.Bundle_TYPE_ROLE, DisplayName: "second"})
+	require.NoError(t, err)
+
+	changed, err := s.ListChangedBundles(first.UpdatedAt.AsTime())
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.Equal(t, second.Id, changed[0].Id)
+
+	// saving a bundle again replaces its change index entry
+	first, err = s.WriteBundle(first)
+	require.NoError(t, err)
+	changed, err = s.ListChangedBundles(second.UpdatedAt.AsTime())
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.Equal(t, first.Id, changed[0].Id)
+	entries := 0
+	changes, err := s.mdc.ReadDir(context.Background(), bundleChangesFolderLocation)
+	require.NoError(t, err)
+	for _, change := range changes {
+		if _, id, ok := parseBundleChange(change); ok && id == first.Id {
+			entries++
+		}
+	}
+	require.Equal(t, 1, entries)
+
+	changed, err = s.ListChangedBundles(first.UpdatedAt.AsTime())
+	require.NoError(t, err)
+	require.Empty(t, changed)
+
+	all, err := s.ListChangedBundles(time.Time{})
+	require.NoError(t, err)
+	ids := make([]string, 0, len(all))
+	for _, b := range all {
+		ids = append(ids, b.Id)
+	}
+	require.Contains(t, ids, first.Id)
+	require.Contains(t, ids, second.Id)
+}
+
+func TestReadBundleVersion(t *testing.T) {
+	bundle, err := s.WriteBundle(&settingsmsg.Bundle{Id: uuid.Must(uuid.NewV4()).String(), Type: settingsmsg.Bundle_TYPE_DEFAULT, DisplayName: "first"})
+	require.NoError(t, err)
+	firstSaved := bundle.UpdatedAt.AsTime()
+	bundle.DisplayName = "second"
+	bundle, err = s.WriteBundle(bundle)
+	require.NoError(t, err)
+	secondSaved := bundle.UpdatedAt.AsTime()
+
+	for at, name := range map[time.Time]string{
+		firstSaved:                        "first",
+		secondSaved.Add(-time.Nanosecond): "first",
+		secondSaved:                       "second",
+		secondSaved.Add(time.Hour):        "second",
+	} {
+		version, err := s.ReadBundleVersion(bundle.Id, at)
+		require.NoError(t, err)
+		require.Equal(t, name, version.DisplayName)
+	}
+
+	_, err = s.ReadBundleVersion(bundle.Id, firstSaved.Add(-time.Nanosecond))
+	require.ErrorIs(t, err, settings.ErrBundleVersionNotFound)
+}
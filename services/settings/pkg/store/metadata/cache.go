@@ -67,6 +67,7 @@ func (c *CachedMDC) Delete(ctx context.Context, id string) error {
 	// invalidate caches
 	_ = removePrefix(c.files, id)
 	_ = removePrefix(c.dirs, id)
+	_ = c.dirs.Remove(path.Dir(id))
 	return nil
 }
 
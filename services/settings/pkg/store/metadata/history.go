@@ -0,0 +1,130 @@
+// Package store implements the go-micro store interface
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/gofrs/uuid"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/owncloud/ocis/v2/services/settings/pkg/settings"
+)
+
+// ListValueHistory returns all history entries of the given value, oldest first.
+func (s *Store) ListValueHistory(valueID string) ([]*settingsmsg.ValueHistoryEntry, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	eIDs, err := s.mdc.ReadDir(ctx, historyPath(valueID))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return []*settingsmsg.ValueHistoryEntry{}, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]*settingsmsg.ValueHistoryEntry, 0, len(eIDs))
+	for _, eID := range eIDs {
+		b, err := s.mdc.SimpleDownload(ctx, historyEntryPath(valueID, eID))
+		if err != nil {
+			return nil, err
+		}
+
+		e := &settingsmsg.ValueHistoryEntry{}
+		err = json.Unmarshal(b, e)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].GetChangedAt().AsTime().Before(entries[j].GetChangedAt().AsTime())
+	})
+	return entries, nil
+}
+
+// WriteValueHistoryEntry appends the given entry to the history of its value.
+func (s *Store) WriteValueHistoryEntry(entry *settingsmsg.ValueHistoryEntry) (*settingsmsg.ValueHistoryEntry, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	valueID := entry.GetValue().GetId()
+	if valueID == "" {
+		return nil, fmt.Errorf("history entry without value id")
+	}
+
+	err := s.mdc.MakeDirIfNotExist(ctx, historyPath(valueID))
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Id == "" {
+		entry.Id = uuid.Must(uuid.NewV4()).String()
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, s.mdc.SimpleUpload(ctx, historyEntryPath(valueID, entry.Id), b)
+}
+
+// ReadBundleVersion returns the bundle as it was saved at the given time, i.e. the last version saved before or at it.
+func (s *Store) ReadBundleVersion(bundleID string, at time.Time) (*settingsmsg.Bundle, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	versions, err := s.mdc.ReadDir(ctx, bundleHistoryPath(bundleID))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); !ok {
+			return nil, err
+		}
+	}
+
+	// the entry names are the zero padded save times in nanoseconds
+	version := ""
+	for _, v := range versions {
+		savedAt, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || savedAt > at.UnixNano() {
+			continue
+		}
+		if v > version {
+			version = v
+		}
+	}
+	if version == "" {
+		return nil, fmt.Errorf("%w: %s at %s", settings.ErrBundleVersionNotFound, bundleID, at)
+	}
+
+	b, err := s.mdc.SimpleDownload(ctx, bundleHistoryPath(bundleID)+"/"+version)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &settingsmsg.Bundle{}
+	return bundle, json.Unmarshal(b, bundle)
+}
+
+// writeBundleVersion adds the marshalled bundle to its history under its updated_at time
+func writeBundleVersion(ctx context.Context, mdc MetadataClient, bundle *settingsmsg.Bundle, b []byte) error {
+	if err := mdc.MakeDirIfNotExist(ctx, bundleHistoryPath(bundle.Id)); err != nil {
+		return err
+	}
+	return mdc.SimpleUpload(ctx, fmt.Sprintf("%s/%020d", bundleHistoryPath(bundle.Id), bundle.GetUpdatedAt().AsTime().UnixNano()), b)
+}
+
+func bundleHistoryPath(bundleID string) string {
+	return fmt.Sprintf("%s/%s", bundleHistoryFolderLocation, bundleID)
+}
+
+func historyPath(valueID string) string {
+	return fmt.Sprintf("%s/%s", historyFolderLocation, valueID)
+}
+
+func historyEntryPath(valueID, entryID string) string {
+	return fmt.Sprintf("%s/%s/%s", historyFolderLocation, valueID, entryID)
+}
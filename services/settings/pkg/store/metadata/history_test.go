@@ -0,0 +1,42 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValueHistory(t *testing.T) {
+	value := valueScenarios[0].value
+	now := time.Now()
+
+	// write the newer entry first to make sure entries are sorted by change time
+	_, err := s.WriteValueHistoryEntry(&settingsmsg.ValueHistoryEntry{
+		Value:     value,
+		Comment:   "second change",
+		ChangedBy: accountUUID1,
+		ChangedAt: timestamppb.New(now),
+	})
+	require.NoError(t, err)
+	e, err := s.WriteValueHistoryEntry(&settingsmsg.ValueHistoryEntry{
+		Value:     value,
+		Comment:   "first change",
+		ChangedBy: accountUUID1,
+		ChangedAt: timestamppb.New(now.Add(-time.Minute)),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, e.Id)
+
+	entries, err := s.ListValueHistory(value.Id)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "first change", entries[0].Comment)
+	require.Equal(t, "second change", entries[1].Comment)
+	require.Equal(t, value.Id, entries[0].Value.Id)
+
+	_, err = s.WriteValueHistoryEntry(&settingsmsg.ValueHistoryEntry{Comment: "no value"})
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+// Package store implements the go-micro store interface
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
+)
+
+// migrationState records the bundle version the values of a bundle have been migrated to
+type migrationState struct {
+	BundleID string `json:"bundle_id"`
+	Version  uint32 `json:"version"`
+}
+
+// ListBundleValues reads all values of the given bundle, regardless of the account they belong to.
+func (s *Store) ListBundleValues(bundleID string) ([]*settingsmsg.Value, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	vIDs, err := s.mdc.ReadDir(ctx, valuesFolderLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []*settingsmsg.Value
+	for _, vid := range vIDs {
+		b, err := s.mdc.SimpleDownload(ctx, valuePath(vid))
+		if err != nil {
+			return nil, err
+		}
+
+		v := &settingsmsg.Value{}
+		err = json.Unmarshal(b, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.BundleId == bundleID {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// ReadMigrationVersion returns the bundle version the values of the given bundle have been migrated to.
+// It returns 0 if no migration has been applied yet.
+func (s *Store) ReadMigrationVersion(bundleID string) (uint32, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	b, err := s.mdc.SimpleDownload(ctx, migrationPath(bundleID))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	state := &migrationState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return 0, err
+	}
+	return state.Version, nil
+}
+
+// WriteMigrationVersion records the bundle version the values of the given bundle have been migrated to.
+func (s *Store) WriteMigrationVersion(bundleID string, version uint32) error {
+	s.Init()
+	ctx := context.TODO()
+
+	b, err := json.Marshal(migrationState{BundleID: bundleID, Version: version})
+	if err != nil {
+		return err
+	}
+	return s.mdc.SimpleUpload(ctx, migrationPath(bundleID), b)
+}
+
+func migrationPath(bundleID string) string {
+	return fmt.Sprintf("%s/%s", migrationsFolderLocation, bundleID)
+}
@@ -0,0 +1,20 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationVersion(t *testing.T) {
+	bundleID := "d7a4c2a1-75a8-4d21-9a3c-bd1b0e6e4b2d"
+
+	v, err := s.ReadMigrationVersion(bundleID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), v)
+
+	require.NoError(t, s.WriteMigrationVersion(bundleID, 3))
+	v, err = s.ReadMigrationVersion(bundleID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), v)
+}
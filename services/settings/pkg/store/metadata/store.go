@@ -7,6 +7,7 @@ import (
 	"log"
 	"sync"
 
+	"github.com/cs3org/reva/v2/pkg/errtypes"
 	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
 	"github.com/gofrs/uuid"
 	olog "github.com/owncloud/ocis/v2/ocis-pkg/log"
@@ -18,13 +19,21 @@ import (
 
 var (
 	// Name is the default name for the settings store
-	Name                   = "ocis-settings"
-	managerName            = "metadata"
-	settingsSpaceID        = "f1bdd61a-da7c-49fc-8203-0558109d1b4f" // uuid.Must(uuid.NewV4()).String()
-	rootFolderLocation     = "settings"
-	bundleFolderLocation   = "settings/bundles"
-	accountsFolderLocation = "settings/accounts"
-	valuesFolderLocation   = "settings/values"
+	Name                         = "ocis-settings"
+	managerName                  = "metadata"
+	settingsSpaceID              = "f1bdd61a-da7c-49fc-8203-0558109d1b4f" // uuid.Must(uuid.NewV4()).String()
+	rootFolderLocation           = "settings"
+	bundleFolderLocation         = "settings/bundles"
+	bundleNamesFolderLocation    = "settings/bundlenames"
+	settingBundlesFolderLocation = "settings/settingbundles"
+	bundleChangesFolderLocation  = "settings/bundlechanges"
+	bundleHistoryFolderLocation  = "settings/bundlehistory"
+	accountsFolderLocation       = "settings/accounts"
+	valuesFolderLocation         = "settings/values"
+	valueIndexFolderLocation     = "settings/accountvalues"
+	historyFolderLocation        = "settings/history"
+	auditFolderLocation          = "settings/audit"
+	migrationsFolderLocation     = "settings/migrations"
 )
 
 // MetadataClient is the interface to talk to metadata service
@@ -99,12 +108,29 @@ func (s *Store) initMetadataClient(mdc MetadataClient) error {
 	if err != nil {
 		return err
 	}
+	// the folders, default bundles and indices of a read-only replica are written by the writable instance
+	if s.cfg.ReadOnly {
+		s.mdc = mdc
+		return nil
+	}
+
+	// values written by older versions have to be added to the index of values per account
+	_, err = mdc.ReadDir(ctx, valueIndexFolderLocation)
+	_, missingValueIndex := err.(errtypes.NotFound)
 
 	for _, p := range []string{
 		rootFolderLocation,
 		accountsFolderLocation,
 		bundleFolderLocation,
+		bundleNamesFolderLocation,
+		settingBundlesFolderLocation,
+		bundleChangesFolderLocation,
+		bundleHistoryFolderLocation,
 		valuesFolderLocation,
+		valueIndexFolderLocation,
+		historyFolderLocation,
+		auditFolderLocation,
+		migrationsFolderLocation,
 	} {
 		err = mdc.MakeDirIfNotExist(ctx, p)
 		if err != nil {
@@ -146,10 +172,45 @@ func (s *Store) initMetadataClient(mdc MetadataClient) error {
 		}
 	}
 
+	// bundles written by older versions are missing in the name and setting indices
+	if err := indexBundles(ctx, mdc); err != nil {
+		return err
+	}
+	if missingValueIndex {
+		if err := indexValues(ctx, mdc); err != nil {
+			return err
+		}
+	}
+
 	s.mdc = mdc
 	return nil
 }
 
+// indexBundles adds all stored bundles to the name and setting indices
+func indexBundles(ctx context.Context, mdc MetadataClient) error {
+	bIDs, err := mdc.ReadDir(ctx, bundleFolderLocation)
+	if err != nil {
+		return err
+	}
+	for _, id := range bIDs {
+		b, err := mdc.SimpleDownload(ctx, bundlePath(id))
+		if err != nil {
+			return err
+		}
+		bundle := &settingsmsg.Bundle{}
+		if err := json.Unmarshal(b, bundle); err != nil {
+			return err
+		}
+		if err := writeBundleName(ctx, mdc, bundle); err != nil {
+			return err
+		}
+		if err := writeSettingBundles(ctx, mdc, bundle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func init() {
 	settings.Registry[managerName] = New
 }
@@ -3,8 +3,11 @@ package store
 import (
 	"context"
 	"strings"
+	"sync"
+	"testing"
 
 	"github.com/owncloud/ocis/v2/services/settings/pkg/config/defaults"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -108,3 +111,18 @@ func (m *MockedMetadataClient) IDExists(id string) bool {
 func (m *MockedMetadataClient) IDHasContent(id string, content []byte) bool {
 	return string(m.data[id]) == string(content)
 }
+
+func TestReadOnlyInit(t *testing.T) {
+	cfg := *s.cfg
+	cfg.ReadOnly = true
+	mdc := &MockedMetadataClient{data: make(map[string][]byte)}
+	st := &Store{
+		Logger: logger,
+		cfg:    &cfg,
+		l:      &sync.Mutex{},
+	}
+	require.NoError(t, st.initMetadataClient(mdc))
+	// the default bundles and assignments are left to the writable instance
+	require.Empty(t, mdc.data)
+	require.Equal(t, mdc, st.mdc)
+}
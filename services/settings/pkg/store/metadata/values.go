@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/cs3org/reva/v2/pkg/errtypes"
 	"github.com/gofrs/uuid"
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
 )
@@ -60,12 +63,7 @@ func (s *Store) ReadValue(valueID string) (*settingsmsg.Value, error) {
 	s.Init()
 	ctx := context.TODO()
 
-	b, err := s.mdc.SimpleDownload(ctx, valuePath(valueID))
-	if err != nil {
-		return nil, err
-	}
-	val := &settingsmsg.Value{}
-	return val, json.Unmarshal(b, val)
+	return readValue(ctx, s.mdc, valueID)
 }
 
 // ReadValueByUniqueIdentifiers tries to find a value given a set of unique identifiers
@@ -81,14 +79,131 @@ func (s *Store) WriteValue(value *settingsmsg.Value) (*settingsmsg.Value, error)
 
 	if value.Id == "" {
 		value.Id = uuid.Must(uuid.NewV4()).String()
+	} else if previous, err := readValue(ctx, s.mdc, value.Id); err == nil &&
+		(previous.AccountUuid != value.AccountUuid || previous.BundleId != value.BundleId) {
+		if err := removeValueIndexEntry(ctx, s.mdc, previous); err != nil {
+			return nil, err
+		}
 	}
 	b, err := json.Marshal(value)
 	if err != nil {
 		return nil, err
 	}
-	return value, s.mdc.SimpleUpload(ctx, valuePath(value.Id), b)
+	if err := s.mdc.SimpleUpload(ctx, valuePath(value.Id), b); err != nil {
+		return nil, err
+	}
+	return value, writeValueIndexEntry(ctx, s.mdc, value)
+}
+
+// DeleteValue deletes the value with the given valueId together with its history
+func (s *Store) DeleteValue(valueID string) error {
+	s.Init()
+	ctx := context.TODO()
+
+	if err := s.mdc.Delete(ctx, historyPath(valueID)); err != nil {
+		if _, ok := err.(errtypes.NotFound); !ok {
+			return err
+		}
+	}
+	// the value is needed to find its index entry
+	value, rerr := readValue(ctx, s.mdc, valueID)
+	if err := s.mdc.Delete(ctx, valuePath(valueID)); err != nil {
+		return err
+	}
+	if rerr != nil {
+		return nil
+	}
+	return removeValueIndexEntry(ctx, s.mdc, value)
+}
+
+// ListBundleIDsWithValues returns the ids of the bundles the account has stored values in.
+// Only the index of the values of the account is read, not the values themselves.
+func (s *Store) ListBundleIDsWithValues(accountUUID string) ([]string, error) {
+	s.Init()
+	ctx := context.TODO()
+
+	entries, err := s.mdc.ReadDir(ctx, accountValuesPath(accountUUID))
+	if err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	seen := make(map[string]bool, len(entries))
+	bundleIDs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		bundleID, _, ok := strings.Cut(e, ".")
+		if !ok || seen[bundleID] {
+			continue
+		}
+		seen[bundleID] = true
+		bundleIDs = append(bundleIDs, bundleID)
+	}
+	sort.Strings(bundleIDs)
+	return bundleIDs, nil
+}
+
+func readValue(ctx context.Context, mdc MetadataClient, valueID string) (*settingsmsg.Value, error) {
+	b, err := mdc.SimpleDownload(ctx, valuePath(valueID))
+	if err != nil {
+		return nil, err
+	}
+	val := &settingsmsg.Value{}
+	return val, json.Unmarshal(b, val)
+}
+
+// writeValueIndexEntry adds the value to the index of the values of its account. Values without
+// an account are not indexed.
+func writeValueIndexEntry(ctx context.Context, mdc MetadataClient, value *settingsmsg.Value) error {
+	if value.AccountUuid == "" {
+		return nil
+	}
+	if err := mdc.MakeDirIfNotExist(ctx, accountValuesPath(value.AccountUuid)); err != nil {
+		return err
+	}
+	return mdc.SimpleUpload(ctx, accountValuePath(value), []byte(value.Id))
+}
+
+func removeValueIndexEntry(ctx context.Context, mdc MetadataClient, value *settingsmsg.Value) error {
+	if value.AccountUuid == "" {
+		return nil
+	}
+	if err := mdc.Delete(ctx, accountValuePath(value)); err != nil {
+		if _, ok := err.(errtypes.NotFound); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexValues adds all stored values to the index of values per account
+func indexValues(ctx context.Context, mdc MetadataClient) error {
+	vIDs, err := mdc.ReadDir(ctx, valuesFolderLocation)
+	if err != nil {
+		return err
+	}
+	for _, id := range vIDs {
+		value, err := readValue(ctx, mdc, id)
+		if err != nil {
+			return err
+		}
+		if err := writeValueIndexEntry(ctx, mdc, value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func valuePath(id string) string {
 	return fmt.Sprintf("%s/%s", valuesFolderLocation, id)
 }
+
+func accountValuesPath(accountUUID string) string {
+	return fmt.Sprintf("%s/%s", valueIndexFolderLocation, accountUUID)
+}
+
+// accountValuePath returns the path of the index entry of a value. The bundle id is part of the name,
+// so that the bundles can be listed without downloading the entries. Ids can't contain dots.
+func accountValuePath(value *settingsmsg.Value) string {
+	return fmt.Sprintf("%s/%s/%s.%s", valueIndexFolderLocation, value.AccountUuid, value.BundleId, value.Id)
+}
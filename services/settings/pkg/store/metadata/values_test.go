@@ -1,6 +1,8 @@
 package store
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	settingsmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/settings/v0"
@@ -98,3 +100,87 @@ func TestListValues(t *testing.T) {
 	require.Len(t, vs, 1)
 
 }
+
+func TestDeleteValue(t *testing.T) {
+	value := &settingsmsg.Value{
+		Id:          "f7a3c7a6-2c4c-4f1c-8a3e-4d0bd7a4b1e5",
+		BundleId:    bundle1,
+		SettingId:   setting1,
+		AccountUuid: accountUUID1,
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_USER,
+		},
+		Value: &settingsmsg.Value_StringValue{
+			StringValue: "deleted",
+		},
+	}
+	_, err := s.WriteValue(value)
+	require.NoError(t, err)
+	_, err = s.WriteValueHistoryEntry(&settingsmsg.ValueHistoryEntry{Value: value})
+	require.NoError(t, err)
+
+	require.NoError(t, s.DeleteValue(value.Id))
+
+	vs, err := s.ListValues(bundle1, accountUUID1)
+	require.NoError(t, err)
+	for _, v := range vs {
+		require.NotEqual(t, value.Id, v.Id)
+	}
+	entries, err := s.ListValueHistory(value.Id)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestListBundleIDsWithValues(t *testing.T) {
+	account := "0b7dfc1c-8e3a-4b7d-9d5c-1c3a4a2df5a1"
+	newValue := func(id, bundleID string) *settingsmsg.Value {
+		return &settingsmsg.Value{
+			Id:          id,
+			BundleId:    bundleID,
+			SettingId:   setting1,
+			AccountUuid: account,
+			Resource:    &settingsmsg.Resource{Type: settingsmsg.Resource_TYPE_USER},
+			Value:       &settingsmsg.Value_StringValue{StringValue: "value"},
+		}
+	}
+
+	ids, err := s.ListBundleIDsWithValues(account)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	for _, v := range []*settingsmsg.Value{
+		newValue("5f0b3f4e-7f2c-4d0c-9d4e-0d6b8a1b2c3d", bundle1),
+		newValue("6a1c4f5e-8a3d-4e1d-8e5f-1e7c9b2c3d4e", bundle1),
+		newValue("7b2d5a6f-9b4e-4f2e-9f6a-2f8d0c3d4e5f", bundle2),
+	} {
+		_, err := s.WriteValue(v)
+		require.NoError(t, err)
+	}
+	ids, err = s.ListBundleIDsWithValues(account)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{bundle1, bundle2}, ids)
+
+	// moving a value to another bundle updates the index
+	_, err = s.WriteValue(newValue("7b2d5a6f-9b4e-4f2e-9f6a-2f8d0c3d4e5f", bundle3))
+	require.NoError(t, err)
+	ids, err = s.ListBundleIDsWithValues(account)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{bundle1, bundle3}, ids)
+
+	require.NoError(t, s.DeleteValue("7b2d5a6f-9b4e-4f2e-9f6a-2f8d0c3d4e5f"))
+	ids, err = s.ListBundleIDsWithValues(account)
+	require.NoError(t, err)
+	require.Equal(t, []string{bundle1}, ids)
+
+	// values written before the index existed are added to it
+	mdc := s.mdc.(*MockedMetadataClient)
+	for k := range mdc.data {
+		if strings.HasPrefix(k, valueIndexFolderLocation+"/") {
+			delete(mdc.data, k)
+		}
+	}
+	require.NoError(t, indexValues(context.Background(), mdc))
+	ids, err = s.ListBundleIDsWithValues(account)
+	require.NoError(t, err)
+	require.Equal(t, []string{bundle1}, ids)
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/storage-shares/pkg/config"
+	"github.com/owncloud/ocis/v2/services/storage-shares/pkg/config/parser"
+	"github.com/owncloud/ocis/v2/services/storage-shares/pkg/transfer"
+	"github.com/urfave/cli/v2"
+)
+
+// Transfer reassigns the shares of a departing user to a successor.
+func Transfer(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "transfer",
+		Usage: "transfer the shares created by a user to a successor, or remove them",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "from",
+				Usage:    "the id of the user whose shares are transferred",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Usage: "the id of the user the shares are transferred to",
+			},
+			&cli.BoolFlag{
+				Name:  "revoke",
+				Usage: "remove the shares instead of transferring them",
+			},
+			&cli.StringSliceFlag{
+				Name:  "type",
+				Usage: fmt.Sprintf("only handle shares of the given type, one of %s, can be repeated", strings.Join(transfer.Types, ", ")),
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only list the shares which would be transferred or removed",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			from, to, revoke := c.String("from"), c.String("to"), c.Bool("revoke")
+			switch {
+			case revoke && to != "":
+				return errors.New("either a successor or --revoke can be given")
+			case !revoke && to == "":
+				return errors.New("a successor is required unless the shares are revoked")
+			case from == to:
+				return errors.New("the shares can't be transferred to the same user")
+			}
+			types := c.StringSlice("type")
+			for _, t := range types {
+				if !contains(transfer.Types, t) {
+					return fmt.Errorf("unknown share type '%s', expected one of %s", t, strings.Join(transfer.Types, ", "))
+				}
+			}
+			if cfg.MachineAuthAPIKey == "" {
+				return errors.New("the machine auth api key is not configured, set OCIS_MACHINE_AUTH_API_KEY")
+			}
+
+			tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+			if err != nil {
+				return err
+			}
+			gw, err := pool.GetGatewayServiceClient(cfg.Reva.Address, pool.WithTLSCACert(cfg.GRPCClientTLS.CACert), pool.WithTLSMode(tm))
+			if err != nil {
+				return fmt.Errorf("could not get the gateway client: %w", err)
+			}
+
+			t := &transfer.Transferrer{
+				Gateway:           gw,
+				MachineAuthAPIKey: cfg.MachineAuthAPIKey,
+				Types:             types,
+				Revoke:            revoke,
+				DryRun:            c.Bool("dry-run"),
+				Out:               os.Stdout,
+			}
+			if t.DryRun {
+				fmt.Println("Dry run, no share is changed:")
+			}
+			if revoke {
+				fmt.Printf("Removing the shares of %s:\n", from)
+			} else {
+				fmt.Printf("Transferring the shares of %s to %s:\n", from, to)
+			}
+			summary, err := t.Transfer(c.Context, from, to)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to transfer the shares of %s: %s\n", from, err)
+				return err
+			}
+
+			fmt.Printf("Transferred: %d\n", summary.Transferred)
+			fmt.Printf("Removed: %d\n", summary.Removed)
+			fmt.Printf("Skipped: %d\n", summary.Skipped)
+			fmt.Printf("Failed: %d\n", summary.Failed)
+			if summary.Failed > 0 {
+				return fmt.Errorf("%d shares could not be handled", summary.Failed)
+			}
+			return nil
+		},
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,297 @@
+// Package transfer moves the shares of a departing user to a successor.
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	collaboration "github.com/cs3org/go-cs3apis/cs3/sharing/collaboration/v1beta1"
+	link "github.com/cs3org/go-cs3apis/cs3/sharing/link/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	ctxpkg "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"google.golang.org/grpc/metadata"
+)
+
+// The share types which can be transferred.
+const (
+	TypeUser  = "user"
+	TypeGroup = "group"
+	TypeLink  = "link"
+)
+
+// Types are all share types.
+var Types = []string{TypeUser, TypeGroup, TypeLink}
+
+// Transferrer reassigns the shares created by a user to a successor, or removes them.
+type Transferrer struct {
+	Gateway gateway.GatewayAPIClient
+	// MachineAuthAPIKey is used to act on behalf of the users
+	MachineAuthAPIKey string
+	// Types are the share types to transfer, all types if empty
+	Types []string
+	// Revoke removes the shares instead of transferring them
+	Revoke bool
+	// DryRun only reports what would be done
+	DryRun bool
+	// Out receives the progress
+	Out io.Writer
+}
+
+// Summary counts the handled shares.
+type Summary struct {
+	Transferred int
+	Removed     int
+	// Skipped are shares which can't be transferred, e.g. because the successor can't share the resource
+	Skipped int
+	Failed  int
+}
+
+// Transfer handles all shares created by the user with the id from. The successor is ignored when revoking.
+func (t *Transferrer) Transfer(ctx context.Context, from, to string) (Summary, error) {
+	summary := Summary{}
+	fromCtx, fromUser, err := t.authenticate(ctx, from)
+	if err != nil {
+		return summary, fmt.Errorf("could not authenticate the departing user %s: %w", from, err)
+	}
+	var toCtx context.Context
+	if !t.Revoke {
+		if toCtx, _, err = t.authenticate(ctx, to); err != nil {
+			return summary, fmt.Errorf("could not authenticate the successor %s: %w", to, err)
+		}
+	}
+
+	if t.includes(TypeUser) || t.includes(TypeGroup) {
+		shares, err := t.listShares(fromCtx, fromUser.GetId())
+		if err != nil {
+			return summary, err
+		}
+		for _, s := range shares {
+			t.transferShare(fromCtx, toCtx, s, &summary)
+		}
+	}
+	if t.includes(TypeLink) {
+		links, err := t.listLinks(fromCtx, fromUser.GetId())
+		if err != nil {
+			return summary, err
+		}
+		for _, l := range links {
+			t.transferLink(fromCtx, l, &summary)
+		}
+	}
+	return summary, nil
+}
+
+func (t *Transferrer) includes(shareType string) bool {
+	if len(t.Types) == 0 {
+		return true
+	}
+	for _, typ := range t.Types {
+		if typ == shareType {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate returns a context acting as the user.
+func (t *Transferrer) authenticate(ctx context.Context, userID string) (context.Context, *userpb.User, error) {
+	res, err := t.Gateway.Authenticate(ctx, &gateway.AuthenticateRequest{
+		Type:         "machine",
+		ClientId:     "userid:" + userID,
+		ClientSecret: t.MachineAuthAPIKey,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return nil, nil, errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	ctx = ctxpkg.ContextSetUser(ctx, res.GetUser())
+	return metadata.AppendToOutgoingContext(ctx, ctxpkg.TokenHeader, res.GetToken()), res.GetUser(), nil
+}
+
+// listShares returns the user and group shares created by the user.
+func (t *Transferrer) listShares(ctx context.Context, creator *userpb.UserId) ([]*collaboration.Share, error) {
+	res, err := t.Gateway.ListShares(ctx, &collaboration.ListSharesRequest{})
+	if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		err = errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not list the shares: %w", err)
+	}
+	shares := []*collaboration.Share{}
+	for _, s := range res.GetShares() {
+		if !utils.UserIDEqual(s.GetCreator(), creator) {
+			continue
+		}
+		if s.GetGrantee().GetType() == provider.GranteeType_GRANTEE_TYPE_GROUP && !t.includes(TypeGroup) ||
+			s.GetGrantee().GetType() == provider.GranteeType_GRANTEE_TYPE_USER && !t.includes(TypeUser) {
+			continue
+		}
+		shares = append(shares, s)
+	}
+	return shares, nil
+}
+
+// listLinks returns the public links created by the user.
+func (t *Transferrer) listLinks(ctx context.Context, creator *userpb.UserId) ([]*link.PublicShare, error) {
+	res, err := t.Gateway.ListPublicShares(ctx, &link.ListPublicSharesRequest{})
+	if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		err = errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not list the public links: %w", err)
+	}
+	links := []*link.PublicShare{}
+	for _, l := range res.GetShare() {
+		if utils.UserIDEqual(l.GetCreator(), creator) {
+			links = append(links, l)
+		}
+	}
+	return links, nil
+}
+
+// transferShare recreates the share as the successor. Shares can't change their creator, the share is removed
+// and created again with the same grantee and permissions. If the successor can't create it, the
+// original share is restored.
+func (t *Transferrer) transferShare(fromCtx, toCtx context.Context, s *collaboration.Share, summary *Summary) {
+	desc := fmt.Sprintf("share %s of %s with %s", s.GetId().GetOpaqueId(), resourceID(s.GetResourceId()), grantee(s.GetGrantee()))
+	if t.Revoke {
+		if t.DryRun {
+			t.printf(" - would remove the %s\n", desc)
+			summary.Removed++
+			return
+		}
+		if err := t.removeShare(fromCtx, s); err != nil {
+			t.printf(" - failed to remove the %s: %s\n", desc, err)
+			summary.Failed++
+			return
+		}
+		t.printf(" - removed the %s\n", desc)
+		summary.Removed++
+		return
+	}
+
+	info, err := stat(toCtx, t.Gateway, s.GetResourceId())
+	switch {
+	case err != nil:
+		t.printf(" - skipped the %s, the successor can't access the resource: %s\n", desc, err)
+		summary.Skipped++
+		return
+	case !info.GetPermissionSet().GetAddGrant():
+		t.printf(" - skipped the %s, the successor isn't allowed to share the resource\n", desc)
+		summary.Skipped++
+		return
+	}
+	if t.DryRun {
+		t.printf(" - would transfer the %s\n", desc)
+		summary.Transferred++
+		return
+	}
+
+	if err := t.removeShare(fromCtx, s); err != nil {
+		t.printf(" - failed to transfer the %s, it could not be removed: %s\n", desc, err)
+		summary.Failed++
+		return
+	}
+	created, err := t.createShare(toCtx, info, s)
+	if err != nil {
+		origInfo, serr := stat(fromCtx, t.Gateway, s.GetResourceId())
+		if serr == nil {
+			_, serr = t.createShare(fromCtx, origInfo, s)
+		}
+		if serr != nil {
+			err = fmt.Errorf("%w, the original share could not be restored: %v", err, serr)
+		} else {
+			err = fmt.Errorf("%w, the original share was restored", err)
+		}
+		t.printf(" - failed to transfer the %s: %s\n", desc, err)
+		summary.Failed++
+		return
+	}
+	t.printf(" - transferred the %s, the new share is %s\n", desc, created.GetId().GetOpaqueId())
+	summary.Transferred++
+}
+
+// transferLink removes a public link when revoking. Links can't be recreated by another user without changing
+// their token and losing their password, they are skipped otherwise.
+func (t *Transferrer) transferLink(ctx context.Context, l *link.PublicShare, summary *Summary) {
+	desc := fmt.Sprintf("public link %s of %s", l.GetToken(), resourceID(l.GetResourceId()))
+	if !t.Revoke {
+		t.printf(" - skipped the %s, public links can only be revoked\n", desc)
+		summary.Skipped++
+		return
+	}
+	if t.DryRun {
+		t.printf(" - would remove the %s\n", desc)
+		summary.Removed++
+		return
+	}
+	res, err := t.Gateway.RemovePublicShare(ctx, &link.RemovePublicShareRequest{
+		Ref: &link.PublicShareReference{Spec: &link.PublicShareReference_Id{Id: l.GetId()}},
+	})
+	if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		err = errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	if err != nil {
+		t.printf(" - failed to remove the %s: %s\n", desc, err)
+		summary.Failed++
+		return
+	}
+	t.printf(" - removed the %s\n", desc)
+	summary.Removed++
+}
+
+func (t *Transferrer) removeShare(ctx context.Context, s *collaboration.Share) error {
+	res, err := t.Gateway.RemoveShare(ctx, &collaboration.RemoveShareRequest{
+		Ref: &collaboration.ShareReference{Spec: &collaboration.ShareReference_Id{Id: s.GetId()}},
+	})
+	if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		err = errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	return err
+}
+
+func (t *Transferrer) createShare(ctx context.Context, info *provider.ResourceInfo, s *collaboration.Share) (*collaboration.Share, error) {
+	res, err := t.Gateway.CreateShare(ctx, &collaboration.CreateShareRequest{
+		ResourceInfo: info,
+		Grant: &collaboration.ShareGrant{
+			Grantee:     s.GetGrantee(),
+			Permissions: s.GetPermissions(),
+		},
+	})
+	if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		err = errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	return res.GetShare(), err
+}
+
+func (t *Transferrer) printf(format string, args ...interface{}) {
+	fmt.Fprintf(t.Out, format, args...)
+}
+
+func stat(ctx context.Context, gw gateway.GatewayAPIClient, id *provider.ResourceId) (*provider.ResourceInfo, error) {
+	res, err := gw.Stat(ctx, &provider.StatRequest{Ref: &provider.Reference{ResourceId: id}})
+	if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		err = errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	return res.GetInfo(), err
+}
+
+func resourceID(id *provider.ResourceId) string {
+	return storagespace.FormatStorageID(id.GetStorageId(), id.GetSpaceId()) + "!" + id.GetOpaqueId()
+}
+
+func grantee(g *provider.Grantee) string {
+	if g.GetType() == provider.GranteeType_GRANTEE_TYPE_GROUP {
+		return "the group " + g.GetGroupId().GetOpaqueId()
+	}
+	return "the user " + g.GetUserId().GetOpaqueId()
+}
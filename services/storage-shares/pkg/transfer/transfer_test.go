@@ -0,0 +1,228 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	collaboration "github.com/cs3org/go-cs3apis/cs3/sharing/collaboration/v1beta1"
+	link "github.com/cs3org/go-cs3apis/cs3/sharing/link/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	ctxpkg "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/status"
+	cs3mocks "github.com/cs3org/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+var (
+	departing = &userpb.UserId{OpaqueId: "einstein"}
+	successor = &userpb.UserId{OpaqueId: "marie"}
+	other     = &userpb.UserId{OpaqueId: "richard"}
+
+	resource = &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"}
+
+	userShare = &collaboration.Share{
+		Id:          &collaboration.ShareId{OpaqueId: "user-share"},
+		ResourceId:  resource,
+		Creator:     departing,
+		Grantee:     &provider.Grantee{Type: provider.GranteeType_GRANTEE_TYPE_USER, Id: &provider.Grantee_UserId{UserId: other}},
+		Permissions: &collaboration.SharePermissions{Permissions: &provider.ResourcePermissions{Stat: true}},
+	}
+	groupShare = &collaboration.Share{
+		Id:          &collaboration.ShareId{OpaqueId: "group-share"},
+		ResourceId:  resource,
+		Creator:     departing,
+		Grantee:     &provider.Grantee{Type: provider.GranteeType_GRANTEE_TYPE_GROUP, Id: &provider.Grantee_GroupId{}},
+		Permissions: &collaboration.SharePermissions{Permissions: &provider.ResourcePermissions{Stat: true}},
+	}
+	// shares of other users are never touched
+	foreignShare = &collaboration.Share{
+		Id:         &collaboration.ShareId{OpaqueId: "foreign-share"},
+		ResourceId: resource,
+		Creator:    other,
+		Grantee:    &provider.Grantee{Type: provider.GranteeType_GRANTEE_TYPE_USER, Id: &provider.Grantee_UserId{UserId: departing}},
+	}
+	publicLink = &link.PublicShare{
+		Id:         &link.PublicShareId{OpaqueId: "link"},
+		Token:      "token",
+		ResourceId: resource,
+		Creator:    departing,
+	}
+)
+
+// actingUser returns the id of the user the request is sent as
+func actingUser(ctx context.Context) string {
+	u, _ := ctxpkg.ContextGetUser(ctx)
+	return u.GetId().GetOpaqueId()
+}
+
+// newGateway returns a gateway where the successor can share the resource and all requests succeed
+func newGateway() *cs3mocks.GatewayAPIClient {
+	gw := &cs3mocks.GatewayAPIClient{}
+	gw.On("Authenticate", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *gateway.AuthenticateRequest, _ ...grpc.CallOption) *gateway.AuthenticateResponse {
+			id := strings.TrimPrefix(req.ClientId, "userid:")
+			return &gateway.AuthenticateResponse{
+				Status: status.NewOK(context.Background()),
+				User:   &userpb.User{Id: &userpb.UserId{OpaqueId: id}},
+				Token:  "token-" + id,
+			}
+		}, nil)
+	gw.On("ListShares", mock.Anything, mock.Anything).Return(&collaboration.ListSharesResponse{
+		Status: status.NewOK(context.Background()),
+		Shares: []*collaboration.Share{userShare, groupShare, foreignShare},
+	}, nil)
+	gw.On("ListPublicShares", mock.Anything, mock.Anything).Return(&link.ListPublicSharesResponse{
+		Status: status.NewOK(context.Background()),
+		Share:  []*link.PublicShare{publicLink},
+	}, nil)
+	gw.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{
+		Status: status.NewOK(context.Background()),
+		Info:   &provider.ResourceInfo{Id: resource, PermissionSet: &provider.ResourcePermissions{AddGrant: true}},
+	}, nil)
+	gw.On("RemoveShare", mock.Anything, mock.Anything).Return(&collaboration.RemoveShareResponse{
+		Status: status.NewOK(context.Background()),
+	}, nil)
+	gw.On("RemovePublicShare", mock.Anything, mock.Anything).Return(&link.RemovePublicShareResponse{
+		Status: status.NewOK(context.Background()),
+	}, nil)
+	return gw
+}
+
+func okCreateShare(gw *cs3mocks.GatewayAPIClient) {
+	gw.On("CreateShare", mock.Anything, mock.Anything).Return(&collaboration.CreateShareResponse{
+		Status: status.NewOK(context.Background()),
+		Share:  &collaboration.Share{Id: &collaboration.ShareId{OpaqueId: "new-share"}},
+	}, nil)
+}
+
+// calls returns the requests of the method with the user they were sent as
+func calls(gw *cs3mocks.GatewayAPIClient, method string) map[string][]interface{} {
+	requests := map[string][]interface{}{}
+	for _, c := range gw.Calls {
+		if c.Method == method {
+			user := actingUser(c.Arguments.Get(0).(context.Context))
+			requests[user] = append(requests[user], c.Arguments.Get(1))
+		}
+	}
+	return requests
+}
+
+func TestTransfer(t *testing.T) {
+	gw := newGateway()
+	okCreateShare(gw)
+	transferrer := &Transferrer{Gateway: gw, Out: ioutil.Discard}
+
+	summary, err := transferrer.Transfer(context.Background(), departing.OpaqueId, successor.OpaqueId)
+	require.NoError(t, err)
+	// public links can't be transferred
+	assert.Equal(t, Summary{Transferred: 2, Skipped: 1}, summary)
+
+	removed := calls(gw, "RemoveShare")
+	require.Len(t, removed[departing.OpaqueId], 2)
+	assert.Len(t, removed, 1)
+	for _, req := range removed[departing.OpaqueId] {
+		assert.NotEqual(t, "foreign-share", req.(*collaboration.RemoveShareRequest).GetRef().GetId().GetOpaqueId())
+	}
+
+	// the shares are created again by the successor, with the same grantee and permissions
+	created := calls(gw, "CreateShare")
+	require.Len(t, created[successor.OpaqueId], 2)
+	assert.Len(t, created, 1)
+	req := created[successor.OpaqueId][0].(*collaboration.CreateShareRequest)
+	assert.Equal(t, userShare.Grantee, req.GetGrant().GetGrantee())
+	assert.Equal(t, userShare.Permissions, req.GetGrant().GetPermissions())
+	gw.AssertNotCalled(t, "RemovePublicShare", mock.Anything, mock.Anything)
+}
+
+func TestTransferRestoresTheShareIfItCantBeCreated(t *testing.T) {
+	gw := newGateway()
+	gw.On("CreateShare", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, _ *collaboration.CreateShareRequest, _ ...grpc.CallOption) *collaboration.CreateShareResponse {
+			if actingUser(ctx) == successor.OpaqueId {
+				return &collaboration.CreateShareResponse{Status: status.NewInternal(ctx, "no")}
+			}
+			return &collaboration.CreateShareResponse{Status: status.NewOK(ctx), Share: userShare}
+		}, nil)
+	transferrer := &Transferrer{Gateway: gw, Types: []string{TypeUser}, Out: ioutil.Discard}
+
+	summary, err := transferrer.Transfer(context.Background(), departing.OpaqueId, successor.OpaqueId)
+	require.NoError(t, err)
+	assert.Equal(t, Summary{Failed: 1}, summary)
+
+	// the share is created again as the departing user
+	created := calls(gw, "CreateShare")
+	assert.Len(t, created[successor.OpaqueId], 1)
+	require.Len(t, created[departing.OpaqueId], 1)
+	assert.Equal(t, userShare.Grantee, created[departing.OpaqueId][0].(*collaboration.CreateShareRequest).GetGrant().GetGrantee())
+}
+
+func TestTransferRevoke(t *testing.T) {
+	gw := newGateway()
+	transferrer := &Transferrer{Gateway: gw, Revoke: true, Out: ioutil.Discard}
+
+	summary, err := transferrer.Transfer(context.Background(), departing.OpaqueId, "")
+	require.NoError(t, err)
+	assert.Equal(t, Summary{Removed: 3}, summary)
+
+	assert.Len(t, calls(gw, "RemoveShare")[departing.OpaqueId], 2)
+	assert.Len(t, calls(gw, "RemovePublicShare")[departing.OpaqueId], 1)
+	// the successor isn't needed to revoke the shares
+	gw.AssertNumberOfCalls(t, "Authenticate", 1)
+	gw.AssertNotCalled(t, "CreateShare", mock.Anything, mock.Anything)
+}
+
+func TestTransferTypes(t *testing.T) {
+	gw := newGateway()
+	transferrer := &Transferrer{Gateway: gw, Types: []string{TypeGroup}, Revoke: true, Out: ioutil.Discard}
+
+	summary, err := transferrer.Transfer(context.Background(), departing.OpaqueId, "")
+	require.NoError(t, err)
+	assert.Equal(t, Summary{Removed: 1}, summary)
+
+	removed := calls(gw, "RemoveShare")[departing.OpaqueId]
+	require.Len(t, removed, 1)
+	assert.Equal(t, "group-share", removed[0].(*collaboration.RemoveShareRequest).GetRef().GetId().GetOpaqueId())
+	gw.AssertNotCalled(t, "ListPublicShares", mock.Anything, mock.Anything)
+}
+
+func TestTransferDryRun(t *testing.T) {
+	for _, revoke := range []bool{false, true} {
+		gw := newGateway()
+		transferrer := &Transferrer{Gateway: gw, Revoke: revoke, DryRun: true, Out: ioutil.Discard}
+
+		summary, err := transferrer.Transfer(context.Background(), departing.OpaqueId, successor.OpaqueId)
+		require.NoError(t, err)
+		if revoke {
+			assert.Equal(t, Summary{Removed: 3}, summary)
+		} else {
+			assert.Equal(t, Summary{Transferred: 2, Skipped: 1}, summary)
+		}
+
+		for _, method := range []string{"RemoveShare", "CreateShare", "RemovePublicShare"} {
+			gw.AssertNotCalled(t, method, mock.Anything, mock.Anything)
+		}
+	}
+}
+
+func TestTransferFailsIfTheSharesCantBeListed(t *testing.T) {
+	gw := &cs3mocks.GatewayAPIClient{}
+	gw.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+		Status: status.NewOK(context.Background()),
+		User:   &userpb.User{Id: departing},
+	}, nil)
+	gw.On("ListShares", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+	transferrer := &Transferrer{Gateway: gw, Revoke: true, Out: ioutil.Discard}
+
+	_, err := transferrer.Transfer(context.Background(), departing.OpaqueId, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not list the shares")
+	gw.AssertNotCalled(t, "RemoveShare", mock.Anything, mock.Anything)
+}
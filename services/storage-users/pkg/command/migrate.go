@@ -0,0 +1,150 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/storage"
+	"github.com/cs3org/reva/v2/pkg/storage/fs/registry"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/storage-users/pkg/config"
+	"github.com/owncloud/ocis/v2/services/storage-users/pkg/config/parser"
+	"github.com/owncloud/ocis/v2/services/storage-users/pkg/migrate"
+	"github.com/owncloud/ocis/v2/services/storage-users/pkg/revaconfig"
+	"github.com/urfave/cli/v2"
+)
+
+// MigrateSpace copies a space from one storage driver to another.
+func MigrateSpace(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "migrate-space",
+		Usage:     "copy the contents and the metadata of a space to another storage driver",
+		ArgsUsage: "<space-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "from",
+				Usage:    "the storage driver the space is stored in",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Usage:    "the storage driver the space is copied to",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "admin-user-id",
+				Usage:    "the id of a user who is allowed to list and create all spaces",
+				Required: true,
+			},
+			&cli.BoolFlag{
+				Name:  "commit",
+				Usage: "copy the space, without it the files which would be copied are only listed",
+			},
+			&cli.StringFlag{
+				Name:  "state",
+				Usage: "the file the progress is recorded in, an interrupted migration is resumed from it (default: $OCIS_BASE_DATA_PATH/storage/migrate-space/<space-id>.json)",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			spaceID := c.Args().First()
+			if spaceID == "" || c.NArg() > 1 {
+				return errors.New("exactly one space id is expected")
+			}
+			from, to := c.String("from"), c.String("to")
+			if from == to {
+				return errors.New("the source and the target driver have to differ")
+			}
+			drivers := revaconfig.UserDrivers(cfg)
+			if root := driverRoot(drivers, from); root != "" && root == driverRoot(drivers, to) {
+				return fmt.Errorf("the drivers '%s' and '%s' use the same root %s, configure a different root for the target driver", from, to, root)
+			}
+			source, err := initDriver(drivers, from)
+			if err != nil {
+				return err
+			}
+			target, err := initDriver(drivers, to)
+			if err != nil {
+				return err
+			}
+
+			statePath := c.String("state")
+			if statePath == "" {
+				_, sid, _, err := storagespace.SplitID(spaceID)
+				if err != nil {
+					return err
+				}
+				statePath = filepath.Join(defaults.BaseDataPath(), "storage", "migrate-space", sid+".json")
+			}
+
+			m := &migrate.Migrator{
+				Source:    source,
+				Target:    target,
+				Admin:     &userpb.User{Id: &userpb.UserId{OpaqueId: c.String("admin-user-id")}},
+				StatePath: statePath,
+				Commit:    c.Bool("commit"),
+				Out:       os.Stdout,
+			}
+			if m.Commit {
+				fmt.Printf("Migrating the space %s from '%s' to '%s':\n", spaceID, from, to)
+			} else {
+				fmt.Printf("Dry run of the migration of the space %s from '%s' to '%s':\n", spaceID, from, to)
+			}
+			report, err := m.Migrate(c.Context, spaceID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to migrate the space %s: %s\n", spaceID, err)
+				if m.Commit {
+					fmt.Fprintf(os.Stderr, "Run the command again to resume the migration, the progress is recorded in %s\n", statePath)
+				}
+				return err
+			}
+
+			fmt.Printf("Directories: %d\n", report.Directories)
+			fmt.Printf("Files: %d (%d bytes)\n", report.Files, report.Bytes)
+			if report.Resumed > 0 {
+				fmt.Printf("Files copied by an earlier run: %d\n", report.Resumed)
+			}
+			if !m.Commit {
+				fmt.Println("Nothing was copied, run the command with --commit to migrate the space.")
+				return nil
+			}
+			fmt.Printf("Migrated the space %s, all files match the checksums of the source.\n", spaceID)
+			fmt.Printf("Switch STORAGE_USERS_DRIVER to '%s' before removing the data of the '%s' driver.\n", to, from)
+			return nil
+		},
+	}
+}
+
+// initDriver initializes the configured storage driver with the given name.
+func initDriver(drivers map[string]interface{}, name string) (storage.FS, error) {
+	f, ok := registry.NewFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown filesystem driver '%s'", name)
+	}
+	conf, ok := drivers[name].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the filesystem driver '%s' is not configured", name)
+	}
+	fs, err := f(conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize filesystem driver '%s': %w", name, err)
+	}
+	return fs, nil
+}
+
+// driverRoot returns the data directory of a driver, if it has one.
+func driverRoot(drivers map[string]interface{}, name string) string {
+	conf, _ := drivers[name].(map[string]interface{})
+	root, _ := conf["root"].(string)
+	if root == "" {
+		return ""
+	}
+	return filepath.Clean(root)
+}
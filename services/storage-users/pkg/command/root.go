@@ -19,6 +19,7 @@ func GetCommands(cfg *config.Config) cli.Commands {
 
 		// interaction with this service
 		Uploads(cfg),
+		MigrateSpace(cfg),
 
 		// infos about this service
 		Health(cfg),
@@ -0,0 +1,372 @@
+// Package migrate copies storage spaces between storage drivers.
+package migrate
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	ctxpkg "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/storage"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+)
+
+// ErrChecksumMismatch is returned when a copied file doesn't match its source.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// Migrator copies the contents and the metadata of a space from the source to the target driver.
+type Migrator struct {
+	Source storage.FS
+	Target storage.FS
+	// Admin is the user the space is looked up and created with, it has to be allowed to list and create all spaces.
+	// The contents are read and written as the owner of the space.
+	Admin *userpb.User
+	// StatePath is the file the copied files are recorded in, an interrupted migration resumes from it
+	StatePath string
+	// Commit performs the migration, without it the files which would be copied are only reported
+	Commit bool
+	// Out receives the progress
+	Out io.Writer
+}
+
+// Report summarizes a migration.
+type Report struct {
+	Directories int
+	Files       int
+	Bytes       uint64
+	// Resumed are the files copied by an earlier, interrupted run
+	Resumed int
+	// CreatedSpace is set when the space didn't exist in the target driver yet
+	CreatedSpace bool
+}
+
+// state is the progress of a migration, persisted after every file.
+type state struct {
+	SpaceID string `json:"space_id"`
+	// Files are the sha1 checksums of the copied files by their path in the space
+	Files map[string]string `json:"files"`
+}
+
+// entry is a file or directory of the source space.
+type entry struct {
+	path string
+	info *provider.ResourceInfo
+}
+
+// Migrate copies the space with the given id.
+func (m *Migrator) Migrate(ctx context.Context, spaceID string) (Report, error) {
+	report := Report{}
+	adminCtx := ctxpkg.ContextSetUser(ctx, m.Admin)
+	source, err := findSpace(adminCtx, m.Source, spaceID)
+	if err != nil {
+		return report, fmt.Errorf("could not find the space in the source driver: %w", err)
+	}
+	if source == nil {
+		return report, errtypes.NotFound(spaceID)
+	}
+	ownerCtx := ctxpkg.ContextSetUser(ctx, &userpb.User{Id: source.GetOwner().GetId()})
+
+	entries, err := m.walk(ownerCtx, m.Source, source.GetRoot(), ".")
+	if err != nil {
+		return report, fmt.Errorf("could not list the source space: %w", err)
+	}
+
+	st, err := m.loadState(spaceID)
+	if err != nil {
+		return report, err
+	}
+
+	if !m.Commit {
+		for _, e := range entries {
+			if e.info.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
+				report.Directories++
+				continue
+			}
+			if m.copied(st, e) {
+				report.Resumed++
+				continue
+			}
+			report.Files++
+			report.Bytes += e.info.GetSize()
+			fmt.Fprintf(m.Out, " - would copy %s (%d bytes)\n", e.path, e.info.GetSize())
+		}
+		return report, nil
+	}
+
+	target, err := findSpace(adminCtx, m.Target, spaceID)
+	if err != nil {
+		return report, fmt.Errorf("could not look up the space in the target driver: %w", err)
+	}
+	if target == nil {
+		if target, err = createSpace(adminCtx, m.Target, source); err != nil {
+			return report, fmt.Errorf("could not create the space in the target driver: %w", err)
+		}
+		report.CreatedSpace = true
+		fmt.Fprintf(m.Out, "Created the space %s in the target driver\n", spaceID)
+	}
+	if err := copyGrants(ownerCtx, m.Source, m.Target, rootRef(source.GetRoot(), "."), rootRef(target.GetRoot(), ".")); err != nil {
+		return report, err
+	}
+
+	for i, e := range entries {
+		sref, tref := rootRef(source.GetRoot(), e.path), rootRef(target.GetRoot(), e.path)
+		if e.info.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
+			if _, err := m.Target.GetMD(ownerCtx, tref, nil, nil); err != nil {
+				if err := m.Target.CreateDir(ownerCtx, tref); err != nil {
+					return report, fmt.Errorf("could not create %s: %w", e.path, err)
+				}
+			}
+			report.Directories++
+		} else {
+			if m.copied(st, e) {
+				if info, err := m.Target.GetMD(ownerCtx, tref, nil, nil); err == nil && info.GetSize() == e.info.GetSize() {
+					report.Resumed++
+					continue
+				}
+			}
+			sum, err := m.copyFile(ownerCtx, sref, tref, e.info)
+			if err != nil {
+				return report, fmt.Errorf("could not copy %s: %w", e.path, err)
+			}
+			st.Files[e.path] = sum
+			if err := m.saveState(st); err != nil {
+				return report, err
+			}
+			report.Files++
+			report.Bytes += e.info.GetSize()
+			fmt.Fprintf(m.Out, " [%d/%d] copied %s (%d bytes)\n", i+1, len(entries), e.path, e.info.GetSize())
+		}
+		if md := e.info.GetArbitraryMetadata(); len(md.GetMetadata()) > 0 {
+			if err := m.Target.SetArbitraryMetadata(ownerCtx, tref, md); err != nil {
+				return report, fmt.Errorf("could not copy the metadata of %s: %w", e.path, err)
+			}
+		}
+		if err := copyGrants(ownerCtx, m.Source, m.Target, sref, tref); err != nil {
+			return report, fmt.Errorf("could not copy the grants of %s: %w", e.path, err)
+		}
+	}
+	return report, nil
+}
+
+// walk lists the entries below the given path, directories precede their contents.
+func (m *Migrator) walk(ctx context.Context, fs storage.FS, root *provider.ResourceId, dir string) ([]entry, error) {
+	infos, err := fs.ListFolder(ctx, rootRef(root, dir), []string{"*"}, nil)
+	if err != nil {
+		return nil, err
+	}
+	entries := []entry{}
+	for _, info := range infos {
+		p := path.Join(dir, path.Base(info.GetPath()))
+		entries = append(entries, entry{path: p, info: info})
+		if info.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
+			children, err := m.walk(ctx, fs, root, p)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, children...)
+		}
+	}
+	return entries, nil
+}
+
+// copied checks if the file was copied by an earlier run and didn't change since.
+func (m *Migrator) copied(st *state, e entry) bool {
+	sum, ok := st.Files[e.path]
+	if !ok {
+		return false
+	}
+	if c := e.info.GetChecksum(); c.GetType() == provider.ResourceChecksumType_RESOURCE_CHECKSUM_TYPE_SHA1 {
+		return strings.EqualFold(c.GetSum(), sum)
+	}
+	return true
+}
+
+// copyFile uploads the file to the target driver and verifies the copy, it returns the sha1 checksum of the file.
+func (m *Migrator) copyFile(ctx context.Context, sref, tref *provider.Reference, info *provider.ResourceInfo) (string, error) {
+	metadata := map[string]string{}
+	if mtime := info.GetMtime(); mtime != nil {
+		metadata["mtime"] = fmt.Sprintf("%d.%d", mtime.GetSeconds(), mtime.GetNanos())
+	}
+	expected := ""
+	if c := info.GetChecksum(); c.GetType() == provider.ResourceChecksumType_RESOURCE_CHECKSUM_TYPE_SHA1 && c.GetSum() != "" {
+		expected = strings.ToLower(c.GetSum())
+		// let the target driver verify the upload as well
+		metadata["checksum"] = "sha1 " + expected
+	}
+	uploads, err := m.Target.InitiateUpload(ctx, tref, int64(info.GetSize()), metadata)
+	if err != nil {
+		return "", err
+	}
+	r, err := m.Source.Download(ctx, sref)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New()
+	if _, err := m.Target.Upload(ctx, &provider.Reference{Path: uploads["simple"]}, ioutil.NopCloser(io.TeeReader(r, h)), nil); err != nil {
+		r.Close()
+		return "", err
+	}
+	r.Close()
+	sum := hex.EncodeToString(h.Sum(nil))
+	if expected != "" && sum != expected {
+		return "", fmt.Errorf("%w: the source file has the checksum %s but %s was read", ErrChecksumMismatch, expected, sum)
+	}
+
+	// read the copy back, the checksum reported by the target driver could have been computed before the write
+	// reached the blob store
+	c, err := m.Target.Download(ctx, tref)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+	h.Reset()
+	if _, err := io.Copy(h, c); err != nil {
+		return "", err
+	}
+	if copied := hex.EncodeToString(h.Sum(nil)); copied != sum {
+		return "", fmt.Errorf("%w: the copy has the checksum %s instead of %s", ErrChecksumMismatch, copied, sum)
+	}
+	return sum, nil
+}
+
+// copyGrants adds the grants of the source to the target.
+func copyGrants(ctx context.Context, source, target storage.FS, sref, tref *provider.Reference) error {
+	grants, err := source.ListGrants(ctx, sref)
+	if err != nil {
+		return err
+	}
+	existing, err := target.ListGrants(ctx, tref)
+	if err != nil {
+		return err
+	}
+	for _, g := range grants {
+		found := false
+		for _, e := range existing {
+			if granteeEqual(g.GetGrantee(), e.GetGrantee()) {
+				found = true
+				break
+			}
+		}
+		if found {
+			err = target.UpdateGrant(ctx, tref, g)
+		} else {
+			err = target.AddGrant(ctx, tref, g)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func granteeEqual(a, b *provider.Grantee) bool {
+	if a.GetType() != b.GetType() {
+		return false
+	}
+	if a.GetType() == provider.GranteeType_GRANTEE_TYPE_GROUP {
+		return a.GetGroupId().GetOpaqueId() == b.GetGroupId().GetOpaqueId()
+	}
+	return utils.UserIDEqual(a.GetUserId(), b.GetUserId())
+}
+
+// findSpace returns the space with the given id, or nil if the driver doesn't have it.
+func findSpace(ctx context.Context, fs storage.FS, spaceID string) (*provider.StorageSpace, error) {
+	spaces, err := fs.ListStorageSpaces(ctx, []*provider.ListStorageSpacesRequest_Filter{{
+		Type: provider.ListStorageSpacesRequest_Filter_TYPE_ID,
+		Term: &provider.ListStorageSpacesRequest_Filter_Id{Id: &provider.StorageSpaceId{OpaqueId: spaceID}},
+	}}, true)
+	if err != nil {
+		var notFound errtypes.IsNotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(spaces) == 0 {
+		return nil, nil
+	}
+	return spaces[0], nil
+}
+
+// createSpace creates a space with the id, the owner, the name and the quota of the source space.
+func createSpace(ctx context.Context, fs storage.FS, source *provider.StorageSpace) (*provider.StorageSpace, error) {
+	_, spaceID, _, err := storagespace.SplitID(source.GetId().GetOpaqueId())
+	if err != nil {
+		return nil, err
+	}
+	opaque := utils.AppendPlainToOpaque(nil, "spaceid", spaceID)
+	for _, key := range []string{"description", "spaceAlias"} {
+		if v := utils.ReadPlainFromOpaque(source.GetOpaque(), key); v != "" {
+			opaque = utils.AppendPlainToOpaque(opaque, key, v)
+		}
+	}
+	res, err := fs.CreateStorageSpace(ctx, &provider.CreateStorageSpaceRequest{
+		Opaque: opaque,
+		Owner:  &userpb.User{Id: source.GetOwner().GetId()},
+		Type:   source.GetSpaceType(),
+		Name:   source.GetName(),
+		Quota:  source.GetQuota(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return nil, errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+	return res.GetStorageSpace(), nil
+}
+
+func rootRef(root *provider.ResourceId, p string) *provider.Reference {
+	return &provider.Reference{ResourceId: root, Path: utils.MakeRelativePath(p)}
+}
+
+func (m *Migrator) loadState(spaceID string) (*state, error) {
+	st := &state{SpaceID: spaceID, Files: map[string]string{}}
+	data, err := ioutil.ReadFile(m.StatePath)
+	switch {
+	case os.IsNotExist(err):
+		return st, nil
+	case err != nil:
+		return nil, err
+	}
+	if err := json.Unmarshal(data, st); err != nil {
+		return nil, fmt.Errorf("could not read the state file %s: %w", m.StatePath, err)
+	}
+	if st.SpaceID != spaceID {
+		return nil, fmt.Errorf("the state file %s belongs to the space %s", m.StatePath, st.SpaceID)
+	}
+	if st.Files == nil {
+		st.Files = map[string]string{}
+	}
+	return st, nil
+}
+
+// saveState replaces the state file, so that it is never left behind half written.
+func (m *Migrator) saveState(st *state) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(m.StatePath), 0700); err != nil {
+		return err
+	}
+	tmp := m.StatePath + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.StatePath)
+}
@@ -0,0 +1,375 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/status"
+	"github.com/cs3org/reva/v2/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memFS is an in-memory storage driver with a single space. Only the methods used by the migrator are
+// implemented, the others panic.
+type memFS struct {
+	storage.FS
+
+	space    *provider.StorageSpace
+	dirs     map[string]bool
+	files    map[string][]byte
+	grants   map[string][]*provider.Grant
+	metadata map[string]map[string]string
+	// checksums overrides the sha1 checksums reported for the files
+	checksums map[string]string
+	// uploadsLeft fails the uploads once it dropped to 0, a negative value never fails
+	uploadsLeft int
+	// corrupt changes the data of every upload
+	corrupt bool
+
+	calls   int
+	uploads int
+}
+
+func newMemFS() *memFS {
+	return &memFS{
+		dirs:        map[string]bool{".": true},
+		files:       map[string][]byte{},
+		grants:      map[string][]*provider.Grant{},
+		metadata:    map[string]map[string]string{},
+		checksums:   map[string]string{},
+		uploadsLeft: -1,
+	}
+}
+
+func newSourceSpace() *provider.StorageSpace {
+	return &provider.StorageSpace{
+		Id:        &provider.StorageSpaceId{OpaqueId: "storage$space!space"},
+		Root:      &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "space"},
+		Owner:     &userpb.User{Id: &userpb.UserId{OpaqueId: "einstein"}},
+		Name:      "Project",
+		SpaceType: "project",
+	}
+}
+
+func cleanPath(ref *provider.Reference) string {
+	return path.Clean(ref.GetPath())
+}
+
+func sha1Sum(data []byte) string {
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (fs *memFS) info(p string) (*provider.ResourceInfo, error) {
+	if fs.dirs[p] {
+		return &provider.ResourceInfo{Path: p, Type: provider.ResourceType_RESOURCE_TYPE_CONTAINER}, nil
+	}
+	data, ok := fs.files[p]
+	if !ok {
+		return nil, errtypes.NotFound(p)
+	}
+	sum, ok := fs.checksums[p]
+	if !ok {
+		sum = sha1Sum(data)
+	}
+	info := &provider.ResourceInfo{
+		Path:     p,
+		Type:     provider.ResourceType_RESOURCE_TYPE_FILE,
+		Size:     uint64(len(data)),
+		Checksum: &provider.ResourceChecksum{Type: provider.ResourceChecksumType_RESOURCE_CHECKSUM_TYPE_SHA1, Sum: sum},
+	}
+	if md := fs.metadata[p]; len(md) > 0 {
+		info.ArbitraryMetadata = &provider.ArbitraryMetadata{Metadata: md}
+	}
+	return info, nil
+}
+
+func (fs *memFS) ListStorageSpaces(context.Context, []*provider.ListStorageSpacesRequest_Filter, bool) ([]*provider.StorageSpace, error) {
+	fs.calls++
+	if fs.space == nil {
+		return nil, nil
+	}
+	return []*provider.StorageSpace{fs.space}, nil
+}
+
+func (fs *memFS) CreateStorageSpace(ctx context.Context, req *provider.CreateStorageSpaceRequest) (*provider.CreateStorageSpaceResponse, error) {
+	fs.calls++
+	fs.space = &provider.StorageSpace{
+		Id:        &provider.StorageSpaceId{OpaqueId: "target$space!space"},
+		Root:      &provider.ResourceId{StorageId: "target", SpaceId: "space", OpaqueId: "space"},
+		Owner:     req.GetOwner(),
+		Name:      req.GetName(),
+		SpaceType: req.GetType(),
+	}
+	return &provider.CreateStorageSpaceResponse{Status: status.NewOK(ctx), StorageSpace: fs.space}, nil
+}
+
+func (fs *memFS) ListFolder(_ context.Context, ref *provider.Reference, _, _ []string) ([]*provider.ResourceInfo, error) {
+	fs.calls++
+	dir := cleanPath(ref)
+	var children []string
+	for p := range fs.dirs {
+		if p != "." && path.Dir(p) == dir {
+			children = append(children, p)
+		}
+	}
+	for p := range fs.files {
+		if path.Dir(p) == dir {
+			children = append(children, p)
+		}
+	}
+	sort.Strings(children)
+	infos := make([]*provider.ResourceInfo, 0, len(children))
+	for _, p := range children {
+		info, _ := fs.info(p)
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (fs *memFS) GetMD(_ context.Context, ref *provider.Reference, _, _ []string) (*provider.ResourceInfo, error) {
+	fs.calls++
+	return fs.info(cleanPath(ref))
+}
+
+func (fs *memFS) CreateDir(_ context.Context, ref *provider.Reference) error {
+	fs.calls++
+	fs.dirs[cleanPath(ref)] = true
+	return nil
+}
+
+func (fs *memFS) InitiateUpload(_ context.Context, ref *provider.Reference, _ int64, _ map[string]string) (map[string]string, error) {
+	fs.calls++
+	return map[string]string{"simple": cleanPath(ref)}, nil
+}
+
+func (fs *memFS) Upload(_ context.Context, ref *provider.Reference, r io.ReadCloser, _ storage.UploadFinishedFunc) (provider.ResourceInfo, error) {
+	fs.calls++
+	if fs.uploadsLeft == 0 {
+		return provider.ResourceInfo{}, errors.New("interrupted")
+	}
+	fs.uploadsLeft--
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return provider.ResourceInfo{}, err
+	}
+	if fs.corrupt {
+		data = append(data, '!')
+	}
+	fs.files[ref.GetPath()] = data
+	fs.uploads++
+	return provider.ResourceInfo{}, nil
+}
+
+func (fs *memFS) Download(_ context.Context, ref *provider.Reference) (io.ReadCloser, error) {
+	fs.calls++
+	data, ok := fs.files[cleanPath(ref)]
+	if !ok {
+		return nil, errtypes.NotFound(ref.GetPath())
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (fs *memFS) SetArbitraryMetadata(_ context.Context, ref *provider.Reference, md *provider.ArbitraryMetadata) error {
+	fs.calls++
+	p := cleanPath(ref)
+	if fs.metadata[p] == nil {
+		fs.metadata[p] = map[string]string{}
+	}
+	for k, v := range md.GetMetadata() {
+		fs.metadata[p][k] = v
+	}
+	return nil
+}
+
+func (fs *memFS) ListGrants(_ context.Context, ref *provider.Reference) ([]*provider.Grant, error) {
+	fs.calls++
+	return fs.grants[cleanPath(ref)], nil
+}
+
+func (fs *memFS) AddGrant(_ context.Context, ref *provider.Reference, g *provider.Grant) error {
+	fs.calls++
+	p := cleanPath(ref)
+	fs.grants[p] = append(fs.grants[p], g)
+	return nil
+}
+
+func (fs *memFS) UpdateGrant(_ context.Context, ref *provider.Reference, g *provider.Grant) error {
+	fs.calls++
+	p := cleanPath(ref)
+	for i, e := range fs.grants[p] {
+		if granteeEqual(e.GetGrantee(), g.GetGrantee()) {
+			fs.grants[p][i] = g
+		}
+	}
+	return nil
+}
+
+// newSource returns a source driver with a directory, three files, grants and metadata
+func newSource() *memFS {
+	source := newMemFS()
+	source.space = newSourceSpace()
+	source.dirs["docs"] = true
+	source.files["docs/a.txt"] = []byte("first file")
+	source.files["docs/b.txt"] = []byte("second file")
+	source.files["readme.md"] = []byte("# readme")
+	source.metadata["readme.md"] = map[string]string{"color": "red"}
+	source.grants["."] = []*provider.Grant{{
+		Grantee:     &provider.Grantee{Type: provider.GranteeType_GRANTEE_TYPE_USER, Id: &provider.Grantee_UserId{UserId: &userpb.UserId{OpaqueId: "marie"}}},
+		Permissions: &provider.ResourcePermissions{Stat: true},
+	}}
+	source.grants["docs/a.txt"] = []*provider.Grant{{
+		Grantee:     &provider.Grantee{Type: provider.GranteeType_GRANTEE_TYPE_USER, Id: &provider.Grantee_UserId{UserId: &userpb.UserId{OpaqueId: "richard"}}},
+		Permissions: &provider.ResourcePermissions{Stat: true, InitiateFileDownload: true},
+	}}
+	return source
+}
+
+func newMigrator(t *testing.T, source, target *memFS, commit bool) *Migrator {
+	return &Migrator{
+		Source:    source,
+		Target:    target,
+		Admin:     &userpb.User{Id: &userpb.UserId{OpaqueId: "admin"}},
+		StatePath: filepath.Join(t.TempDir(), "state.json"),
+		Commit:    commit,
+		Out:       ioutil.Discard,
+	}
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	source, target := newSource(), newMemFS()
+	m := newMigrator(t, source, target, false)
+
+	report, err := m.Migrate(context.Background(), "space")
+	require.NoError(t, err)
+	assert.Equal(t, Report{Directories: 1, Files: 3, Bytes: 29}, report)
+
+	// the target driver isn't even read
+	assert.Equal(t, 0, target.calls)
+	assert.Nil(t, target.space)
+	assert.NoFileExists(t, m.StatePath)
+}
+
+func TestMigrateCommit(t *testing.T) {
+	source, target := newSource(), newMemFS()
+	m := newMigrator(t, source, target, true)
+
+	report, err := m.Migrate(context.Background(), "space")
+	require.NoError(t, err)
+	assert.Equal(t, Report{Directories: 1, Files: 3, Bytes: 29, CreatedSpace: true}, report)
+
+	require.NotNil(t, target.space)
+	assert.Equal(t, "Project", target.space.GetName())
+	assert.Equal(t, "einstein", target.space.GetOwner().GetId().GetOpaqueId())
+	assert.True(t, target.dirs["docs"])
+	assert.Equal(t, source.files, target.files)
+	assert.Equal(t, map[string]string{"color": "red"}, target.metadata["readme.md"])
+	assert.Equal(t, source.grants["."], target.grants["."])
+	assert.Equal(t, source.grants["docs/a.txt"], target.grants["docs/a.txt"])
+
+	data, err := ioutil.ReadFile(m.StatePath)
+	require.NoError(t, err)
+	st := state{}
+	require.NoError(t, json.Unmarshal(data, &st))
+	assert.Equal(t, "space", st.SpaceID)
+	assert.Equal(t, sha1Sum(source.files["readme.md"]), st.Files["readme.md"])
+	assert.Len(t, st.Files, 3)
+
+	// migrating again only updates the grants of the existing space
+	report, err = m.Migrate(context.Background(), "space")
+	require.NoError(t, err)
+	assert.Equal(t, Report{Directories: 1, Resumed: 3}, report)
+	assert.Equal(t, 3, target.uploads)
+	assert.Len(t, target.grants["."], 1)
+}
+
+func TestMigrateResumesInterruptedRuns(t *testing.T) {
+	source, target := newSource(), newMemFS()
+	target.uploadsLeft = 1
+	m := newMigrator(t, source, target, true)
+
+	_, err := m.Migrate(context.Background(), "space")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "interrupted")
+	assert.Equal(t, 1, target.uploads)
+
+	target.uploadsLeft = -1
+	report, err := m.Migrate(context.Background(), "space")
+	require.NoError(t, err)
+	// the file copied by the first run isn't copied again
+	assert.Equal(t, Report{Directories: 1, Files: 2, Bytes: 19, Resumed: 1}, report)
+	assert.Equal(t, 3, target.uploads)
+	assert.Equal(t, source.files, target.files)
+}
+
+func TestMigrateResumeCopiesChangedFiles(t *testing.T) {
+	source, target := newSource(), newMemFS()
+	m := newMigrator(t, source, target, true)
+	_, err := m.Migrate(context.Background(), "space")
+	require.NoError(t, err)
+
+	// the state file records the checksum, a changed file is copied again
+	source.files["readme.md"] = []byte("# changed")
+	report, err := m.Migrate(context.Background(), "space")
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Files)
+	assert.Equal(t, 2, report.Resumed)
+	assert.Equal(t, []byte("# changed"), target.files["readme.md"])
+}
+
+func TestMigrateChecksumMismatch(t *testing.T) {
+	t.Run("source", func(t *testing.T) {
+		source, target := newSource(), newMemFS()
+		source.checksums["docs/a.txt"] = strings.Repeat("0", 40)
+		m := newMigrator(t, source, target, true)
+
+		_, err := m.Migrate(context.Background(), "space")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch), err)
+		assert.Contains(t, err.Error(), "docs/a.txt")
+
+		// the file isn't recorded as copied
+		data, err := ioutil.ReadFile(m.StatePath)
+		if err == nil {
+			st := state{}
+			require.NoError(t, json.Unmarshal(data, &st))
+			assert.NotContains(t, st.Files, "docs/a.txt")
+		}
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		source, target := newSource(), newMemFS()
+		target.corrupt = true
+		m := newMigrator(t, source, target, true)
+
+		_, err := m.Migrate(context.Background(), "space")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch), err)
+		assert.Contains(t, err.Error(), "the copy has the checksum")
+	})
+}
+
+func TestMigrateUnknownSpace(t *testing.T) {
+	source, target := newMemFS(), newMemFS()
+	m := newMigrator(t, source, target, true)
+
+	_, err := m.Migrate(context.Background(), "space")
+	var notFound errtypes.IsNotFound
+	assert.True(t, errors.As(err, &notFound), err)
+	assert.Equal(t, 0, target.calls)
+}
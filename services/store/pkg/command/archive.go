@@ -0,0 +1,76 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/logging"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
+	"github.com/urfave/cli/v2"
+)
+
+// Export writes all records of the store into an archive file.
+func Export(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "export",
+		Usage:     "write all records in the data path into an archive file, the service must be stopped",
+		ArgsUsage: "<file>",
+		Category:  "maintenance",
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("the archive file is missing")
+			}
+			logger := logging.Configure(cfg.Service.Name, cfg.Log)
+			file := c.Args().First()
+
+			exported, err := svc.Export(cfg, file, logger)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not export the records in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			fmt.Printf("Exported %d records to %s.\n", exported, file)
+			return nil
+		},
+	}
+}
+
+// Import restores the records of an archive file.
+func Import(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "import",
+		Usage:     "restore the records of an archive file written by 'export', the service must be stopped",
+		ArgsUsage: "<file>",
+		Category:  "maintenance",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "mode",
+				Value: svc.ImportModeMerge,
+				Usage: fmt.Sprintf("'%s' keeps the stored records and overwrites the ones with the same key, '%s' removes all stored records first",
+					svc.ImportModeMerge, svc.ImportModeReplace),
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("the archive file is missing")
+			}
+			logger := logging.Configure(cfg.Service.Name, cfg.Log)
+			file := c.Args().First()
+
+			imported, err := svc.Import(cfg, file, c.String("mode"), logger)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not import %s: %v", file, err))
+				return err
+			}
+			fmt.Printf("Imported %d records into %s.\n", imported, cfg.Datapath)
+			return nil
+		},
+	}
+}
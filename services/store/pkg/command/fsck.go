@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/logging"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
+	"github.com/urfave/cli/v2"
+)
+
+// Fsck checks the consistency of the index, the counters and the files in the data path.
+func Fsck(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:     "fsck",
+		Usage:    "check the consistency of the index, the counters and the files in the data path, the service must be stopped",
+		Category: "maintenance",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "repair",
+				Usage: "repair the inconsistencies, unreadable records are moved to the lost+found directory of the data path",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			logger := logging.Configure(cfg.Service.Name, cfg.Log)
+			// the check is run explicitly, not on start
+			cfg.FsckOnStart = config.FsckOff
+			s, err := svc.New(svc.Config(cfg), svc.Logger(logger))
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not open the store in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			defer s.Close(context.Background())
+
+			report, err := s.Fsck(c.Bool("repair"))
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not check the store in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			printFsckReport(report)
+			if report.Clean() {
+				fmt.Printf("The store in %s is consistent.\n", cfg.Datapath)
+				return nil
+			}
+			if report.Repaired {
+				fmt.Printf("Repaired the inconsistencies of the store in %s.\n", cfg.Datapath)
+				return nil
+			}
+			fmt.Println("Run 'ocis store fsck --repair' to repair the inconsistencies.")
+			return errors.New("the store is inconsistent")
+		},
+	}
+}
+
+func printFsckReport(report svc.FsckReport) {
+	sections := []struct {
+		title string
+		ids   []string
+	}{
+		{"Records missing in the index", report.MissingEntries},
+		{"Stale index entries", report.StaleEntries},
+		{"Records not stored at the path of their key", report.MisplacedFiles},
+		{"Unreadable record files", report.OrphanedFiles},
+		{"Leftover temporary files", report.TempFiles},
+	}
+	for _, section := range sections {
+		if len(section.ids) == 0 {
+			continue
+		}
+		fmt.Printf("%s (%d):\n", section.title, len(section.ids))
+		for _, id := range section.ids {
+			fmt.Printf("  %s\n", id)
+		}
+	}
+	if report.CountersDrifted {
+		fmt.Println("The record counters don't match the records.")
+	}
+}
@@ -0,0 +1,166 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
+	"github.com/urfave/cli/v2"
+)
+
+// Quota manages the quotas of the databases.
+func Quota(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "quota",
+		Usage: "manage the quotas of the databases",
+		Subcommands: []*cli.Command{
+			ListQuotas(cfg),
+			SetQuota(cfg),
+			ResetQuota(cfg),
+		},
+	}
+}
+
+// ListQuotas prints the quota and usage of every database.
+func ListQuotas(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "print the quota and usage of every database",
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			quotas, err := svc.ReadQuotas(cfg.Datapath)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not read the quotas in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			usage, err := svc.DatabaseUsage(cfg)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not read the records in %s: %v", cfg.Datapath, err))
+				return err
+			}
+
+			names := make([]string, 0, len(usage))
+			for db := range usage {
+				names = append(names, db)
+			}
+			for db := range quotas {
+				if _, ok := usage[db]; !ok {
+					names = append(names, db)
+				}
+			}
+			sort.Strings(names)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "DATABASE\tRECORDS\tMAX RECORDS\tBYTES\tMAX BYTES")
+			for _, db := range names {
+				q, ok := quotas[db]
+				if !ok {
+					q = svc.Quota{MaxRecords: uint64(cfg.QuotaMaxRecords), MaxBytes: uint64(cfg.QuotaMaxBytes)}
+				}
+				fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s\n", db, usage[db].Records, quotaString(q.MaxRecords), usage[db].Bytes, quotaString(q.MaxBytes))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// SetQuota sets the quota of a database.
+func SetQuota(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "set",
+		Usage:     "set the quota of a database, overriding STORE_QUOTA_MAX_RECORDS and STORE_QUOTA_MAX_BYTES",
+		ArgsUsage: "<database>",
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{
+				Name:  "max-records",
+				Usage: "maximum number of records, 0 for no limit",
+			},
+			&cli.Uint64Flag{
+				Name:  "max-bytes",
+				Usage: "maximum size of the records in bytes, 0 for no limit",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("the database is missing")
+			}
+			db := c.Args().First()
+			quotas, err := svc.ReadQuotas(cfg.Datapath)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not read the quotas in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			// the unset limit is kept
+			q, ok := quotas[db]
+			if !ok {
+				q = svc.Quota{MaxRecords: uint64(cfg.QuotaMaxRecords), MaxBytes: uint64(cfg.QuotaMaxBytes)}
+			}
+			if c.IsSet("max-records") {
+				q.MaxRecords = c.Uint64("max-records")
+			}
+			if c.IsSet("max-bytes") {
+				q.MaxBytes = c.Uint64("max-bytes")
+			}
+			quotas[db] = q
+
+			if err := svc.WriteQuotas(cfg.Datapath, quotas); err != nil {
+				fmt.Println(fmt.Errorf("could not write the quotas in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			fmt.Printf("The quota of database %s is %s records and %s bytes.\n", db, quotaString(q.MaxRecords), quotaString(q.MaxBytes))
+			return nil
+		},
+	}
+}
+
+// ResetQuota removes the quota of a database, so the configured default quota applies again.
+func ResetQuota(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "reset",
+		Usage:     "remove the quota of a database, STORE_QUOTA_MAX_RECORDS and STORE_QUOTA_MAX_BYTES apply again",
+		ArgsUsage: "<database>",
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 1 {
+				return errors.New("the database is missing")
+			}
+			db := c.Args().First()
+			quotas, err := svc.ReadQuotas(cfg.Datapath)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not read the quotas in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			if _, ok := quotas[db]; !ok {
+				fmt.Printf("Database %s has no quota of its own.\n", db)
+				return nil
+			}
+			delete(quotas, db)
+
+			if err := svc.WriteQuotas(cfg.Datapath, quotas); err != nil {
+				fmt.Println(fmt.Errorf("could not write the quotas in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			fmt.Printf("Removed the quota of database %s.\n", db)
+			return nil
+		},
+	}
+}
+
+func quotaString(limit uint64) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprint(limit)
+}
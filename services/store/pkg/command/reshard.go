@@ -0,0 +1,47 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/logging"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
+	"github.com/urfave/cli/v2"
+)
+
+// Reshard moves the records in the data path to a different number of shard levels.
+func Reshard(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:     "reshard",
+		Usage:    "move the records in the data path to a different number of shard directory levels, the service must be stopped",
+		Category: "maintenance",
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  "levels",
+				Usage: fmt.Sprintf("number of shard levels between 0 and %d, defaults to STORE_SHARD_LEVELS", config.MaxShardLevels),
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(filesystemBackend(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			logger := logging.Configure(cfg.Service.Name, cfg.Log)
+			levels := cfg.ShardLevels
+			if c.IsSet("levels") {
+				levels = c.Int("levels")
+			}
+
+			moved, err := svc.Reshard(cfg, levels, logger)
+			if err != nil {
+				fmt.Println(fmt.Errorf("could not reshard the records in %s: %v", cfg.Datapath, err))
+				return err
+			}
+			fmt.Printf("Moved %d records, the records in %s are sharded with %d levels now.\n", moved, cfg.Datapath, levels)
+			if levels != cfg.ShardLevels {
+				fmt.Printf("Set STORE_SHARD_LEVELS=%d before starting the store service.\n", levels)
+			}
+			return nil
+		},
+	}
+}
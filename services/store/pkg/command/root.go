@@ -2,11 +2,13 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/clihelper"
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
 	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config/parser"
 	"github.com/thejerf/suture/v4"
 	"github.com/urfave/cli/v2"
 )
@@ -18,6 +20,11 @@ func GetCommands(cfg *config.Config) cli.Commands {
 		Server(cfg),
 
 		// interaction with this service
+		Reshard(cfg),
+		Fsck(cfg),
+		Export(cfg),
+		Import(cfg),
+		Quota(cfg),
 
 		// infos about this service
 		Health(cfg),
@@ -57,3 +64,15 @@ func (s SutureService) Serve(ctx context.Context) error {
 
 	return nil
 }
+
+// filesystemBackend parses the config of the maintenance commands, they work on the data path of the
+// filesystem backend.
+func filesystemBackend(cfg *config.Config) error {
+	if err := parser.ParseConfig(cfg); err != nil {
+		return err
+	}
+	if cfg.Backend != config.BackendFilesystem {
+		return fmt.Errorf("the command is only supported by the %s backend, the configured backend is %s", config.BackendFilesystem, cfg.Backend)
+	}
+	return nil
+}
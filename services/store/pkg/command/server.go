@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/oklog/run"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
 	"github.com/owncloud/ocis/v2/services/store/pkg/server/debug"
 	"github.com/owncloud/ocis/v2/services/store/pkg/server/grpc"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
 	"github.com/owncloud/ocis/v2/services/store/pkg/tracing"
 	"github.com/urfave/cli/v2"
 )
@@ -55,14 +57,33 @@ func Server(cfg *config.Config) *cli.Command {
 			metrics.BuildInfo.WithLabelValues(version.GetString()).Set(1)
 
 			{
+				handler, err := svc.NewHandler(
+					svc.Logger(logger),
+					svc.Config(cfg),
+					svc.Metrics(metrics),
+				)
+				if err != nil {
+					logger.Error().Err(err).Str("server", "grpc").Msg("Failed to initialize service handler")
+					return err
+				}
 				server := grpc.Server(
 					grpc.Logger(logger),
 					grpc.Context(ctx),
 					grpc.Config(cfg),
-					grpc.Metrics(metrics),
+					grpc.Handler(handler),
 				)
 
-				gr.Add(server.Run, func(err error) {
+				gr.Add(func() error {
+					err := server.Run()
+
+					// the server doesn't accept requests anymore, make sure all writes reach the disk
+					sctx, scancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
+					defer scancel()
+					if cerr := handler.Close(sctx); cerr != nil {
+						logger.Error().Err(cerr).Msg("could not flush the store")
+					}
+					return err
+				}, func(err error) {
 					logger.Error().
 						Err(err).
 						Str("server", "grpc").
@@ -6,6 +6,54 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 )
 
+const (
+	// KeyPolicyHash stores records with keys that can't be used as file names under the hash of the key.
+	KeyPolicyHash = "hash"
+	// KeyPolicyReject rejects records with keys that can't be used as file names.
+	KeyPolicyReject = "reject"
+
+	// CompressionNone stores record payloads as they are.
+	CompressionNone = "none"
+	// CompressionGzip stores record payloads gzip compressed.
+	CompressionGzip = "gzip"
+
+	// CodecJSON serializes records as JSON.
+	CodecJSON = "json"
+	// CodecProtobuf serializes records in the protobuf wire format.
+	CodecProtobuf = "protobuf"
+	// CodecMsgpack serializes records as msgpack.
+	CodecMsgpack = "msgpack"
+
+	// FsyncPolicyAlways syncs every record to the disk before the write returns.
+	FsyncPolicyAlways = "always"
+	// FsyncPolicyInterval syncs records to the disk in the background.
+	FsyncPolicyInterval = "interval"
+	// FsyncPolicyNever leaves syncing records to the disk to the operating system.
+	FsyncPolicyNever = "never"
+
+	// FsckOff doesn't check the consistency of the data path on start.
+	FsckOff = "off"
+	// FsckCheck checks the consistency of the data path on start and logs the inconsistencies.
+	FsckCheck = "check"
+	// FsckRepair checks the consistency of the data path on start and repairs the inconsistencies.
+	FsckRepair = "repair"
+
+	// MaxShardLevels is the maximum number of directory levels the records of a table can be sharded into.
+	MaxShardLevels = 3
+
+	// EvictionPolicyNone never evicts records.
+	EvictionPolicyNone = "none"
+	// EvictionPolicyOldest evicts the records written the longest time ago first.
+	EvictionPolicyOldest = "oldest"
+	// EvictionPolicyLRU evicts the records read or written the longest time ago first.
+	EvictionPolicyLRU = "lru"
+
+	// BackendFilesystem stores the records as files in the data path.
+	BackendFilesystem = "filesystem"
+	// BackendS3 stores the records as objects in an S3 compatible object storage.
+	BackendS3 = "s3"
+)
+
 // Config combines all available configuration parts.
 type Config struct {
 	Commons *shared.Commons `yaml:"-"` // don't use this directly as configuration for a service
@@ -20,7 +68,49 @@ type Config struct {
 
 	GRPCClientTLS *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
 
-	Datapath string `yaml:"data_path" env:"STORE_DATA_PATH" desc:"The directory where the filesystem storage will store ocis settings. If not definied, the root directory derives from $OCIS_BASE_DATA_PATH:/store."`
+	Backend                string `yaml:"backend" env:"STORE_BACKEND" desc:"The storage of the records. Supported values are 'filesystem' and 's3'. 'filesystem' stores the records in the data path. 's3' stores them as objects in an S3 compatible object storage configured with the STORE_S3_* options and doesn't use a local data path. The options for the write-ahead log, fsync, sharding, the cache, quotas, eviction and fsck only apply to the 'filesystem' backend."`
+	S3                     S3     `yaml:"s3"`
+	Datapath               string `yaml:"data_path" env:"STORE_DATA_PATH" desc:"The directory where the filesystem storage will store ocis settings. If not definied, the root directory derives from $OCIS_BASE_DATA_PATH:/store."`
+	MaxValueSize           int    `yaml:"max_value_size" env:"STORE_MAX_VALUE_SIZE" desc:"The maximum size of a record value in bytes. Larger values are rejected on write. Set to 0 to disable the limit."`
+	MaxKeyLength           int    `yaml:"max_key_length" env:"STORE_MAX_KEY_LENGTH" desc:"The maximum length of a record key in bytes. Longer keys are rejected on write. Set to 0 to disable the limit."`
+	Compression            string `yaml:"compression" env:"STORE_COMPRESSION" desc:"Compression of stored records. Supported values are 'none' and 'gzip'. Records that were written uncompressed can still be read after enabling compression and vice versa."`
+	Codec                  string `yaml:"codec" env:"STORE_CODEC" desc:"Serialization format of stored records. Supported values are 'json', 'protobuf' and 'msgpack'. 'json' is human-readable, 'protobuf' and 'msgpack' are smaller and faster to decode. Each record is marked with its format, so records stay readable after changing this."`
+	FsyncPolicy            string `yaml:"fsync_policy" env:"STORE_FSYNC_POLICY" desc:"Defines when written records are synced to the disk. Supported values are 'always', 'interval' and 'never'. 'always' syncs every record before the write returns, so acknowledged writes survive a power loss or a crash of the operating system. 'interval' syncs records in the background, every STORE_FSYNC_BATCH_SIZE writes or STORE_FSYNC_INTERVAL milliseconds, whatever comes first. Records written since the last sync can be lost on a power loss. 'never' leaves syncing to the operating system. Records are replaced atomically in all cases, so a crash never leaves partially written records behind."`
+	FsyncInterval          int    `yaml:"fsync_interval" env:"STORE_FSYNC_INTERVAL" desc:"The maximum time in milliseconds between two syncs when using the 'interval' fsync policy."`
+	FsyncBatchSize         int    `yaml:"fsync_batch_size" env:"STORE_FSYNC_BATCH_SIZE" desc:"The number of writes after which records are synced when using the 'interval' fsync policy. Set to 0 to only sync periodically."`
+	KeyPolicy              string `yaml:"key_policy" env:"STORE_KEY_POLICY" desc:"Defines how record keys that can't be used as file names are handled, e.g. because they are too long for the filesystem or contain reserved characters like '/'. Supported values are 'hash' and 'reject'. When using 'hash', these records are stored under the SHA-256 hash of their key. When using 'reject', they are rejected."`
+	WAL                    bool   `yaml:"wal" env:"STORE_WAL" desc:"Record writes and deletes in a write-ahead log in the data path before applying them. Only the log is synced according to STORE_FSYNC_POLICY, the records are synced when the log is truncated at a checkpoint. Entries left in the log by a crash are replayed on startup."`
+	WALCheckpointEntries   int    `yaml:"wal_checkpoint_entries" env:"STORE_WAL_CHECKPOINT_ENTRIES" desc:"The number of write-ahead log entries after which the changed records are synced and the log is truncated."`
+	ShardLevels            int    `yaml:"shard_levels" env:"STORE_SHARD_LEVELS" desc:"The number of directory levels the records of a table are spread across, at most 3. Each level consists of up to 256 directories named after two hex digits of the SHA-256 hash of the file name of a record. Set to 0 to store all records of a table in one directory. The records of an existing store have to be moved with 'ocis store reshard' after changing this."`
+	CacheEntries           int    `yaml:"cache_entries" env:"STORE_CACHE_ENTRIES" desc:"The maximum number of records kept in an in-memory LRU cache in front of the record files. Set to 0 to disable the cache. Records written or deleted by this service are evicted from the cache, records changed by other processes are detected by the size and modification time of their files on a best-effort basis."`
+	CacheMaxBytes          int    `yaml:"cache_max_bytes" env:"STORE_CACHE_MAX_BYTES" desc:"The maximum size in bytes of all records in the cache. Larger records are never cached. Set to 0 to only limit the number of records."`
+	NegativeCacheEntries   int    `yaml:"negative_cache_entries" env:"STORE_NEGATIVE_CACHE_ENTRIES" desc:"The maximum number of keys of missing records kept in an in-memory LRU cache, so repeated reads of absent keys don't access the data path. Set to 0 to disable the cache. Keys written by this service are evicted from the cache, records created by other processes are only found after the entry expired."`
+	NegativeCacheTTL       int    `yaml:"negative_cache_ttl" env:"STORE_NEGATIVE_CACHE_TTL" desc:"The time in milliseconds a missing record is remembered in the negative cache."`
+	ReadTimeout            int    `yaml:"read_timeout" env:"STORE_READ_TIMEOUT" desc:"The time in milliseconds after which reads are aborted with a timeout error. An earlier deadline of the request is honored as well. Set to 0 to only use the deadline of the request."`
+	WriteTimeout           int    `yaml:"write_timeout" env:"STORE_WRITE_TIMEOUT" desc:"The time in milliseconds after which writes and deletes are aborted with a timeout error. An earlier deadline of the request is honored as well. The deadline is checked before the record is changed, a write or delete that has started is completed to keep the record, the write-ahead log and the index consistent. Set to 0 to only use the deadline of the request."`
+	ListTimeout            int    `yaml:"list_timeout" env:"STORE_LIST_TIMEOUT" desc:"The time in milliseconds after which listing records is aborted with a timeout error. An earlier deadline of the request is honored as well. Set to 0 to only use the deadline of the request."`
+	SlowOperationThreshold int    `yaml:"slow_operation_threshold" env:"STORE_SLOW_OPERATION_THRESHOLD" desc:"Reads, writes, deletes and lists taking longer than this time in milliseconds are logged as warnings, including the database and table. Set to 0 to disable the log."`
+	RetryAttempts          int    `yaml:"retry_attempts" env:"STORE_RETRY_ATTEMPTS" desc:"The number of times reading, writing and renaming a record file is retried after a transient filesystem error like EAGAIN, EINTR, EBUSY, ENOSPC, ESTALE or ETIMEDOUT. Other errors fail right away. Retries stop when the deadline of the operation is exceeded. If the error persists, the operation fails with a 'retries exhausted' error. Set to 0 to disable retries."`
+	RetryBackoff           int    `yaml:"retry_backoff" env:"STORE_RETRY_BACKOFF" desc:"The time in milliseconds before the first retry of a file operation. It doubles with every further retry."`
+	QuotaMaxRecords        int    `yaml:"quota_max_records" env:"STORE_QUOTA_MAX_RECORDS" desc:"The maximum number of records of every database. Writes adding a record to a database at its quota are rejected with a quota exceeded error. Quotas of single databases are set with 'ocis store quota set'. Set to 0 to disable the limit."`
+	QuotaMaxBytes          int    `yaml:"quota_max_bytes" env:"STORE_QUOTA_MAX_BYTES" desc:"The maximum size in bytes of the stored records of every database. Writes growing a database beyond its quota are rejected with a quota exceeded error. Quotas of single databases are set with 'ocis store quota set'. Set to 0 to disable the limit."`
+	EvictionPolicy         string `yaml:"eviction_policy" env:"STORE_EVICTION_POLICY" desc:"Evict expirable records when the free space of the filesystem of the data path drops below STORE_EVICTION_MIN_FREE_BYTES, to keep the store writable when it is used as a cache. Only records with an expiry which are not marked as non-evictable are evicted, expired records first. Supported values are 'none', 'oldest' and 'lru'. 'oldest' evicts the records written the longest time ago first, 'lru' the records read or written the longest time ago. The reads are tracked in memory, records which weren't used since the service started are evicted by the time they were written."`
+	EvictionMinFreeBytes   int    `yaml:"eviction_min_free_bytes" env:"STORE_EVICTION_MIN_FREE_BYTES" desc:"The free space in bytes of the filesystem of the data path below which records are evicted before writes, if an eviction policy is configured."`
+	ExpirySweepInterval    int    `yaml:"expiry_sweep_interval" env:"STORE_EXPIRY_SWEEP_INTERVAL" desc:"The time in seconds between two removals of the expired records of the 'filesystem' backend, e.g. of the signed URL nonces recorded by the proxy. Expired records are read until they are removed, appending elements to an expired record replaces it. Set to 0 to keep expired records until they are deleted or evicted."`
+	FsckOnStart            string `yaml:"fsck_on_start" env:"STORE_FSCK_ON_START" desc:"Check the consistency of the index, the counters and the files in the data path when the service starts, like 'ocis store fsck' does. Supported values are 'off', 'check' and 'repair'. 'check' logs the inconsistencies, 'repair' repairs them as well. Checking reads all records, which delays the start of large stores."`
+	ShutdownTimeout        int    `yaml:"shutdown_timeout" env:"STORE_SHUTDOWN_TIMEOUT" desc:"The time in seconds the service waits for in-flight writes when shutting down. Afterwards pending syncs are flushed and the write-ahead log is checkpointed. If the writes don't finish in time, the write-ahead log is replayed on the next start instead."`
 
 	Context context.Context `yaml:"-"`
 }
+
+// S3 configures the object storage of the 's3' backend.
+type S3 struct {
+	Endpoint     string `yaml:"endpoint" env:"STORE_S3_ENDPOINT" desc:"The host and port of the S3 compatible object storage, e.g. 's3.example.com' or 'minio:9000'."`
+	Region       string `yaml:"region" env:"STORE_S3_REGION" desc:"The region of the bucket. If empty, the region is looked up from the object storage."`
+	AccessKey    string `yaml:"access_key" env:"STORE_S3_ACCESS_KEY" desc:"The access key to authenticate with the object storage."`
+	SecretKey    string `yaml:"secret_key" env:"STORE_S3_SECRET_KEY" desc:"The secret key to authenticate with the object storage."`
+	Bucket       string `yaml:"bucket" env:"STORE_S3_BUCKET" desc:"The bucket the records are stored in. It has to exist."`
+	Prefix       string `yaml:"prefix" env:"STORE_S3_PREFIX" desc:"A prefix of the names of all objects, e.g. 'ocis/store', to share a bucket with other applications."`
+	Insecure     bool   `yaml:"insecure" env:"STORE_S3_INSECURE" desc:"Connect to the object storage via HTTP instead of HTTPS."`
+	ListPageSize int    `yaml:"list_page_size" env:"STORE_S3_LIST_PAGE_SIZE" desc:"The maximum number of objects requested with each call of the S3 list API."`
+}
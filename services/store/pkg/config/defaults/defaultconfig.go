@@ -30,7 +30,30 @@ func DefaultConfig() *config.Config {
 		Service: config.Service{
 			Name: "store",
 		},
-		Datapath: path.Join(defaults.BaseDataPath(), "store"),
+		Backend: config.BackendFilesystem,
+		S3: config.S3{
+			ListPageSize: 1000,
+		},
+		Datapath:               path.Join(defaults.BaseDataPath(), "store"),
+		MaxValueSize:           1024 * 1024, // 1 MiB
+		MaxKeyLength:           1024,
+		KeyPolicy:              config.KeyPolicyHash,
+		Compression:            config.CompressionNone,
+		Codec:                  config.CodecJSON,
+		FsyncPolicy:            config.FsyncPolicyAlways,
+		FsyncInterval:          1000,
+		FsyncBatchSize:         100,
+		WALCheckpointEntries:   1000,
+		SlowOperationThreshold: 1000,
+		RetryAttempts:          3,
+		RetryBackoff:           10,
+		ShutdownTimeout:        30,
+		FsckOnStart:            config.FsckOff,
+		CacheMaxBytes:          64 * 1024 * 1024, // 64 MiB
+		NegativeCacheTTL:       1000,
+		EvictionPolicy:         config.EvictionPolicyNone,
+		EvictionMinFreeBytes:   1024 * 1024 * 1024, // 1 GiB
+		ExpirySweepInterval:    600,
 	}
 }
 
@@ -63,6 +86,8 @@ func EnsureDefaults(cfg *config.Config) {
 		if cfg.Commons != nil && cfg.Commons.GRPCClientTLS != nil {
 			cfg.GRPCClientTLS.Mode = cfg.Commons.GRPCClientTLS.Mode
 			cfg.GRPCClientTLS.CACert = cfg.Commons.GRPCClientTLS.CACert
+			cfg.GRPCClientTLS.Cert = cfg.Commons.GRPCClientTLS.Cert
+			cfg.GRPCClientTLS.Key = cfg.Commons.GRPCClientTLS.Key
 		}
 	}
 	if cfg.GRPC.TLS == nil {
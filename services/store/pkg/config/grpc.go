@@ -7,4 +7,11 @@ type GRPCConfig struct {
 	Addr      string                 `yaml:"addr" env:"STORE_GRPC_ADDR" desc:"The bind address of the GRPC service."`
 	Namespace string                 `yaml:"-"`
 	TLS       *shared.GRPCServiceTLS `yaml:"tls"`
+	Auth      GRPCAuth               `yaml:"auth"`
+}
+
+// GRPCAuth defines how the clients of the grpc service are authenticated.
+type GRPCAuth struct {
+	ClientCA string `yaml:"client_ca" env:"STORE_GRPC_CLIENT_CA" desc:"Path/File name of the CA certificate (in PEM format) used to verify client certificates. If set, clients have to present a certificate signed by the CA, see OCIS_GRPC_CLIENT_TLS_CERTIFICATE. Requires TLS to be enabled."`
+	Secret   string `mask:"password" yaml:"secret" env:"OCIS_STORE_AUTH_SECRET;STORE_GRPC_AUTH_SECRET" desc:"Shared secret clients have to send with every call. Calls without the secret are rejected as unauthenticated. The clients of the store in other services read it from OCIS_STORE_AUTH_SECRET."`
 }
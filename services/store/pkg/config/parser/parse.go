@@ -2,6 +2,7 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
 	"github.com/owncloud/ocis/v2/services/store/pkg/config"
@@ -34,5 +35,126 @@ func ParseConfig(cfg *config.Config) error {
 }
 
 func Validate(cfg *config.Config) error {
+	if cfg.KeyPolicy != config.KeyPolicyHash && cfg.KeyPolicy != config.KeyPolicyReject {
+		return fmt.Errorf(
+			"Invalid value '%s' for 'key_policy' in service %s. Possible values are: '%s' or '%s'.",
+			cfg.KeyPolicy, cfg.Service.Name,
+			config.KeyPolicyHash, config.KeyPolicyReject,
+		)
+	}
+	if cfg.Compression != config.CompressionNone && cfg.Compression != config.CompressionGzip {
+		return fmt.Errorf(
+			"Invalid value '%s' for 'compression' in service %s. Possible values are: '%s' or '%s'.",
+			cfg.Compression, cfg.Service.Name,
+			config.CompressionNone, config.CompressionGzip,
+		)
+	}
+	switch cfg.Codec {
+	case config.CodecJSON, config.CodecProtobuf, config.CodecMsgpack:
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'codec' in service %s. Possible values are: '%s', '%s' or '%s'.",
+			cfg.Codec, cfg.Service.Name,
+			config.CodecJSON, config.CodecProtobuf, config.CodecMsgpack,
+		)
+	}
+	switch cfg.FsyncPolicy {
+	case config.FsyncPolicyAlways, config.FsyncPolicyInterval, config.FsyncPolicyNever:
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'fsync_policy' in service %s. Possible values are: '%s', '%s' or '%s'.",
+			cfg.FsyncPolicy, cfg.Service.Name,
+			config.FsyncPolicyAlways, config.FsyncPolicyInterval, config.FsyncPolicyNever,
+		)
+	}
+	switch cfg.FsckOnStart {
+	case config.FsckOff, config.FsckCheck, config.FsckRepair:
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'fsck_on_start' in service %s. Possible values are: '%s', '%s' or '%s'.",
+			cfg.FsckOnStart, cfg.Service.Name,
+			config.FsckOff, config.FsckCheck, config.FsckRepair,
+		)
+	}
+	if cfg.ShardLevels < 0 || cfg.ShardLevels > config.MaxShardLevels {
+		return fmt.Errorf(
+			"Invalid value '%d' for 'shard_levels' in service %s. It must be between 0 and %d.",
+			cfg.ShardLevels, cfg.Service.Name, config.MaxShardLevels,
+		)
+	}
+	if cfg.ReadTimeout < 0 || cfg.WriteTimeout < 0 || cfg.ListTimeout < 0 || cfg.SlowOperationThreshold < 0 {
+		return fmt.Errorf(
+			"Invalid timeout in service %s. 'read_timeout', 'write_timeout', 'list_timeout' and 'slow_operation_threshold' must not be negative.",
+			cfg.Service.Name,
+		)
+	}
+	if cfg.RetryAttempts < 0 || cfg.RetryBackoff < 0 {
+		return fmt.Errorf(
+			"Invalid retry configuration in service %s. 'retry_attempts' and 'retry_backoff' must not be negative.",
+			cfg.Service.Name,
+		)
+	}
+	if cfg.CacheEntries < 0 || cfg.CacheMaxBytes < 0 {
+		return fmt.Errorf(
+			"Invalid cache size in service %s. 'cache_entries' and 'cache_max_bytes' must not be negative.",
+			cfg.Service.Name,
+		)
+	}
+	if cfg.NegativeCacheEntries < 0 || cfg.NegativeCacheTTL < 0 {
+		return fmt.Errorf(
+			"Invalid negative cache configuration in service %s. 'negative_cache_entries' and 'negative_cache_ttl' must not be negative.",
+			cfg.Service.Name,
+		)
+	}
+	switch cfg.EvictionPolicy {
+	case config.EvictionPolicyNone:
+	case config.EvictionPolicyOldest, config.EvictionPolicyLRU:
+		if cfg.EvictionMinFreeBytes <= 0 {
+			return fmt.Errorf(
+				"Invalid value '%d' for 'eviction_min_free_bytes' in service %s. It must be positive when an eviction policy is configured.",
+				cfg.EvictionMinFreeBytes, cfg.Service.Name,
+			)
+		}
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'eviction_policy' in service %s. Possible values are: '%s', '%s' or '%s'.",
+			cfg.EvictionPolicy, cfg.Service.Name,
+			config.EvictionPolicyNone, config.EvictionPolicyOldest, config.EvictionPolicyLRU,
+		)
+	}
+	switch cfg.Backend {
+	case config.BackendFilesystem:
+	case config.BackendS3:
+		if cfg.S3.Endpoint == "" || cfg.S3.Bucket == "" {
+			return fmt.Errorf(
+				"The 's3' backend of service %s requires an endpoint and a bucket, set STORE_S3_ENDPOINT and STORE_S3_BUCKET.",
+				cfg.Service.Name,
+			)
+		}
+		if cfg.S3.ListPageSize < 0 || cfg.S3.ListPageSize > 1000 {
+			return fmt.Errorf(
+				"Invalid value '%d' for 's3.list_page_size' in service %s. It must be between 0 and 1000.",
+				cfg.S3.ListPageSize, cfg.Service.Name,
+			)
+		}
+	default:
+		return fmt.Errorf(
+			"Invalid value '%s' for 'backend' in service %s. Possible values are: '%s' or '%s'.",
+			cfg.Backend, cfg.Service.Name,
+			config.BackendFilesystem, config.BackendS3,
+		)
+	}
+	if cfg.GRPC.Auth.ClientCA != "" && (cfg.GRPC.TLS == nil || !cfg.GRPC.TLS.Enabled) {
+		return fmt.Errorf(
+			"The client CA of service %s requires TLS, set OCIS_GRPC_TLS_ENABLED or remove STORE_GRPC_CLIENT_CA.",
+			cfg.Service.Name,
+		)
+	}
+	if cfg.QuotaMaxRecords < 0 || cfg.QuotaMaxBytes < 0 {
+		return fmt.Errorf(
+			"Invalid quota in service %s. 'quota_max_records' and 'quota_max_bytes' must not be negative.",
+			cfg.Service.Name,
+		)
+	}
 	return nil
 }
@@ -13,7 +13,12 @@ var (
 // Metrics defines the available metrics of this service.
 type Metrics struct {
 	// Counter  *prometheus.CounterVec
-	BuildInfo *prometheus.GaugeVec
+	BuildInfo         *prometheus.GaugeVec
+	CompressionRatio  prometheus.Histogram
+	CacheHits         prometheus.Counter
+	CacheMisses       prometheus.Counter
+	NegativeCacheHits prometheus.Counter
+	EvictedRecords    prometheus.Counter
 }
 
 // New initializes the available metrics.
@@ -31,6 +36,37 @@ func New() *Metrics {
 			Name:      "build_info",
 			Help:      "Build Information",
 		}, []string{"version"}),
+		CompressionRatio: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "record_compression_ratio",
+			Help:      "Ratio of the compressed to the uncompressed size of written records",
+			Buckets:   []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1, 1.1},
+		}),
+		CacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "cache_hits_total",
+			Help:      "Number of record reads served from the cache",
+		}),
+		CacheMisses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "cache_misses_total",
+			Help:      "Number of record reads which were not served from the cache",
+		}),
+		NegativeCacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "negative_cache_hits_total",
+			Help:      "Number of reads of missing records served from the negative cache",
+		}),
+		EvictedRecords: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "evicted_records_total",
+			Help:      "Number of records evicted because the free space of the data path was low",
+		}),
 	}
 
 	// prometheus.Register(
@@ -40,6 +76,21 @@ func New() *Metrics {
 	_ = prometheus.Register(
 		m.BuildInfo,
 	)
+	_ = prometheus.Register(
+		m.CompressionRatio,
+	)
+	_ = prometheus.Register(
+		m.CacheHits,
+	)
+	_ = prometheus.Register(
+		m.CacheMisses,
+	)
+	_ = prometheus.Register(
+		m.NegativeCacheHits,
+	)
+	_ = prometheus.Register(
+		m.EvictedRecords,
+	)
 
 	return m
 }
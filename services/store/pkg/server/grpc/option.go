@@ -5,7 +5,7 @@ import (
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/store/pkg/config"
-	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
 	"github.com/urfave/cli/v2"
 )
 
@@ -18,7 +18,7 @@ type Options struct {
 	Logger  log.Logger
 	Context context.Context
 	Config  *config.Config
-	Metrics *metrics.Metrics
+	Handler svc.Handler
 	Flags   []cli.Flag
 }
 
@@ -61,10 +61,10 @@ func Config(val *config.Config) Option {
 	}
 }
 
-// Metrics provides a function to set the metrics option.
-func Metrics(val *metrics.Metrics) Option {
+// Handler provides a function to set the handler option.
+func Handler(val svc.Handler) Option {
 	return func(o *Options) {
-		o.Metrics = val
+		o.Handler = val
 	}
 }
 
@@ -4,19 +4,19 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
 	"github.com/owncloud/ocis/v2/ocis-pkg/version"
 	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
-	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
 )
 
 // Server initializes a new go-micro service ready to run
 func Server(opts ...Option) grpc.Service {
 	options := newOptions(opts...)
 
-	service, err := grpc.NewService(
+	sopts := []grpc.Option{
 		grpc.TLSEnabled(options.Config.GRPC.TLS.Enabled),
 		grpc.TLSCert(
 			options.Config.GRPC.TLS.Cert,
 			options.Config.GRPC.TLS.Key,
 		),
+		grpc.TLSClientCA(options.Config.GRPC.Auth.ClientCA),
 		grpc.Namespace(options.Config.GRPC.Namespace),
 		grpc.Name(options.Config.Service.Name),
 		grpc.Version(version.GetString()),
@@ -24,20 +24,18 @@ func Server(opts ...Option) grpc.Service {
 		grpc.Address(options.Config.GRPC.Addr),
 		grpc.Logger(options.Logger),
 		grpc.Flags(options.Flags...),
-	)
+	}
+	if options.Config.GRPC.Auth.Secret != "" {
+		sopts = append(sopts, grpc.HandlerWrapper(grpc.NewSecretHandlerWrapper(options.Config.GRPC.Auth.Secret)))
+	}
+
+	service, err := grpc.NewService(sopts...)
 	if err != nil {
 		options.Logger.Fatal().Err(err).Msg("Error creating store service")
 		return grpc.Service{}
 	}
 
-	hdlr, err := svc.New(
-		svc.Logger(options.Logger),
-		svc.Config(options.Config),
-	)
-	if err != nil {
-		options.Logger.Fatal().Err(err).Msg("could not initialize service handler")
-	}
-	if err = storesvc.RegisterStoreHandler(service.Server(), hdlr); err != nil {
+	if err = storesvc.RegisterStoreHandler(service.Server(), options.Handler); err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not register service handler")
 	}
 
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mgrpcc "github.com/go-micro/plugins/v4/client/grpc"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/registry"
+	ogrpc "github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config/defaults"
+	svc "github.com/owncloud/ocis/v2/services/store/pkg/service/v0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/client"
+	merrors "go-micro.dev/v4/errors"
+)
+
+func init() {
+	registry.Configure("memory")
+	if err := ogrpc.Configure(); err != nil {
+		panic(err)
+	}
+}
+
+// startServer starts the grpc server of the store and returns its address.
+func startServer(t *testing.T, configure func(cfg *config.Config)) string {
+	cfg := defaults.DefaultConfig()
+	defaults.EnsureDefaults(cfg)
+	cfg.Datapath = t.TempDir()
+	cfg.GRPC.Addr = "127.0.0.1:0"
+	configure(cfg)
+
+	handler, err := svc.NewHandler(svc.Config(cfg), svc.Logger(log.NopLogger()))
+	require.NoError(t, err)
+	service := Server(Config(cfg), Logger(log.NopLogger()), Context(context.Background()), Handler(handler))
+	require.NoError(t, service.Server().Start())
+	t.Cleanup(func() {
+		_ = service.Server().Stop()
+		_ = handler.Close(context.Background())
+	})
+	return service.Server().Options().Address
+}
+
+func write(c client.Client, addr string) error {
+	_, err := storesvc.NewStoreService("com.owncloud.api.store", c).Write(context.Background(), &storesvc.WriteRequest{
+		Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+		Record:  &storemsg.Record{Key: "key", Value: []byte("value")},
+	}, client.WithAddress(addr), client.WithRetries(0))
+	return err
+}
+
+func TestSecretAuth(t *testing.T) {
+	addr := startServer(t, func(cfg *config.Config) {
+		cfg.GRPC.Auth.Secret = "secret"
+	})
+
+	err := write(mgrpcc.NewClient(), addr)
+	require.Error(t, err)
+	assert.Equal(t, int32(401), merrors.FromError(err).Code)
+
+	err = write(ogrpc.NewSecretClient(mgrpcc.NewClient(), "wrong"), addr)
+	require.Error(t, err)
+	assert.Equal(t, int32(401), merrors.FromError(err).Code)
+
+	assert.NoError(t, write(ogrpc.NewSecretClient(mgrpcc.NewClient(), "secret"), addr))
+}
+
+func TestClientCertificates(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := newCertificate(t, dir, "ca", nil, nil)
+	newCertificate(t, dir, "server", ca, caKey)
+	clientCA, clientCAKey := newCertificate(t, dir, "client-ca", nil, nil)
+	newCertificate(t, dir, "client", clientCA, clientCAKey)
+	newCertificate(t, dir, "other", ca, caKey)
+
+	addr := startServer(t, func(cfg *config.Config) {
+		cfg.GRPC.TLS.Enabled = true
+		cfg.GRPC.TLS.Cert = filepath.Join(dir, "server.crt")
+		cfg.GRPC.TLS.Key = filepath.Join(dir, "server.key")
+		cfg.GRPC.Auth.ClientCA = filepath.Join(dir, "client-ca.crt")
+	})
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	tlsClient := func(name string) client.Client {
+		tlsConfig := &tls.Config{RootCAs: roots}
+		if name != "" {
+			cert, err := tls.LoadX509KeyPair(filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key"))
+			require.NoError(t, err)
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		return mgrpcc.NewClient(mgrpcc.AuthTLS(tlsConfig))
+	}
+
+	assert.Error(t, write(mgrpcc.NewClient(), addr), "plaintext")
+	assert.Error(t, write(tlsClient(""), addr), "without a client certificate")
+	assert.Error(t, write(tlsClient("other"), addr), "with a certificate of another CA")
+	assert.NoError(t, write(tlsClient("client"), addr))
+}
+
+// newCertificate writes a certificate and its key to dir, it is a CA if parent is nil.
+func newCertificate(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return cert, key
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// ArchiveVersion is the version of the archives written by Export. Import reads archives up to this version.
+	ArchiveVersion = 1
+
+	// ImportModeMerge keeps the stored records and replaces the ones with the same key as an archived record
+	ImportModeMerge = "merge"
+	// ImportModeReplace removes all stored records before importing the archive
+	ImportModeReplace = "replace"
+
+	archiveManifest   = "manifest.json"
+	archiveRecordsDir = "records/"
+)
+
+// manifest is the first entry of an archive.
+type manifest struct {
+	Version int    `json:"version"`
+	Created string `json:"created"`
+	Records int    `json:"records"`
+}
+
+// archivedRecord is an entry of an archive. The record is independent of the sharding, compression and
+// key policy of the exporting store.
+type archivedRecord struct {
+	Database string          `json:"database"`
+	Table    string          `json:"table"`
+	Record   json.RawMessage `json:"record"`
+}
+
+// Export writes all records in the data path into a gzip compressed tar archive. The store service must not be running.
+func Export(cfg *config.Config, file string, logger log.Logger) (int, error) {
+	if err := checkWALEmpty(cfg.Datapath); err != nil {
+		return 0, err
+	}
+
+	databases := filepath.Join(cfg.Datapath, "databases")
+	type entry struct {
+		database, table, path string
+	}
+	var entries []entry
+	dbs, err := readDirNames(databases)
+	if err != nil {
+		return 0, err
+	}
+	for _, db := range dbs {
+		tables, err := readDirNames(filepath.Join(databases, db))
+		if err != nil {
+			return 0, err
+		}
+		for _, table := range tables {
+			if err := walkRecords(filepath.Join(databases, db, table), func(p string) error {
+				entries = append(entries, entry{database: db, table: table, path: p})
+				return nil
+			}); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return 0, err
+	}
+	written := 0
+	err = func() error {
+		zw := gzip.NewWriter(f)
+		tw := tar.NewWriter(zw)
+		m := manifest{
+			Version: ArchiveVersion,
+			Created: time.Now().UTC().Format(time.RFC3339),
+			Records: len(entries),
+		}
+		if err := writeArchiveEntry(tw, archiveManifest, m); err != nil {
+			return err
+		}
+		for i, e := range entries {
+			data, err := ioutil.ReadFile(e.path)
+			if err != nil {
+				return err
+			}
+			rec := &storemsg.Record{}
+			if err := unmarshalRecord(data, rec); err != nil {
+				return fmt.Errorf("could not unmarshal record %s: %w", e.path, err)
+			}
+			raw, err := protojson.Marshal(rec)
+			if err != nil {
+				return err
+			}
+			name := fmt.Sprintf("%s%08d.json", archiveRecordsDir, i)
+			if err := writeArchiveEntry(tw, name, archivedRecord{Database: e.database, Table: e.table, Record: raw}); err != nil {
+				return err
+			}
+			written++
+			logger.Debug().Str("database", e.database).Str("table", e.table).Str("key", rec.Key).Msg("exported record")
+		}
+		if err := tw.Close(); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		return f.Sync()
+	}()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(file)
+		return 0, err
+	}
+	return written, nil
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+// Import restores the records of an archive written by Export. The store service must not be running.
+// The whole archive is validated before any record is written, so an invalid archive leaves the store as it is.
+func Import(cfg *config.Config, file, mode string, logger log.Logger) (int, error) {
+	if mode != ImportModeMerge && mode != ImportModeReplace {
+		return 0, fmt.Errorf("unknown import mode '%s', use '%s' or '%s'", mode, ImportModeMerge, ImportModeReplace)
+	}
+	if err := checkWALEmpty(cfg.Datapath); err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Join(cfg.Datapath, "tmp"), 0700); err != nil {
+		return 0, err
+	}
+	if err := checkLayout(cfg); err != nil {
+		return 0, err
+	}
+
+	// every record is synced, the index is rebuilt from the records on the next start anyway
+	c := *cfg
+	c.FsyncPolicy = config.FsyncPolicyAlways
+	s := &Service{Config: &c, log: logger}
+
+	if _, err := readArchive(file, func(database, table string, rec *storemsg.Record) error {
+		_, err := s.getID(database, table, rec.Key)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	databases := filepath.Join(cfg.Datapath, "databases")
+	if mode == ImportModeReplace {
+		if err := os.RemoveAll(databases); err != nil {
+			return 0, err
+		}
+	}
+	if err := os.MkdirAll(databases, 0700); err != nil {
+		return 0, err
+	}
+
+	return readArchive(file, func(database, table string, rec *storemsg.Record) error {
+		id, err := s.getID(database, table, rec.Key)
+		if err != nil {
+			return err
+		}
+		data, err := s.marshalRecord(rec)
+		if err != nil {
+			return err
+		}
+		if err := s.writeFile(context.Background(), filepath.Join(databases, id), data); err != nil {
+			return err
+		}
+		logger.Debug().Str("database", database).Str("table", table).Str("key", rec.Key).Msg("imported record")
+		return nil
+	})
+}
+
+// readArchive calls fn for every record in the archive and returns the number of records.
+func readArchive(file string, fn func(database, table string, rec *storemsg.Record) error) (int, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a store archive: %w", file, err)
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != archiveManifest {
+		return 0, fmt.Errorf("%s is not a store archive, the manifest is missing", file)
+	}
+	var m manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return 0, fmt.Errorf("could not parse the manifest: %w", err)
+	}
+	switch {
+	case m.Version < 1:
+		return 0, fmt.Errorf("invalid archive version %d", m.Version)
+	case m.Version > ArchiveVersion:
+		return 0, fmt.Errorf("the archive version %d is not supported, this store reads archives up to version %d", m.Version, ArchiveVersion)
+	}
+
+	n := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+		if !strings.HasPrefix(hdr.Name, archiveRecordsDir) || path.Ext(hdr.Name) != ".json" {
+			return n, fmt.Errorf("unexpected archive entry %s", hdr.Name)
+		}
+		var ar archivedRecord
+		if err := json.NewDecoder(tr).Decode(&ar); err != nil {
+			return n, fmt.Errorf("could not parse archive entry %s: %w", hdr.Name, err)
+		}
+		rec := &storemsg.Record{}
+		if err := protojson.Unmarshal(ar.Record, rec); err != nil {
+			return n, fmt.Errorf("could not parse the record of archive entry %s: %w", hdr.Name, err)
+		}
+		if err := fn(ar.Database, ar.Table, rec); err != nil {
+			return n, fmt.Errorf("archive entry %s: %w", hdr.Name, err)
+		}
+		n++
+	}
+	if n != m.Records {
+		return n, fmt.Errorf("the archive is incomplete, it contains %d of %d records", n, m.Records)
+	}
+	return n, nil
+}
+
+// checkWALEmpty makes sure there are no writes left to replay, the records wouldn't be up to date otherwise.
+func checkWALEmpty(datapath string) error {
+	if fi, err := os.Stat(filepath.Join(datapath, "wal.log")); err == nil && fi.Size() > 0 {
+		return errors.New("the write-ahead log contains entries, start the store service first to replay them")
+	}
+	return nil
+}
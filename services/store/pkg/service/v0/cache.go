@@ -0,0 +1,170 @@
+package service
+
+import (
+	clist "container/list"
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
+	"google.golang.org/protobuf/proto"
+)
+
+// cacheEntry is a cached record with the size and modification time of its file when it was read.
+type cacheEntry struct {
+	id       string
+	rec      *storemsg.Record
+	bytes    int
+	size     int64
+	modified time.Time
+}
+
+// recordCache is an LRU cache of decoded records in front of the record files, bounded by the number of
+// entries and their total size. Entries are invalidated by writes and deletes of this service. Every hit
+// is checked against the size and modification time of the file, so records changed by other processes
+// are read again as well. That check is best-effort, as modification times have a limited resolution.
+type recordCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxBytes   int
+	bytes      int
+	order      *clist.List
+	entries    map[string]*clist.Element
+	hits       uint64
+	misses     uint64
+	metrics    *metrics.Metrics
+}
+
+// newRecordCache returns nil if the cache is disabled, the methods of a nil cache read from the disk.
+func newRecordCache(maxEntries, maxBytes int, m *metrics.Metrics) *recordCache {
+	if maxEntries <= 0 {
+		return nil
+	}
+	return &recordCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		order:      clist.New(),
+		entries:    map[string]*clist.Element{},
+		metrics:    m,
+	}
+}
+
+// read returns the record in the given file, from the cache if it is still up to date. With allowStale
+// a cached record is returned without checking the file.
+func (c *recordCache) read(ctx context.Context, r retrier, id, file string, allowStale bool) (*storemsg.Record, error) {
+	if c == nil {
+		return readRecordFile(ctx, r, file)
+	}
+	if allowStale {
+		if rec := c.get(id, nil); rec != nil {
+			return rec, nil
+		}
+	}
+	// the file is stat'ed before reading it, a concurrent write makes the entry outdated instead of hiding the write
+	fi, err := os.Stat(file)
+	if err != nil {
+		c.invalidate(id)
+		return nil, err
+	}
+	if rec := c.get(id, fi); rec != nil {
+		return rec, nil
+	}
+	rec, err := readRecordFile(ctx, r, file)
+	if err != nil {
+		return nil, err
+	}
+	c.add(&cacheEntry{id: id, rec: proto.Clone(rec).(*storemsg.Record), bytes: proto.Size(rec), size: fi.Size(), modified: fi.ModTime()})
+	return rec, nil
+}
+
+// get returns the cached record if it matches the given file info, any cached record if fi is nil.
+func (c *recordCache) get(id string, fi os.FileInfo) *storemsg.Record {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[id]
+	if ok {
+		e := el.Value.(*cacheEntry)
+		if fi == nil || (e.size == fi.Size() && e.modified.Equal(fi.ModTime())) {
+			c.order.MoveToFront(el)
+			c.hits++
+			if c.metrics != nil {
+				c.metrics.CacheHits.Inc()
+			}
+			// callers may modify the returned record
+			return proto.Clone(e.rec).(*storemsg.Record)
+		}
+		c.remove(el)
+	}
+	if fi == nil {
+		// the record is read from the disk and counted as a miss then
+		return nil
+	}
+	c.misses++
+	if c.metrics != nil {
+		c.metrics.CacheMisses.Inc()
+	}
+	return nil
+}
+
+func (c *recordCache) add(e *cacheEntry) {
+	if c.maxBytes > 0 && e.bytes > c.maxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[e.id]; ok {
+		c.remove(el)
+	}
+	c.entries[e.id] = c.order.PushFront(e)
+	c.bytes += e.bytes
+	for c.order.Len() > c.maxEntries || (c.maxBytes > 0 && c.bytes > c.maxBytes) {
+		c.remove(c.order.Back())
+	}
+}
+
+// invalidate removes the record with the given id from the cache.
+func (c *recordCache) invalidate(id string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *recordCache) remove(el *clist.Element) {
+	e := c.order.Remove(el).(*cacheEntry)
+	delete(c.entries, e.id)
+	c.bytes -= e.bytes
+}
+
+// ratio returns the number of hits and misses since the cache was created.
+func (c *recordCache) ratio() (hits, misses uint64) {
+	if c == nil {
+		return 0, 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
+
+func readRecordFile(ctx context.Context, r retrier, file string) (*storemsg.Record, error) {
+	data, err := r.readFile(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	rec := &storemsg.Record{}
+	if err := unmarshalRecord(data, rec); err != nil {
+		return nil, errUnmarshal{err}
+	}
+	return rec, nil
+}
+
+// errUnmarshal distinguishes records which can't be unmarshaled from files which can't be read.
+type errUnmarshal struct {
+	error
+}
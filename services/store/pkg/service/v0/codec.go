@@ -0,0 +1,104 @@
+package service
+
+import (
+	"bytes"
+
+	"github.com/hashicorp/go-msgpack/codec"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordCodec serializes records. The marker is prepended to the serialized record to detect the codec
+// when reading, JSON records have no marker as they were written before codecs could be configured.
+type recordCodec struct {
+	marker    []byte
+	marshal   func(rec *storemsg.Record) ([]byte, error)
+	unmarshal func(data []byte, rec *storemsg.Record) error
+}
+
+var recordCodecs = map[string]recordCodec{
+	config.CodecJSON: {
+		marshal:   func(rec *storemsg.Record) ([]byte, error) { return protojson.Marshal(rec) },
+		unmarshal: func(data []byte, rec *storemsg.Record) error { return protojson.Unmarshal(data, rec) },
+	},
+	config.CodecProtobuf: {
+		marker:    []byte("\x00pb1"),
+		marshal:   func(rec *storemsg.Record) ([]byte, error) { return proto.Marshal(rec) },
+		unmarshal: func(data []byte, rec *storemsg.Record) error { return proto.Unmarshal(data, rec) },
+	},
+	config.CodecMsgpack: {
+		marker:    []byte("\x00mp1"),
+		marshal:   marshalMsgpack,
+		unmarshal: unmarshalMsgpack,
+	},
+}
+
+// encodeRecord serializes the record with the given codec, JSON is used if the codec is unknown.
+func encodeRecord(name string, rec *storemsg.Record) ([]byte, error) {
+	c, ok := recordCodecs[name]
+	if !ok {
+		c = recordCodecs[config.CodecJSON]
+	}
+	data, err := c.marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(make([]byte, 0, len(c.marker)+len(data)), c.marker...), data...), nil
+}
+
+// decodeRecord deserializes a record written with any of the codecs.
+func decodeRecord(data []byte, rec *storemsg.Record) error {
+	for _, c := range recordCodecs {
+		if len(c.marker) > 0 && bytes.HasPrefix(data, c.marker) {
+			return c.unmarshal(data[len(c.marker):], rec)
+		}
+	}
+	return recordCodecs[config.CodecJSON].unmarshal(data, rec)
+}
+
+// msgpackRecord is the msgpack representation of a record, the generated record has no msgpack mapping.
+type msgpackRecord struct {
+	Key          string                  `codec:"key"`
+	Value        []byte                  `codec:"value"`
+	Expiry       int64                   `codec:"expiry,omitempty"`
+	Metadata     map[string]msgpackField `codec:"metadata,omitempty"`
+	NonEvictable bool                    `codec:"non_evictable,omitempty"`
+}
+
+type msgpackField struct {
+	Type  string `codec:"type"`
+	Value string `codec:"value"`
+}
+
+// msgpackHandle writes values as the binary type of the current msgpack spec.
+var msgpackHandle = &codec.MsgpackHandle{WriteExt: true}
+
+func marshalMsgpack(rec *storemsg.Record) ([]byte, error) {
+	m := msgpackRecord{Key: rec.Key, Value: rec.Value, Expiry: rec.Expiry, NonEvictable: rec.NonEvictable}
+	if len(rec.Metadata) > 0 {
+		m.Metadata = make(map[string]msgpackField, len(rec.Metadata))
+		for k, f := range rec.Metadata {
+			m.Metadata[k] = msgpackField{Type: f.GetType(), Value: f.GetValue()}
+		}
+	}
+	var data []byte
+	err := codec.NewEncoderBytes(&data, msgpackHandle).Encode(m)
+	return data, err
+}
+
+func unmarshalMsgpack(data []byte, rec *storemsg.Record) error {
+	var m msgpackRecord
+	if err := codec.NewDecoderBytes(data, msgpackHandle).Decode(&m); err != nil {
+		return err
+	}
+	rec.Key, rec.Value, rec.Expiry, rec.NonEvictable = m.Key, m.Value, m.Expiry, m.NonEvictable
+	if len(m.Metadata) > 0 {
+		rec.Metadata = make(map[string]*storemsg.Field, len(m.Metadata))
+		for k, f := range m.Metadata {
+			rec.Metadata[k] = &storemsg.Field{Type: f.Type, Value: f.Value}
+		}
+	}
+	return nil
+}
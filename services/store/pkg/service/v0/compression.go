@@ -0,0 +1,64 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
+)
+
+// gzipHeader marks gzip compressed records. Uncompressed records either are plain JSON, which
+// never starts with a NUL byte, or start with the marker of their codec, so they can be told apart when reading.
+var gzipHeader = []byte("\x00gz1")
+
+// marshalRecord marshals the record with the configured codec and compresses it if compression is enabled.
+// Records that don't get smaller when compressed are stored uncompressed.
+func (s *Service) marshalRecord(rec *storemsg.Record) ([]byte, error) {
+	return marshalRecord(s.Config, s.metrics, rec)
+}
+
+func marshalRecord(cfg *config.Config, m *metrics.Metrics, rec *storemsg.Record) ([]byte, error) {
+	data, err := encodeRecord(cfg.Codec, rec)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Compression != config.CompressionGzip || len(data) == 0 {
+		return data, nil
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)/2))
+	buf.Write(gzipHeader)
+	zw := gzip.NewWriter(buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	if m != nil {
+		m.CompressionRatio.Observe(float64(buf.Len()) / float64(len(data)))
+	}
+	if buf.Len() >= len(data) {
+		return data, nil
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalRecord unmarshals a compressed or uncompressed record written with any codec.
+func unmarshalRecord(data []byte, rec *storemsg.Record) error {
+	if bytes.HasPrefix(data, gzipHeader) {
+		zr, err := gzip.NewReader(bytes.NewReader(data[len(gzipHeader):]))
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		if data, err = ioutil.ReadAll(zr); err != nil {
+			return err
+		}
+	}
+	return decodeRecord(data, rec)
+}
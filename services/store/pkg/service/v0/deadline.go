@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	merrors "go-micro.dev/v4/errors"
+)
+
+// operations with their own deadlines
+const (
+	opRead   = "read"
+	opWrite  = "write"
+	opDelete = "delete"
+	opList   = "list"
+	opAppend = "append"
+	opRemove = "remove"
+)
+
+// operationContext applies the configured deadline of the operation to the context of the request.
+// An earlier deadline of the request is kept.
+func (s *Service) operationContext(c context.Context, op string) (context.Context, context.CancelFunc) {
+	var timeout int
+	switch op {
+	case opRead:
+		timeout = s.Config.ReadTimeout
+	case opWrite, opDelete, opAppend, opRemove:
+		timeout = s.Config.WriteTimeout
+	case opList:
+		timeout = s.Config.ListTimeout
+	}
+	if timeout <= 0 {
+		return context.WithCancel(c)
+	}
+	return context.WithTimeout(c, time.Duration(timeout)*time.Millisecond)
+}
+
+// logSlow warns about operations which took longer than the slow operation threshold since start.
+func (s *Service) logSlow(op, database, table string, start time.Time) {
+	if s.Config.SlowOperationThreshold <= 0 {
+		return
+	}
+	if d := time.Since(start); d >= time.Duration(s.Config.SlowOperationThreshold)*time.Millisecond {
+		s.log.Warn().
+			Str("operation", op).
+			Str("database", database).
+			Str("table", table).
+			Dur("duration", d).
+			Msg("slow store operation")
+	}
+}
+
+// abandonable runs fn and returns the error of the context as soon as it is done, even if fn is still running.
+// Only operations without side effects can be abandoned, fn should return once the context is done.
+func abandonable(ctx context.Context, fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// operationError turns exceeded deadlines and cancelled requests into timeout errors.
+func (s *Service) operationError(op string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return timeoutError(s.id, op, err)
+	}
+	return err
+}
+
+func timeoutError(id, op string, err error) error {
+	return merrors.Timeout(id, "the %s operation was aborted: %s", op, err)
+}
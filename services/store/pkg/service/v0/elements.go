@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	merrors "go-micro.dev/v4/errors"
+)
+
+// keyLocks hands out a lock per record id, so read-modify-write operations like AppendElements don't lose
+// concurrent changes of the same record. The zero value is ready to use.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the record id and returns the function to unlock it again.
+func (l *keyLocks) lock(id string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*keyLock{}
+	}
+	kl, ok := l.locks[id]
+	if !ok {
+		kl = &keyLock{}
+		l.locks[id] = kl
+	}
+	kl.refs++
+	l.mu.Unlock()
+
+	kl.Lock()
+	return func() {
+		kl.Unlock()
+		l.mu.Lock()
+		// unused locks are removed, so the map doesn't grow with every key ever written
+		if kl.refs--; kl.refs == 0 {
+			delete(l.locks, id)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// elementsFunc returns the updated elements of a JSON array and the number of changed elements. It gets a
+// function which checks if an element is contained in the stored array.
+type elementsFunc func(elements []json.RawMessage, contained func(json.RawMessage) bool) ([]json.RawMessage, int)
+
+// appendElementsFunc appends the elements, elements already contained in the array are skipped if unique is set.
+func appendElementsFunc(appended [][]byte, unique bool) elementsFunc {
+	return func(elements []json.RawMessage, contained func(json.RawMessage) bool) ([]json.RawMessage, int) {
+		seen := map[string]struct{}{}
+		changed := 0
+		for _, e := range appended {
+			if unique {
+				key := canonicalJSON(e)
+				if _, ok := seen[key]; ok || contained(e) {
+					continue
+				}
+				seen[key] = struct{}{}
+			}
+			elements = append(elements, e)
+			changed++
+		}
+		return elements, changed
+	}
+}
+
+// removeElementsFunc removes all occurrences of the elements.
+func removeElementsFunc(elements [][]byte) elementsFunc {
+	removed := make(map[string]struct{}, len(elements))
+	for _, e := range elements {
+		removed[canonicalJSON(e)] = struct{}{}
+	}
+	return func(elements []json.RawMessage, _ func(json.RawMessage) bool) ([]json.RawMessage, int) {
+		kept := elements[:0]
+		for _, e := range elements {
+			if _, ok := removed[canonicalJSON(e)]; !ok {
+				kept = append(kept, e)
+			}
+		}
+		return kept, len(elements) - len(kept)
+	}
+}
+
+// AppendElements implements the StoreHandler interface.
+func (s *Service) AppendElements(c context.Context, req *storesvc.AppendElementsRequest, res *storesvc.AppendElementsResponse) error {
+	value, changed, err := s.updateElements(c, opAppend, req.Key, req.Options, req.Elements, true, req.Expiry, appendElementsFunc(req.Elements, req.Unique))
+	if err != nil {
+		return err
+	}
+	res.Value = value
+	res.Changed = uint32(changed)
+	return nil
+}
+
+// RemoveElements implements the StoreHandler interface.
+func (s *Service) RemoveElements(c context.Context, req *storesvc.RemoveElementsRequest, res *storesvc.RemoveElementsResponse) error {
+	value, changed, err := s.updateElements(c, opRemove, req.Key, req.Options, req.Elements, false, 0, removeElementsFunc(req.Elements))
+	if err != nil {
+		return err
+	}
+	res.Value = value
+	res.Changed = uint32(changed)
+	return nil
+}
+
+// updateElements replaces the elements of the JSON array stored in the record with the ones returned by fn
+// while holding the lock of the key. The record is only written if fn changed elements, missing records are
+// created if create is set. Expired records count as missing. An expiry greater than 0 replaces the expiry of
+// the record.
+func (s *Service) updateElements(c context.Context, op, key string, opts *storemsg.WriteOptions, elements [][]byte, create bool, expiry int64, fn elementsFunc) ([]byte, int, error) {
+	if err := s.beginWrite(); err != nil {
+		return nil, 0, err
+	}
+	defer s.endWrite()
+	if opts == nil {
+		opts = &storemsg.WriteOptions{}
+	}
+	defer s.logSlow(op, opts.Database, opts.Table, time.Now())
+	ctx, cancel := s.operationContext(c, op)
+	defer cancel()
+
+	if err := validateElements(s.id, elements); err != nil {
+		return nil, 0, err
+	}
+	id, err := s.getID(opts.Database, opts.Table, key)
+	if err != nil {
+		return nil, 0, merrors.BadRequest(s.id, "%s", err)
+	}
+	file := filepath.Join(s.Config.Datapath, "databases", id)
+	s.evictIfLow()
+	defer s.keys.lock(id)()
+
+	// the record is read while holding the lock, the cache is only used if it is up to date
+	rec, err := s.cache.read(ctx, s.retry, id, file, false)
+	if err == nil && s.recordExpired(file, rec, time.Now()) {
+		// the sweep didn't remove the record yet
+		rec, err = nil, os.ErrNotExist
+	}
+	switch _, unmarshal := err.(errUnmarshal); {
+	case err == nil:
+	case os.IsNotExist(err) && create:
+		rec = &storemsg.Record{Key: key}
+	case os.IsNotExist(err):
+		return nil, 0, merrors.NotFound(s.id, "could not find record")
+	case unmarshal:
+		return nil, 0, merrors.InternalServerError(s.id, "could not unmarshal record")
+	default:
+		return nil, 0, s.fileError(opRead, err, merrors.InternalServerError(s.id, "could not read record"))
+	}
+
+	value, changed, err := updateElementsValue(s.id, rec.Value, fn)
+	if err != nil || changed == 0 {
+		return value, 0, err
+	}
+	rec.Value = value
+	if expiry > 0 {
+		rec.Expiry = expiry
+	}
+	if err := s.checkLimits(rec); err != nil {
+		return nil, 0, err
+	}
+	if err := s.write(ctx, opts.Database, opts.Table, id, rec); err != nil {
+		return nil, 0, s.operationError(op, err)
+	}
+	return rec.Value, changed, nil
+}
+
+// validateElements checks that there are elements and that all of them are valid JSON.
+func validateElements(id string, elements [][]byte) error {
+	if len(elements) == 0 {
+		return merrors.BadRequest(id, "no elements given")
+	}
+	for i, e := range elements {
+		if !json.Valid(e) {
+			return merrors.BadRequest(id, "element %d is not valid JSON", i)
+		}
+	}
+	return nil
+}
+
+// updateElementsValue applies fn to the JSON array of a record value. The value is returned as it is if no
+// element was changed.
+func updateElementsValue(id string, value []byte, fn elementsFunc) ([]byte, int, error) {
+	var stored []json.RawMessage
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &stored); err != nil {
+			return nil, 0, merrors.BadRequest(id, "the value of the record is not a JSON array")
+		}
+	}
+	contained := make(map[string]struct{}, len(stored))
+	for _, e := range stored {
+		contained[canonicalJSON(e)] = struct{}{}
+	}
+	updated, changed := fn(stored, func(e json.RawMessage) bool {
+		_, ok := contained[canonicalJSON(e)]
+		return ok
+	})
+	if changed == 0 {
+		return value, 0, nil
+	}
+
+	if updated == nil {
+		updated = []json.RawMessage{}
+	}
+	updatedValue, err := json.Marshal(updated)
+	if err != nil {
+		return nil, 0, merrors.InternalServerError(id, "could not marshal elements")
+	}
+	return updatedValue, changed, nil
+}
+
+// canonicalJSON returns a representation of a valid JSON value which is equal for equal values, regardless of
+// the formatting and the order of object keys. Numbers are compared as written, so large integers stay distinct.
+func canonicalJSON(raw json.RawMessage) string {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return string(raw)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return string(raw)
+	}
+	return string(b)
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+)
+
+// evictor evicts expirable records when the free space of the data path is low, so the store stays writable
+// when it is used as a cache. Records without an expiry and records marked as non-evictable are never evicted.
+type evictor struct {
+	policy  string
+	minFree uint64
+	// freeSpace returns the free space of the filesystem of the given path, it is replaced in the tests
+	freeSpace func(path string) (uint64, error)
+
+	// mu serializes the evictions, writes waiting for it check the free space again
+	mu sync.Mutex
+
+	// accessed holds the time of the last read or write of the records for the lru policy
+	accessMu sync.Mutex
+	accessed map[string]time.Time
+}
+
+// evictionCandidate is a record which can be evicted.
+type evictionCandidate struct {
+	id       string
+	database string
+	table    string
+	expired  bool
+	used     time.Time
+}
+
+// newEvictor returns nil if no eviction policy is configured, records are never evicted then.
+func newEvictor(policy string, minFree uint64) *evictor {
+	if policy == "" || policy == config.EvictionPolicyNone || minFree == 0 {
+		return nil
+	}
+	e := &evictor{
+		policy:    policy,
+		minFree:   minFree,
+		freeSpace: diskFree,
+	}
+	if policy == config.EvictionPolicyLRU {
+		e.accessed = map[string]time.Time{}
+	}
+	return e
+}
+
+// diskFree returns the space of the filesystem of the path which is available to unprivileged users.
+func diskFree(path string) (uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	return uint64(st.Bavail) * uint64(st.Bsize), nil
+}
+
+// used records a read or write of the record for the lru policy.
+func (e *evictor) used(id string) {
+	if e == nil || e.accessed == nil {
+		return
+	}
+	e.accessMu.Lock()
+	defer e.accessMu.Unlock()
+	e.accessed[id] = time.Now()
+}
+
+// forget removes a deleted record from the access times.
+func (e *evictor) forget(id string) {
+	if e == nil || e.accessed == nil {
+		return
+	}
+	e.accessMu.Lock()
+	defer e.accessMu.Unlock()
+	delete(e.accessed, id)
+}
+
+// lastUsed returns the time of the last read or write of the record, records which weren't used since the
+// service started were last used when they were written.
+func (e *evictor) lastUsed(id string, modified time.Time) time.Time {
+	if e.accessed == nil {
+		return modified
+	}
+	e.accessMu.Lock()
+	defer e.accessMu.Unlock()
+	if t, ok := e.accessed[id]; ok {
+		return t
+	}
+	return modified
+}
+
+// lowSpace checks if the free space of the data path is below the threshold, it returns the missing bytes.
+func (s *Service) lowSpace() (uint64, bool) {
+	free, err := s.evictor.freeSpace(s.Config.Datapath)
+	if err != nil {
+		s.log.Error().Err(err).Str("path", s.Config.Datapath).Msg("could not get the free space of the data path")
+		return 0, false
+	}
+	if free >= s.evictor.minFree {
+		return 0, false
+	}
+	return s.evictor.minFree - free, true
+}
+
+// evictIfLow evicts records until the free space of the data path is above the threshold again, or no records
+// can be evicted anymore. It must not be called while the lock of a key is held.
+func (s *Service) evictIfLow() {
+	if s.evictor == nil {
+		return
+	}
+	if _, low := s.lowSpace(); !low {
+		return
+	}
+	s.evictor.mu.Lock()
+	defer s.evictor.mu.Unlock()
+	// another write might have evicted records in the meantime
+	missing, low := s.lowSpace()
+	if !low {
+		return
+	}
+
+	candidates, err := s.evictionCandidates()
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not find the records to evict")
+		return
+	}
+	var evicted, freed uint64
+	for _, c := range candidates {
+		size, ok := s.evict(c)
+		if !ok {
+			continue
+		}
+		evicted++
+		freed += uint64(size)
+		// the freed space is estimated from the file sizes, it is checked again once enough was freed
+		if freed >= missing {
+			if missing, low = s.lowSpace(); !low {
+				break
+			}
+			freed = 0
+		}
+	}
+	if s.metrics != nil {
+		s.metrics.EvictedRecords.Add(float64(evicted))
+	}
+	if low {
+		s.log.Warn().
+			Uint64("evicted", evicted).
+			Int("candidates", len(candidates)).
+			Uint64("min_free_bytes", s.evictor.minFree).
+			Msg("the free space of the data path is low and no more records can be evicted")
+		return
+	}
+	s.log.Info().
+		Uint64("evicted", evicted).
+		Str("policy", s.evictor.policy).
+		Uint64("min_free_bytes", s.evictor.minFree).
+		Msg("evicted records because the free space of the data path was low")
+}
+
+// evictionCandidates returns the expirable records from the index in the order they are evicted, the expired
+// records first, then by the eviction policy.
+func (s *Service) evictionCandidates() ([]evictionCandidate, error) {
+	count, err := s.index.DocCount()
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	min := float64(0)
+	query := bleve.NewNumericRangeInclusiveQuery(&min, nil, boolPtr(false), nil)
+	query.SetField("expiry")
+	req := bleve.NewSearchRequestOptions(query, int(count), 0, false)
+	req.Fields = []string{"database", "table", "modified", "expiry", "non_evictable"}
+	result, err := s.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	candidates := make([]evictionCandidate, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		if nonEvictable, _ := hit.Fields["non_evictable"].(bool); nonEvictable {
+			continue
+		}
+		database, _ := hit.Fields["database"].(string)
+		table, _ := hit.Fields["table"].(string)
+		modified, _ := hit.Fields["modified"].(string)
+		expiry, _ := hit.Fields["expiry"].(float64)
+		written, err := time.Parse(time.RFC3339Nano, modified)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, evictionCandidate{
+			id:       hit.ID,
+			database: database,
+			table:    table,
+			expired:  isExpired(written, int64(expiry), now),
+			used:     s.evictor.lastUsed(hit.ID, written),
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].expired != candidates[j].expired {
+			return candidates[i].expired
+		}
+		return candidates[i].used.Before(candidates[j].used)
+	})
+	return candidates, nil
+}
+
+// evict removes the candidate if it still can be evicted, the record might have been changed since the index
+// was searched. It returns the size of the removed file.
+func (s *Service) evict(c evictionCandidate) (int64, bool) {
+	defer s.keys.lock(c.id)()
+	data, err := ioutil.ReadFile(filepath.Join(s.Config.Datapath, "databases", c.id))
+	if err != nil {
+		return 0, false
+	}
+	rec := &storemsg.Record{}
+	if err := unmarshalRecord(data, rec); err != nil || rec.Expiry <= 0 || rec.NonEvictable {
+		return 0, false
+	}
+	size, err := s.remove(c.database, c.table, c.id)
+	if err != nil {
+		s.log.Error().Err(err).Str("id", c.id).Msg("could not evict record")
+		return 0, false
+	}
+	s.log.Debug().Str("database", c.database).Str("table", c.table).Str("key", rec.Key).Msg("evicted record")
+	return size, true
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+)
+
+// recordExpired checks if the record read from the file expired. The expiry counts from the last write of the
+// record, which is the modification time of its file.
+func (s *Service) recordExpired(file string, rec *storemsg.Record, now time.Time) bool {
+	if rec.Expiry <= 0 {
+		return false
+	}
+	fi, err := os.Stat(file)
+	if err != nil {
+		return false
+	}
+	return isExpired(fi.ModTime(), rec.Expiry, now)
+}
+
+// removeExpired removes the expired records found in the index. It returns the number of removed records.
+func (s *Service) removeExpired() (int, error) {
+	if err := s.beginWrite(); err != nil {
+		// the service is shutting down
+		return 0, nil
+	}
+	defer s.endWrite()
+
+	count, err := s.index.DocCount()
+	if err != nil || count == 0 {
+		return 0, err
+	}
+	min := float64(0)
+	query := bleve.NewNumericRangeInclusiveQuery(&min, nil, boolPtr(false), nil)
+	query.SetField("expiry")
+	req := bleve.NewSearchRequestOptions(query, int(count), 0, false)
+	req.Fields = []string{"database", "table", "modified", "expiry"}
+	result, err := s.index.Search(req)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	removed := 0
+	for _, hit := range result.Hits {
+		modified, _ := hit.Fields["modified"].(string)
+		expiry, _ := hit.Fields["expiry"].(float64)
+		written, err := time.Parse(time.RFC3339Nano, modified)
+		if err != nil || !isExpired(written, int64(expiry), now) {
+			continue
+		}
+		database, _ := hit.Fields["database"].(string)
+		table, _ := hit.Fields["table"].(string)
+		if s.removeIfExpired(database, table, hit.ID, now) {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// removeIfExpired removes the record if it is still expired, it might have been written since the index was
+// searched.
+func (s *Service) removeIfExpired(database, table, id string, now time.Time) bool {
+	defer s.keys.lock(id)()
+	file := filepath.Join(s.Config.Datapath, "databases", id)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return false
+	}
+	rec := &storemsg.Record{}
+	if err := unmarshalRecord(data, rec); err != nil || !s.recordExpired(file, rec, now) {
+		return false
+	}
+	if _, err := s.remove(database, table, id); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not remove expired record")
+		return false
+	}
+	return true
+}
+
+// sweeper removes the expired records periodically.
+type sweeper struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newSweeper(logger log.Logger, interval time.Duration, sweep func() (int, error)) *sweeper {
+	sw := &sweeper{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(sw.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-sw.stop:
+				return
+			}
+			removed, err := sweep()
+			if err != nil {
+				logger.Error().Err(err).Msg("could not remove the expired records")
+				continue
+			}
+			if removed > 0 {
+				logger.Debug().Int("removed", removed).Msg("removed expired records")
+			}
+		}
+	}()
+	return sw
+}
+
+// Close stops the sweeper and waits for a running sweep.
+func (sw *sweeper) Close() {
+	close(sw.stop)
+	<-sw.done
+}
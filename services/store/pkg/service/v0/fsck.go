@@ -0,0 +1,262 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/blevesearch/bleve/v2"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+)
+
+// lostFoundDir is the directory record files which can't be read are moved to on repair
+const lostFoundDir = "lost+found"
+
+// FsckReport lists the inconsistencies between the index, the counters and the files in the data path.
+// The entries and files are the ids of the records, the paths relative to the databases directory.
+type FsckReport struct {
+	// MissingEntries are records which are not in the index
+	MissingEntries []string
+	// StaleEntries are index entries of records which were deleted or changed since they were indexed
+	StaleEntries []string
+	// MisplacedFiles are records which are not stored at the path of their key, they can't be read by key
+	MisplacedFiles []string
+	// OrphanedFiles are record files which can't be read
+	OrphanedFiles []string
+	// TempFiles are files left behind in the temporary directory by interrupted writes
+	TempFiles []string
+	// CountersDrifted is set when the counters of a table don't match its records
+	CountersDrifted bool
+	// Repaired is set when the inconsistencies were repaired
+	Repaired bool
+}
+
+// Clean returns true if no inconsistencies were found.
+func (r FsckReport) Clean() bool {
+	return len(r.MissingEntries) == 0 && len(r.StaleEntries) == 0 && len(r.MisplacedFiles) == 0 &&
+		len(r.OrphanedFiles) == 0 && len(r.TempFiles) == 0 && !r.CountersDrifted
+}
+
+// fsckFile is a readable record file found by Fsck.
+type fsckFile struct {
+	database string
+	table    string
+	fi       os.FileInfo
+	rec      *storemsg.Record
+}
+
+// Fsck compares the index and the counters with the record files in the data path. If repair is set,
+// missing and stale entries are reindexed or removed, misplaced records are moved to the path of their key,
+// orphaned files are moved to the lost+found directory of the data path, temporary files are removed and
+// the counters are recomputed. Records must not be written or deleted while the check is running.
+func (s *Service) Fsck(repair bool) (FsckReport, error) {
+	report := FsckReport{Repaired: repair}
+	recordsDir := filepath.Join(s.Config.Datapath, "databases")
+
+	files := map[string]fsckFile{}
+	misplaced := map[string]fsckFile{}
+	dbs, err := readDirNames(recordsDir)
+	if err != nil {
+		return report, err
+	}
+	for _, db := range dbs {
+		tables, err := readDirNames(filepath.Join(recordsDir, db))
+		if err != nil {
+			return report, err
+		}
+		for _, table := range tables {
+			err := walkRecords(filepath.Join(recordsDir, db, table), func(file string) error {
+				id, err := filepath.Rel(recordsDir, file)
+				if err != nil {
+					return err
+				}
+				fi, err := os.Stat(file)
+				if err != nil {
+					return err
+				}
+				rec := &storemsg.Record{}
+				data, err := ioutil.ReadFile(file)
+				if err == nil {
+					err = unmarshalRecord(data, rec)
+				}
+				if err != nil {
+					s.log.Debug().Err(err).Str("id", id).Msg("could not read record")
+					report.OrphanedFiles = append(report.OrphanedFiles, id)
+					return nil
+				}
+				f := fsckFile{database: db, table: table, fi: fi, rec: rec}
+				if expected, err := s.getID(db, table, rec.Key); err != nil || expected != id {
+					report.MisplacedFiles = append(report.MisplacedFiles, id)
+					misplaced[id] = f
+					return nil
+				}
+				files[id] = f
+				return nil
+			})
+			if err != nil {
+				return report, err
+			}
+		}
+	}
+
+	indexed, err := s.indexedChecksums()
+	if err != nil {
+		return report, err
+	}
+	for id, checksum := range indexed {
+		f, ok := files[id]
+		if !ok || newBleveDocument(f.database, f.table, f.rec, f.fi.ModTime()).Checksum != checksum {
+			report.StaleEntries = append(report.StaleEntries, id)
+		}
+	}
+	for id := range files {
+		if _, ok := indexed[id]; !ok {
+			report.MissingEntries = append(report.MissingEntries, id)
+		}
+	}
+
+	tmpDir := filepath.Join(s.Config.Datapath, "tmp")
+	tmpFiles, err := ioutil.ReadDir(tmpDir)
+	if err != nil && !os.IsNotExist(err) {
+		return report, err
+	}
+	for _, fi := range tmpFiles {
+		// directories like the staging directory of resharding are not left behind by writes
+		if fi.Mode().IsRegular() {
+			report.TempFiles = append(report.TempFiles, fi.Name())
+		}
+	}
+
+	scanned, err := s.scanStats()
+	if err != nil {
+		return report, err
+	}
+	report.CountersDrifted = countersDrifted(s.stats.snapshot(), scanned)
+
+	for _, ids := range [][]string{report.MissingEntries, report.StaleEntries, report.MisplacedFiles, report.OrphanedFiles, report.TempFiles} {
+		sort.Strings(ids)
+	}
+	if !repair || report.Clean() {
+		return report, nil
+	}
+
+	for _, id := range report.StaleEntries {
+		if err := s.index.Delete(id); err != nil {
+			return report, err
+		}
+	}
+	for _, id := range append(report.StaleEntries, report.MissingEntries...) {
+		if f, ok := files[id]; ok {
+			if err := s.index.Index(id, newBleveDocument(f.database, f.table, f.rec, f.fi.ModTime())); err != nil {
+				return report, err
+			}
+		}
+	}
+	for _, id := range report.MisplacedFiles {
+		f := misplaced[id]
+		expected, err := s.getID(f.database, f.table, f.rec.Key)
+		if err == nil {
+			if _, serr := os.Stat(filepath.Join(recordsDir, expected)); os.IsNotExist(serr) {
+				if err := s.moveFile(filepath.Join(recordsDir, id), filepath.Join(recordsDir, expected)); err != nil {
+					return report, err
+				}
+				if err := s.index.Index(expected, newBleveDocument(f.database, f.table, f.rec, f.fi.ModTime())); err != nil {
+					return report, err
+				}
+				continue
+			}
+		}
+		// the key can't be stored or another version of the record exists at its path
+		if err := s.moveFile(filepath.Join(recordsDir, id), filepath.Join(s.Config.Datapath, lostFoundDir, id)); err != nil {
+			return report, err
+		}
+	}
+	for _, id := range report.OrphanedFiles {
+		if err := s.moveFile(filepath.Join(recordsDir, id), filepath.Join(s.Config.Datapath, lostFoundDir, id)); err != nil {
+			return report, err
+		}
+	}
+	for _, name := range report.TempFiles {
+		if err := os.Remove(filepath.Join(tmpDir, name)); err != nil && !os.IsNotExist(err) {
+			return report, err
+		}
+	}
+
+	if scanned, err = s.scanStats(); err != nil {
+		return report, err
+	}
+	s.stats.reset(scanned)
+	return report, nil
+}
+
+// indexedChecksums returns the checksums of the values of all indexed records by id.
+func (s *Service) indexedChecksums() (map[string]string, error) {
+	indexed := map[string]string{}
+	count, err := s.index.DocCount()
+	if err != nil || count == 0 {
+		return indexed, err
+	}
+	req := bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), int(count), 0, false)
+	req.Fields = []string{"checksum"}
+	result, err := s.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, hit := range result.Hits {
+		indexed[hit.ID], _ = hit.Fields["checksum"].(string)
+	}
+	return indexed, nil
+}
+
+// moveFile moves a record file and evicts it from the cache.
+func (s *Service) moveFile(file, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+		return err
+	}
+	if err := os.Rename(file, target); err != nil {
+		return err
+	}
+	if id, err := filepath.Rel(filepath.Join(s.Config.Datapath, "databases"), file); err == nil {
+		s.cache.invalidate(id)
+	}
+	return nil
+}
+
+// countersDrifted checks if the number and size of the records of the counted tables differ from a scan.
+func countersDrifted(counted, scanned map[[2]string]*tableStats) bool {
+	for _, tables := range [][2]map[[2]string]*tableStats{{counted, scanned}, {scanned, counted}} {
+		for k, t := range tables[0] {
+			o, ok := tables[1][k]
+			if !ok {
+				o = &tableStats{}
+			}
+			if t.records != o.records || t.bytes != o.bytes {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fsckOnStart checks the data path and logs the inconsistencies, the service starts anyway.
+func (s *Service) fsckOnStart(repair bool) {
+	report, err := s.Fsck(repair)
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not check the consistency of the data path")
+		return
+	}
+	if report.Clean() {
+		s.log.Info().Msg("the data path is consistent")
+		return
+	}
+	s.log.Warn().
+		Strs("missing_entries", report.MissingEntries).
+		Strs("stale_entries", report.StaleEntries).
+		Strs("misplaced_files", report.MisplacedFiles).
+		Strs("orphaned_files", report.OrphanedFiles).
+		Strs("temp_files", report.TempFiles).
+		Bool("counters_drifted", report.CountersDrifted).
+		Bool("repaired", report.Repaired).
+		Msg("found inconsistencies in the data path")
+}
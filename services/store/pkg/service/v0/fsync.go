@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+)
+
+// writeFile atomically replaces the file with the given data by writing it to a temporary file first.
+// Depending on the fsync policy, the file and its directory are synced right away, later or never.
+// Transient errors of writing and renaming the file are retried until the context is done.
+func (s *Service) writeFile(ctx context.Context, file string, data []byte) error {
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	// the temporary files don't live next to the records, they would be indexed otherwise.
+	// With a write-ahead log the records are synced at the next checkpoint.
+	sync := s.Config.FsyncPolicy == config.FsyncPolicyAlways && s.wal == nil
+	tmp, err := s.retry.writeTempFile(ctx, filepath.Join(s.Config.Datapath, "tmp"), "record-", data, sync)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if err := s.retry.rename(ctx, tmp, file); err != nil {
+		return err
+	}
+
+	return s.synced(file, dir)
+}
+
+// removeFile removes the file and, depending on the fsync policy, syncs its directory.
+func (s *Service) removeFile(file string) error {
+	if err := os.Remove(file); err != nil {
+		return err
+	}
+	return s.synced(filepath.Dir(file))
+}
+
+// synced makes sure the given paths are synced according to the fsync policy.
+func (s *Service) synced(paths ...string) error {
+	if s.wal != nil {
+		s.wal.markDirty(paths...)
+		return nil
+	}
+	switch s.Config.FsyncPolicy {
+	case config.FsyncPolicyAlways:
+		for _, p := range paths {
+			if err := syncPath(p); err != nil {
+				return err
+			}
+		}
+	case config.FsyncPolicyInterval:
+		s.flusher.add(paths...)
+	}
+	return nil
+}
+
+// syncPath flushes the file or directory at the given path to the disk.
+func syncPath(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	err = f.Sync()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// flusher syncs changed files in the background, either after a number of writes or periodically.
+type flusher struct {
+	log       log.Logger
+	batchSize int
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+	writes  int
+
+	kick chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newFlusher(logger log.Logger, interval time.Duration, batchSize int) *flusher {
+	f := &flusher{
+		log:       logger,
+		batchSize: batchSize,
+		pending:   map[string]struct{}{},
+		kick:      make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go f.run(interval)
+	return f
+}
+
+// add schedules the paths of a write to be synced.
+func (f *flusher) add(paths ...string) {
+	f.mu.Lock()
+	for _, p := range paths {
+		f.pending[p] = struct{}{}
+	}
+	f.writes++
+	full := f.batchSize > 0 && f.writes >= f.batchSize
+	f.mu.Unlock()
+
+	if full {
+		select {
+		case f.kick <- struct{}{}:
+		default:
+			// a flush is already scheduled
+		}
+	}
+}
+
+func (f *flusher) run(interval time.Duration) {
+	defer close(f.done)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-tick:
+		case <-f.kick:
+		case <-f.stop:
+			f.flush()
+			return
+		}
+		f.flush()
+	}
+}
+
+// flush syncs all pending paths.
+func (f *flusher) flush() {
+	f.mu.Lock()
+	pending := f.pending
+	f.pending = map[string]struct{}{}
+	f.writes = 0
+	f.mu.Unlock()
+
+	for p := range pending {
+		// the file may have been deleted in the meantime
+		if err := syncPath(p); err != nil && !os.IsNotExist(err) {
+			f.log.Error().Err(err).Str("path", p).Msg("could not sync file")
+		}
+	}
+}
+
+// Close syncs the pending paths and stops the flusher.
+func (f *flusher) Close() {
+	close(f.stop)
+	<-f.done
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+)
+
+// listKeys reads all records of a table and returns their keys. The keys have to be read
+// from the records because the file names of long keys are hashed.
+func (s *Service) listKeys(ctx context.Context, database, table string) ([]string, error) {
+	var keys []string
+	err := walkRecords(filepath.Join(s.Config.Datapath, "databases", database, table), func(file string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := s.retry.readFile(ctx, file)
+		if err != nil {
+			return err
+		}
+		rec := &storemsg.Record{}
+		if err := unmarshalRecord(data, rec); err != nil {
+			return err
+		}
+		keys = append(keys, rec.Key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// listInfos returns the infos of all records of a table from the index.
+func (s *Service) listInfos(ctx context.Context, database, table string) ([]*storemsg.RecordInfo, error) {
+	count, err := s.index.DocCount()
+	if err != nil || count == 0 {
+		return nil, err
+	}
+
+	dtq := bleve.NewTermQuery(database)
+	dtq.SetField("database")
+	ttq := bleve.NewTermQuery(table)
+	ttq.SetField("table")
+
+	req := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(dtq, ttq), int(count), 0, false)
+	req.Fields = []string{"key", "size", "modified", "checksum"}
+	req.SortBy([]string{"key"})
+	result, err := s.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*storemsg.RecordInfo, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		info := &storemsg.RecordInfo{}
+		info.Key, _ = hit.Fields["key"].(string)
+		info.Checksum, _ = hit.Fields["checksum"].(string)
+		// numbers are stored as float64 in the index
+		if size, ok := hit.Fields["size"].(float64); ok {
+			info.Size = uint64(size)
+		}
+		if modified, ok := hit.Fields["modified"].(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, modified); err == nil {
+				info.Modified = t.UnixNano()
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// matches checks if the key has the prefix and suffix of the list options.
+func matches(key string, opts *storemsg.ListOptions) bool {
+	return strings.HasPrefix(key, opts.Prefix) && strings.HasSuffix(key, opts.Suffix)
+}
+
+// page returns the bounds of the page selected by the offset and limit of the list options.
+func page(n int, opts *storemsg.ListOptions) (int, int) {
+	if opts.Offset >= uint64(n) {
+		return n, n
+	}
+	start, end := int(opts.Offset), n
+	if opts.Limit > 0 && opts.Limit < uint64(end-start) {
+		end = start + int(opts.Limit)
+	}
+	return start, end
+}
+
+// readDirNames returns the names of the entries of a directory, a missing directory is empty.
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdirnames(0)
+}
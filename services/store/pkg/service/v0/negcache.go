@@ -0,0 +1,117 @@
+package service
+
+import (
+	clist "container/list"
+	"sync"
+	"time"
+
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
+)
+
+// negativeEntry is the id of a record which was found missing.
+type negativeEntry struct {
+	id      string
+	expires time.Time
+}
+
+// negativeCache is an LRU cache of the ids of records which were recently found missing, so repeated reads of
+// absent keys don't stat the record file every time. Entries expire after the ttl and are invalidated by the
+// writes of this service. Records created by other processes are only read once the entry expired.
+type negativeCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	order      *clist.List
+	entries    map[string]*clist.Element
+	// generation is increased by every invalidation, a miss is only added if nothing was written since the
+	// read started, so a concurrent write is never hidden
+	generation uint64
+	hits       uint64
+	metrics    *metrics.Metrics
+}
+
+// newNegativeCache returns nil if the cache is disabled, reads always check the record file then.
+func newNegativeCache(maxEntries int, ttl time.Duration, m *metrics.Metrics) *negativeCache {
+	if maxEntries <= 0 || ttl <= 0 {
+		return nil
+	}
+	return &negativeCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		order:      clist.New(),
+		entries:    map[string]*clist.Element{},
+		metrics:    m,
+	}
+}
+
+// lookup returns true if the record was recently found missing. Otherwise it returns the generation to add a
+// miss of the read with.
+func (c *negativeCache) lookup(id string) (bool, uint64) {
+	if c == nil {
+		return false, 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[id]
+	if !ok {
+		return false, c.generation
+	}
+	if time.Now().After(el.Value.(*negativeEntry).expires) {
+		c.remove(el)
+		return false, c.generation
+	}
+	c.order.MoveToFront(el)
+	c.hits++
+	if c.metrics != nil {
+		c.metrics.NegativeCacheHits.Inc()
+	}
+	return true, 0
+}
+
+// add records that the record is missing, unless a record was written since the generation was looked up.
+func (c *negativeCache) add(id string, generation uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+	c.entries[id] = c.order.PushFront(&negativeEntry{id: id, expires: time.Now().Add(c.ttl)})
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// invalidate removes the record with the given id from the cache, it has to be called once the record was
+// written.
+func (c *negativeCache) invalidate(id string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *negativeCache) remove(el *clist.Element) {
+	e := c.order.Remove(el).(*negativeEntry)
+	delete(c.entries, e.id)
+}
+
+// hitCount returns the number of reads served from the cache since it was created.
+func (c *negativeCache) hitCount() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
 )
 
 // Option defines a single option function.
@@ -10,8 +11,9 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Logger log.Logger
-	Config *config.Config
+	Logger  log.Logger
+	Config  *config.Config
+	Metrics *metrics.Metrics
 
 	Database, Table string
 	Nodes           []string
@@ -61,3 +63,10 @@ func Config(val *config.Config) Option {
 		o.Config = val
 	}
 }
+
+// Metrics configures the Metrics option.
+func Metrics(val *metrics.Metrics) Option {
+	return func(o *Options) {
+		o.Metrics = val
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	merrors "go-micro.dev/v4/errors"
+)
+
+// quotaFile holds the quotas of single databases, overriding the configured default quota.
+const quotaFile = "quotas.json"
+
+// Quota limits the number and size of the records of a database. Zero values are unlimited.
+type Quota struct {
+	MaxRecords uint64 `json:"max_records,omitempty"`
+	MaxBytes   uint64 `json:"max_bytes,omitempty"`
+}
+
+// Usage is the number and size of the records of a database.
+type Usage struct {
+	Records uint64
+	Bytes   uint64
+}
+
+type quotas struct {
+	Databases map[string]Quota `json:"databases"`
+}
+
+// ReadQuotas reads the quotas of the databases from the quota file in the data path. A missing file
+// defines no quotas.
+func ReadQuotas(datapath string) (map[string]Quota, error) {
+	q := quotas{}
+	data, err := ioutil.ReadFile(filepath.Join(datapath, quotaFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]Quota{}, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &q); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", quotaFile, err)
+	}
+	if q.Databases == nil {
+		q.Databases = map[string]Quota{}
+	}
+	return q.Databases, nil
+}
+
+// WriteQuotas atomically replaces the quota file in the data path. A running store service picks up
+// the changed quotas with its next write.
+func WriteQuotas(datapath string, databases map[string]Quota) error {
+	data, err := json.MarshalIndent(quotas{Databases: databases}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(datapath, "tmp"), 0700); err != nil {
+		return err
+	}
+	return replaceDataPathFile(datapath, quotaFile, data)
+}
+
+// DatabaseUsage adds up the size of the record files of every database in the data path. Records
+// written or deleted while the files are walked may be missed.
+func DatabaseUsage(cfg *config.Config) (map[string]Usage, error) {
+	databases := filepath.Join(cfg.Datapath, "databases")
+	dbs, err := readDirNames(databases)
+	if err != nil {
+		return nil, err
+	}
+	usage := make(map[string]Usage, len(dbs))
+	for _, db := range dbs {
+		u := Usage{}
+		if err := walkRecords(filepath.Join(databases, db), func(file string) error {
+			fi, err := os.Stat(file)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			u.Records++
+			u.Bytes += uint64(fi.Size())
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		usage[db] = u
+	}
+	return usage, nil
+}
+
+// quotaSet returns the quota of a database. The quota file is read again whenever it was changed.
+type quotaSet struct {
+	log      log.Logger
+	datapath string
+	defaults Quota
+
+	mu        sync.Mutex
+	modified  time.Time
+	databases map[string]Quota
+}
+
+func newQuotaSet(logger log.Logger, cfg *config.Config) (*quotaSet, error) {
+	q := &quotaSet{
+		log:      logger,
+		datapath: cfg.Datapath,
+		defaults: Quota{MaxRecords: uint64(cfg.QuotaMaxRecords), MaxBytes: uint64(cfg.QuotaMaxBytes)},
+	}
+	if err := q.reload(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// reload reads the quota file if it was changed since it was read the last time.
+func (q *quotaSet) reload() error {
+	fi, err := os.Stat(filepath.Join(q.datapath, quotaFile))
+	switch {
+	case os.IsNotExist(err):
+		q.modified, q.databases = time.Time{}, nil
+		return nil
+	case err != nil:
+		return err
+	case fi.ModTime().Equal(q.modified) && q.databases != nil:
+		return nil
+	}
+	databases, err := ReadQuotas(q.datapath)
+	if err != nil {
+		return err
+	}
+	q.modified, q.databases = fi.ModTime(), databases
+	return nil
+}
+
+// get returns the quota of the database. The previous quotas are kept if the quota file can't be read.
+func (q *quotaSet) get(database string) Quota {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.reload(); err != nil {
+		q.log.Error().Err(err).Msg("could not read the quotas, keeping the previous ones")
+	}
+	if quota, ok := q.databases[database]; ok {
+		return quota
+	}
+	return q.defaults
+}
+
+// checkQuota rejects writes which would make the records of the database exceed its quota. Writes which don't
+// add a record or grow it are always accepted, so records can still be updated after a quota was lowered.
+// Concurrent writes of different records are checked against the same usage and can exceed the quota slightly.
+func (s *Service) checkQuota(database string, previous os.FileInfo, size int64) error {
+	quota := s.quotas.get(database)
+	if quota.MaxRecords == 0 && quota.MaxBytes == 0 {
+		return nil
+	}
+	records, bytes := s.stats.usage(database)
+	var previousSize int64
+	if previous != nil {
+		previousSize = previous.Size()
+	}
+	if quota.MaxRecords > 0 && previous == nil && records+1 > quota.MaxRecords {
+		return merrors.New(s.id, fmt.Sprintf("the quota of database %s of %d records is exceeded", database, quota.MaxRecords), http.StatusInsufficientStorage)
+	}
+	if quota.MaxBytes > 0 && size > previousSize && bytes+uint64(size-previousSize) > quota.MaxBytes {
+		return merrors.New(s.id, fmt.Sprintf("the quota of database %s of %d bytes is exceeded", database, quota.MaxBytes), http.StatusInsufficientStorage)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"syscall"
+	"time"
+
+	merrors "go-micro.dev/v4/errors"
+)
+
+// the low-level file operations of the records, tests replace them to simulate a slow or failing filesystem
+var (
+	readFile      = ioutil.ReadFile
+	writeTempFile = createTempFile
+	renameFile    = os.Rename
+)
+
+// transientErrors are the errors of file operations which may succeed when they are retried, e.g. after
+// space was freed or a hiccup of a network filesystem.
+var transientErrors = []error{
+	syscall.EAGAIN,
+	syscall.EINTR,
+	syscall.EBUSY,
+	syscall.ENOSPC,
+	syscall.ESTALE,
+	syscall.ETIMEDOUT,
+}
+
+// retrier retries file operations failing with transient errors, the backoff doubles with every retry.
+// The zero value doesn't retry.
+type retrier struct {
+	attempts int
+	backoff  time.Duration
+}
+
+// errRetriesExhausted is returned if a file operation still failed with a transient error after all retries.
+type errRetriesExhausted struct {
+	op       string
+	attempts int
+	err      error
+}
+
+func (e errRetriesExhausted) Error() string {
+	return fmt.Sprintf("retries exhausted, the %s failed %d times: %s", e.op, e.attempts, e.err)
+}
+
+func (e errRetriesExhausted) Unwrap() error {
+	return e.err
+}
+
+// do runs fn until it succeeds, fails with an error which isn't transient or the retries are exhausted.
+// The error of the context is returned if it is done while waiting for the next attempt.
+func (r retrier) do(ctx context.Context, op string, fn func() error) error {
+	backoff := r.backoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !isTransient(err) {
+			return err
+		}
+		if r.attempts <= 0 {
+			return err
+		}
+		if attempt > r.attempts {
+			return errRetriesExhausted{op: op, attempts: attempt, err: err}
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (r retrier) readFile(ctx context.Context, file string) (data []byte, err error) {
+	err = r.do(ctx, "read", func() error {
+		data, err = readFile(file)
+		return err
+	})
+	return data, err
+}
+
+func (r retrier) writeTempFile(ctx context.Context, dir, pattern string, data []byte, sync bool) (name string, err error) {
+	err = r.do(ctx, "write", func() error {
+		name, err = writeTempFile(dir, pattern, data, sync)
+		return err
+	})
+	return name, err
+}
+
+func (r retrier) rename(ctx context.Context, from, to string) error {
+	return r.do(ctx, "rename", func() error {
+		return renameFile(from, to)
+	})
+}
+
+// fileError returns the error of a failed file operation. Transient errors which persisted after all retries are
+// reported as unavailable, exceeded deadlines as timeouts and all other errors as the fallback.
+func (s *Service) fileError(op string, err error, fallback error) error {
+	var exhausted errRetriesExhausted
+	switch {
+	case errors.As(err, &exhausted):
+		return merrors.New(s.id, exhausted.Error(), http.StatusServiceUnavailable)
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
+		return s.operationError(op, err)
+	}
+	return fallback
+}
+
+// isTransient checks if the error of a file operation is worth retrying.
+func isTransient(err error) bool {
+	for _, t := range transientErrors {
+		if errors.Is(err, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// createTempFile writes the data to a new temporary file in dir and returns its name. The file is removed
+// again if it couldn't be written.
+func createTempFile(dir, pattern string, data []byte, sync bool) (string, error) {
+	tmp, err := ioutil.TempFile(dir, pattern)
+	if err != nil {
+		return "", err
+	}
+	if _, err = tmp.Write(data); err == nil && sync {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
@@ -0,0 +1,603 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
+	merrors "go-micro.dev/v4/errors"
+)
+
+const (
+	// maxS3KeyLength is the maximum length of an escaped record key in an object name, longer keys are hashed.
+	// Object names are limited to 1024 bytes, the rest is left for the prefix, database and table.
+	maxS3KeyLength = 512
+
+	// the objects of a table are stored under the escaped key, or under the hash of the key if it is too long
+	s3KeysDir   = "k"
+	s3HashesDir = "h"
+
+	// user metadata of the record objects, the record infos and the expiry are listed without reading the records
+	s3MetaSize     = "Record-Size"
+	s3MetaChecksum = "Record-Checksum"
+	s3MetaExpiry   = "Record-Expiry"
+)
+
+// S3Service implements the StoreHandler interface with an S3 compatible object storage. Every record is stored as an
+// object named {prefix}/{database}/{table}/k/{escaped key}, serialized and compressed like the records of the
+// filesystem backend, including the record metadata. Queries by metadata read all records of the table.
+type S3Service struct {
+	id      string
+	log     log.Logger
+	Config  *config.Config
+	metrics *metrics.Metrics
+	client  *minio.Client
+	bucket  string
+	prefix  string
+	// keys serializes the changes of each record within this service
+	keys keyLocks
+
+	lifecycle sync.Mutex
+	closing   bool
+	inflight  sync.WaitGroup
+}
+
+// NewS3 returns a store using the object storage configured in the S3 options of the config.
+func NewS3(opts ...Option) (*S3Service, error) {
+	return newS3(http.DefaultTransport, opts...)
+}
+
+func newS3(transport http.RoundTripper, opts ...Option) (*S3Service, error) {
+	options := newOptions(opts...)
+	cfg := options.Config
+
+	client, err := minio.New(cfg.S3.Endpoint, &minio.Options{
+		Creds:     credentials.NewStaticV4(cfg.S3.AccessKey, cfg.S3.SecretKey, ""),
+		Secure:    !cfg.S3.Insecure,
+		Region:    cfg.S3.Region,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	exists, err := client.BucketExists(ctx, cfg.S3.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("could not check the bucket %s: %w", cfg.S3.Bucket, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("the bucket %s doesn't exist", cfg.S3.Bucket)
+	}
+
+	prefix := strings.Trim(cfg.S3.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &S3Service{
+		id:      cfg.GRPC.Namespace + "." + cfg.Service.Name,
+		log:     options.Logger,
+		Config:  cfg,
+		metrics: options.Metrics,
+		client:  client,
+		bucket:  cfg.S3.Bucket,
+		prefix:  prefix,
+	}, nil
+}
+
+// Read implements the StoreHandler interface.
+func (s *S3Service) Read(c context.Context, rreq *storesvc.ReadRequest, rres *storesvc.ReadResponse) error {
+	opts := rreq.Options
+	if opts == nil {
+		opts = &storemsg.ReadOptions{}
+	}
+	ctx, cancel := s.operationContext(c, s.Config.ReadTimeout)
+	defer cancel()
+
+	if len(rreq.Key) != 0 {
+		name, err := s.objectName(opts.Database, opts.Table, rreq.Key)
+		if err != nil {
+			return merrors.BadRequest(s.id, "%s", err)
+		}
+		rec, err := s.readRecord(ctx, name)
+		if err != nil {
+			return s.objectError(opRead, err, "could not read record")
+		}
+		rres.Records = append(rres.Records, rec)
+		return nil
+	}
+	if opts.Where == nil {
+		return merrors.InternalServerError(s.id, "neither id nor metadata present")
+	}
+	if !isValidFileName(opts.Database) || !isValidFileName(opts.Table) {
+		return merrors.BadRequest(s.id, "invalid database or table name")
+	}
+
+	objects, err := s.listObjects(ctx, s.tablePrefix(opts.Database, opts.Table), true)
+	if err != nil {
+		return s.objectError(opRead, err, "could not list records")
+	}
+	for _, o := range objects {
+		rec, err := s.readRecord(ctx, o.Key)
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			// deleted while reading the table
+			continue
+		}
+		if err != nil {
+			return s.objectError(opRead, err, "could not read record")
+		}
+		if matchesWhere(rec, opts.Where) {
+			rres.Records = append(rres.Records, rec)
+		}
+	}
+	return nil
+}
+
+// matchesWhere checks if the record has all the metadata values.
+func matchesWhere(rec *storemsg.Record, where map[string]*storemsg.Field) bool {
+	for k, v := range where {
+		if f, ok := rec.Metadata[k]; !ok || f.GetValue() != v.GetValue() {
+			return false
+		}
+	}
+	return true
+}
+
+// Write implements the StoreHandler interface.
+func (s *S3Service) Write(c context.Context, wreq *storesvc.WriteRequest, wres *storesvc.WriteResponse) error {
+	if err := s.beginWrite(); err != nil {
+		return err
+	}
+	defer s.endWrite()
+	opts := wreq.Options
+	if opts == nil {
+		opts = &storemsg.WriteOptions{}
+	}
+	ctx, cancel := s.operationContext(c, s.Config.WriteTimeout)
+	defer cancel()
+
+	if err := checkRecordLimits(s.id, s.Config, wreq.Record); err != nil {
+		return err
+	}
+	name, err := s.objectName(opts.Database, opts.Table, wreq.Record.Key)
+	if err != nil {
+		return merrors.BadRequest(s.id, "%s", err)
+	}
+	defer s.keys.lock(name)()
+	if err := s.writeRecord(ctx, name, wreq.Record); err != nil {
+		return s.objectError(opWrite, err, "could not write record")
+	}
+	return nil
+}
+
+// Delete implements the StoreHandler interface.
+func (s *S3Service) Delete(c context.Context, dreq *storesvc.DeleteRequest, dres *storesvc.DeleteResponse) error {
+	if err := s.beginWrite(); err != nil {
+		return err
+	}
+	defer s.endWrite()
+	opts := dreq.Options
+	if opts == nil {
+		opts = &storemsg.DeleteOptions{}
+	}
+	ctx, cancel := s.operationContext(c, s.Config.WriteTimeout)
+	defer cancel()
+
+	name, err := s.objectName(opts.Database, opts.Table, dreq.Key)
+	if err != nil {
+		return merrors.BadRequest(s.id, "%s", err)
+	}
+	defer s.keys.lock(name)()
+	// deleting a missing object succeeds, the record is looked up to report missing records like the filesystem backend
+	if _, err := s.client.StatObject(ctx, s.bucket, name, minio.StatObjectOptions{}); err != nil {
+		return s.objectError(opDelete, err, "could not delete record")
+	}
+	if err := s.client.RemoveObject(ctx, s.bucket, name, minio.RemoveObjectOptions{}); err != nil {
+		return s.objectError(opDelete, err, "could not delete record")
+	}
+	return nil
+}
+
+// List implements the StoreHandler interface.
+func (s *S3Service) List(c context.Context, lreq *storesvc.ListRequest, stream storesvc.Store_ListStream) error {
+	opts := lreq.Options
+	if opts == nil {
+		opts = &storemsg.ListOptions{}
+	}
+	if !isValidFileName(opts.Database) || !isValidFileName(opts.Table) {
+		return merrors.BadRequest(s.id, "invalid database or table name")
+	}
+	ctx, cancel := s.operationContext(c, s.Config.ListTimeout)
+	defer cancel()
+
+	objects, err := s.listObjects(ctx, s.tablePrefix(opts.Database, opts.Table), true)
+	if err != nil {
+		return s.objectError(opList, err, "could not list records")
+	}
+	infos := make([]*storemsg.RecordInfo, 0, len(objects))
+	for _, o := range objects {
+		info, err := s.recordInfo(ctx, o, opts)
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			continue
+		}
+		if err != nil {
+			return s.objectError(opList, err, "could not list records")
+		}
+		if matches(info.Key, opts) {
+			infos = append(infos, info)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
+
+	res := &storesvc.ListResponse{}
+	start, end := page(len(infos), opts)
+	for _, info := range infos[start:end] {
+		res.Keys = append(res.Keys, info.Key)
+		if opts.MetadataOnly {
+			res.Infos = append(res.Infos, info)
+		}
+	}
+	return stream.Send(res)
+}
+
+// recordInfo returns the key of a listed object, the other infos are only read in metadata only mode.
+// The keys of hashed objects are read from the record.
+func (s *S3Service) recordInfo(ctx context.Context, o minio.ObjectInfo, opts *storemsg.ListOptions) (*storemsg.RecordInfo, error) {
+	info := &storemsg.RecordInfo{}
+	dir, name := path.Split(o.Key)
+	if path.Base(dir) == s3KeysDir {
+		key, err := url.PathUnescape(name)
+		if err != nil {
+			return nil, err
+		}
+		info.Key = key
+	} else {
+		rec, err := s.readRecord(ctx, o.Key)
+		if err != nil {
+			return nil, err
+		}
+		info.Key = rec.Key
+	}
+	if !opts.MetadataOnly || !matches(info.Key, opts) {
+		return info, nil
+	}
+
+	stat, err := s.client.StatObject(ctx, s.bucket, o.Key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	info.Size, _ = strconv.ParseUint(stat.UserMetadata[s3MetaSize], 10, 64)
+	info.Checksum = stat.UserMetadata[s3MetaChecksum]
+	info.Modified = stat.LastModified.UnixNano()
+	return info, nil
+}
+
+// Databases implements the StoreHandler interface.
+func (s *S3Service) Databases(c context.Context, dbreq *storesvc.DatabasesRequest, dbres *storesvc.DatabasesResponse) error {
+	names, err := s.listDirs(c, s.prefix)
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not list the databases")
+	}
+	dbres.Databases = names
+	return nil
+}
+
+// Tables implements the StoreHandler interface.
+func (s *S3Service) Tables(c context.Context, in *storesvc.TablesRequest, out *storesvc.TablesResponse) error {
+	if !isValidFileName(in.Database) {
+		return merrors.BadRequest(s.id, "invalid database name")
+	}
+	names, err := s.listDirs(c, s.prefix+in.Database+"/")
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not list the tables")
+	}
+	out.Tables = names
+	return nil
+}
+
+// Stats implements the StoreHandler interface. There are no counters, all objects are listed for every request.
+func (s *S3Service) Stats(c context.Context, sreq *storesvc.StatsRequest, sres *storesvc.StatsResponse) error {
+	objects, err := s.listObjects(c, s.prefix, true)
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not list the records")
+		return merrors.InternalServerError(s.id, "could not list the records")
+	}
+
+	now := time.Now()
+	total := &storemsg.TableStats{}
+	tables := map[[2]string]*storemsg.TableStats{}
+	for _, o := range objects {
+		// {database}/{table}/{k|h}/{name}
+		parts := strings.Split(strings.TrimPrefix(o.Key, s.prefix), "/")
+		if len(parts) != 4 {
+			continue
+		}
+		k := [2]string{parts[0], parts[1]}
+		t, ok := tables[k]
+		if !ok {
+			t = &storemsg.TableStats{Database: parts[0], Table: parts[1]}
+			tables[k] = t
+		}
+		stat, err := s.client.StatObject(c, s.bucket, o.Key, minio.StatObjectOptions{})
+		if err != nil {
+			continue
+		}
+		modified := stat.LastModified.UnixNano()
+		for _, ts := range []*storemsg.TableStats{t, total} {
+			ts.Records++
+			ts.Bytes += uint64(stat.Size)
+			if ts.Oldest == 0 || modified < ts.Oldest {
+				ts.Oldest = modified
+			}
+			if modified > ts.Newest {
+				ts.Newest = modified
+			}
+			if expiry, _ := strconv.ParseInt(stat.UserMetadata[s3MetaExpiry], 10, 64); isExpired(stat.LastModified, expiry, now) {
+				ts.Expired++
+			}
+		}
+	}
+
+	sres.Total = total
+	for _, t := range tables {
+		sres.Tables = append(sres.Tables, t)
+	}
+	sort.Slice(sres.Tables, func(i, j int) bool {
+		if sres.Tables[i].Database != sres.Tables[j].Database {
+			return sres.Tables[i].Database < sres.Tables[j].Database
+		}
+		return sres.Tables[i].Table < sres.Tables[j].Table
+	})
+	return nil
+}
+
+// AppendElements implements the StoreHandler interface.
+func (s *S3Service) AppendElements(c context.Context, req *storesvc.AppendElementsRequest, res *storesvc.AppendElementsResponse) error {
+	value, changed, err := s.updateElements(c, req.Key, req.Options, req.Elements, true, req.Expiry, appendElementsFunc(req.Elements, req.Unique))
+	if err != nil {
+		return err
+	}
+	res.Value = value
+	res.Changed = uint32(changed)
+	return nil
+}
+
+// RemoveElements implements the StoreHandler interface.
+func (s *S3Service) RemoveElements(c context.Context, req *storesvc.RemoveElementsRequest, res *storesvc.RemoveElementsResponse) error {
+	value, changed, err := s.updateElements(c, req.Key, req.Options, req.Elements, false, 0, removeElementsFunc(req.Elements))
+	if err != nil {
+		return err
+	}
+	res.Value = value
+	res.Changed = uint32(changed)
+	return nil
+}
+
+// updateElements updates the JSON array of the record like the filesystem backend. Concurrent updates of the
+// same record are only serialized within this service, a change by another instance in between is overwritten.
+func (s *S3Service) updateElements(c context.Context, key string, opts *storemsg.WriteOptions, elements [][]byte, create bool, expiry int64, fn elementsFunc) ([]byte, int, error) {
+	if err := s.beginWrite(); err != nil {
+		return nil, 0, err
+	}
+	defer s.endWrite()
+	if opts == nil {
+		opts = &storemsg.WriteOptions{}
+	}
+	ctx, cancel := s.operationContext(c, s.Config.WriteTimeout)
+	defer cancel()
+
+	if err := validateElements(s.id, elements); err != nil {
+		return nil, 0, err
+	}
+	name, err := s.objectName(opts.Database, opts.Table, key)
+	if err != nil {
+		return nil, 0, merrors.BadRequest(s.id, "%s", err)
+	}
+	defer s.keys.lock(name)()
+
+	rec, err := s.readRecord(ctx, name)
+	if err == nil && rec.Expiry > 0 {
+		// expired records count as missing, like in the filesystem backend
+		if stat, serr := s.client.StatObject(ctx, s.bucket, name, minio.StatObjectOptions{}); serr == nil && isExpired(stat.LastModified, rec.Expiry, time.Now()) {
+			rec, err = nil, minio.ErrorResponse{Code: "NoSuchKey"}
+		}
+	}
+	switch {
+	case err == nil:
+	case minio.ToErrorResponse(err).Code == "NoSuchKey" && create:
+		rec = &storemsg.Record{Key: key}
+	default:
+		return nil, 0, s.objectError(opRead, err, "could not read record")
+	}
+
+	value, changed, err := updateElementsValue(s.id, rec.Value, fn)
+	if err != nil || changed == 0 {
+		return value, 0, err
+	}
+	rec.Value = value
+	if expiry > 0 {
+		rec.Expiry = expiry
+	}
+	if err := checkRecordLimits(s.id, s.Config, rec); err != nil {
+		return nil, 0, err
+	}
+	if err := s.writeRecord(ctx, name, rec); err != nil {
+		return nil, 0, s.objectError(opWrite, err, "could not write record")
+	}
+	return rec.Value, changed, nil
+}
+
+// Close stops accepting writes and deletes and waits for the in-flight ones.
+func (s *S3Service) Close(ctx context.Context) error {
+	s.lifecycle.Lock()
+	s.closing = true
+	s.lifecycle.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("in-flight writes didn't finish before the shutdown timeout: %w", ctx.Err())
+	}
+}
+
+func (s *S3Service) beginWrite() error {
+	s.lifecycle.Lock()
+	defer s.lifecycle.Unlock()
+	if s.closing {
+		return merrors.New(s.id, "the store is shutting down", http.StatusServiceUnavailable)
+	}
+	s.inflight.Add(1)
+	return nil
+}
+
+func (s *S3Service) endWrite() {
+	s.inflight.Done()
+}
+
+// objectName returns the name of the object of a record. Keys whose escaped form is too long are stored under
+// their hash, or rejected, depending on the configured key policy.
+func (s *S3Service) objectName(database, table, key string) (string, error) {
+	if !isValidFileName(database) {
+		return "", fmt.Errorf("invalid database name")
+	}
+	if !isValidFileName(table) {
+		return "", fmt.Errorf("invalid table name")
+	}
+	if key == "" {
+		return "", fmt.Errorf("key is empty")
+	}
+	if escaped := url.PathEscape(key); len(escaped) <= maxS3KeyLength {
+		return s.tablePrefix(database, table) + s3KeysDir + "/" + escaped, nil
+	}
+	if s.Config.KeyPolicy != config.KeyPolicyHash {
+		return "", fmt.Errorf("key is too long")
+	}
+	sum := sha256.Sum256([]byte(key))
+	return s.tablePrefix(database, table) + s3HashesDir + "/" + hex.EncodeToString(sum[:]), nil
+}
+
+func (s *S3Service) tablePrefix(database, table string) string {
+	return s.prefix + database + "/" + table + "/"
+}
+
+func (s *S3Service) readRecord(ctx context.Context, name string) (*storemsg.Record, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	data, err := ioutil.ReadAll(obj)
+	if err != nil {
+		return nil, err
+	}
+	rec := &storemsg.Record{}
+	if err := unmarshalRecord(data, rec); err != nil {
+		return nil, errUnmarshal{err}
+	}
+	return rec, nil
+}
+
+func (s *S3Service) writeRecord(ctx context.Context, name string, rec *storemsg.Record) error {
+	data, err := marshalRecord(s.Config, s.metrics, rec)
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not marshal record")
+	}
+	sum := sha256.Sum256(rec.Value)
+	meta := map[string]string{
+		s3MetaSize:     strconv.Itoa(len(rec.Value)),
+		s3MetaChecksum: hex.EncodeToString(sum[:]),
+	}
+	if rec.Expiry > 0 {
+		meta[s3MetaExpiry] = strconv.FormatInt(rec.Expiry, 10)
+	}
+	_, err = s.client.PutObject(ctx, s.bucket, name, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType:  "application/octet-stream",
+		UserMetadata: meta,
+	})
+	return err
+}
+
+// listObjects lists the objects with the prefix, the list API is called with the configured page size until all
+// objects are listed. Without recursion the common prefixes are returned as objects ending with a slash.
+func (s *S3Service) listObjects(ctx context.Context, prefix string, recursive bool) ([]minio.ObjectInfo, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	// stops the listing if it is abandoned after an error
+	defer cancel()
+	var objects []minio.ObjectInfo
+	for o := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: recursive,
+		MaxKeys:   s.Config.S3.ListPageSize,
+	}) {
+		if o.Err != nil {
+			return nil, o.Err
+		}
+		objects = append(objects, o)
+	}
+	return objects, ctx.Err()
+}
+
+// listDirs returns the names of the common prefixes below the prefix.
+func (s *S3Service) listDirs(ctx context.Context, prefix string) ([]string, error) {
+	objects, err := s.listObjects(ctx, prefix, false)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(objects))
+	for _, o := range objects {
+		if name := strings.TrimSuffix(strings.TrimPrefix(o.Key, prefix), "/"); strings.HasSuffix(o.Key, "/") && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (s *S3Service) operationContext(c context.Context, timeout int) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(c)
+	}
+	return context.WithTimeout(c, time.Duration(timeout)*time.Millisecond)
+}
+
+// objectError maps the errors of the object storage to the errors of the service.
+func (s *S3Service) objectError(op string, err error, msg string) error {
+	if _, ok := err.(*merrors.Error); ok {
+		return err
+	}
+	if _, ok := err.(errUnmarshal); ok {
+		return merrors.InternalServerError(s.id, "could not unmarshal record")
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return timeoutError(s.id, op, err)
+	}
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return merrors.NotFound(s.id, "could not find record")
+	}
+	s.log.Error().Err(err).Str("operation", op).Msg(msg)
+	return merrors.InternalServerError(s.id, "%s", msg)
+}
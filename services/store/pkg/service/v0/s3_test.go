@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config/defaults"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	merrors "go-micro.dev/v4/errors"
+)
+
+type fakeObject struct {
+	data     []byte
+	header   http.Header
+	modified time.Time
+}
+
+// fakeS3 implements the parts of the S3 API used by the S3 backend for a single bucket.
+type fakeS3 struct {
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string]fakeObject
+	// lists counts the ListObjectsV2 requests
+	lists int
+}
+
+type fakeListResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string
+	Prefix                string
+	KeyCount              int
+	MaxKeys               int
+	IsTruncated           bool
+	NextContinuationToken string `xml:",omitempty"`
+	Contents              []fakeListObject
+	CommonPrefixes        []fakeListPrefix
+}
+
+type fakeListObject struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+}
+
+type fakeListPrefix struct {
+	Prefix string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, name := r.URL.Path[1:], ""
+	if i := strings.Index(bucket, "/"); i >= 0 {
+		bucket, name = bucket[:i], bucket[i+1:]
+	}
+	if bucket != f.bucket {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch {
+	case name == "" && r.Method == http.MethodHead:
+	case name == "" && r.Method == http.MethodGet && r.URL.Query().Has("location"):
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`)
+	case name == "" && r.Method == http.MethodGet:
+		f.list(w, r)
+	case r.Method == http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		header := http.Header{}
+		for k, v := range r.Header {
+			if strings.HasPrefix(k, "X-Amz-Meta-") {
+				header[k] = v
+			}
+		}
+		f.objects[name] = fakeObject{data: data, header: header, modified: time.Now()}
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		o, ok := f.objects[name]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		for k, v := range o.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.data)))
+		w.Header().Set("Last-Modified", o.modified.UTC().Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(o.data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+// list implements ListObjectsV2, the continuation token is the last key of the previous page.
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	f.lists++
+	q := r.URL.Query()
+	prefix, delimiter, token := q.Get("prefix"), q.Get("delimiter"), q.Get("continuation-token")
+	maxKeys, err := strconv.Atoi(q.Get("max-keys"))
+	if err != nil || maxKeys <= 0 || maxKeys > 1000 {
+		maxKeys = 1000
+	}
+
+	names := make([]string, 0, len(f.objects))
+	for name := range f.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := fakeListResult{Name: f.bucket, Prefix: prefix, MaxKeys: maxKeys}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) || name <= token {
+			continue
+		}
+		entry := name
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				entry = name[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		if seen[entry] {
+			continue
+		}
+		if res.KeyCount == maxKeys {
+			res.IsTruncated = true
+			break
+		}
+		seen[entry] = true
+		res.KeyCount++
+		res.NextContinuationToken = name
+		if entry != name {
+			res.CommonPrefixes = append(res.CommonPrefixes, fakeListPrefix{Prefix: entry})
+			// skips the other objects below the common prefix
+			res.NextContinuationToken = entry + "\xff"
+			token = res.NextContinuationToken
+			continue
+		}
+		o := f.objects[name]
+		res.Contents = append(res.Contents, fakeListObject{
+			Key:          name,
+			LastModified: o.modified.UTC().Format(time.RFC3339),
+			ETag:         `"etag"`,
+			Size:         len(o.data),
+		})
+	}
+	if !res.IsTruncated {
+		res.NextContinuationToken = ""
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(res)
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func newTestS3Service(t *testing.T, configure func(cfg *config.Config)) (*S3Service, *fakeS3) {
+	fake := &fakeS3{bucket: "store", objects: map[string]fakeObject{}}
+	srv := httptest.NewTLSServer(fake)
+	t.Cleanup(srv.Close)
+
+	cfg := defaults.DefaultConfig()
+	cfg.Backend = config.BackendS3
+	cfg.S3.Endpoint = strings.TrimPrefix(srv.URL, "https://")
+	cfg.S3.Region = "us-east-1"
+	cfg.S3.Bucket = "store"
+	cfg.S3.Prefix = "ocis"
+	cfg.S3.ListPageSize = 2
+	if configure != nil {
+		configure(cfg)
+	}
+	s, err := newS3(srv.Client().Transport, Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	return s, fake
+}
+
+func TestS3WriteReadDelete(t *testing.T) {
+	s, fake := newTestS3Service(t, nil)
+	ctx := context.Background()
+	opts := &storemsg.WriteOptions{Database: "db", Table: "table"}
+
+	rec := &storemsg.Record{
+		Key:      "users/einstein",
+		Value:    []byte(`{"name":"Albert"}`),
+		Metadata: map[string]*storemsg.Field{"type": {Value: "user"}},
+	}
+	require.NoError(t, s.Write(ctx, &storesvc.WriteRequest{Options: opts, Record: rec}, &storesvc.WriteResponse{}))
+	require.Contains(t, fake.objects, "ocis/db/table/k/users%2Feinstein")
+	assert.Equal(t, []string{"17"}, fake.objects["ocis/db/table/k/users%2Feinstein"].header["X-Amz-Meta-Record-Size"])
+
+	rres := &storesvc.ReadResponse{}
+	require.NoError(t, s.Read(ctx, &storesvc.ReadRequest{
+		Options: &storemsg.ReadOptions{Database: "db", Table: "table"},
+		Key:     "users/einstein",
+	}, rres))
+	require.Len(t, rres.Records, 1)
+	assert.Equal(t, rec.Value, rres.Records[0].Value)
+	assert.Equal(t, "user", rres.Records[0].Metadata["type"].Value)
+
+	require.NoError(t, s.Write(ctx, &storesvc.WriteRequest{Options: opts, Record: &storemsg.Record{Key: "groups/physics", Value: []byte("{}")}}, &storesvc.WriteResponse{}))
+	rres = &storesvc.ReadResponse{}
+	require.NoError(t, s.Read(ctx, &storesvc.ReadRequest{
+		Options: &storemsg.ReadOptions{Database: "db", Table: "table", Where: map[string]*storemsg.Field{"type": {Value: "user"}}},
+	}, rres))
+	require.Len(t, rres.Records, 1)
+	assert.Equal(t, "users/einstein", rres.Records[0].Key)
+
+	require.NoError(t, s.Delete(ctx, &storesvc.DeleteRequest{Options: &storemsg.DeleteOptions{Database: "db", Table: "table"}, Key: "users/einstein"}, &storesvc.DeleteResponse{}))
+	err := s.Read(ctx, &storesvc.ReadRequest{Options: &storemsg.ReadOptions{Database: "db", Table: "table"}, Key: "users/einstein"}, &storesvc.ReadResponse{})
+	assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	err = s.Delete(ctx, &storesvc.DeleteRequest{Options: &storemsg.DeleteOptions{Database: "db", Table: "table"}, Key: "users/einstein"}, &storesvc.DeleteResponse{})
+	assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+}
+
+func TestS3Limits(t *testing.T) {
+	s, _ := newTestS3Service(t, func(cfg *config.Config) {
+		cfg.MaxValueSize = 8
+		cfg.KeyPolicy = config.KeyPolicyHash
+	})
+	ctx := context.Background()
+	opts := &storemsg.WriteOptions{Database: "db", Table: "table"}
+
+	err := s.Write(ctx, &storesvc.WriteRequest{Options: opts, Record: &storemsg.Record{Key: "big", Value: []byte("123456789")}}, &storesvc.WriteResponse{})
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	err = s.Write(ctx, &storesvc.WriteRequest{Options: &storemsg.WriteOptions{Database: "../db", Table: "table"}, Record: &storemsg.Record{Key: "key"}}, &storesvc.WriteResponse{})
+	assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+
+	// long keys are stored under their hash and listed with the key of the record
+	long := strings.Repeat("k", maxS3KeyLength+1)
+	require.NoError(t, s.Write(ctx, &storesvc.WriteRequest{Options: opts, Record: &storemsg.Record{Key: long, Value: []byte("v")}}, &storesvc.WriteResponse{}))
+	stream := &listStream{}
+	require.NoError(t, s.List(ctx, &storesvc.ListRequest{Options: &storemsg.ListOptions{Database: "db", Table: "table"}}, stream))
+	assert.Equal(t, []string{long}, stream.responses[0].Keys)
+}
+
+func TestS3List(t *testing.T) {
+	s, fake := newTestS3Service(t, nil)
+	ctx := context.Background()
+	for _, key := range []string{"c", "a", "b/1", "b/2", "d"} {
+		require.NoError(t, s.Write(ctx, &storesvc.WriteRequest{
+			Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+			Record:  &storemsg.Record{Key: key, Value: []byte(key)},
+		}, &storesvc.WriteResponse{}))
+	}
+	require.NoError(t, s.Write(ctx, &storesvc.WriteRequest{
+		Options: &storemsg.WriteOptions{Database: "other", Table: "table"},
+		Record:  &storemsg.Record{Key: "x", Value: []byte("x")},
+	}, &storesvc.WriteResponse{}))
+
+	fake.lists = 0
+	stream := &listStream{}
+	require.NoError(t, s.List(ctx, &storesvc.ListRequest{Options: &storemsg.ListOptions{Database: "db", Table: "table"}}, stream))
+	assert.Equal(t, []string{"a", "b/1", "b/2", "c", "d"}, stream.responses[0].Keys)
+	// five records with a page size of two
+	assert.Equal(t, 3, fake.lists)
+
+	stream = &listStream{}
+	require.NoError(t, s.List(ctx, &storesvc.ListRequest{Options: &storemsg.ListOptions{
+		Database: "db", Table: "table", Prefix: "b/", MetadataOnly: true,
+	}}, stream))
+	require.Len(t, stream.responses[0].Infos, 2)
+	assert.Equal(t, "b/1", stream.responses[0].Infos[0].Key)
+	assert.Equal(t, uint64(3), stream.responses[0].Infos[0].Size)
+
+	stream = &listStream{}
+	require.NoError(t, s.List(ctx, &storesvc.ListRequest{Options: &storemsg.ListOptions{
+		Database: "db", Table: "table", Offset: 1, Limit: 2,
+	}}, stream))
+	assert.Equal(t, []string{"b/1", "b/2"}, stream.responses[0].Keys)
+
+	dbres := &storesvc.DatabasesResponse{}
+	require.NoError(t, s.Databases(ctx, &storesvc.DatabasesRequest{}, dbres))
+	assert.Equal(t, []string{"db", "other"}, dbres.Databases)
+	tres := &storesvc.TablesResponse{}
+	require.NoError(t, s.Tables(ctx, &storesvc.TablesRequest{Database: "db"}, tres))
+	assert.Equal(t, []string{"table"}, tres.Tables)
+
+	sres := &storesvc.StatsResponse{}
+	require.NoError(t, s.Stats(ctx, &storesvc.StatsRequest{}, sres))
+	assert.Equal(t, uint64(6), sres.Total.Records)
+	require.Len(t, sres.Tables, 2)
+	assert.Equal(t, "db", sres.Tables[0].Database)
+	assert.Equal(t, uint64(5), sres.Tables[0].Records)
+}
+
+func TestS3Elements(t *testing.T) {
+	s, _ := newTestS3Service(t, nil)
+	ctx := context.Background()
+	opts := &storemsg.WriteOptions{Database: "db", Table: "table"}
+
+	ares := &storesvc.AppendElementsResponse{}
+	require.NoError(t, s.AppendElements(ctx, &storesvc.AppendElementsRequest{
+		Key: "set", Options: opts, Unique: true, Elements: [][]byte{[]byte(`"a"`), []byte(`"b"`), []byte(`"a"`)},
+	}, ares))
+	assert.JSONEq(t, `["a","b"]`, string(ares.Value))
+	assert.Equal(t, uint32(2), ares.Changed)
+
+	rres := &storesvc.RemoveElementsResponse{}
+	require.NoError(t, s.RemoveElements(ctx, &storesvc.RemoveElementsRequest{
+		Key: "set", Options: opts, Elements: [][]byte{[]byte(`"a"`)},
+	}, rres))
+	assert.JSONEq(t, `["b"]`, string(rres.Value))
+	assert.Equal(t, uint32(1), rres.Changed)
+
+	err := s.RemoveElements(ctx, &storesvc.RemoveElementsRequest{
+		Key: "missing", Options: opts, Elements: [][]byte{[]byte(`"a"`)},
+	}, &storesvc.RemoveElementsResponse{})
+	assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+}
+
+func TestS3MissingBucket(t *testing.T) {
+	srv := httptest.NewTLSServer(&fakeS3{bucket: "store"})
+	defer srv.Close()
+	cfg := defaults.DefaultConfig()
+	cfg.S3.Endpoint = strings.TrimPrefix(srv.URL, "https://")
+	cfg.S3.Region = "us-east-1"
+	cfg.S3.Bucket = "missing"
+
+	_, err := newS3(srv.Client().Transport, Config(cfg), Logger(log.NopLogger()))
+	assert.Error(t, err)
+}
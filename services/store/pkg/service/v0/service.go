@@ -2,10 +2,17 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
+	"unicode"
 
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
@@ -13,15 +20,59 @@ import (
 	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
 	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
 	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
 	merrors "go-micro.dev/v4/errors"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// maxFileNameLength is the maximum length of a file name in bytes supported by most filesystems
+const maxFileNameLength = 255
+
 // BleveDocument wraps the generated Record.Metadata and adds a property that is used to distinguish documents in the index.
+// The key, size, modification time and checksum are stored to list records without reading them,
+// the expiry to count expired records and to find the records which can be evicted.
 type BleveDocument struct {
 	Metadata map[string]*storemsg.Field `json:"metadata"`
 	Database string                     `json:"database"`
 	Table    string                     `json:"table"`
+	Key      string                     `json:"key"`
+	Size     uint64                     `json:"size"`
+	Modified string                     `json:"modified"`
+	Checksum string                     `json:"checksum"`
+	Expiry   int64                      `json:"expiry"`
+	// NonEvictable is only set for records which must not be evicted
+	NonEvictable bool `json:"non_evictable,omitempty"`
+}
+
+// newBleveDocument creates the index document of a record.
+func newBleveDocument(database, table string, rec *storemsg.Record, modified time.Time) BleveDocument {
+	sum := sha256.Sum256(rec.Value)
+	return BleveDocument{
+		Metadata:     rec.Metadata,
+		Database:     database,
+		Table:        table,
+		Key:          rec.Key,
+		Size:         uint64(len(rec.Value)),
+		Modified:     modified.UTC().Format(time.RFC3339Nano),
+		Checksum:     hex.EncodeToString(sum[:]),
+		Expiry:       rec.Expiry,
+		NonEvictable: rec.NonEvictable,
+	}
+}
+
+// Handler is a store backend.
+type Handler interface {
+	storesvc.StoreHandler
+	// Close finishes the in-flight writes, it is called after the server stopped.
+	Close(ctx context.Context) error
+}
+
+// NewHandler returns the store backend selected in the config.
+func NewHandler(opts ...Option) (Handler, error) {
+	options := newOptions(opts...)
+	if options.Config.Backend == config.BackendS3 {
+		return NewS3(opts...)
+	}
+	return New(opts...)
 }
 
 // New returns a new instance of Service
@@ -45,14 +96,47 @@ func New(opts ...Option) (s *Service, err error) {
 		}
 	}
 
+	// temporary files for atomic writes, on the same filesystem as the records
+	if err = os.MkdirAll(filepath.Join(cfg.Datapath, "tmp"), 0700); err != nil {
+		return nil, err
+	}
+	if err = checkLayout(cfg); err != nil {
+		return nil, err
+	}
+
 	indexMapping := bleve.NewIndexMapping()
 	// keep all symbols in terms to allow exact matching, eg. emails
 	indexMapping.DefaultAnalyzer = keyword.Name
+	// keep the nanoseconds, dynamically mapped date times are truncated to seconds
+	indexMapping.DefaultMapping.AddFieldMappingsAt("modified", bleve.NewTextFieldMapping())
 
 	s = &Service{
-		id:     cfg.GRPC.Namespace + "." + cfg.Service.Name,
-		log:    logger,
-		Config: cfg,
+		id:      cfg.GRPC.Namespace + "." + cfg.Service.Name,
+		log:     logger,
+		Config:  cfg,
+		metrics: options.Metrics,
+		stats:   newStatsCounters(),
+		cache:   newRecordCache(cfg.CacheEntries, cfg.CacheMaxBytes, options.Metrics),
+		missing: newNegativeCache(cfg.NegativeCacheEntries, time.Duration(cfg.NegativeCacheTTL)*time.Millisecond, options.Metrics),
+		evictor: newEvictor(cfg.EvictionPolicy, uint64(cfg.EvictionMinFreeBytes)),
+		retry:   retrier{attempts: cfg.RetryAttempts, backoff: time.Duration(cfg.RetryBackoff) * time.Millisecond},
+	}
+	if s.quotas, err = newQuotaSet(logger, cfg); err != nil {
+		return nil, err
+	}
+	if cfg.FsyncPolicy == config.FsyncPolicyInterval {
+		s.flusher = newFlusher(logger, time.Duration(cfg.FsyncInterval)*time.Millisecond, cfg.FsyncBatchSize)
+	}
+
+	// writes and deletes of a crash are recovered before the records are indexed
+	walPath := filepath.Join(cfg.Datapath, "wal.log")
+	if err = s.replayWAL(walPath); err != nil {
+		return nil, err
+	}
+	if cfg.WAL {
+		if s.wal, err = openWAL(logger, walPath, cfg.FsyncPolicy, s.flusher, cfg.WALCheckpointEntries); err != nil {
+			return nil, err
+		}
 	}
 
 	indexDir := filepath.Join(cfg.Datapath, "index.bleve")
@@ -66,36 +150,84 @@ func New(opts ...Option) (s *Service, err error) {
 	if err = s.indexRecords(recordsDir); err != nil {
 		return nil, err
 	}
+	if cfg.FsckOnStart != "" && cfg.FsckOnStart != config.FsckOff {
+		s.fsckOnStart(cfg.FsckOnStart == config.FsckRepair)
+	}
+	if cfg.ExpirySweepInterval > 0 {
+		s.sweeper = newSweeper(logger, time.Duration(cfg.ExpirySweepInterval)*time.Second, s.removeExpired)
+	}
 	return
 }
 
 // Service implements the AccountsServiceHandler interface
 type Service struct {
-	id     string
-	log    log.Logger
-	Config *config.Config
-	index  bleve.Index
+	id      string
+	log     log.Logger
+	Config  *config.Config
+	index   bleve.Index
+	metrics *metrics.Metrics
+	flusher *flusher
+	wal     *wal
+	stats   *statsCounters
+	quotas  *quotaSet
+	cache   *recordCache
+	missing *negativeCache
+	evictor *evictor
+	sweeper *sweeper
+	retry   retrier
+	// keys serializes the changes of each record
+	keys keyLocks
+
+	// lifecycle guards closing, in-flight writes and deletes are tracked to flush them on shutdown
+	lifecycle sync.Mutex
+	closing   bool
+	inflight  sync.WaitGroup
 }
 
 // Read implements the StoreHandler interface.
 func (s *Service) Read(c context.Context, rreq *storesvc.ReadRequest, rres *storesvc.ReadResponse) error {
-	if len(rreq.Key) != 0 {
-		id := getID(rreq.Options.Database, rreq.Options.Table, rreq.Key)
-		file := filepath.Join(s.Config.Datapath, "databases", id)
+	defer s.logSlow(opRead, rreq.Options.Database, rreq.Options.Table, time.Now())
+	ctx, cancel := s.operationContext(c, opRead)
+	defer cancel()
+
+	var records []*storemsg.Record
+	err := abandonable(ctx, func() (err error) {
+		records, err = s.read(ctx, rreq)
+		return err
+	})
+	if err != nil {
+		return s.operationError(opRead, err)
+	}
+	rres.Records = append(rres.Records, records...)
+	return nil
+}
 
-		var data []byte
-		rec := &storemsg.Record{}
-		data, err := ioutil.ReadFile(file)
+// read returns the records of the read request, it stops as soon as the context is done.
+func (s *Service) read(ctx context.Context, rreq *storesvc.ReadRequest) ([]*storemsg.Record, error) {
+	if len(rreq.Key) != 0 {
+		id, err := s.getID(rreq.Options.Database, rreq.Options.Table, rreq.Key)
 		if err != nil {
-			return merrors.NotFound(s.id, "could not read record")
+			return nil, merrors.BadRequest(s.id, "%s", err)
 		}
+		file := filepath.Join(s.Config.Datapath, "databases", id)
 
-		if err = protojson.Unmarshal(data, rec); err != nil {
-			return merrors.InternalServerError(s.id, "could not unmarshal record")
+		missing, generation := s.missing.lookup(id)
+		if missing {
+			return nil, merrors.NotFound(s.id, "could not read record")
+		}
+		rec, err := s.cache.read(ctx, s.retry, id, file, rreq.Options.AllowStale)
+		if _, ok := err.(errUnmarshal); ok {
+			return nil, merrors.InternalServerError(s.id, "could not unmarshal record")
 		}
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				s.missing.add(id, generation)
+			}
+			return nil, s.fileError(opRead, err, merrors.NotFound(s.id, "could not read record"))
+		}
+		s.evictor.used(id)
 
-		rres.Records = append(rres.Records, rec)
-		return nil
+		return []*storemsg.Record{rec}, nil
 	}
 
 	s.log.Info().Interface("request", rreq).Msg("read request")
@@ -117,62 +249,119 @@ func (s *Service) Read(c context.Context, rreq *storesvc.ReadRequest, rres *stor
 
 		searchRequest := bleve.NewSearchRequest(query)
 		var searchResult *bleve.SearchResult
-		searchResult, err := s.index.Search(searchRequest)
+		searchResult, err := s.index.SearchInContext(ctx, searchRequest)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			s.log.Error().Err(err).Msg("could not execute bleve search")
-			return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+			return nil, merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
 		}
 
+		var records []*storemsg.Record
 		for _, hit := range searchResult.Hits {
-			rec := &storemsg.Record{}
-
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 			dest := filepath.Join(s.Config.Datapath, "databases", hit.ID)
 
-			var data []byte
-			data, err := ioutil.ReadFile(dest)
+			rec, err := s.cache.read(ctx, s.retry, hit.ID, dest, rreq.Options.AllowStale)
 			s.log.Info().Str("path", dest).Interface("hit", hit).Msgf("hit info")
+			if _, ok := err.(errUnmarshal); ok {
+				return nil, merrors.InternalServerError(s.id, "could not unmarshal record")
+			}
 			if err != nil {
 				s.log.Info().Str("path", dest).Interface("hit", hit).Msgf("file not found")
-				return merrors.NotFound(s.id, "could not read record")
-			}
-
-			if err = protojson.Unmarshal(data, rec); err != nil {
-				return merrors.InternalServerError(s.id, "could not unmarshal record")
+				return nil, s.fileError(opRead, err, merrors.NotFound(s.id, "could not read record"))
 			}
+			s.evictor.used(hit.ID)
 
-			rres.Records = append(rres.Records, rec)
+			records = append(records, rec)
 		}
-		return nil
+		return records, nil
 	}
 
-	return merrors.InternalServerError(s.id, "neither id nor metadata present")
+	return nil, merrors.InternalServerError(s.id, "neither id nor metadata present")
 }
 
 // Write implements the StoreHandler interface.
 func (s *Service) Write(c context.Context, wreq *storesvc.WriteRequest, wres *storesvc.WriteResponse) error {
-	id := getID(wreq.Options.Database, wreq.Options.Table, wreq.Record.Key)
+	if err := s.beginWrite(); err != nil {
+		return err
+	}
+	defer s.endWrite()
+	defer s.logSlow(opWrite, wreq.Options.Database, wreq.Options.Table, time.Now())
+	ctx, cancel := s.operationContext(c, opWrite)
+	defer cancel()
+
+	if err := s.checkLimits(wreq.Record); err != nil {
+		return err
+	}
+	id, err := s.getID(wreq.Options.Database, wreq.Options.Table, wreq.Record.Key)
+	if err != nil {
+		return merrors.BadRequest(s.id, "%s", err)
+	}
+	// records are evicted before the lock of the key is taken, the eviction locks the keys of the evicted records
+	s.evictIfLow()
+	defer s.keys.lock(id)()
+	if err := s.write(ctx, wreq.Options.Database, wreq.Options.Table, id, wreq.Record); err != nil {
+		return s.operationError(opWrite, err)
+	}
+	return nil
+}
+
+// checkLimits checks the configured maximum length of the key and size of the value of a record.
+func (s *Service) checkLimits(rec *storemsg.Record) error {
+	return checkRecordLimits(s.id, s.Config, rec)
+}
+
+func checkRecordLimits(id string, cfg *config.Config, rec *storemsg.Record) error {
+	if cfg.MaxKeyLength > 0 && len(rec.Key) > cfg.MaxKeyLength {
+		return merrors.BadRequest(id, "key exceeds the maximum length of %d bytes", cfg.MaxKeyLength)
+	}
+	if cfg.MaxValueSize > 0 && len(rec.Value) > cfg.MaxValueSize {
+		return merrors.BadRequest(id, "value exceeds the maximum size of %d bytes", cfg.MaxValueSize)
+	}
+	return nil
+}
+
+// write stores the record with the given id and indexes it. The caller holds the lock of the key.
+func (s *Service) write(ctx context.Context, database, table, id string, rec *storemsg.Record) error {
 	file := filepath.Join(s.Config.Datapath, "databases", id)
 
-	var bytes []byte
-	bytes, err := protojson.Marshal(wreq.Record)
+	bytes, err := s.marshalRecord(rec)
 	if err != nil {
 		return merrors.InternalServerError(s.id, "could not marshal record")
 	}
 
-	err = os.MkdirAll(filepath.Dir(file), 0700)
-	if err != nil {
+	// the previous version of the record is replaced in the stats and doesn't count against the quota
+	previous, _ := os.Stat(file)
+	if err := s.checkQuota(database, previous, int64(len(bytes))); err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(file, bytes, 0600)
-	if err != nil {
-		return merrors.InternalServerError(s.id, "could not write record")
+
+	// once the record is being written the write is completed, it would be inconsistent with the index otherwise
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.wal != nil {
+		if err := s.wal.begin(walEntry{Op: walOpWrite, ID: id, Data: bytes}); err != nil {
+			return merrors.InternalServerError(s.id, "could not write record to the write-ahead log")
+		}
+		defer s.wal.end()
 	}
 
-	doc := BleveDocument{
-		Metadata: wreq.Record.Metadata,
-		Database: wreq.Options.Database,
-		Table:    wreq.Options.Table,
+	err = s.writeFile(ctx, file, bytes)
+	s.cache.invalidate(id)
+	s.missing.invalidate(id)
+	if err != nil {
+		return s.fileError(opWrite, err, merrors.InternalServerError(s.id, "could not write record"))
 	}
+	modified := time.Now()
+	s.stats.written(database, table, previous, int64(len(bytes)), modified)
+	s.evictor.used(id)
+
+	doc := newBleveDocument(database, table, rec, modified)
 	if err := s.index.Index(id, doc); err != nil {
 		s.log.Error().Err(err).Interface("document", doc).Msg("could not index record metadata")
 		return err
@@ -183,27 +372,128 @@ func (s *Service) Write(c context.Context, wreq *storesvc.WriteRequest, wres *st
 
 // Delete implements the StoreHandler interface.
 func (s *Service) Delete(c context.Context, dreq *storesvc.DeleteRequest, dres *storesvc.DeleteResponse) error {
-	id := getID(dreq.Options.Database, dreq.Options.Table, dreq.Key)
+	if err := s.beginWrite(); err != nil {
+		return err
+	}
+	defer s.endWrite()
+	defer s.logSlow(opDelete, dreq.Options.Database, dreq.Options.Table, time.Now())
+	ctx, cancel := s.operationContext(c, opDelete)
+	defer cancel()
+
+	id, err := s.getID(dreq.Options.Database, dreq.Options.Table, dreq.Key)
+	if err != nil {
+		return merrors.BadRequest(s.id, "%s", err)
+	}
+	defer s.keys.lock(id)()
+	if err := ctx.Err(); err != nil {
+		return s.operationError(opDelete, err)
+	}
+	if _, err := s.remove(dreq.Options.Database, dreq.Options.Table, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// remove deletes the record with the given id and removes it from the index, it returns the size of the removed
+// file. The caller holds the lock of the key.
+func (s *Service) remove(database, table, id string) (int64, error) {
 	file := filepath.Join(s.Config.Datapath, "databases", id)
-	if err := os.Remove(file); err != nil {
+	if s.wal != nil {
+		if err := s.wal.begin(walEntry{Op: walOpDelete, ID: id}); err != nil {
+			return 0, merrors.InternalServerError(s.id, "could not write deletion to the write-ahead log")
+		}
+		defer s.wal.end()
+	}
+	previous, _ := os.Stat(file)
+	err := s.removeFile(file)
+	s.cache.invalidate(id)
+	s.evictor.forget(id)
+	if err != nil {
 		if os.IsNotExist(err) {
-			return merrors.NotFound(s.id, "could not find record")
+			return 0, merrors.NotFound(s.id, "could not find record")
 		}
 
-		return merrors.InternalServerError(s.id, "could not delete record")
+		return 0, merrors.InternalServerError(s.id, "could not delete record")
+	}
+	var size int64
+	if previous != nil {
+		size = previous.Size()
+		s.stats.deleted(database, table, previous)
 	}
 
 	if err := s.index.Delete(id); err != nil {
 		s.log.Error().Err(err).Str("id", id).Msg("could not remove record from index")
-		return merrors.InternalServerError(s.id, "could not remove record from index")
+		return size, merrors.InternalServerError(s.id, "could not remove record from index")
 	}
 
-	return nil
+	return size, nil
 }
 
 // List implements the StoreHandler interface.
-func (s *Service) List(context.Context, *storesvc.ListRequest, storesvc.Store_ListStream) error {
-	return nil
+// In metadata only mode the keys and record infos are taken from the index, the records are not read.
+func (s *Service) List(c context.Context, lreq *storesvc.ListRequest, stream storesvc.Store_ListStream) error {
+	opts := lreq.Options
+	if opts == nil {
+		opts = &storemsg.ListOptions{}
+	}
+	if !isValidFileName(opts.Database) || !isValidFileName(opts.Table) {
+		return merrors.BadRequest(s.id, "invalid database or table name")
+	}
+	defer s.logSlow(opList, opts.Database, opts.Table, time.Now())
+	ctx, cancel := s.operationContext(c, opList)
+	defer cancel()
+
+	var res *storesvc.ListResponse
+	err := abandonable(ctx, func() (err error) {
+		res, err = s.list(ctx, opts)
+		return err
+	})
+	if err != nil {
+		return s.operationError(opList, err)
+	}
+	return stream.Send(res)
+}
+
+// list returns the page of keys and infos selected by the list options, it stops as soon as the context is done.
+func (s *Service) list(ctx context.Context, opts *storemsg.ListOptions) (*storesvc.ListResponse, error) {
+	res := &storesvc.ListResponse{}
+	if opts.MetadataOnly {
+		infos, err := s.listInfos(ctx, opts.Database, opts.Table)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			s.log.Error().Err(err).Msg("could not list record infos")
+			return nil, merrors.InternalServerError(s.id, "could not list record infos")
+		}
+		for _, info := range infos {
+			if matches(info.Key, opts) {
+				res.Infos = append(res.Infos, info)
+			}
+		}
+		start, end := page(len(res.Infos), opts)
+		res.Infos = res.Infos[start:end]
+		for _, info := range res.Infos {
+			res.Keys = append(res.Keys, info.Key)
+		}
+	} else {
+		keys, err := s.listKeys(ctx, opts.Database, opts.Table)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			s.log.Error().Err(err).Msg("could not list records")
+			return nil, merrors.InternalServerError(s.id, "could not list records")
+		}
+		for _, key := range keys {
+			if matches(key, opts) {
+				res.Keys = append(res.Keys, key)
+			}
+		}
+		start, end := page(len(res.Keys), opts)
+		res.Keys = res.Keys[start:end]
+	}
+	return res, nil
 }
 
 // Databases implements the StoreHandler interface.
@@ -242,14 +532,41 @@ func (s *Service) Tables(ctx context.Context, in *storesvc.TablesRequest, out *s
 	return nil
 }
 
-// TODO sanitize key. As it may contain invalid characters, such as slashes.
-// file: /tmp/ocis-store/databases/{database}/{table}/{record.key}.
-func getID(database string, table string, key string) string {
-	// TODO sanitize input.
-	return filepath.Join(database, table, key)
+// getID returns the path of a record relative to the databases directory.
+// file: /tmp/ocis-store/databases/{database}/{table}/{shards}/{record.key}.
+// Keys that can't be used as file names are hashed or rejected, depending on the configured key policy.
+func (s *Service) getID(database string, table string, key string) (string, error) {
+	if !isValidFileName(database) {
+		return "", fmt.Errorf("invalid database name")
+	}
+	if !isValidFileName(table) {
+		return "", fmt.Errorf("invalid table name")
+	}
+	name := key
+	if !isValidFileName(key) {
+		if key == "" || s.Config.KeyPolicy != config.KeyPolicyHash {
+			return "", fmt.Errorf("key is empty, too long or contains reserved characters")
+		}
+		sum := sha256.Sum256([]byte(key))
+		name = hex.EncodeToString(sum[:])
+	}
+	return filepath.Join(database, table, shardPath(name, s.Config.ShardLevels), name), nil
 }
 
-func (s Service) indexRecords(recordsDir string) (err error) {
+// isValidFileName checks if the given name can be used as a single path segment on common filesystems.
+func isValidFileName(name string) bool {
+	if name == "" || name == "." || name == ".." || len(name) > maxFileNameLength {
+		return false
+	}
+	for _, r := range name {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) indexRecords(recordsDir string) (err error) {
 
 	// TODO use filepath.Walk to clean up code
 	rh, err := os.Open(recordsDir)
@@ -281,23 +598,12 @@ func (s Service) indexRecords(recordsDir string) (err error) {
 		for j := range tables {
 
 			tp := filepath.Join(s.Config.Datapath, "databases", dbs[i], tables[j])
-			kh, err := os.Open(tp)
-			if err != nil {
-				s.log.Error().Err(err).Str("database", dbs[i]).Str("table", tables[i]).Msg("could not open table directory")
-				continue
-			}
-			defer kh.Close()
-
-			keys, err := kh.Readdirnames(0)
-			if err != nil {
-				s.log.Error().Err(err).Str("database", dbs[i]).Str("table", tables[i]).Msg("could not read table directory")
-				continue
-			}
-
-			for k := range keys {
-
-				id := getID(dbs[i], tables[j], keys[k])
-				kp := filepath.Join(s.Config.Datapath, "databases", id)
+			err := walkRecords(tp, func(kp string) error {
+				// the file names have already been mapped by getID when the record was written
+				id, err := filepath.Rel(recordsDir, kp)
+				if err != nil {
+					return err
+				}
 
 				// read record
 				var data []byte
@@ -305,26 +611,33 @@ func (s Service) indexRecords(recordsDir string) (err error) {
 				data, err = ioutil.ReadFile(kp)
 				if err != nil {
 					s.log.Error().Err(err).Str("id", id).Msg("could not read record")
-					continue
+					return nil
 				}
 
-				if err = protojson.Unmarshal(data, rec); err != nil {
+				if err = unmarshalRecord(data, rec); err != nil {
 					s.log.Error().Err(err).Str("id", id).Msg("could not unmarshal record")
-					continue
+					return nil
 				}
 
-				// index record
-				doc := BleveDocument{
-					Metadata: rec.Metadata,
-					Database: dbs[i],
-					Table:    tables[j],
+				// index record, the modification time of the file is the time of the last write
+				modified := time.Now()
+				size := int64(len(data))
+				if fi, err := os.Stat(kp); err == nil {
+					modified = fi.ModTime()
+					size = fi.Size()
 				}
+				s.stats.written(dbs[i], tables[j], nil, size, modified)
+				doc := newBleveDocument(dbs[i], tables[j], rec, modified)
 				if err := s.index.Index(id, doc); err != nil {
 					s.log.Error().Err(err).Interface("document", doc).Str("id", id).Msg("could not index record metadata")
-					continue
+					return nil
 				}
 
 				s.log.Debug().Str("id", id).Msg("indexed record")
+				return nil
+			})
+			if err != nil {
+				s.log.Error().Err(err).Str("database", dbs[i]).Str("table", tables[j]).Msg("could not read table directory")
 			}
 		}
 	}
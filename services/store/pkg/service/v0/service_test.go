@@ -0,0 +1,1869 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/store/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	merrors "go-micro.dev/v4/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestService(t testing.TB, configure func(cfg *config.Config)) *Service {
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	cfg.MaxValueSize = 8
+	cfg.MaxKeyLength = 300
+	if configure != nil {
+		configure(cfg)
+	}
+	s, err := New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.index.Close() })
+	return s
+}
+
+func write(s *Service, key string, value []byte) error {
+	return s.Write(context.Background(), &storesvc.WriteRequest{
+		Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+		Record:  &storemsg.Record{Key: key, Value: value},
+	}, &storesvc.WriteResponse{})
+}
+
+func read(s *Service, key string) (*storemsg.Record, error) {
+	res := &storesvc.ReadResponse{}
+	err := s.Read(context.Background(), &storesvc.ReadRequest{
+		Options: &storemsg.ReadOptions{Database: "db", Table: "table"},
+		Key:     key,
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Records[0], nil
+}
+
+func TestWriteValueSizeLimit(t *testing.T) {
+	s := newTestService(t, nil)
+
+	assert.NoError(t, write(s, "at-limit", []byte("12345678")))
+	err := write(s, "over-limit", []byte("123456789"))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "value exceeds the maximum size of 8 bytes")
+	}
+
+	s = newTestService(t, func(cfg *config.Config) { cfg.MaxValueSize = 0 })
+	assert.NoError(t, write(s, "unlimited", []byte(strings.Repeat("a", 1024))))
+}
+
+func TestWriteKeyLengthLimit(t *testing.T) {
+	s := newTestService(t, nil)
+
+	assert.NoError(t, write(s, strings.Repeat("k", 300), nil))
+	err := write(s, strings.Repeat("k", 301), nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "key exceeds the maximum length of 300 bytes")
+	}
+}
+
+func TestKeyPolicyHash(t *testing.T) {
+	s := newTestService(t, nil)
+
+	for _, key := range []string{strings.Repeat("k", 256), "../../escape", "with/slash", ".."} {
+		require.NoError(t, write(s, key, []byte("value")), key)
+		rec, err := read(s, key)
+		require.NoError(t, err, key)
+		assert.Equal(t, key, rec.Key)
+		assert.Equal(t, []byte("value"), rec.Value)
+	}
+
+	res := &storesvc.TablesResponse{}
+	require.NoError(t, s.Tables(context.Background(), &storesvc.TablesRequest{Database: "db"}, res))
+	assert.Equal(t, []string{"table"}, res.Tables)
+	assert.Error(t, write(s, "", []byte("value")))
+}
+
+func TestKeyPolicyReject(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) { cfg.KeyPolicy = config.KeyPolicyReject })
+
+	assert.NoError(t, write(s, strings.Repeat("k", 255), nil))
+	for _, key := range []string{strings.Repeat("k", 256), "../../escape", "with/slash", ".."} {
+		assert.Error(t, write(s, key, nil), key)
+		_, err := read(s, key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestInvalidDatabaseAndTableNames(t *testing.T) {
+	s := newTestService(t, nil)
+
+	for _, opts := range []*storemsg.WriteOptions{
+		{Database: "..", Table: "table"},
+		{Database: "db", Table: "../table"},
+		{Database: "", Table: "table"},
+	} {
+		err := s.Write(context.Background(), &storesvc.WriteRequest{
+			Options: opts,
+			Record:  &storemsg.Record{Key: "key"},
+		}, &storesvc.WriteResponse{})
+		assert.Error(t, err)
+	}
+}
+
+func TestWriteCompression(t *testing.T) {
+	m := metrics.New()
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	cfg.MaxValueSize = 0
+
+	var s *Service
+	open := func(compression string) {
+		if s != nil {
+			require.NoError(t, s.index.Close())
+		}
+		cfg.Compression = compression
+		var err error
+		s, err = New(Config(cfg), Logger(log.NopLogger()), Metrics(m))
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() { _ = s.index.Close() })
+	stored := func(key string) []byte {
+		data, err := ioutil.ReadFile(filepath.Join(cfg.Datapath, "databases", "db", "table", key))
+		require.NoError(t, err)
+		return data
+	}
+
+	compressible := []byte(strings.Repeat("all work and no play makes jack a dull boy. ", 1000))
+	incompressible := make([]byte, 4096)
+	_, err := rand.Read(incompressible)
+	require.NoError(t, err)
+
+	// records written before compression was enabled must stay readable
+	open(config.CompressionNone)
+	require.NoError(t, write(s, "legacy", compressible))
+	assert.False(t, bytes.HasPrefix(stored("legacy"), gzipHeader))
+
+	open(config.CompressionGzip)
+	require.NoError(t, write(s, "compressible", compressible))
+	require.NoError(t, write(s, "incompressible", incompressible))
+
+	assert.True(t, bytes.HasPrefix(stored("compressible"), gzipHeader))
+	assert.Less(t, len(stored("compressible")), len(compressible)/10)
+	// compressing random data doesn't pay off, it is stored as is
+	assert.False(t, bytes.HasPrefix(stored("incompressible"), gzipHeader))
+
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(m.CompressionRatio))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	assert.Equal(t, uint64(2), families[0].GetMetric()[0].GetHistogram().GetSampleCount())
+
+	// compressed records are indexed on start and stay readable after disabling compression
+	open(config.CompressionNone)
+	count, err := s.index.DocCount()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), count)
+	for key, value := range map[string][]byte{
+		"legacy":         compressible,
+		"compressible":   compressible,
+		"incompressible": incompressible,
+	} {
+		rec, err := read(s, key)
+		require.NoError(t, err)
+		assert.Equal(t, value, rec.Value, key)
+	}
+}
+
+func TestCodecs(t *testing.T) {
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	cfg.MaxValueSize = 0
+
+	var s *Service
+	open := func(codec, compression string) {
+		if s != nil {
+			require.NoError(t, s.index.Close())
+		}
+		cfg.Codec = codec
+		cfg.Compression = compression
+		var err error
+		s, err = New(Config(cfg), Logger(log.NopLogger()))
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() { _ = s.index.Close() })
+	stored := func(key string) []byte {
+		data, err := ioutil.ReadFile(filepath.Join(cfg.Datapath, "databases", "db", "table", key))
+		require.NoError(t, err)
+		return data
+	}
+
+	// records written before codecs could be configured are plain JSON
+	legacy := &storemsg.Record{Key: "legacy", Value: []byte("old"), Metadata: map[string]*storemsg.Field{"owner": {Type: "string", Value: "einstein"}}}
+	data, err := protojson.Marshal(legacy)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(cfg.Datapath, "databases", "db", "table"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.Datapath, "databases", "db", "table", "legacy"), data, 0600))
+
+	compressible := []byte(strings.Repeat("all work and no play makes jack a dull boy. ", 100))
+	values := map[string][]byte{"legacy": []byte("old")}
+	for _, codec := range []string{config.CodecJSON, config.CodecProtobuf, config.CodecMsgpack} {
+		for _, compression := range []string{config.CompressionNone, config.CompressionGzip} {
+			open(codec, compression)
+			key := codec + "-" + compression
+			require.NoError(t, s.Write(context.Background(), &storesvc.WriteRequest{
+				Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+				Record: &storemsg.Record{
+					Key:      key,
+					Value:    compressible,
+					Expiry:   3600,
+					Metadata: map[string]*storemsg.Field{"owner": {Type: "string", Value: "marie"}},
+				},
+			}, &storesvc.WriteResponse{}))
+			values[key] = compressible
+
+			data := stored(key)
+			if compression == config.CompressionGzip {
+				assert.True(t, bytes.HasPrefix(data, gzipHeader), key)
+			} else if marker := recordCodecs[codec].marker; marker != nil {
+				assert.True(t, bytes.HasPrefix(data, marker), key)
+			} else {
+				assert.Equal(t, byte('{'), data[0], key)
+			}
+
+			rec, err := read(s, key)
+			require.NoError(t, err)
+			assert.Equal(t, compressible, rec.Value, key)
+			assert.Equal(t, "marie", rec.Metadata["owner"].GetValue(), key)
+		}
+	}
+
+	// the records of all codecs are indexed on start and stay readable with any codec
+	for _, codec := range []string{config.CodecJSON, config.CodecProtobuf, config.CodecMsgpack} {
+		open(codec, config.CompressionNone)
+		count, err := s.index.DocCount()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(len(values)), count)
+		for key, value := range values {
+			rec, err := read(s, key)
+			require.NoError(t, err, key)
+			assert.Equal(t, value, rec.Value, key)
+		}
+	}
+	rec, err := read(s, "legacy")
+	require.NoError(t, err)
+	assert.Equal(t, "einstein", rec.Metadata["owner"].GetValue())
+}
+
+func BenchmarkCodecs(b *testing.B) {
+	rec := &storemsg.Record{
+		Key:    "settings/values/61445573-4dbe-4d56-88dc-88ab47aceba7",
+		Value:  []byte(strings.Repeat(`{"id":"08ab1a0f-8b3a-4b2e-8e67-d4b2b0c466d7","value":"en"}`, 16)),
+		Expiry: 3600,
+		Metadata: map[string]*storemsg.Field{
+			"owner": {Type: "string", Value: "einstein"},
+			"mtime": {Type: "int", Value: "1665739200"},
+		},
+	}
+	for _, codec := range []string{config.CodecJSON, config.CodecProtobuf, config.CodecMsgpack} {
+		data, err := encodeRecord(codec, rec)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run("marshal/"+codec, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := encodeRecord(codec, rec); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(len(data)), "bytes/record")
+		})
+		b.Run("unmarshal/"+codec, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := decodeRecord(data, &storemsg.Record{}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestFsyncPolicies(t *testing.T) {
+	for _, policy := range []string{config.FsyncPolicyAlways, config.FsyncPolicyInterval, config.FsyncPolicyNever} {
+		t.Run(policy, func(t *testing.T) {
+			s := newTestService(t, func(cfg *config.Config) {
+				cfg.FsyncPolicy = policy
+				cfg.FsyncBatchSize = 2
+				cfg.FsyncInterval = 60 * 1000
+			})
+
+			require.NoError(t, write(s, "key", []byte("first")))
+			require.NoError(t, write(s, "key", []byte("second")))
+			rec, err := read(s, "key")
+			require.NoError(t, err)
+			assert.Equal(t, []byte("second"), rec.Value)
+
+			// no temporary files are left behind
+			tmp, err := ioutil.ReadDir(filepath.Join(s.Config.Datapath, "tmp"))
+			require.NoError(t, err)
+			assert.Empty(t, tmp)
+
+			if policy == config.FsyncPolicyInterval {
+				// the second write filled the batch
+				assert.Eventually(t, func() bool {
+					s.flusher.mu.Lock()
+					defer s.flusher.mu.Unlock()
+					return len(s.flusher.pending) == 0
+				}, time.Second, 10*time.Millisecond)
+
+				require.NoError(t, write(s, "other", []byte("third")))
+				s.flusher.Close()
+				assert.Empty(t, s.flusher.pending)
+			}
+		})
+	}
+}
+
+func BenchmarkWriteFsyncPolicies(b *testing.B) {
+	for _, policy := range []string{config.FsyncPolicyAlways, config.FsyncPolicyInterval, config.FsyncPolicyNever} {
+		b.Run(policy, func(b *testing.B) {
+			s := newTestService(b, func(cfg *config.Config) {
+				cfg.FsyncPolicy = policy
+				cfg.MaxValueSize = 0
+			})
+			value := []byte(strings.Repeat("v", 1024))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := write(s, strconv.Itoa(i%100), value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+type listStream struct {
+	storesvc.Store_ListStream
+	responses []*storesvc.ListResponse
+}
+
+func (l *listStream) Send(res *storesvc.ListResponse) error {
+	l.responses = append(l.responses, res)
+	return nil
+}
+
+func list(s *Service, opts *storemsg.ListOptions) (*storesvc.ListResponse, error) {
+	opts.Database, opts.Table = "db", "table"
+	stream := &listStream{}
+	if err := s.List(context.Background(), &storesvc.ListRequest{Options: opts}, stream); err != nil {
+		return nil, err
+	}
+	return stream.responses[0], nil
+}
+
+func TestList(t *testing.T) {
+	s := newTestService(t, nil)
+	long := "b" + strings.Repeat("k", 260)
+	before := time.Now()
+	for _, key := range []string{"c", "a", long, "ab"} {
+		require.NoError(t, write(s, key, []byte("value-"+key[:1])))
+	}
+
+	res, err := list(s, &storemsg.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "ab", long, "c"}, res.Keys)
+	assert.Empty(t, res.Infos)
+
+	res, err = list(s, &storemsg.ListOptions{Prefix: "a", Offset: 1, Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ab"}, res.Keys)
+
+	// metadata only requests don't read the records, they can be corrupted without notice
+	dir := filepath.Join(s.Config.Datapath, "databases", "db", "table")
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+	for _, f := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, f.Name()), []byte("corrupted"), 0600))
+	}
+	_, err = list(s, &storemsg.ListOptions{})
+	assert.Error(t, err)
+
+	res, err = list(s, &storemsg.ListOptions{MetadataOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "ab", long, "c"}, res.Keys)
+	require.Len(t, res.Infos, 4)
+	info := res.Infos[0]
+	assert.Equal(t, "a", info.Key)
+	assert.Equal(t, uint64(7), info.Size)
+	// sha256 of "value-a"
+	assert.Equal(t, "3ae64165abf9b86f45540b695d1f8b1bf5386c95b45bfef6cc0d710b759209d5", info.Checksum)
+	assert.False(t, time.Unix(0, info.Modified).Before(before))
+
+	res, err = list(s, &storemsg.ListOptions{MetadataOnly: true, Suffix: "c", Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, res.Keys)
+
+	err = s.List(context.Background(), &storesvc.ListRequest{Options: &storemsg.ListOptions{Database: ".."}}, &listStream{})
+	assert.Error(t, err)
+}
+
+func TestWALReplay(t *testing.T) {
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	cfg.WAL = true
+	s, err := New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+
+	require.NoError(t, write(s, "applied", []byte("value")))
+	require.NoError(t, write(s, "deleted", []byte("value")))
+
+	// simulate a crash after logging the entries but before applying them
+	data, err := s.marshalRecord(&storemsg.Record{Key: "logged", Value: []byte("value")})
+	require.NoError(t, err)
+	require.NoError(t, s.wal.begin(walEntry{Op: walOpWrite, ID: filepath.Join("db", "table", "logged"), Data: data}))
+	require.NoError(t, s.wal.begin(walEntry{Op: walOpDelete, ID: filepath.Join("db", "table", "deleted")}))
+	// an entry torn by the crash
+	_, err = s.wal.f.Write([]byte(`{"op":"write","id":"db/table/torn","da`))
+	require.NoError(t, err)
+	require.NoError(t, s.index.Close())
+
+	s, err = New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.index.Close() })
+
+	for _, key := range []string{"applied", "logged"} {
+		rec, err := read(s, key)
+		require.NoError(t, err, key)
+		assert.Equal(t, []byte("value"), rec.Value)
+	}
+	for _, key := range []string{"deleted", "torn"} {
+		_, err := read(s, key)
+		assert.Error(t, err, key)
+	}
+	// the replayed records are indexed
+	count, err := s.index.DocCount()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+
+	fi, err := os.Stat(filepath.Join(cfg.Datapath, "wal.log"))
+	require.NoError(t, err)
+	assert.Zero(t, fi.Size())
+}
+
+func TestWALCheckpoint(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.WAL = true
+		cfg.WALCheckpointEntries = 3
+	})
+	walSize := func() int64 {
+		fi, err := os.Stat(filepath.Join(s.Config.Datapath, "wal.log"))
+		require.NoError(t, err)
+		return fi.Size()
+	}
+
+	require.NoError(t, write(s, "a", []byte("1")))
+	require.NoError(t, write(s, "b", []byte("2")))
+	assert.NotZero(t, walSize())
+	require.NoError(t, s.Delete(context.Background(), &storesvc.DeleteRequest{
+		Options: &storemsg.DeleteOptions{Database: "db", Table: "table"},
+		Key:     "a",
+	}, &storesvc.DeleteResponse{}))
+	// the third entry triggered a checkpoint
+	assert.Zero(t, walSize())
+	assert.Empty(t, s.wal.dirty)
+
+	require.NoError(t, write(s, "c", []byte("3")))
+	assert.NotZero(t, walSize())
+	require.NoError(t, s.wal.Close())
+	assert.Zero(t, walSize())
+}
+
+func TestShutdown(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		configure func(cfg *config.Config)
+	}{
+		{name: "interval", configure: func(cfg *config.Config) {
+			cfg.FsyncPolicy = config.FsyncPolicyInterval
+			cfg.FsyncInterval = 0
+			cfg.FsyncBatchSize = 0
+		}},
+		{name: "wal", configure: func(cfg *config.Config) {
+			cfg.WAL = true
+			cfg.WALCheckpointEntries = 0
+		}},
+		{name: "wal interval", configure: func(cfg *config.Config) {
+			cfg.WAL = true
+			cfg.WALCheckpointEntries = 0
+			cfg.FsyncPolicy = config.FsyncPolicyInterval
+			cfg.FsyncInterval = 0
+			cfg.FsyncBatchSize = 0
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := defaults.DefaultConfig()
+			cfg.Datapath = t.TempDir()
+			tc.configure(cfg)
+			s, err := New(Config(cfg), Logger(log.NopLogger()))
+			require.NoError(t, err)
+
+			for _, key := range []string{"a", "b", "c"} {
+				require.NoError(t, write(s, key, []byte("value")))
+			}
+			require.NoError(t, s.Delete(context.Background(), &storesvc.DeleteRequest{
+				Options: &storemsg.DeleteOptions{Database: "db", Table: "table"},
+				Key:     "b",
+			}, &storesvc.DeleteResponse{}))
+
+			require.NoError(t, s.Close(context.Background()))
+			if s.flusher != nil {
+				assert.Empty(t, s.flusher.pending)
+			}
+			if s.wal != nil {
+				assert.Empty(t, s.wal.dirty)
+				fi, err := os.Stat(filepath.Join(cfg.Datapath, "wal.log"))
+				require.NoError(t, err)
+				assert.Zero(t, fi.Size())
+			}
+
+			// no writes are accepted anymore
+			err = write(s, "d", []byte("value"))
+			require.Error(t, err)
+			assert.Equal(t, int32(http.StatusServiceUnavailable), merrors.FromError(err).Code)
+			// closing again is a no-op
+			require.NoError(t, s.Close(context.Background()))
+
+			s, err = New(Config(cfg), Logger(log.NopLogger()))
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = s.Close(context.Background()) })
+			for _, key := range []string{"a", "c"} {
+				rec, err := read(s, key)
+				require.NoError(t, err, key)
+				assert.Equal(t, []byte("value"), rec.Value)
+			}
+			for _, key := range []string{"b", "d"} {
+				_, err := read(s, key)
+				assert.Error(t, err, key)
+			}
+		})
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	cfg.WAL = true
+	cfg.WALCheckpointEntries = 0
+	s, err := New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	require.NoError(t, write(s, "a", []byte("value")))
+
+	// a write that doesn't finish in time
+	require.NoError(t, s.beginWrite())
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = s.Close(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the write-ahead log is left for the replay
+	fi, err := os.Stat(filepath.Join(cfg.Datapath, "wal.log"))
+	require.NoError(t, err)
+	assert.NotZero(t, fi.Size())
+
+	s.endWrite()
+	require.NoError(t, s.index.Close())
+	s, err = New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close(context.Background()) })
+	rec, err := read(s, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), rec.Value)
+}
+
+func TestStats(t *testing.T) {
+	s := newTestService(t, nil)
+	writeTo := func(db, table, key string, expiry int64) {
+		require.NoError(t, s.Write(context.Background(), &storesvc.WriteRequest{
+			Options: &storemsg.WriteOptions{Database: db, Table: table},
+			Record:  &storemsg.Record{Key: key, Value: []byte("value"), Expiry: expiry},
+		}, &storesvc.WriteResponse{}))
+	}
+	writeTo("db", "table", "a", 0)
+	writeTo("db", "table", "b", 0)
+	writeTo("db", "table", "b", 0)
+	writeTo("db", "other", "c", int64(time.Nanosecond))
+	writeTo("db2", "table", "d", int64(time.Hour))
+	require.NoError(t, s.Delete(context.Background(), &storesvc.DeleteRequest{
+		Options: &storemsg.DeleteOptions{Database: "db", Table: "table"},
+		Key:     "a",
+	}, &storesvc.DeleteResponse{}))
+	// wait for the record with the short expiry to expire
+	time.Sleep(time.Millisecond)
+
+	stats := func(fresh bool) *storesvc.StatsResponse {
+		res := &storesvc.StatsResponse{}
+		require.NoError(t, s.Stats(context.Background(), &storesvc.StatsRequest{Fresh: fresh}, res))
+		return res
+	}
+	counted := stats(false)
+	require.Len(t, counted.Tables, 3)
+	for i, name := range [][2]string{{"db", "other"}, {"db", "table"}, {"db2", "table"}} {
+		assert.Equal(t, name[0], counted.Tables[i].Database)
+		assert.Equal(t, name[1], counted.Tables[i].Table)
+		assert.Equal(t, uint64(1), counted.Tables[i].Records)
+		assert.NotZero(t, counted.Tables[i].Bytes)
+		assert.NotZero(t, counted.Tables[i].Oldest)
+	}
+	assert.Equal(t, uint64(1), counted.Tables[0].Expired)
+	assert.Zero(t, counted.Tables[1].Expired)
+	assert.Zero(t, counted.Tables[2].Expired)
+	assert.Equal(t, uint64(3), counted.Total.Records)
+	assert.Equal(t, uint64(1), counted.Total.Expired)
+	assert.Equal(t, counted.Tables[0].Bytes+counted.Tables[1].Bytes+counted.Tables[2].Bytes, counted.Total.Bytes)
+
+	// the maintained counters match a scan of the records
+	fresh := stats(true)
+	require.Len(t, fresh.Tables, 3)
+	for i := range fresh.Tables {
+		assert.Equal(t, counted.Tables[i].Records, fresh.Tables[i].Records)
+		assert.Equal(t, counted.Tables[i].Bytes, fresh.Tables[i].Bytes)
+		assert.Equal(t, counted.Tables[i].Expired, fresh.Tables[i].Expired)
+	}
+
+	// the counters are rebuilt from the records on startup
+	restarted := &storesvc.StatsResponse{}
+	s, err := New(Config(s.Config), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.index.Close() })
+	require.NoError(t, s.Stats(context.Background(), &storesvc.StatsRequest{}, restarted))
+	assert.Equal(t, fresh.Total.Records, restarted.Total.Records)
+	assert.Equal(t, fresh.Total.Bytes, restarted.Total.Bytes)
+	assert.Equal(t, fresh.Total.Expired, restarted.Total.Expired)
+}
+
+func TestSharding(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) { cfg.ShardLevels = 2 })
+	keys := []string{"a", "b", "with/slash", strings.Repeat("k", 256)}
+	for _, key := range keys {
+		require.NoError(t, write(s, key, []byte("value")), key)
+	}
+
+	// the records are spread across the shard directories
+	id, err := s.getID("db", "table", "a")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("db", "table", shardPath("a", 2), "a"), id)
+	assert.Len(t, strings.Split(id, string(filepath.Separator)), 5)
+	_, err = os.Stat(filepath.Join(s.Config.Datapath, "databases", id))
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		rec, err := read(s, key)
+		require.NoError(t, err, key)
+		assert.Equal(t, key, rec.Key)
+	}
+	res, err := list(s, &storemsg.ListOptions{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, keys, res.Keys)
+	require.NoError(t, s.Delete(context.Background(), &storesvc.DeleteRequest{
+		Options: &storemsg.DeleteOptions{Database: "db", Table: "table"},
+		Key:     "a",
+	}, &storesvc.DeleteResponse{}))
+	_, err = read(s, "a")
+	assert.Error(t, err)
+
+	// sharded records are indexed on startup
+	s, err = New(Config(s.Config), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.index.Close() })
+	count, err := s.index.DocCount()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), count)
+}
+
+func TestReshard(t *testing.T) {
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	s, err := New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	keys := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		keys = append(keys, strconv.Itoa(i))
+		require.NoError(t, write(s, keys[i], []byte("value")))
+	}
+	require.NoError(t, s.index.Close())
+
+	// the configuration has to match the layout of the records
+	cfg.ShardLevels = 2
+	_, err = New(Config(cfg), Logger(log.NopLogger()))
+	assert.Error(t, err)
+
+	for _, levels := range []int{2, 1, 0} {
+		moved, err := Reshard(cfg, levels, log.NopLogger())
+		require.NoError(t, err)
+		assert.Equal(t, 100, moved, levels)
+		// resharding again doesn't move anything
+		moved, err = Reshard(cfg, levels, log.NopLogger())
+		require.NoError(t, err)
+		assert.Zero(t, moved)
+
+		cfg.ShardLevels = levels
+		s, err := New(Config(cfg), Logger(log.NopLogger()))
+		require.NoError(t, err, levels)
+		for _, key := range keys {
+			rec, err := read(s, key)
+			require.NoError(t, err, key)
+			assert.Equal(t, []byte("value"), rec.Value)
+		}
+		res, err := list(s, &storemsg.ListOptions{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, keys, res.Keys)
+		require.NoError(t, s.index.Close())
+	}
+
+	// records staged by an interrupted run are moved by the next one
+	staged := filepath.Join(cfg.Datapath, reshardDir, "db", "table", "0")
+	require.NoError(t, os.MkdirAll(filepath.Dir(staged), 0700))
+	require.NoError(t, os.Rename(filepath.Join(cfg.Datapath, "databases", "db", "table", "0"), staged))
+	_, err = New(Config(cfg), Logger(log.NopLogger()))
+	assert.Error(t, err)
+	moved, err := Reshard(cfg, 0, log.NopLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 1, moved)
+
+	// no shard directories are left behind
+	names, err := readDirNames(filepath.Join(cfg.Datapath, "databases", "db", "table"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, keys, names)
+
+	_, err = Reshard(cfg, config.MaxShardLevels+1, log.NopLogger())
+	assert.Error(t, err)
+}
+
+func TestFsck(t *testing.T) {
+	s := newTestService(t, nil)
+	for _, key := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, write(s, key, []byte("value")))
+	}
+	report, err := s.Fsck(false)
+	require.NoError(t, err)
+	assert.True(t, report.Clean(), report)
+
+	id := func(key string) string {
+		id, err := s.getID("db", "table", key)
+		require.NoError(t, err)
+		return id
+	}
+	file := func(id string) string { return filepath.Join(s.Config.Datapath, "databases", id) }
+	writeRecord := func(id string, rec *storemsg.Record) {
+		data, err := s.marshalRecord(rec)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file(id)), 0700))
+		require.NoError(t, ioutil.WriteFile(file(id), data, 0600))
+	}
+
+	// a is not indexed, b was deleted and c was changed behind the index
+	require.NoError(t, s.index.Delete(id("a")))
+	require.NoError(t, os.Remove(file(id("b"))))
+	writeRecord(id("c"), &storemsg.Record{Key: "c", Value: []byte("changed")})
+	// e is stored under the name of another key, f is garbage
+	misplaced := filepath.Join("db", "table", "x")
+	writeRecord(misplaced, &storemsg.Record{Key: "e", Value: []byte("value")})
+	orphaned := filepath.Join("db", "table", "f")
+	require.NoError(t, ioutil.WriteFile(file(orphaned), []byte("garbage"), 0600))
+	// a write was interrupted
+	require.NoError(t, ioutil.WriteFile(filepath.Join(s.Config.Datapath, "tmp", "record-1"), []byte("value"), 0600))
+
+	report, err = s.Fsck(false)
+	require.NoError(t, err)
+	assert.False(t, report.Clean())
+	assert.Equal(t, []string{id("a")}, report.MissingEntries)
+	assert.Equal(t, []string{id("b"), id("c")}, report.StaleEntries)
+	assert.Equal(t, []string{misplaced}, report.MisplacedFiles)
+	assert.Equal(t, []string{orphaned}, report.OrphanedFiles)
+	assert.Equal(t, []string{"record-1"}, report.TempFiles)
+	assert.True(t, report.CountersDrifted)
+	assert.False(t, report.Repaired)
+
+	// checking doesn't change anything
+	report, err = s.Fsck(false)
+	require.NoError(t, err)
+	assert.Len(t, report.StaleEntries, 2)
+
+	report, err = s.Fsck(true)
+	require.NoError(t, err)
+	assert.True(t, report.Repaired)
+	report, err = s.Fsck(false)
+	require.NoError(t, err)
+	assert.True(t, report.Clean(), report)
+
+	rec, err := read(s, "e")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), rec.Value)
+	res, err := list(s, &storemsg.ListOptions{MetadataOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "c", "d", "e"}, res.Keys)
+	assert.Equal(t, uint64(len("changed")), res.Infos[1].Size)
+	_, err = os.Stat(filepath.Join(s.Config.Datapath, lostFoundDir, orphaned))
+	assert.NoError(t, err)
+	sres := &storesvc.StatsResponse{}
+	require.NoError(t, s.Stats(context.Background(), &storesvc.StatsRequest{}, sres))
+	assert.Equal(t, uint64(4), sres.Total.Records)
+
+	// the data path is repaired on start if configured
+	require.NoError(t, ioutil.WriteFile(file(orphaned), []byte("garbage"), 0600))
+	s.Config.FsckOnStart = config.FsckRepair
+	s, err = New(Config(s.Config), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.index.Close() })
+	_, err = os.Stat(file(orphaned))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func BenchmarkShardLevels(b *testing.B) {
+	const records = 10000
+	for _, levels := range []int{0, 1, 2} {
+		s := newTestService(b, func(cfg *config.Config) {
+			cfg.ShardLevels = levels
+			cfg.FsyncPolicy = config.FsyncPolicyNever
+		})
+		for i := 0; i < records; i++ {
+			if err := write(s, strconv.Itoa(i), []byte("value")); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.Run(fmt.Sprintf("read/levels=%d", levels), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := read(s, strconv.Itoa(i%records)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("list/levels=%d", levels), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := list(s, &storemsg.ListOptions{Limit: 100}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	sourceCfg := defaults.DefaultConfig()
+	sourceCfg.Datapath = t.TempDir()
+	sourceCfg.ShardLevels = 1
+	sourceCfg.Compression = config.CompressionGzip
+	sourceCfg.KeyPolicy = config.KeyPolicyHash
+	source, err := New(Config(sourceCfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	long := strings.Repeat("k", 300)
+	records := map[string]*storemsg.Record{
+		"plain": {Key: "plain", Value: []byte("value")},
+		"meta": {Key: "meta", Value: []byte("v"), Expiry: 3600, Metadata: map[string]*storemsg.Field{
+			"email": {Type: "string", Value: "einstein@example.org"},
+		}},
+		long: {Key: long, Value: []byte("hashed")},
+	}
+	for _, rec := range records {
+		require.NoError(t, source.Write(context.Background(), &storesvc.WriteRequest{
+			Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+			Record:  rec,
+		}, &storesvc.WriteResponse{}))
+	}
+	require.NoError(t, source.Write(context.Background(), &storesvc.WriteRequest{
+		Options: &storemsg.WriteOptions{Database: "other", Table: "values"},
+		Record:  &storemsg.Record{Key: "plain", Value: []byte("other")},
+	}, &storesvc.WriteResponse{}))
+	require.NoError(t, source.Close(context.Background()))
+
+	archive := filepath.Join(t.TempDir(), "store.tar.gz")
+	exported, err := Export(source.Config, archive, log.NopLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 4, exported)
+
+	// the archive doesn't depend on the sharding and compression of the exporting store
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	cfg.KeyPolicy = config.KeyPolicyHash
+	imported, err := Import(cfg, archive, ImportModeMerge, log.NopLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 4, imported)
+
+	target, err := New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	for key, expected := range records {
+		rec, err := read(target, key)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(expected, rec), key)
+	}
+	res := &storesvc.ReadResponse{}
+	require.NoError(t, target.Read(context.Background(), &storesvc.ReadRequest{
+		Options: &storemsg.ReadOptions{Database: "other", Table: "values"},
+		Key:     "plain",
+	}, res))
+	assert.Equal(t, []byte("other"), res.Records[0].Value)
+
+	// merging keeps the stored records and overwrites the archived ones
+	require.NoError(t, write(target, "plain", []byte("changed")))
+	require.NoError(t, write(target, "extra", []byte("kept")))
+	require.NoError(t, target.Close(context.Background()))
+	_, err = Import(cfg, archive, ImportModeMerge, log.NopLogger())
+	require.NoError(t, err)
+	target, err = New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	rec, err := read(target, "plain")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), rec.Value)
+	rec, err = read(target, "extra")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("kept"), rec.Value)
+	require.NoError(t, target.Close(context.Background()))
+
+	// replacing removes the stored records
+	_, err = Import(cfg, archive, ImportModeReplace, log.NopLogger())
+	require.NoError(t, err)
+	target, err = New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	_, err = read(target, "extra")
+	assert.Error(t, err)
+	l, err := list(target, &storemsg.ListOptions{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"plain", "meta", long}, l.Keys)
+	require.NoError(t, target.Close(context.Background()))
+
+	_, err = Import(cfg, archive, "overwrite", log.NopLogger())
+	assert.Error(t, err)
+}
+
+func TestImportRejectsInvalidArchives(t *testing.T) {
+	cfg := defaults.DefaultConfig()
+	cfg.Datapath = t.TempDir()
+	s, err := New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	require.NoError(t, write(s, "key", []byte("value")))
+	require.NoError(t, s.Close(context.Background()))
+
+	newArchive := func(m manifest, entries ...archivedRecord) string {
+		file := filepath.Join(t.TempDir(), "store.tar.gz")
+		f, err := os.Create(file)
+		require.NoError(t, err)
+		zw := gzip.NewWriter(f)
+		tw := tar.NewWriter(zw)
+		require.NoError(t, writeArchiveEntry(tw, archiveManifest, m))
+		for i, e := range entries {
+			require.NoError(t, writeArchiveEntry(tw, fmt.Sprintf("records/%d.json", i), e))
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, zw.Close())
+		require.NoError(t, f.Close())
+		return file
+	}
+	valid := archivedRecord{Database: "db", Table: "table", Record: []byte(`{"key":"key","value":"Y2hhbmdlZA=="}`)}
+
+	notAnArchive := filepath.Join(t.TempDir(), "store.tar.gz")
+	require.NoError(t, ioutil.WriteFile(notAnArchive, []byte("no archive"), 0600))
+	for name, file := range map[string]string{
+		"not an archive":  notAnArchive,
+		"newer version":   newArchive(manifest{Version: ArchiveVersion + 1, Records: 1}, valid),
+		"missing version": newArchive(manifest{Records: 1}, valid),
+		"incomplete":      newArchive(manifest{Version: ArchiveVersion, Records: 2}, valid),
+		"invalid table":   newArchive(manifest{Version: ArchiveVersion, Records: 2}, valid, archivedRecord{Database: "db", Table: "..", Record: []byte(`{"key":"key"}`)}),
+	} {
+		_, err := Import(cfg, file, ImportModeReplace, log.NopLogger())
+		assert.Error(t, err, name)
+	}
+
+	// the store is left as it is
+	s, err = New(Config(cfg), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	rec, err := read(s, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), rec.Value)
+	require.NoError(t, s.Close(context.Background()))
+
+	imported, err := Import(cfg, newArchive(manifest{Version: ArchiveVersion, Records: 1}, valid), ImportModeMerge, log.NopLogger())
+	require.NoError(t, err)
+	assert.Equal(t, 1, imported)
+}
+
+func TestRecordCache(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.CacheEntries = 2
+		cfg.MaxValueSize = 0
+	})
+
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, write(s, key, []byte("value of "+key)))
+	}
+
+	// the first read of a record misses, the following ones hit
+	for i := 0; i < 10; i++ {
+		rec, err := read(s, "a")
+		require.NoError(t, err)
+		assert.Equal(t, "value of a", string(rec.Value))
+	}
+	hits, misses := s.cache.ratio()
+	assert.Equal(t, uint64(9), hits)
+	assert.Equal(t, uint64(1), misses)
+
+	// returned records don't share memory with the cache
+	rec, err := read(s, "a")
+	require.NoError(t, err)
+	rec.Value[0] = 'X'
+	rec, err = read(s, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "value of a", string(rec.Value))
+
+	t.Run("writes and deletes evict records", func(t *testing.T) {
+		require.NoError(t, write(s, "a", []byte("new value")))
+		rec, err := read(s, "a")
+		require.NoError(t, err)
+		assert.Equal(t, "new value", string(rec.Value))
+
+		require.NoError(t, s.Delete(context.Background(), &storesvc.DeleteRequest{
+			Options: &storemsg.DeleteOptions{Database: "db", Table: "table"},
+			Key:     "a",
+		}, &storesvc.DeleteResponse{}))
+		_, err = read(s, "a")
+		assert.Error(t, err)
+	})
+
+	t.Run("records changed by other processes are read again", func(t *testing.T) {
+		_, err := read(s, "b")
+		require.NoError(t, err)
+
+		data, err := s.marshalRecord(&storemsg.Record{Key: "b", Value: []byte("changed elsewhere")})
+		require.NoError(t, err)
+		file := filepath.Join(s.Config.Datapath, "databases", "db", "table", "b")
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		// make sure the change is detected with coarse modification times
+		require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+
+		rec, err := read(s, "b")
+		require.NoError(t, err)
+		assert.Equal(t, "changed elsewhere", string(rec.Value))
+
+		require.NoError(t, os.Remove(file))
+		_, err = read(s, "b")
+		assert.Error(t, err)
+	})
+
+	t.Run("least recently used records are evicted", func(t *testing.T) {
+		for _, key := range []string{"c", "d", "e"} {
+			require.NoError(t, write(s, key, []byte("value of "+key)))
+		}
+		for _, key := range []string{"c", "d", "c", "e"} {
+			_, err := read(s, key)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, s.cache.order.Len())
+		assert.Contains(t, s.cache.entries, filepath.Join("db", "table", "c"))
+		assert.Contains(t, s.cache.entries, filepath.Join("db", "table", "e"))
+	})
+}
+
+func TestRecordCacheMaxBytes(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.CacheEntries = 100
+		cfg.CacheMaxBytes = 100
+		cfg.MaxValueSize = 0
+	})
+	require.NoError(t, write(s, "large", []byte(strings.Repeat("v", 200))))
+	require.NoError(t, write(s, "small", []byte("v")))
+
+	for i := 0; i < 3; i++ {
+		_, err := read(s, "large")
+		require.NoError(t, err)
+		_, err = read(s, "small")
+		require.NoError(t, err)
+	}
+	// the large record is never cached
+	hits, misses := s.cache.ratio()
+	assert.Equal(t, uint64(2), hits)
+	assert.Equal(t, uint64(4), misses)
+	assert.LessOrEqual(t, s.cache.bytes, 100)
+}
+
+func TestRecordCacheDisabled(t *testing.T) {
+	s := newTestService(t, nil)
+	assert.Nil(t, s.cache)
+	require.NoError(t, write(s, "key", []byte("value")))
+	rec, err := read(s, "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", string(rec.Value))
+}
+
+func TestNegativeCache(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.NegativeCacheEntries = 2
+		cfg.NegativeCacheTTL = 60 * 1000
+	})
+	notFound := func(key string) {
+		t.Helper()
+		_, err := read(s, key)
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	}
+
+	// the first miss reads the data path, the second one is served from the cache
+	notFound("missing")
+	assert.Equal(t, uint64(0), s.missing.hitCount())
+	notFound("missing")
+	assert.Equal(t, uint64(1), s.missing.hitCount())
+
+	t.Run("the data path is not read for cached misses", func(t *testing.T) {
+		// a record created by another process is only found once the entry expired
+		id, err := s.getID("db", "table", "missing")
+		require.NoError(t, err)
+		data, err := s.marshalRecord(&storemsg.Record{Key: "missing", Value: []byte("value")})
+		require.NoError(t, err)
+		file := filepath.Join(s.Config.Datapath, "databases", id)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0700))
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		notFound("missing")
+		assert.Equal(t, uint64(2), s.missing.hitCount())
+		require.NoError(t, os.Remove(file))
+	})
+
+	t.Run("writes invalidate misses", func(t *testing.T) {
+		require.NoError(t, write(s, "missing", []byte("value")))
+		rec, err := read(s, "missing")
+		require.NoError(t, err)
+		assert.Equal(t, "value", string(rec.Value))
+	})
+
+	t.Run("misses are evicted", func(t *testing.T) {
+		hits := s.missing.hitCount()
+		for _, key := range []string{"a", "b", "c", "a"} {
+			notFound(key)
+		}
+		assert.Equal(t, hits, s.missing.hitCount())
+		assert.Equal(t, 2, s.missing.order.Len())
+	})
+}
+
+func TestNegativeCacheExpires(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.NegativeCacheEntries = 10
+		cfg.NegativeCacheTTL = 10
+	})
+	_, err := read(s, "key")
+	require.Error(t, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = read(s, "key")
+	require.Error(t, err)
+	assert.Equal(t, uint64(0), s.missing.hitCount())
+}
+
+func TestNegativeCacheConcurrentWrite(t *testing.T) {
+	c := newNegativeCache(10, time.Minute, nil)
+	_, generation := c.lookup("key")
+	// the record is written while the read looks for it, the miss of the read is outdated
+	c.invalidate("key")
+	c.add("key", generation)
+	missing, _ := c.lookup("key")
+	assert.False(t, missing)
+}
+
+func TestStaleReads(t *testing.T) {
+	readWith := func(s *Service, key string, allowStale bool) (*storemsg.Record, error) {
+		res := &storesvc.ReadResponse{}
+		err := s.Read(context.Background(), &storesvc.ReadRequest{
+			Options: &storemsg.ReadOptions{Database: "db", Table: "table", AllowStale: allowStale},
+			Key:     key,
+		}, res)
+		if err != nil {
+			return nil, err
+		}
+		return res.Records[0], nil
+	}
+	changeElsewhere := func(s *Service, key, value string) {
+		data, err := s.marshalRecord(&storemsg.Record{Key: key, Value: []byte(value)})
+		require.NoError(t, err)
+		file := filepath.Join(s.Config.Datapath, "databases", "db", "table", key)
+		require.NoError(t, os.WriteFile(file, data, 0600))
+		require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+	}
+
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.CacheEntries = 10
+		cfg.MaxValueSize = 0
+	})
+	require.NoError(t, write(s, "key", []byte("cached")))
+
+	// a stale read of an uncached record reads it from the disk and caches it
+	rec, err := readWith(s, "key", true)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", string(rec.Value))
+
+	changeElsewhere(s, "key", "changed elsewhere")
+	rec, err = readWith(s, "key", true)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", string(rec.Value))
+
+	// the default read checks the file and gets the current record
+	rec, err = readWith(s, "key", false)
+	require.NoError(t, err)
+	assert.Equal(t, "changed elsewhere", string(rec.Value))
+	rec, err = readWith(s, "key", true)
+	require.NoError(t, err)
+	assert.Equal(t, "changed elsewhere", string(rec.Value))
+
+	// writes of the store itself are visible to stale reads right away
+	require.NoError(t, write(s, "key", []byte("written")))
+	rec, err = readWith(s, "key", true)
+	require.NoError(t, err)
+	assert.Equal(t, "written", string(rec.Value))
+
+	t.Run("without cache", func(t *testing.T) {
+		s := newTestService(t, nil)
+		require.NoError(t, write(s, "key", []byte("value")))
+		_, err := readWith(s, "key", true)
+		require.NoError(t, err)
+
+		changeElsewhere(s, "key", "changed elsewhere")
+		rec, err := readWith(s, "key", true)
+		require.NoError(t, err)
+		assert.Equal(t, "changed elsewhere", string(rec.Value))
+	})
+}
+
+func BenchmarkRecordCache(b *testing.B) {
+	const records = 1000
+	for _, entries := range []int{0, records / 10, records} {
+		s := newTestService(b, func(cfg *config.Config) {
+			cfg.CacheEntries = entries
+			cfg.FsyncPolicy = config.FsyncPolicyNever
+			cfg.MaxValueSize = 0
+		})
+		value := []byte(strings.Repeat("v", 1024))
+		for i := 0; i < records; i++ {
+			if err := write(s, strconv.Itoa(i), value); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.Run(fmt.Sprintf("entries=%d", entries), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				// every tenth record is hot
+				key := i % records
+				if i%2 == 0 {
+					key = (i % (records / 10)) * 10
+				}
+				if _, err := read(s, strconv.Itoa(key)); err != nil {
+					b.Fatal(err)
+				}
+			}
+			if hits, misses := s.cache.ratio(); hits+misses > 0 {
+				b.ReportMetric(float64(hits)/float64(hits+misses), "hit-ratio")
+			}
+		})
+	}
+}
+
+func TestDeadlines(t *testing.T) {
+	var (
+		delay   int64
+		pending sync.WaitGroup
+	)
+	// the filesystem is restored once the abandoned reads are done
+	t.Cleanup(func() {
+		atomic.StoreInt64(&delay, 0)
+		pending.Wait()
+		readFile = ioutil.ReadFile
+	})
+	readFile = func(file string) ([]byte, error) {
+		pending.Add(1)
+		defer pending.Done()
+		time.Sleep(time.Duration(atomic.LoadInt64(&delay)))
+		return ioutil.ReadFile(file)
+	}
+	slow := func(d time.Duration) {
+		atomic.StoreInt64(&delay, int64(d))
+	}
+	timedOut := func(t *testing.T, err error) {
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusRequestTimeout), merrors.FromError(err).Code)
+	}
+
+	t.Run("reads", func(t *testing.T) {
+		s := newTestService(t, func(cfg *config.Config) { cfg.ReadTimeout = 20 })
+		slow(0)
+		require.NoError(t, write(s, "a", []byte("value")))
+
+		slow(500 * time.Millisecond)
+		start := time.Now()
+		_, err := read(s, "a")
+		timedOut(t, err)
+		assert.Less(t, time.Since(start), 400*time.Millisecond)
+	})
+
+	t.Run("deadline of the request", func(t *testing.T) {
+		s := newTestService(t, nil)
+		slow(0)
+		require.NoError(t, write(s, "a", []byte("value")))
+
+		slow(500 * time.Millisecond)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := s.Read(ctx, &storesvc.ReadRequest{
+			Options: &storemsg.ReadOptions{Database: "db", Table: "table"},
+			Key:     "a",
+		}, &storesvc.ReadResponse{})
+		timedOut(t, err)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		s := newTestService(t, func(cfg *config.Config) { cfg.ListTimeout = 50 })
+		slow(0)
+		for i := 0; i < 5; i++ {
+			require.NoError(t, write(s, strconv.Itoa(i), []byte("value")))
+		}
+		res, err := list(s, &storemsg.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, res.Keys, 5)
+
+		slow(30 * time.Millisecond)
+		_, err = list(s, &storemsg.ListOptions{})
+		timedOut(t, err)
+	})
+
+	t.Run("writes", func(t *testing.T) {
+		s := newTestService(t, nil)
+		slow(0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := s.Write(ctx, &storesvc.WriteRequest{
+			Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+			Record:  &storemsg.Record{Key: "a", Value: []byte("value")},
+		}, &storesvc.WriteResponse{})
+		timedOut(t, err)
+
+		// the record was not written
+		_, err = read(s, "a")
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+	})
+
+	t.Run("slow operation log", func(t *testing.T) {
+		s := newTestService(t, func(cfg *config.Config) { cfg.SlowOperationThreshold = 10 })
+		logs := &bytes.Buffer{}
+		s.log = log.Logger{Logger: zerolog.New(logs)}
+		// the go-micro zerolog logger raises the global level on init
+		level := zerolog.GlobalLevel()
+		zerolog.SetGlobalLevel(zerolog.TraceLevel)
+		t.Cleanup(func() { zerolog.SetGlobalLevel(level) })
+		slow(0)
+		require.NoError(t, write(s, "a", []byte("value")))
+		assert.Empty(t, logs.String())
+
+		slow(20 * time.Millisecond)
+		_, err := read(s, "a")
+		require.NoError(t, err)
+		assert.Contains(t, logs.String(), `"message":"slow store operation"`)
+		assert.Contains(t, logs.String(), `"operation":"read"`)
+		assert.Contains(t, logs.String(), `"table":"table"`)
+	})
+}
+
+func TestRetries(t *testing.T) {
+	var (
+		failures int64
+		calls    int64
+		failWith syscall.Errno
+	)
+	t.Cleanup(func() {
+		readFile = ioutil.ReadFile
+		writeTempFile = createTempFile
+		renameFile = os.Rename
+	})
+	// every file operation fails until the failures are used up
+	fail := func() error {
+		atomic.AddInt64(&calls, 1)
+		if atomic.AddInt64(&failures, -1) >= 0 {
+			return &os.PathError{Op: "test", Path: "record", Err: failWith}
+		}
+		return nil
+	}
+	readFile = func(file string) ([]byte, error) {
+		if err := fail(); err != nil {
+			return nil, err
+		}
+		return ioutil.ReadFile(file)
+	}
+	writeTempFile = func(dir, pattern string, data []byte, sync bool) (string, error) {
+		if err := fail(); err != nil {
+			return "", err
+		}
+		return createTempFile(dir, pattern, data, sync)
+	}
+	renameFile = func(from, to string) error {
+		if err := fail(); err != nil {
+			return err
+		}
+		return os.Rename(from, to)
+	}
+	failNext := func(n int64, err syscall.Errno) {
+		atomic.StoreInt64(&failures, n)
+		atomic.StoreInt64(&calls, 0)
+		failWith = err
+	}
+	retrying := func(cfg *config.Config) {
+		cfg.RetryAttempts = 3
+		cfg.RetryBackoff = 1
+	}
+
+	t.Run("reads", func(t *testing.T) {
+		s := newTestService(t, retrying)
+		failNext(0, 0)
+		require.NoError(t, write(s, "a", []byte("value")))
+
+		failNext(2, syscall.EAGAIN)
+		rec, err := read(s, "a")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), rec.Value)
+		assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("writes and renames", func(t *testing.T) {
+		s := newTestService(t, retrying)
+		// the temporary file fails twice, the rename once
+		failNext(2, syscall.ENOSPC)
+		require.NoError(t, write(s, "a", []byte("value")))
+		assert.Equal(t, int64(4), atomic.LoadInt64(&calls))
+
+		failNext(0, 0)
+		rec, err := read(s, "a")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), rec.Value)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		s := newTestService(t, retrying)
+		failNext(0, 0)
+		require.NoError(t, write(s, "a", []byte("value")))
+
+		failNext(10, syscall.ESTALE)
+		_, err := read(s, "a")
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusServiceUnavailable), merrors.FromError(err).Code)
+		assert.Contains(t, merrors.FromError(err).Detail, "retries exhausted")
+		assert.Equal(t, int64(4), atomic.LoadInt64(&calls))
+
+		err = write(s, "b", []byte("value"))
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusServiceUnavailable), merrors.FromError(err).Code)
+	})
+
+	t.Run("errors which aren't transient", func(t *testing.T) {
+		s := newTestService(t, retrying)
+		failNext(10, syscall.EACCES)
+		err := write(s, "a", []byte("value"))
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusInternalServerError), merrors.FromError(err).Code)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := newTestService(t, func(cfg *config.Config) { cfg.RetryAttempts = 0 })
+		failNext(0, 0)
+		require.NoError(t, write(s, "a", []byte("value")))
+
+		failNext(1, syscall.EAGAIN)
+		_, err := read(s, "a")
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		s := newTestService(t, func(cfg *config.Config) {
+			cfg.RetryAttempts = 3
+			cfg.RetryBackoff = 1000
+			cfg.ReadTimeout = 20
+		})
+		failNext(0, 0)
+		require.NoError(t, write(s, "a", []byte("value")))
+
+		failNext(10, syscall.EAGAIN)
+		start := time.Now()
+		_, err := read(s, "a")
+		require.Error(t, err)
+		assert.Equal(t, int32(http.StatusRequestTimeout), merrors.FromError(err).Code)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+}
+
+func appendElements(s *Service, key string, unique bool, elements ...string) (*storesvc.AppendElementsResponse, error) {
+	req := &storesvc.AppendElementsRequest{
+		Key:     key,
+		Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+		Unique:  unique,
+	}
+	for _, e := range elements {
+		req.Elements = append(req.Elements, []byte(e))
+	}
+	res := &storesvc.AppendElementsResponse{}
+	return res, s.AppendElements(context.Background(), req, res)
+}
+
+func removeElements(s *Service, key string, elements ...string) (*storesvc.RemoveElementsResponse, error) {
+	req := &storesvc.RemoveElementsRequest{
+		Key:     key,
+		Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+	}
+	for _, e := range elements {
+		req.Elements = append(req.Elements, []byte(e))
+	}
+	res := &storesvc.RemoveElementsResponse{}
+	return res, s.RemoveElements(context.Background(), req, res)
+}
+
+func TestElements(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.MaxValueSize = 0
+	})
+
+	t.Run("append creates the record", func(t *testing.T) {
+		res, err := appendElements(s, "list", false, `"a"`, `{"b": 1, "a": 2}`)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), res.Changed)
+		assert.JSONEq(t, `["a", {"a": 2, "b": 1}]`, string(res.Value))
+
+		res, err = appendElements(s, "list", false, `"a"`)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), res.Changed)
+		rec, err := read(s, "list")
+		require.NoError(t, err)
+		assert.JSONEq(t, `["a", {"a": 2, "b": 1}, "a"]`, string(rec.Value))
+	})
+
+	t.Run("unique appends skip contained elements", func(t *testing.T) {
+		res, err := appendElements(s, "set", true, `"a"`, `{"x": 1, "y": 2}`, `"a"`)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), res.Changed)
+
+		// equal values are contained regardless of the formatting
+		res, err = appendElements(s, "set", true, `{ "y": 2, "x": 1 }`, `"a"`, `"b"`)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), res.Changed)
+		assert.JSONEq(t, `["a", {"x": 1, "y": 2}, "b"]`, string(res.Value))
+	})
+
+	t.Run("remove removes all occurrences", func(t *testing.T) {
+		_, err := appendElements(s, "remove", false, `"a"`, `"b"`, `"a"`, `1`)
+		require.NoError(t, err)
+
+		res, err := removeElements(s, "remove", `"a"`, `2`)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), res.Changed)
+		assert.JSONEq(t, `["b", 1]`, string(res.Value))
+
+		res, err = removeElements(s, "remove", `"c"`)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), res.Changed)
+		assert.JSONEq(t, `["b", 1]`, string(res.Value))
+
+		res, err = removeElements(s, "remove", `"b"`, `1`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[]`, string(res.Value))
+	})
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		_, err := removeElements(s, "missing", `"a"`)
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+
+		_, err = appendElements(s, "list", false, `not json`)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+
+		_, err = appendElements(s, "list", false)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+
+		require.NoError(t, write(s, "object", []byte(`{"a": 1}`)))
+		_, err = appendElements(s, "object", false, `"a"`)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+	})
+
+	t.Run("the value size limit applies", func(t *testing.T) {
+		s := newTestService(t, func(cfg *config.Config) {
+			cfg.MaxValueSize = 10
+		})
+		_, err := appendElements(s, "limited", false, `"abc"`)
+		require.NoError(t, err)
+		_, err = appendElements(s, "limited", false, `"def"`)
+		assert.Equal(t, int32(http.StatusBadRequest), merrors.FromError(err).Code)
+		rec, err := read(s, "limited")
+		require.NoError(t, err)
+		assert.Equal(t, `["abc"]`, string(rec.Value))
+	})
+}
+
+func TestExpiredRecords(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.MaxValueSize = 0
+	})
+	appendExpiring := func(key string, expiry time.Duration, element string) *storesvc.AppendElementsResponse {
+		res := &storesvc.AppendElementsResponse{}
+		require.NoError(t, s.AppendElements(context.Background(), &storesvc.AppendElementsRequest{
+			Key:      key,
+			Options:  &storemsg.WriteOptions{Database: "db", Table: "table"},
+			Elements: [][]byte{[]byte(element)},
+			Unique:   true,
+			Expiry:   int64(expiry),
+		}, res))
+		return res
+	}
+
+	appendExpiring("expiring", time.Millisecond, "true")
+	appendExpiring("valid", time.Hour, "true")
+	require.NoError(t, write(s, "kept", []byte("value")))
+	rec, err := read(s, "expiring")
+	require.NoError(t, err)
+	assert.Equal(t, int64(time.Millisecond), rec.Expiry)
+	time.Sleep(5 * time.Millisecond)
+
+	t.Run("appending to an expired record replaces it", func(t *testing.T) {
+		appendExpiring("replaced", time.Millisecond, `"a"`)
+		time.Sleep(5 * time.Millisecond)
+		res := appendExpiring("replaced", time.Hour, `"a"`)
+		assert.Equal(t, uint32(1), res.Changed)
+		assert.JSONEq(t, `["a"]`, string(res.Value))
+		rec, err := read(s, "replaced")
+		require.NoError(t, err)
+		assert.Equal(t, int64(time.Hour), rec.Expiry)
+	})
+
+	t.Run("expired records are removed", func(t *testing.T) {
+		removed, err := s.removeExpired()
+		require.NoError(t, err)
+		assert.Equal(t, 1, removed)
+
+		_, err = read(s, "expiring")
+		assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+		for _, key := range []string{"valid", "kept", "replaced"} {
+			_, err := read(s, key)
+			assert.NoError(t, err, key)
+		}
+		records, _ := s.stats.usage("db")
+		assert.Equal(t, uint64(3), records)
+	})
+}
+
+func TestAppendElementsConcurrently(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) {
+		cfg.MaxValueSize = 0
+	})
+
+	const workers, perWorker = 8, 25
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*perWorker)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				if _, err := appendElements(s, "assignments", true, strconv.Quote(fmt.Sprintf("role-%d-%d", w, i))); err != nil {
+					errs <- err
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	rec, err := read(s, "assignments")
+	require.NoError(t, err)
+	var elements []string
+	require.NoError(t, json.Unmarshal(rec.Value, &elements))
+	assert.Len(t, elements, workers*perWorker)
+	for w := 0; w < workers; w++ {
+		for i := 0; i < perWorker; i++ {
+			assert.Contains(t, elements, fmt.Sprintf("role-%d-%d", w, i))
+		}
+	}
+}
+
+func TestQuotas(t *testing.T) {
+	s := newTestService(t, func(cfg *config.Config) { cfg.QuotaMaxRecords = 3 })
+	writeTo := func(db, key, value string) error {
+		return s.Write(context.Background(), &storesvc.WriteRequest{
+			Options: &storemsg.WriteOptions{Database: db, Table: "table"},
+			Record:  &storemsg.Record{Key: key, Value: []byte(value)},
+		}, &storesvc.WriteResponse{})
+	}
+	exceeded := func(err error) {
+		if assert.Error(t, err) {
+			assert.Equal(t, int32(http.StatusInsufficientStorage), merrors.FromError(err).Code)
+			assert.Contains(t, err.Error(), "quota")
+		}
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, writeTo("full", key, "value"))
+	}
+	exceeded(writeTo("full", "d", "value"))
+	// other databases are unaffected, existing records can still be updated
+	require.NoError(t, writeTo("other", "d", "value"))
+	assert.NoError(t, writeTo("full", "a", "changed"))
+
+	require.NoError(t, s.Delete(context.Background(), &storesvc.DeleteRequest{
+		Options: &storemsg.DeleteOptions{Database: "full", Table: "table"},
+		Key:     "a",
+	}, &storesvc.DeleteResponse{}))
+	assert.NoError(t, writeTo("full", "d", "value"))
+	exceeded(writeTo("full", "e", "value"))
+
+	// the quota of a single database is picked up while the service is running
+	usage, err := DatabaseUsage(s.Config)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), usage["other"].Records)
+	require.NoError(t, WriteQuotas(s.Config.Datapath, map[string]Quota{"other": {MaxBytes: usage["other"].Bytes}}))
+	exceeded(writeTo("other", "e", "value"))
+	assert.NoError(t, writeTo("other", "d", "v"))
+	exceeded(writeTo("full", "e", "value"))
+
+	// the usage is rebuilt on startup
+	s, err = New(Config(s.Config), Logger(log.NopLogger()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.index.Close() })
+	exceeded(writeTo("full", "e", "value"))
+	exceeded(writeTo("other", "e", "value"))
+	require.NoError(t, WriteQuotas(s.Config.Datapath, map[string]Quota{}))
+	assert.NoError(t, writeTo("other", "e", "value"))
+}
+
+func TestEviction(t *testing.T) {
+	const minFree = 1000
+	// the capacity of the simulated filesystem, the free space is what the records of the store leave of it
+	newStore := func(t *testing.T, policy string) (*Service, *uint64) {
+		s := newTestService(t, func(cfg *config.Config) {
+			cfg.EvictionPolicy = policy
+			cfg.EvictionMinFreeBytes = minFree
+		})
+		capacity := uint64(1 << 30)
+		s.evictor.freeSpace = func(string) (uint64, error) {
+			_, used := s.stats.usage("db")
+			if used >= capacity {
+				return 0, nil
+			}
+			return capacity - used, nil
+		}
+		return s, &capacity
+	}
+	writeRecord := func(t *testing.T, s *Service, rec *storemsg.Record) {
+		require.NoError(t, s.Write(context.Background(), &storesvc.WriteRequest{
+			Options: &storemsg.WriteOptions{Database: "db", Table: "table"},
+			Record:  rec,
+		}, &storesvc.WriteResponse{}))
+	}
+	// fill writes the expirable records a1 to a3, an expirable non-evictable record and one without an expiry,
+	// and returns the size of the record file of an expirable record
+	fill := func(t *testing.T, s *Service) uint64 {
+		for _, key := range []string{"a1", "a2", "a3"} {
+			writeRecord(t, s, &storemsg.Record{Key: key, Value: []byte("value"), Expiry: int64(time.Hour)})
+		}
+		writeRecord(t, s, &storemsg.Record{Key: "pinned", Value: []byte("value"), Expiry: int64(time.Hour), NonEvictable: true})
+		writeRecord(t, s, &storemsg.Record{Key: "kept", Value: []byte("value")})
+		id, err := s.getID("db", "table", "a1")
+		require.NoError(t, err)
+		fi, err := os.Stat(filepath.Join(s.Config.Datapath, "databases", id))
+		require.NoError(t, err)
+		return uint64(fi.Size())
+	}
+	// lowerFreeSpace lets the free space drop below the threshold by one and a half records
+	lowerFreeSpace := func(s *Service, capacity *uint64, size uint64) {
+		_, used := s.stats.usage("db")
+		*capacity = used + minFree - size - size/2
+	}
+	remaining := func(t *testing.T, s *Service) []string {
+		var keys []string
+		for _, key := range []string{"a1", "a2", "a3", "pinned", "kept", "trigger"} {
+			if _, err := read(s, key); err == nil {
+				keys = append(keys, key)
+			} else {
+				assert.Equal(t, int32(http.StatusNotFound), merrors.FromError(err).Code)
+			}
+		}
+		return keys
+	}
+
+	t.Run("oldest", func(t *testing.T) {
+		s, capacity := newStore(t, config.EvictionPolicyOldest)
+		size := fill(t, s)
+		lowerFreeSpace(s, capacity, size)
+		require.NoError(t, write(s, "trigger", []byte("value")))
+		assert.Equal(t, []string{"a3", "pinned", "kept", "trigger"}, remaining(t, s))
+		records, _ := s.stats.usage("db")
+		assert.Equal(t, uint64(4), records)
+	})
+
+	t.Run("least recently used", func(t *testing.T) {
+		s, capacity := newStore(t, config.EvictionPolicyLRU)
+		size := fill(t, s)
+		_, err := read(s, "a1")
+		require.NoError(t, err)
+		lowerFreeSpace(s, capacity, size)
+		require.NoError(t, write(s, "trigger", []byte("value")))
+		assert.Equal(t, []string{"a1", "pinned", "kept", "trigger"}, remaining(t, s))
+	})
+
+	t.Run("expired records first", func(t *testing.T) {
+		s, capacity := newStore(t, config.EvictionPolicyOldest)
+		size := fill(t, s)
+		writeRecord(t, s, &storemsg.Record{Key: "a4", Value: []byte("value"), Expiry: int64(time.Millisecond)})
+		time.Sleep(5 * time.Millisecond)
+		lowerFreeSpace(s, capacity, size)
+		require.NoError(t, write(s, "trigger", []byte("value")))
+		_, err := read(s, "a4")
+		require.Error(t, err)
+		assert.Equal(t, []string{"a2", "a3", "pinned", "kept", "trigger"}, remaining(t, s))
+	})
+
+	t.Run("only eligible records are evicted", func(t *testing.T) {
+		s, capacity := newStore(t, config.EvictionPolicyOldest)
+		fill(t, s)
+		*capacity = 0
+		require.NoError(t, write(s, "trigger", []byte("value")))
+		assert.Equal(t, []string{"pinned", "kept", "trigger"}, remaining(t, s))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := newTestService(t, nil)
+		assert.Nil(t, s.evictor)
+		fill(t, s)
+		require.NoError(t, write(s, "trigger", []byte("value")))
+		assert.Equal(t, []string{"a1", "a2", "a3", "pinned", "kept", "trigger"}, remaining(t, s))
+	})
+}
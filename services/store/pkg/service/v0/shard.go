@@ -0,0 +1,247 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+)
+
+const (
+	// layoutFile records how the records in the data path are sharded.
+	layoutFile = "layout.json"
+	// reshardDir is the staging directory of the records being resharded
+	reshardDir = "tmp/reshard"
+)
+
+// layout describes how the records in the data path are stored.
+type layout struct {
+	ShardLevels int `json:"shard_levels"`
+}
+
+// shardPath returns the directories of a record with the given file name relative to its table directory.
+// Every level is named after a byte of the hash of the file name.
+func shardPath(name string, levels int) string {
+	if levels == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(name))
+	dirs := make([]string, levels)
+	for i := range dirs {
+		dirs[i] = hex.EncodeToString(sum[i : i+1])
+	}
+	return filepath.Join(dirs...)
+}
+
+// readLayout reads the layout of the data path. Data paths without a layout file were written
+// before records could be sharded, they are not sharded.
+func readLayout(datapath string) (layout, bool, error) {
+	l := layout{}
+	data, err := ioutil.ReadFile(filepath.Join(datapath, layoutFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return l, false, nil
+		}
+		return l, false, err
+	}
+	if err := json.Unmarshal(data, &l); err != nil {
+		return l, false, fmt.Errorf("could not parse %s: %w", layoutFile, err)
+	}
+	return l, true, nil
+}
+
+// writeLayout atomically replaces the layout file of the data path.
+func writeLayout(datapath string, l layout) error {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return err
+	}
+	return replaceDataPathFile(datapath, layoutFile, data)
+}
+
+// replaceDataPathFile atomically replaces a file in the data path and syncs it to the disk.
+func replaceDataPathFile(datapath, name string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Join(datapath, "tmp"), name+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(datapath, name)); err != nil {
+		return err
+	}
+	return syncPath(datapath)
+}
+
+// checkLayout makes sure the records in the data path are sharded as configured.
+func checkLayout(cfg *config.Config) error {
+	if _, err := os.Stat(filepath.Join(cfg.Datapath, reshardDir)); err == nil {
+		return errors.New("resharding the records was interrupted, run 'ocis store reshard' again")
+	}
+	l, ok, err := readLayout(cfg.Datapath)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		dbs, err := readDirNames(filepath.Join(cfg.Datapath, "databases"))
+		if err != nil {
+			return err
+		}
+		if len(dbs) == 0 {
+			// a new store is sharded right away
+			l.ShardLevels = cfg.ShardLevels
+		}
+		if l.ShardLevels == cfg.ShardLevels {
+			return writeLayout(cfg.Datapath, l)
+		}
+	}
+	if l.ShardLevels != cfg.ShardLevels {
+		return fmt.Errorf("the records in %s are sharded with %d levels, but %d are configured, run 'ocis store reshard' to move them",
+			cfg.Datapath, l.ShardLevels, cfg.ShardLevels)
+	}
+	return nil
+}
+
+// walkRecords calls fn with the path of every record below the directory, in any shard level.
+// A missing directory contains no records.
+func walkRecords(dir string, fn func(path string) error) error {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			return fn(path)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Reshard moves the records in the data path to the given number of shard levels. The store service
+// must not be running. The records that have to be moved are moved to a staging directory first, as
+// their names can collide with the shard directories. Resharding is repeated safely when it was
+// interrupted, the records are only read with the new number of levels once all of them were moved.
+func Reshard(cfg *config.Config, levels int, logger log.Logger) (int, error) {
+	if levels < 0 || levels > config.MaxShardLevels {
+		return 0, fmt.Errorf("the number of shard levels must be between 0 and %d", config.MaxShardLevels)
+	}
+	if err := checkWALEmpty(cfg.Datapath); err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Join(cfg.Datapath, "tmp"), 0700); err != nil {
+		return 0, err
+	}
+
+	databases := filepath.Join(cfg.Datapath, "databases")
+	dbs, err := readDirNames(databases)
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	touched := map[string]struct{}{}
+	move := func(file, target string) error {
+		if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+		if err := os.Rename(file, target); err != nil {
+			return err
+		}
+		touched[filepath.Dir(file)] = struct{}{}
+		touched[filepath.Dir(target)] = struct{}{}
+		return nil
+	}
+	for _, db := range dbs {
+		tables, err := readDirNames(filepath.Join(databases, db))
+		if err != nil {
+			return moved, err
+		}
+		for _, table := range tables {
+			dir := filepath.Join(databases, db, table)
+			staging := filepath.Join(cfg.Datapath, reshardDir, db, table)
+			if err := walkRecords(dir, func(file string) error {
+				name := filepath.Base(file)
+				if file == filepath.Join(dir, shardPath(name, levels), name) {
+					return nil
+				}
+				return move(file, filepath.Join(staging, name))
+			}); err != nil {
+				return moved, err
+			}
+			if err := removeEmptyDirs(dir); err != nil {
+				return moved, err
+			}
+			// also moves the records staged by an interrupted run
+			if err := walkRecords(staging, func(file string) error {
+				name := filepath.Base(file)
+				moved++
+				return move(file, filepath.Join(dir, shardPath(name, levels), name))
+			}); err != nil {
+				return moved, err
+			}
+			logger.Debug().Str("database", db).Str("table", table).Msg("resharded table")
+		}
+	}
+
+	// the moves have to be on the disk before the layout says they happened
+	for dir := range touched {
+		if err := syncPath(dir); err != nil && !os.IsNotExist(err) {
+			return moved, err
+		}
+	}
+	if err := writeLayout(cfg.Datapath, layout{ShardLevels: levels}); err != nil {
+		return moved, err
+	}
+	return moved, os.RemoveAll(filepath.Join(cfg.Datapath, reshardDir))
+}
+
+// removeEmptyDirs removes the empty shard directories below the table directory.
+func removeEmptyDirs(dir string) error {
+	var dirs []string
+	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && path != dir {
+			dirs = append(dirs, path)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	// children before their parents
+	sort.Sort(sort.Reverse(sort.StringSlice(dirs)))
+	for _, d := range dirs {
+		names, err := readDirNames(d)
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			if err := os.Remove(d); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
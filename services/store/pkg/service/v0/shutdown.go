@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	merrors "go-micro.dev/v4/errors"
+)
+
+// beginWrite registers a write or delete. It returns an error once the service is shutting down,
+// otherwise endWrite has to be called when the write is done.
+func (s *Service) beginWrite() error {
+	s.lifecycle.Lock()
+	defer s.lifecycle.Unlock()
+	if s.closing {
+		return merrors.New(s.id, "the store is shutting down", http.StatusServiceUnavailable)
+	}
+	s.inflight.Add(1)
+	return nil
+}
+
+func (s *Service) endWrite() {
+	s.inflight.Done()
+}
+
+// Close stops accepting writes and deletes and waits for the in-flight ones. Afterwards the pending
+// syncs are flushed, the write-ahead log is checkpointed and the index is closed. If the context is done
+// before the in-flight writes finished, only the pending syncs are flushed. The write-ahead log is left
+// as it is then and replayed on the next start.
+func (s *Service) Close(ctx context.Context) error {
+	s.lifecycle.Lock()
+	if s.closing {
+		s.lifecycle.Unlock()
+		return nil
+	}
+	s.closing = true
+	s.lifecycle.Unlock()
+	if s.sweeper != nil {
+		s.sweeper.Close()
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		if s.flusher != nil {
+			s.flusher.Close()
+		}
+		return fmt.Errorf("in-flight writes didn't finish before the shutdown timeout: %w", ctx.Err())
+	}
+
+	var err error
+	if s.wal != nil {
+		if err = s.wal.Close(); err != nil {
+			err = fmt.Errorf("could not checkpoint the write-ahead log: %w", err)
+		}
+	}
+	if s.flusher != nil {
+		s.flusher.Close()
+	}
+	if cerr := s.index.Close(); err == nil && cerr != nil {
+		err = fmt.Errorf("could not close the index: %w", cerr)
+	}
+	if err == nil {
+		s.log.Info().Msg("flushed all writes")
+	}
+	return err
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	storemsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/store/v0"
+	storesvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/store/v0"
+	merrors "go-micro.dev/v4/errors"
+)
+
+// tableStats are the counters of a table.
+type tableStats struct {
+	records uint64
+	bytes   uint64
+	oldest  time.Time
+	newest  time.Time
+	expired uint64
+}
+
+// add counts a record of the given size written at the given time.
+func (t *tableStats) add(size int64, modified time.Time) {
+	t.records++
+	t.bytes += uint64(size)
+	if t.oldest.IsZero() || modified.Before(t.oldest) {
+		t.oldest = modified
+	}
+	if modified.After(t.newest) {
+		t.newest = modified
+	}
+}
+
+// remove uncounts a record of the given size.
+func (t *tableStats) remove(size int64) {
+	if t.records > 0 {
+		t.records--
+	}
+	if uint64(size) > t.bytes {
+		size = int64(t.bytes)
+	}
+	t.bytes -= uint64(size)
+}
+
+// statsCounters maintains the number and size of the records per table and database. The counters are
+// updated on every write and delete, the time of the oldest write is not updated when records are deleted.
+type statsCounters struct {
+	mu        sync.Mutex
+	tables    map[[2]string]*tableStats
+	databases map[string]*tableStats
+}
+
+func newStatsCounters() *statsCounters {
+	return &statsCounters{tables: map[[2]string]*tableStats{}, databases: map[string]*tableStats{}}
+}
+
+func (c *statsCounters) database(database string) *tableStats {
+	d, ok := c.databases[database]
+	if !ok {
+		d = &tableStats{}
+		c.databases[database] = d
+	}
+	return d
+}
+
+func (c *statsCounters) table(database, table string) *tableStats {
+	t, ok := c.tables[[2]string{database, table}]
+	if !ok {
+		t = &tableStats{}
+		c.tables[[2]string{database, table}] = t
+	}
+	return t
+}
+
+// written counts a written record, replacing the previous version of the record if there was one.
+func (c *statsCounters) written(database, table string, previous os.FileInfo, size int64, modified time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, t := range []*tableStats{c.table(database, table), c.database(database)} {
+		if previous != nil {
+			t.remove(previous.Size())
+		}
+		t.add(size, modified)
+	}
+}
+
+// deleted uncounts a deleted record.
+func (c *statsCounters) deleted(database, table string, previous os.FileInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.table(database, table).remove(previous.Size())
+	c.database(database).remove(previous.Size())
+}
+
+// usage returns the number and size of the records of a database.
+func (c *statsCounters) usage(database string) (uint64, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if d, ok := c.databases[database]; ok {
+		return d.records, d.bytes
+	}
+	return 0, 0
+}
+
+// snapshot returns a copy of the counters of all tables.
+func (c *statsCounters) snapshot() map[[2]string]*tableStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tables := make(map[[2]string]*tableStats, len(c.tables))
+	for k, t := range c.tables {
+		copied := *t
+		tables[k] = &copied
+	}
+	return tables
+}
+
+// reset replaces the counters with the ones of a scan.
+func (c *statsCounters) reset(tables map[[2]string]*tableStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tables = make(map[[2]string]*tableStats, len(tables))
+	c.databases = map[string]*tableStats{}
+	for k, t := range tables {
+		copied := *t
+		copied.expired = 0
+		c.tables[k] = &copied
+		d := c.database(k[0])
+		d.records += t.records
+		d.bytes += t.bytes
+	}
+}
+
+// Stats implements the StoreHandler interface.
+// The stats are taken from the maintained counters and the index unless a fresh scan of all records is requested.
+func (s *Service) Stats(c context.Context, sreq *storesvc.StatsRequest, sres *storesvc.StatsResponse) error {
+	var tables map[[2]string]*tableStats
+	if sreq.Fresh {
+		var err error
+		if tables, err = s.scanStats(); err != nil {
+			s.log.Error().Err(err).Msg("could not scan the records")
+			return merrors.InternalServerError(s.id, "could not scan the records")
+		}
+		// scanning fixes counters that drifted, e.g. because of concurrent writes of the same record
+		s.stats.reset(tables)
+	} else {
+		tables = s.stats.snapshot()
+		if err := s.countExpired(tables); err != nil {
+			s.log.Error().Err(err).Msg("could not count the expired records")
+			return merrors.InternalServerError(s.id, "could not count the expired records")
+		}
+	}
+
+	total := &tableStats{}
+	keys := make([][2]string, 0, len(tables))
+	for k, t := range tables {
+		if t.records == 0 {
+			continue
+		}
+		keys = append(keys, k)
+		total.records += t.records
+		total.bytes += t.bytes
+		total.expired += t.expired
+		if total.oldest.IsZero() || t.oldest.Before(total.oldest) {
+			total.oldest = t.oldest
+		}
+		if t.newest.After(total.newest) {
+			total.newest = t.newest
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	sres.Total = toTableStats("", "", total)
+	for _, k := range keys {
+		sres.Tables = append(sres.Tables, toTableStats(k[0], k[1], tables[k]))
+	}
+	return nil
+}
+
+// countExpired counts the expired records per table from the index.
+func (s *Service) countExpired(tables map[[2]string]*tableStats) error {
+	count, err := s.index.DocCount()
+	if err != nil || count == 0 {
+		return err
+	}
+	min := float64(0)
+	query := bleve.NewNumericRangeInclusiveQuery(&min, nil, boolPtr(false), nil)
+	query.SetField("expiry")
+	req := bleve.NewSearchRequestOptions(query, int(count), 0, false)
+	req.Fields = []string{"database", "table", "modified", "expiry"}
+	result, err := s.index.Search(req)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, hit := range result.Hits {
+		database, _ := hit.Fields["database"].(string)
+		table, _ := hit.Fields["table"].(string)
+		modified, _ := hit.Fields["modified"].(string)
+		expiry, _ := hit.Fields["expiry"].(float64)
+		written, err := time.Parse(time.RFC3339Nano, modified)
+		if err != nil {
+			continue
+		}
+		if t, ok := tables[[2]string{database, table}]; ok && isExpired(written, int64(expiry), now) {
+			t.expired++
+		}
+	}
+	return nil
+}
+
+// scanStats reads all records to compute the stats.
+func (s *Service) scanStats() (map[[2]string]*tableStats, error) {
+	tables := map[[2]string]*tableStats{}
+	now := time.Now()
+	dbs, err := readDirNames(filepath.Join(s.Config.Datapath, "databases"))
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range dbs {
+		tnames, err := readDirNames(filepath.Join(s.Config.Datapath, "databases", db))
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range tnames {
+			t := &tableStats{}
+			err := walkRecords(filepath.Join(s.Config.Datapath, "databases", db, table), func(file string) error {
+				fi, err := os.Stat(file)
+				if err != nil {
+					if os.IsNotExist(err) {
+						// deleted in the meantime
+						return nil
+					}
+					return err
+				}
+				data, err := ioutil.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				rec := &storemsg.Record{}
+				if err := unmarshalRecord(data, rec); err != nil {
+					s.log.Error().Err(err).Str("path", file).Msg("could not unmarshal record")
+					return nil
+				}
+				t.add(fi.Size(), fi.ModTime())
+				if isExpired(fi.ModTime(), rec.Expiry, now) {
+					t.expired++
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			tables[[2]string{db, table}] = t
+		}
+	}
+	return tables, nil
+}
+
+// isExpired checks if a record written at the given time with an expiry duration is expired.
+func isExpired(written time.Time, expiry int64, now time.Time) bool {
+	return expiry > 0 && written.Add(time.Duration(expiry)).Before(now)
+}
+
+func toTableStats(database, table string, t *tableStats) *storemsg.TableStats {
+	stats := &storemsg.TableStats{
+		Database: database,
+		Table:    table,
+		Records:  t.records,
+		Bytes:    t.bytes,
+		Expired:  t.expired,
+	}
+	if !t.oldest.IsZero() {
+		stats.Oldest = t.oldest.UnixNano()
+		stats.Newest = t.newest.UnixNano()
+	}
+	return stats
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/store/pkg/config"
+)
+
+const (
+	walOpWrite  = "write"
+	walOpDelete = "delete"
+)
+
+// walEntry is a write or delete recorded in the write-ahead log. The id is the path of the
+// record relative to the databases directory, data holds the marshalled record of a write.
+type walEntry struct {
+	Op   string `json:"op"`
+	ID   string `json:"id"`
+	Data []byte `json:"data,omitempty"`
+}
+
+// wal is an append-only log of the writes and deletes that are about to be applied to the records.
+// With a write-ahead log only the log is synced on every write, the records themselves are synced
+// when the log is truncated at a checkpoint.
+type wal struct {
+	log               log.Logger
+	policy            string
+	flusher           *flusher
+	checkpointEntries int
+
+	// apply is held for reading while an entry is applied and for writing during a checkpoint
+	apply sync.RWMutex
+
+	mu      sync.Mutex
+	f       *os.File
+	entries int
+	dirty   map[string]struct{}
+}
+
+func openWAL(logger log.Logger, path string, policy string, f *flusher, checkpointEntries int) (*wal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &wal{
+		log:               logger,
+		policy:            policy,
+		flusher:           f,
+		checkpointEntries: checkpointEntries,
+		f:                 file,
+		dirty:             map[string]struct{}{},
+	}, nil
+}
+
+// begin appends the entry to the log before it is applied. Every successful call has to be followed
+// by a call to end once the entry has been applied.
+func (w *wal) begin(e walEntry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	w.apply.RLock()
+	w.mu.Lock()
+	_, err = w.f.Write(append(line, '\n'))
+	if err == nil && w.policy == config.FsyncPolicyAlways {
+		err = w.f.Sync()
+	}
+	if err == nil {
+		w.entries++
+	}
+	w.mu.Unlock()
+
+	if err != nil {
+		w.apply.RUnlock()
+		return err
+	}
+	if w.policy == config.FsyncPolicyInterval {
+		w.flusher.add(w.f.Name())
+	}
+	return nil
+}
+
+// end marks the entry as applied and runs a checkpoint once enough entries have been logged.
+func (w *wal) end() {
+	w.apply.RUnlock()
+
+	w.mu.Lock()
+	full := w.checkpointEntries > 0 && w.entries >= w.checkpointEntries
+	w.mu.Unlock()
+	if full {
+		if err := w.checkpoint(); err != nil {
+			w.log.Error().Err(err).Msg("could not checkpoint the write-ahead log")
+		}
+	}
+}
+
+// markDirty remembers paths that have to be synced before the log can be truncated.
+func (w *wal) markDirty(paths ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, p := range paths {
+		w.dirty[p] = struct{}{}
+	}
+}
+
+// checkpoint syncs all records changed since the last checkpoint and truncates the log.
+func (w *wal) checkpoint() error {
+	w.apply.Lock()
+	defer w.apply.Unlock()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.entries == 0 {
+		return nil
+	}
+	for p := range w.dirty {
+		// the record may have been deleted in the meantime
+		if err := syncPath(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := w.f.Truncate(0); err != nil {
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		return err
+	}
+	w.entries = 0
+	w.dirty = map[string]struct{}{}
+	return nil
+}
+
+// Close runs a final checkpoint and closes the log.
+func (w *wal) Close() error {
+	err := w.checkpoint()
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// replayWAL applies the entries left in the write-ahead log by a crash and removes the log afterwards.
+// The entries are applied again even if they already were, writes and deletes can be repeated safely.
+// A torn entry at the end of the log was never acknowledged and is ignored.
+func (s *Service) replayWAL(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	var (
+		replayed int
+		touched  []string
+	)
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				s.log.Warn().Str("path", path).Msg("ignoring torn write-ahead log entry")
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		var e walEntry
+		if err := json.Unmarshal(line, &e); err != nil {
+			s.log.Warn().Err(err).Str("path", path).Msg("ignoring corrupt write-ahead log entry")
+			break
+		}
+		id := filepath.Clean(e.ID)
+		if filepath.IsAbs(id) || id == ".." || strings.HasPrefix(id, ".."+string(filepath.Separator)) {
+			s.log.Warn().Str("id", e.ID).Msg("ignoring write-ahead log entry outside of the databases directory")
+			continue
+		}
+		file := filepath.Join(s.Config.Datapath, "databases", id)
+
+		switch e.Op {
+		case walOpWrite:
+			err = s.writeFile(context.Background(), file, e.Data)
+			touched = append(touched, file, filepath.Dir(file))
+		case walOpDelete:
+			if err = os.Remove(file); os.IsNotExist(err) {
+				err = nil
+			}
+			touched = append(touched, filepath.Dir(file))
+		default:
+			s.log.Warn().Str("op", e.Op).Msg("ignoring unknown write-ahead log entry")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		replayed++
+	}
+
+	for _, p := range touched {
+		if err := syncPath(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+	if replayed > 0 {
+		s.log.Info().Int("entries", replayed).Msg("replayed the write-ahead log")
+	}
+	return syncPath(filepath.Dir(path))
+}